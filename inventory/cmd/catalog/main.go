@@ -0,0 +1,181 @@
+// Команда catalog загружает каталог деталей из файла и выгружает его в файл.
+//
+//	catalog import -file parts.yaml [-format yaml] [-dry-run] [-upsert]
+//	catalog export -file parts.csv [-format csv]
+//
+// Формат по умолчанию определяется по расширению файла; "-" вместо пути - stdin/stdout.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/app"
+	"github.com/bogdanovds/rocket_factory/inventory/internal/catalog"
+	"github.com/bogdanovds/rocket_factory/inventory/internal/config"
+	mongoRepo "github.com/bogdanovds/rocket_factory/inventory/internal/repository/mongo"
+	partService "github.com/bogdanovds/rocket_factory/inventory/internal/service/part"
+)
+
+const usage = `usage:
+  catalog import -file <path> [-format json|csv|yaml] [-dry-run] [-upsert]
+  catalog export -file <path> [-format json|csv|yaml]`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprintln(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	if err := config.Load(); err != nil {
+		panic(fmt.Errorf("failed to load config: %w", err))
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	var err error
+	switch os.Args[1] {
+	case "import":
+		err = runImport(ctx, os.Args[2:])
+	case "export":
+		err = runExport(ctx, os.Args[2:])
+	default:
+		fmt.Fprintln(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+		cancel()
+		os.Exit(1)
+	}
+}
+
+func runImport(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("import", flag.ExitOnError)
+	path := flags.String("file", "", "catalog file path, - for stdin")
+	formatName := flags.String("format", "", "json, csv or yaml (default: by file extension)")
+	dryRun := flags.Bool("dry-run", false, "validate the file and report changes without saving")
+	upsert := flags.Bool("upsert", false, "update parts with existing uuid instead of failing")
+	_ = flags.Parse(args)
+
+	format, err := resolveFormat(*path, *formatName)
+	if err != nil {
+		return err
+	}
+
+	in := io.Reader(os.Stdin)
+	if *path != "-" {
+		f, err := os.Open(*path)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = f.Close() }()
+		in = f
+	}
+
+	rows, err := catalog.Decode(in, format)
+	if err != nil {
+		return err
+	}
+
+	importer, closeFn, err := newImporter(ctx)
+	if err != nil {
+		return err
+	}
+	defer closeFn()
+
+	report, err := importer.Import(ctx, rows, catalog.ImportOptions{DryRun: *dryRun, Upsert: *upsert})
+	if report != nil {
+		prefix := "✅ Импорт завершён"
+		if *dryRun {
+			prefix = "ℹ️ Проверка без сохранения"
+		}
+		fmt.Printf("%s: создано %d, обновлено %d, без изменений %d\n", prefix, report.Created, report.Updated, report.Unchanged)
+	}
+
+	return err
+}
+
+func runExport(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("export", flag.ExitOnError)
+	path := flags.String("file", "", "catalog file path, - for stdout")
+	formatName := flags.String("format", "", "json, csv or yaml (default: by file extension)")
+	_ = flags.Parse(args)
+
+	format, err := resolveFormat(*path, *formatName)
+	if err != nil {
+		return err
+	}
+
+	importer, closeFn, err := newImporter(ctx)
+	if err != nil {
+		return err
+	}
+	defer closeFn()
+
+	if *path == "-" {
+		return importer.Export(ctx, os.Stdout, format)
+	}
+
+	f, err := os.Create(*path)
+	if err != nil {
+		return err
+	}
+
+	return errors.Join(importer.Export(ctx, f, format), f.Close())
+}
+
+func resolveFormat(path, name string) (catalog.Format, error) {
+	switch {
+	case path == "":
+		return "", errors.New("-file is required")
+	case name != "":
+		return catalog.ParseFormat(name)
+	case path == "-":
+		return "", errors.New("-format is required when reading stdin or writing stdout")
+	default:
+		return catalog.FormatFromPath(path)
+	}
+}
+
+// newImporter подключается к MongoDB и собирает сервис деталей так же, как сервер inventory
+func newImporter(ctx context.Context) (*catalog.Importer, func(), error) {
+	cfg := config.AppConfig()
+
+	client, err := mongoRepo.Connect(ctx, cfg.Mongo.URI())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	closeFn := func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		if err := mongoRepo.Disconnect(ctx, client); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+		}
+	}
+
+	repos := &app.CatalogRepositories{
+		Parts:         mongoRepo.NewRepository(client, cfg.Mongo.DatabaseName()),
+		Manufacturers: mongoRepo.NewManufacturerRepository(client, cfg.Mongo.DatabaseName()),
+		Categories:    mongoRepo.NewCategoryRepository(client, cfg.Mongo.DatabaseName()),
+		Warehouses:    mongoRepo.NewWarehouseRepository(client, cfg.Mongo.DatabaseName()),
+	}
+	if err := app.PrepareCatalog(ctx, repos); err != nil {
+		closeFn()
+		return nil, nil, err
+	}
+
+	service := partService.NewPartService(repos.Parts, repos.Manufacturers, repos.Categories, repos.Warehouses, cfg.Pagination.PageTokenSecret())
+	return catalog.NewImporter(service), closeFn, nil
+}
@@ -1,23 +1,83 @@
 package v1
 
 import (
+	"errors"
+
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
 	"github.com/bogdanovds/rocket_factory/inventory/internal/service"
 	inventoryV1 "github.com/bogdanovds/rocket_factory/shared/pkg/proto/inventory/v1"
 )
 
 type InventoryAPI struct {
 	inventoryV1.UnimplementedInventoryServiceServer
-	partService service.PartService
+	partService         service.PartService
+	manufacturerService service.ManufacturerService
+	categoryService     service.CategoryService
+	warehouseService    service.WarehouseService
 }
 
-func NewInventoryAPI(partService service.PartService) *InventoryAPI {
+func NewInventoryAPI(
+	partService service.PartService,
+	manufacturerService service.ManufacturerService,
+	categoryService service.CategoryService,
+	warehouseService service.WarehouseService,
+) *InventoryAPI {
 	return &InventoryAPI{
-		partService: partService,
+		partService:         partService,
+		manufacturerService: manufacturerService,
+		categoryService:     categoryService,
+		warehouseService:    warehouseService,
 	}
 }
 
 func RegisterInventoryServiceServer(s *grpc.Server, api *InventoryAPI) {
 	inventoryV1.RegisterInventoryServiceServer(s, api)
 }
+
+// toStatusError преобразует ошибки сервисного слоя в gRPC-статусы
+func toStatusError(err error) error {
+	switch {
+	case errors.Is(err, model.ErrInvalidPart),
+		errors.Is(err, model.ErrInvalidPageToken),
+		errors.Is(err, model.ErrInvalidListQuery),
+		errors.Is(err, model.ErrInvalidBatchGet),
+		errors.Is(err, model.ErrInvalidStockAdjustment),
+		errors.Is(err, model.ErrInvalidResumeToken),
+		errors.Is(err, model.ErrInvalidBomRequest),
+		errors.Is(err, model.ErrInvalidManufacturer),
+		errors.Is(err, model.ErrInvalidCategory),
+		errors.Is(err, model.ErrInvalidPriceChange),
+		errors.Is(err, model.ErrInvalidWarehouse),
+		errors.Is(err, model.ErrInvalidStockTransfer),
+		errors.Is(err, model.ErrInvalidFulfillment),
+		errors.Is(err, model.ErrInvalidLifecycleChange):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, model.ErrResumeTokenExpired):
+		return status.Error(codes.OutOfRange, err.Error())
+	case errors.Is(err, model.ErrInsufficientStock),
+		errors.Is(err, model.ErrInvalidBom),
+		errors.Is(err, model.ErrPartInUse),
+		errors.Is(err, model.ErrManufacturerInUse),
+		errors.Is(err, model.ErrCategoryInUse),
+		errors.Is(err, model.ErrLifecycleTransitionNotAllowed):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, model.ErrPartNotFound),
+		errors.Is(err, model.ErrManufacturerNotFound),
+		errors.Is(err, model.ErrCategoryNotFound),
+		errors.Is(err, model.ErrWarehouseNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, model.ErrPartAlreadyExists),
+		errors.Is(err, model.ErrManufacturerAlreadyExists),
+		errors.Is(err, model.ErrCategoryAlreadyExists),
+		errors.Is(err, model.ErrWarehouseAlreadyExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, model.ErrConcurrentModification):
+		return status.Error(codes.Aborted, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
@@ -0,0 +1,24 @@
+package v1
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/converter"
+	inventoryV1 "github.com/bogdanovds/rocket_factory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *InventoryAPI) ExpandBom(ctx context.Context, req *inventoryV1.ExpandBomRequest) (*inventoryV1.ExpandBomResponse, error) {
+	if req.GetPartUuid() == "" {
+		return nil, status.Error(codes.InvalidArgument, "part_uuid is required")
+	}
+
+	bom, err := a.partService.ExpandBom(ctx, req.GetPartUuid(), req.GetQuantity())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return converter.ToProtoExpandBomResponse(bom), nil
+}
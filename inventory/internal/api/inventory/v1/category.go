@@ -0,0 +1,97 @@
+package v1
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/converter"
+	inventoryV1 "github.com/bogdanovds/rocket_factory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *InventoryAPI) CreateCategory(ctx context.Context, req *inventoryV1.CreateCategoryRequest) (*inventoryV1.CreateCategoryResponse, error) {
+	if req.GetCategory() == nil {
+		return nil, status.Error(codes.InvalidArgument, "category is required")
+	}
+
+	category, err := a.categoryService.CreateCategory(ctx, converter.ToModelCategoryNode(req.GetCategory()))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &inventoryV1.CreateCategoryResponse{Category: converter.ToProtoCategoryNode(category)}, nil
+}
+
+func (a *InventoryAPI) GetCategory(ctx context.Context, req *inventoryV1.GetCategoryRequest) (*inventoryV1.GetCategoryResponse, error) {
+	if req.GetUuid() == "" {
+		return nil, status.Error(codes.InvalidArgument, "uuid is required")
+	}
+
+	category, err := a.categoryService.GetCategory(ctx, req.GetUuid())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &inventoryV1.GetCategoryResponse{Category: converter.ToProtoCategoryNode(category)}, nil
+}
+
+func (a *InventoryAPI) ListCategories(ctx context.Context, req *inventoryV1.ListCategoriesRequest) (*inventoryV1.ListCategoriesResponse, error) {
+	categories, err := a.categoryService.ListCategories(ctx, req.GetRootUuid())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &inventoryV1.ListCategoriesResponse{Categories: converter.ToProtoCategoryNodes(categories)}, nil
+}
+
+func (a *InventoryAPI) UpdateCategory(ctx context.Context, req *inventoryV1.UpdateCategoryRequest) (*inventoryV1.UpdateCategoryResponse, error) {
+	if req.GetCategory().GetUuid() == "" {
+		return nil, status.Error(codes.InvalidArgument, "category.uuid is required")
+	}
+
+	category, err := a.categoryService.UpdateCategory(ctx, converter.ToModelCategoryNode(req.GetCategory()))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &inventoryV1.UpdateCategoryResponse{Category: converter.ToProtoCategoryNode(category)}, nil
+}
+
+func (a *InventoryAPI) DeleteCategory(ctx context.Context, req *inventoryV1.DeleteCategoryRequest) (*inventoryV1.DeleteCategoryResponse, error) {
+	if req.GetUuid() == "" {
+		return nil, status.Error(codes.InvalidArgument, "uuid is required")
+	}
+
+	if err := a.categoryService.DeleteCategory(ctx, req.GetUuid()); err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &inventoryV1.DeleteCategoryResponse{}, nil
+}
+
+func (a *InventoryAPI) SetCategoryMetadataSchema(ctx context.Context, req *inventoryV1.SetCategoryMetadataSchemaRequest) (*inventoryV1.SetCategoryMetadataSchemaResponse, error) {
+	if req.GetCategoryUuid() == "" {
+		return nil, status.Error(codes.InvalidArgument, "category_uuid is required")
+	}
+
+	category, err := a.categoryService.SetMetadataSchema(ctx, req.GetCategoryUuid(), converter.ToModelMetadataFields(req.GetFields()))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &inventoryV1.SetCategoryMetadataSchemaResponse{Category: converter.ToProtoCategoryNode(category)}, nil
+}
+
+func (a *InventoryAPI) GetCategoryMetadataSchema(ctx context.Context, req *inventoryV1.GetCategoryMetadataSchemaRequest) (*inventoryV1.GetCategoryMetadataSchemaResponse, error) {
+	if req.GetCategoryUuid() == "" {
+		return nil, status.Error(codes.InvalidArgument, "category_uuid is required")
+	}
+
+	fields, err := a.categoryService.GetMetadataSchema(ctx, req.GetCategoryUuid())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &inventoryV1.GetCategoryMetadataSchemaResponse{Fields: converter.ToProtoMetadataFields(fields)}, nil
+}
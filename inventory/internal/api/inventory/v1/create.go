@@ -0,0 +1,24 @@
+package v1
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/converter"
+	inventoryV1 "github.com/bogdanovds/rocket_factory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *InventoryAPI) CreatePart(ctx context.Context, req *inventoryV1.CreatePartRequest) (*inventoryV1.CreatePartResponse, error) {
+	if req.GetPart() == nil {
+		return nil, status.Error(codes.InvalidArgument, "part is required")
+	}
+
+	part, err := a.partService.CreatePart(ctx, converter.ToModelPart(req.GetPart()))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &inventoryV1.CreatePartResponse{Part: converter.ToProtoPart(part)}, nil
+}
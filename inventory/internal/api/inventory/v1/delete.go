@@ -0,0 +1,22 @@
+package v1
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	inventoryV1 "github.com/bogdanovds/rocket_factory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *InventoryAPI) DeletePart(ctx context.Context, req *inventoryV1.DeletePartRequest) (*inventoryV1.DeletePartResponse, error) {
+	if req.GetUuid() == "" {
+		return nil, status.Error(codes.InvalidArgument, "uuid is required")
+	}
+
+	if err := a.partService.DeletePart(ctx, req.GetUuid()); err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &inventoryV1.DeletePartResponse{}, nil
+}
@@ -0,0 +1,17 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/converter"
+	inventoryV1 "github.com/bogdanovds/rocket_factory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *InventoryAPI) GetPartFacets(ctx context.Context, req *inventoryV1.GetPartFacetsRequest) (*inventoryV1.GetPartFacetsResponse, error) {
+	facets, err := a.partService.GetPartFacets(ctx, converter.ToProtoPartsFilter(req.GetFilter()))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return converter.ToProtoGetPartFacetsResponse(facets), nil
+}
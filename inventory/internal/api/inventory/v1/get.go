@@ -11,10 +11,19 @@ import (
 )
 
 func (a *InventoryAPI) GetPart(ctx context.Context, req *inventoryV1.GetPartRequest) (*inventoryV1.GetPartResponse, error) {
-	part, err := a.partService.GetPart(ctx, req.GetUuid())
+	part, err := a.partService.GetPart(ctx, req.GetUuid(), converter.ToModelAsOf(req.GetAsOf()))
 	if err != nil {
 		return nil, status.Errorf(codes.NotFound, "part with UUID %s not found", req.Uuid)
 	}
 
 	return &inventoryV1.GetPartResponse{Part: converter.ToProtoPart(part)}, nil
 }
+
+func (a *InventoryAPI) BatchGetParts(ctx context.Context, req *inventoryV1.BatchGetPartsRequest) (*inventoryV1.BatchGetPartsResponse, error) {
+	batch, err := a.partService.BatchGetParts(ctx, req.GetUuids(), converter.ToModelAsOf(req.GetAsOf()))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return converter.ToProtoBatchGetPartsResponse(batch), nil
+}
@@ -0,0 +1,24 @@
+package v1
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/converter"
+	inventoryV1 "github.com/bogdanovds/rocket_factory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *InventoryAPI) ChangePartLifecycleState(ctx context.Context, req *inventoryV1.ChangePartLifecycleStateRequest) (*inventoryV1.ChangePartLifecycleStateResponse, error) {
+	if req.GetPartUuid() == "" {
+		return nil, status.Error(codes.InvalidArgument, "part_uuid is required")
+	}
+
+	part, err := a.partService.ChangeLifecycleState(ctx, converter.ToModelLifecycleChange(req))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &inventoryV1.ChangePartLifecycleStateResponse{Part: converter.ToProtoPart(part)}, nil
+}
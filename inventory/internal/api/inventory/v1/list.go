@@ -8,15 +8,19 @@ import (
 )
 
 func (a *InventoryAPI) ListParts(ctx context.Context, req *inventoryV1.ListPartsRequest) (*inventoryV1.ListPartsResponse, error) {
-	modelParts, err := a.partService.ListParts(ctx, converter.ToProtoPartsFilter(req.Filter))
+	page, err := a.partService.ListParts(ctx, converter.ToModelListPartsParams(req))
 	if err != nil {
-		return nil, err
+		return nil, toStatusError(err)
 	}
 
-	protoParts := make([]*inventoryV1.Part, 0, len(modelParts))
-	for _, part := range modelParts {
+	protoParts := make([]*inventoryV1.Part, 0, len(page.Parts))
+	for _, part := range page.Parts {
 		protoParts = append(protoParts, converter.ToProtoPart(part))
 	}
 
-	return &inventoryV1.ListPartsResponse{Parts: protoParts}, nil
+	return &inventoryV1.ListPartsResponse{
+		Parts:         protoParts,
+		NextPageToken: page.NextPageToken,
+		TotalSize:     int32(page.TotalSize),
+	}, nil
 }
@@ -0,0 +1,75 @@
+package v1
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/converter"
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+	inventoryV1 "github.com/bogdanovds/rocket_factory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *InventoryAPI) CreateManufacturer(ctx context.Context, req *inventoryV1.CreateManufacturerRequest) (*inventoryV1.CreateManufacturerResponse, error) {
+	if req.GetManufacturer() == nil {
+		return nil, status.Error(codes.InvalidArgument, "manufacturer is required")
+	}
+
+	manufacturer, err := a.manufacturerService.CreateManufacturer(ctx, converter.ToModelManufacturer(req.GetManufacturer()))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &inventoryV1.CreateManufacturerResponse{Manufacturer: converter.ToProtoManufacturer(manufacturer)}, nil
+}
+
+func (a *InventoryAPI) GetManufacturer(ctx context.Context, req *inventoryV1.GetManufacturerRequest) (*inventoryV1.GetManufacturerResponse, error) {
+	if req.GetUuid() == "" {
+		return nil, status.Error(codes.InvalidArgument, "uuid is required")
+	}
+
+	manufacturer, err := a.manufacturerService.GetManufacturer(ctx, req.GetUuid())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &inventoryV1.GetManufacturerResponse{Manufacturer: converter.ToProtoManufacturer(manufacturer)}, nil
+}
+
+func (a *InventoryAPI) ListManufacturers(ctx context.Context, req *inventoryV1.ListManufacturersRequest) (*inventoryV1.ListManufacturersResponse, error) {
+	manufacturers, err := a.manufacturerService.ListManufacturers(ctx, &model.ManufacturersFilter{Countries: req.GetCountries()})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &inventoryV1.ListManufacturersResponse{Manufacturers: converter.ToProtoManufacturers(manufacturers)}, nil
+}
+
+func (a *InventoryAPI) UpdateManufacturer(ctx context.Context, req *inventoryV1.UpdateManufacturerRequest) (*inventoryV1.UpdateManufacturerResponse, error) {
+	if req.GetManufacturer().GetUuid() == "" {
+		return nil, status.Error(codes.InvalidArgument, "manufacturer.uuid is required")
+	}
+
+	manufacturer, updatedParts, err := a.manufacturerService.UpdateManufacturer(ctx, converter.ToModelManufacturer(req.GetManufacturer()))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &inventoryV1.UpdateManufacturerResponse{
+		Manufacturer: converter.ToProtoManufacturer(manufacturer),
+		UpdatedParts: int32(updatedParts),
+	}, nil
+}
+
+func (a *InventoryAPI) DeleteManufacturer(ctx context.Context, req *inventoryV1.DeleteManufacturerRequest) (*inventoryV1.DeleteManufacturerResponse, error) {
+	if req.GetUuid() == "" {
+		return nil, status.Error(codes.InvalidArgument, "uuid is required")
+	}
+
+	if err := a.manufacturerService.DeleteManufacturer(ctx, req.GetUuid()); err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &inventoryV1.DeleteManufacturerResponse{}, nil
+}
@@ -0,0 +1,45 @@
+package v1
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/converter"
+	inventoryV1 "github.com/bogdanovds/rocket_factory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *InventoryAPI) SchedulePriceChange(ctx context.Context, req *inventoryV1.SchedulePriceChangeRequest) (*inventoryV1.SchedulePriceChangeResponse, error) {
+	if req.GetPartUuid() == "" {
+		return nil, status.Error(codes.InvalidArgument, "part_uuid is required")
+	}
+	if req.GetEffectiveFrom() == nil {
+		return nil, status.Error(codes.InvalidArgument, "effective_from is required")
+	}
+
+	version, err := a.partService.SchedulePriceChange(ctx, converter.ToModelPriceChange(req))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &inventoryV1.SchedulePriceChangeResponse{PriceVersion: converter.ToProtoPriceVersion(version)}, nil
+}
+
+func (a *InventoryAPI) GetPriceHistory(ctx context.Context, req *inventoryV1.GetPriceHistoryRequest) (*inventoryV1.GetPriceHistoryResponse, error) {
+	if req.GetPartUuid() == "" {
+		return nil, status.Error(codes.InvalidArgument, "part_uuid is required")
+	}
+
+	history, err := a.partService.GetPriceHistory(ctx, req.GetPartUuid())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	versions := make([]*inventoryV1.PriceVersion, len(history))
+	for i := range history {
+		versions[i] = converter.ToProtoPriceVersion(&history[i])
+	}
+
+	return &inventoryV1.GetPriceHistoryResponse{Versions: versions}, nil
+}
@@ -0,0 +1,93 @@
+package v1
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/converter"
+	inventoryV1 "github.com/bogdanovds/rocket_factory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *InventoryAPI) AdjustStock(ctx context.Context, req *inventoryV1.AdjustStockRequest) (*inventoryV1.AdjustStockResponse, error) {
+	if req.GetPartUuid() == "" {
+		return nil, status.Error(codes.InvalidArgument, "part_uuid is required")
+	}
+
+	movement, err := a.partService.AdjustStock(ctx, converter.ToModelStockAdjustment(req))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &inventoryV1.AdjustStockResponse{Movement: converter.ToProtoStockMovement(movement)}, nil
+}
+
+func (a *InventoryAPI) ListStockMovements(ctx context.Context, req *inventoryV1.ListStockMovementsRequest) (*inventoryV1.ListStockMovementsResponse, error) {
+	if req.GetPartUuid() == "" {
+		return nil, status.Error(codes.InvalidArgument, "part_uuid is required")
+	}
+
+	page, err := a.partService.ListStockMovements(ctx, converter.ToModelListStockMovementsParams(req))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	movements := make([]*inventoryV1.StockMovement, len(page.Movements))
+	for i, m := range page.Movements {
+		movements[i] = converter.ToProtoStockMovement(m)
+	}
+
+	return &inventoryV1.ListStockMovementsResponse{
+		Movements:     movements,
+		NextPageToken: page.NextPageToken,
+	}, nil
+}
+
+func (a *InventoryAPI) TransferStock(ctx context.Context, req *inventoryV1.TransferStockRequest) (*inventoryV1.TransferStockResponse, error) {
+	if req.GetPartUuid() == "" {
+		return nil, status.Error(codes.InvalidArgument, "part_uuid is required")
+	}
+
+	outgoing, incoming, err := a.partService.TransferStock(ctx, converter.ToModelStockTransfer(req))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &inventoryV1.TransferStockResponse{
+		Outgoing: converter.ToProtoStockMovement(outgoing),
+		Incoming: converter.ToProtoStockMovement(incoming),
+	}, nil
+}
+
+func (a *InventoryAPI) FulfillStock(ctx context.Context, req *inventoryV1.FulfillStockRequest) (*inventoryV1.FulfillStockResponse, error) {
+	movements, err := a.partService.FulfillStock(ctx, converter.ToModelStockFulfillment(req))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	result := make([]*inventoryV1.StockMovement, len(movements))
+	for i, m := range movements {
+		result[i] = converter.ToProtoStockMovement(m)
+	}
+
+	return &inventoryV1.FulfillStockResponse{Movements: result}, nil
+}
+
+func (a *InventoryAPI) ListLowStockParts(ctx context.Context, req *inventoryV1.ListLowStockPartsRequest) (*inventoryV1.ListLowStockPartsResponse, error) {
+	page, err := a.partService.ListParts(ctx, converter.ToModelListLowStockPartsParams(req))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	parts := make([]*inventoryV1.Part, len(page.Parts))
+	for i, part := range page.Parts {
+		parts[i] = converter.ToProtoPart(part)
+	}
+
+	return &inventoryV1.ListLowStockPartsResponse{
+		Parts:         parts,
+		NextPageToken: page.NextPageToken,
+		TotalSize:     int32(page.TotalSize),
+	}, nil
+}
@@ -0,0 +1,33 @@
+package v1
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/converter"
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+	inventoryV1 "github.com/bogdanovds/rocket_factory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *InventoryAPI) UpdatePart(ctx context.Context, req *inventoryV1.UpdatePartRequest) (*inventoryV1.UpdatePartResponse, error) {
+	if req.GetPart().GetUuid() == "" {
+		return nil, status.Error(codes.InvalidArgument, "part.uuid is required")
+	}
+
+	mask := req.GetUpdateMask()
+	if mask != nil && !mask.IsValid(req.GetPart()) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid update_mask: %v", mask.GetPaths())
+	}
+
+	part, err := a.partService.UpdatePart(ctx, &model.PartUpdate{
+		Part:  converter.ToModelPart(req.GetPart()),
+		Paths: mask.GetPaths(),
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &inventoryV1.UpdatePartResponse{Part: converter.ToProtoPart(part)}, nil
+}
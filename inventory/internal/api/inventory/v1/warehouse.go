@@ -0,0 +1,46 @@
+package v1
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/converter"
+	inventoryV1 "github.com/bogdanovds/rocket_factory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *InventoryAPI) CreateWarehouse(ctx context.Context, req *inventoryV1.CreateWarehouseRequest) (*inventoryV1.CreateWarehouseResponse, error) {
+	if req.GetWarehouse() == nil {
+		return nil, status.Error(codes.InvalidArgument, "warehouse is required")
+	}
+
+	warehouse, err := a.warehouseService.CreateWarehouse(ctx, converter.ToModelWarehouse(req.GetWarehouse()))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &inventoryV1.CreateWarehouseResponse{Warehouse: converter.ToProtoWarehouse(warehouse)}, nil
+}
+
+func (a *InventoryAPI) GetWarehouse(ctx context.Context, req *inventoryV1.GetWarehouseRequest) (*inventoryV1.GetWarehouseResponse, error) {
+	if req.GetUuid() == "" {
+		return nil, status.Error(codes.InvalidArgument, "uuid is required")
+	}
+
+	warehouse, err := a.warehouseService.GetWarehouse(ctx, req.GetUuid())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &inventoryV1.GetWarehouseResponse{Warehouse: converter.ToProtoWarehouse(warehouse)}, nil
+}
+
+func (a *InventoryAPI) ListWarehouses(ctx context.Context, _ *inventoryV1.ListWarehousesRequest) (*inventoryV1.ListWarehousesResponse, error) {
+	warehouses, err := a.warehouseService.ListWarehouses(ctx)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &inventoryV1.ListWarehousesResponse{Warehouses: converter.ToProtoWarehouses(warehouses)}, nil
+}
@@ -0,0 +1,24 @@
+package v1
+
+import (
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/converter"
+	inventoryV1 "github.com/bogdanovds/rocket_factory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *InventoryAPI) WatchParts(req *inventoryV1.WatchPartsRequest, stream grpc.ServerStreamingServer[inventoryV1.WatchPartsResponse]) error {
+	for event, err := range a.partService.WatchParts(stream.Context(), converter.ToModelWatchPartsParams(req)) {
+		if err != nil {
+			return toStatusError(err)
+		}
+
+		if err := stream.Send(converter.ToProtoPartEvent(event)); err != nil {
+			return err
+		}
+	}
+
+	// Поток изменений бесконечен и без ошибки заканчивается, только когда клиент отключился
+	return status.FromContextError(stream.Context().Err()).Err()
+}
@@ -4,13 +4,20 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"net/http"
+	"time"
 
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
 	"github.com/pkg/errors"
+	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
 
 	"github.com/bogdanovds/rocket_factory/inventory/internal/config"
+	partRepo "github.com/bogdanovds/rocket_factory/inventory/internal/repository/part"
+	"github.com/bogdanovds/rocket_factory/inventory/internal/service"
 	"github.com/bogdanovds/rocket_factory/platform/pkg/closer"
 	"github.com/bogdanovds/rocket_factory/platform/pkg/grpc/health"
 	"github.com/bogdanovds/rocket_factory/platform/pkg/logger"
@@ -22,6 +29,7 @@ type App struct {
 	diContainer *diContainer
 	grpcServer  *grpc.Server
 	listener    net.Listener
+	httpServer  *http.Server
 }
 
 // New создаёт новое приложение
@@ -38,7 +46,19 @@ func New(ctx context.Context) (*App, error) {
 
 // Run запускает приложение
 func (a *App) Run(ctx context.Context) error {
-	return a.runGRPCServer(ctx)
+	// Сервисы создаются до запуска горутин, чтобы ошибка конфигурации остановила старт приложения
+	go a.runPriceScheduler(ctx, a.diContainer.PartService(ctx))
+	go a.runLowStockChecker(ctx, a.diContainer.LowStockService(ctx))
+	if repo := a.diContainer.PersistentPartRepository(ctx); repo != nil {
+		go a.runPartsSnapshotter(ctx, repo)
+	}
+
+	// Приложение завершается, когда останавливается любой из серверов
+	errCh := make(chan error, 2)
+	go func() { errCh <- a.runHTTPServer(ctx) }()
+	go func() { errCh <- a.runGRPCServer(ctx) }()
+
+	return <-errCh
 }
 
 func (a *App) initDeps(ctx context.Context) error {
@@ -48,6 +68,7 @@ func (a *App) initDeps(ctx context.Context) error {
 		a.initCloser,
 		a.initListener,
 		a.initGRPCServer,
+		a.initHTTPServer,
 	}
 
 	for _, f := range inits {
@@ -114,6 +135,89 @@ func (a *App) initGRPCServer(ctx context.Context) error {
 	return nil
 }
 
+func (a *App) initHTTPServer(ctx context.Context) error {
+	r := chi.NewRouter()
+
+	r.Use(middleware.Logger)
+	r.Use(middleware.Recoverer)
+	r.Use(middleware.Timeout(10 * time.Second))
+
+	r.Mount("/", a.diContainer.InventoryV1Gateway(ctx))
+
+	a.httpServer = &http.Server{
+		Addr:              config.AppConfig().HTTP.Address(),
+		Handler:           r,
+		ReadHeaderTimeout: config.AppConfig().HTTP.ReadTimeout(),
+	}
+
+	closer.AddNamed("HTTP server", func(ctx context.Context) error {
+		return a.httpServer.Shutdown(ctx)
+	})
+
+	return nil
+}
+
+// runPriceScheduler периодически переносит в детали наступившие запланированные цены, пока ctx не отменён
+func (a *App) runPriceScheduler(ctx context.Context, partService service.PartService) {
+	runPeriodically(ctx, config.AppConfig().Pricing.ScheduleCheckInterval(), func() {
+		applied, err := partService.ApplyScheduledPrices(ctx)
+		if err != nil {
+			logger.Warn(ctx, "Failed to apply scheduled prices", zap.Error(err))
+		}
+		if applied > 0 {
+			logger.Info(ctx, "✅ Applied scheduled prices", zap.Int("parts", applied))
+		}
+	})
+}
+
+// runLowStockChecker периодически проверяет остатки деталей и оповещает о падении до порога дозаказа
+func (a *App) runLowStockChecker(ctx context.Context, lowStockService service.LowStockService) {
+	runPeriodically(ctx, config.AppConfig().LowStock.CheckInterval(), func() {
+		sent, err := lowStockService.CheckLowStock(ctx)
+		if err != nil {
+			logger.Warn(ctx, "Failed to send low stock alerts", zap.Error(err))
+		}
+		if sent > 0 {
+			logger.Info(ctx, "✅ Sent low stock alerts", zap.Int("alerts", sent))
+		}
+	})
+}
+
+// runPartsSnapshotter периодически сохраняет снимок деталей, чтобы журнал предзаписи не рос без ограничений
+func (a *App) runPartsSnapshotter(ctx context.Context, repo *partRepo.Repository) {
+	runPeriodically(ctx, config.AppConfig().Parts.SnapshotInterval(), func() {
+		if err := repo.Snapshot(); err != nil {
+			logger.Warn(ctx, "Failed to save parts snapshot", zap.Error(err))
+		}
+	})
+}
+
+// runPeriodically вызывает task с периодом interval, пока ctx не отменён
+func runPeriodically(ctx context.Context, interval time.Duration, task func()) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			task()
+		}
+	}
+}
+
+func (a *App) runHTTPServer(ctx context.Context) error {
+	logger.Info(ctx, fmt.Sprintf("🚀 HTTP/JSON InventoryService gateway listening on %s", config.AppConfig().HTTP.Address()))
+
+	err := a.httpServer.ListenAndServe()
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+
+	return nil
+}
+
 func (a *App) runGRPCServer(ctx context.Context) error {
 	logger.Info(ctx, fmt.Sprintf("🚀 gRPC InventoryService server listening on %s", config.AppConfig().GRPC.Address()))
 
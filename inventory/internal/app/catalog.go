@@ -0,0 +1,86 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/repository"
+	categoryService "github.com/bogdanovds/rocket_factory/inventory/internal/service/category"
+	warehouseService "github.com/bogdanovds/rocket_factory/inventory/internal/service/warehouse"
+)
+
+// CatalogRepositories - репозитории каталога, с которыми работает сервис деталей
+type CatalogRepositories struct {
+	Parts         repository.PartRepository
+	Manufacturers repository.ManufacturerRepository
+	Categories    repository.CategoryRepository
+	Warehouses    repository.WarehouseRepository
+}
+
+// indexedRepository - репозиторий, которому нужны индексы в хранилище
+type indexedRepository interface {
+	EnsureIndexes(ctx context.Context) error
+}
+
+// backfilledPartRepository - репозиторий деталей, дополняющий детали, сохранённые прежними версиями
+type backfilledPartRepository interface {
+	BackfillCategoryUuids(ctx context.Context) error
+	BackfillStockLevels(ctx context.Context) error
+	BackfillLifecycleStates(ctx context.Context) error
+	BackfillSearchTerms(ctx context.Context) error
+}
+
+// PrepareCatalog готовит хранилища каталога к работе: создаёт индексы, встроенные категории
+// и основной склад и дополняет детали, сохранённые прежними версиями. Её вызывают и сервер,
+// и команда catalog, поэтому обе работают с одинаково подготовленной базой. Повторный вызов
+// ничего не меняет.
+func PrepareCatalog(ctx context.Context, repos *CatalogRepositories) error {
+	for _, r := range []struct {
+		name string
+		repo any
+	}{
+		{"part", repos.Parts},
+		{"manufacturer", repos.Manufacturers},
+		{"category", repos.Categories},
+		{"warehouse", repos.Warehouses},
+	} {
+		if indexed, ok := r.repo.(indexedRepository); ok {
+			if err := indexed.EnsureIndexes(ctx); err != nil {
+				return fmt.Errorf("failed to create %s indexes: %w", r.name, err)
+			}
+		}
+	}
+
+	// Без встроенных категорий фильтр по category_uuids не находит детали со старым enum
+	if err := categoryService.NewCategoryService(repos.Categories, repos.Parts).EnsureBuiltinCategories(ctx); err != nil {
+		return fmt.Errorf("failed to create builtin categories: %w", err)
+	}
+
+	// К основному складу относятся остатки деталей, сохранённых до появления складов
+	if err := warehouseService.NewWarehouseService(repos.Warehouses).EnsureDefaultWarehouse(ctx); err != nil {
+		return fmt.Errorf("failed to create default warehouse: %w", err)
+	}
+
+	parts, ok := repos.Parts.(backfilledPartRepository)
+	if !ok {
+		return nil
+	}
+
+	if err := parts.BackfillCategoryUuids(ctx); err != nil {
+		return fmt.Errorf("failed to link parts to builtin categories: %w", err)
+	}
+
+	if err := parts.BackfillStockLevels(ctx); err != nil {
+		return fmt.Errorf("failed to move parts stock to the default warehouse: %w", err)
+	}
+
+	if err := parts.BackfillLifecycleStates(ctx); err != nil {
+		return fmt.Errorf("failed to mark existing parts as active: %w", err)
+	}
+
+	if err := parts.BackfillSearchTerms(ctx); err != nil {
+		return fmt.Errorf("failed to index existing parts for search: %w", err)
+	}
+
+	return nil
+}
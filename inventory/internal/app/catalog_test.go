@@ -0,0 +1,55 @@
+package app
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+	categoryRepo "github.com/bogdanovds/rocket_factory/inventory/internal/repository/category"
+	manufacturerRepo "github.com/bogdanovds/rocket_factory/inventory/internal/repository/manufacturer"
+	partRepo "github.com/bogdanovds/rocket_factory/inventory/internal/repository/part"
+	warehouseRepo "github.com/bogdanovds/rocket_factory/inventory/internal/repository/warehouse"
+)
+
+type CatalogTestSuite struct {
+	suite.Suite
+	ctx   context.Context
+	repos *CatalogRepositories
+}
+
+func (s *CatalogTestSuite) SetupTest() {
+	s.ctx = context.Background()
+	s.repos = &CatalogRepositories{
+		Parts:         partRepo.NewPartRepository(),
+		Manufacturers: manufacturerRepo.NewManufacturerRepository(),
+		Categories:    categoryRepo.NewCategoryRepository(),
+		Warehouses:    warehouseRepo.NewWarehouseRepository(),
+	}
+}
+
+func (s *CatalogTestSuite) TestPrepareCatalog_CreatesReferenceData() {
+	s.Require().NoError(PrepareCatalog(s.ctx, s.repos))
+
+	categories, err := s.repos.Categories.List(s.ctx)
+	s.Require().NoError(err)
+	s.Len(categories, len(model.BuiltinCategories))
+
+	warehouse, err := s.repos.Warehouses.Get(s.ctx, model.DefaultWarehouse.Uuid)
+	s.Require().NoError(err)
+	s.Equal(model.DefaultWarehouse.Code, warehouse.Code)
+}
+
+func (s *CatalogTestSuite) TestPrepareCatalog_Repeat() {
+	s.Require().NoError(PrepareCatalog(s.ctx, s.repos))
+	s.Require().NoError(PrepareCatalog(s.ctx, s.repos))
+
+	categories, err := s.repos.Categories.List(s.ctx)
+	s.Require().NoError(err)
+	s.Len(categories, len(model.BuiltinCategories))
+}
+
+func TestCatalogTestSuite(t *testing.T) {
+	suite.Run(t, new(CatalogTestSuite))
+}
@@ -10,22 +10,45 @@ import (
 	"go.uber.org/zap"
 
 	api "github.com/bogdanovds/rocket_factory/inventory/internal/api/inventory/v1"
+	"github.com/bogdanovds/rocket_factory/inventory/internal/catalog"
 	"github.com/bogdanovds/rocket_factory/inventory/internal/config"
+	"github.com/bogdanovds/rocket_factory/inventory/internal/gateway"
+	"github.com/bogdanovds/rocket_factory/inventory/internal/notifier"
+	loggingNotifier "github.com/bogdanovds/rocket_factory/inventory/internal/notifier/logging"
+	webhookNotifier "github.com/bogdanovds/rocket_factory/inventory/internal/notifier/webhook"
 	"github.com/bogdanovds/rocket_factory/inventory/internal/repository"
+	categoryRepo "github.com/bogdanovds/rocket_factory/inventory/internal/repository/category"
+	manufacturerRepo "github.com/bogdanovds/rocket_factory/inventory/internal/repository/manufacturer"
 	mongoRepo "github.com/bogdanovds/rocket_factory/inventory/internal/repository/mongo"
+	partRepo "github.com/bogdanovds/rocket_factory/inventory/internal/repository/part"
+	warehouseRepo "github.com/bogdanovds/rocket_factory/inventory/internal/repository/warehouse"
 	"github.com/bogdanovds/rocket_factory/inventory/internal/service"
+	categoryService "github.com/bogdanovds/rocket_factory/inventory/internal/service/category"
+	lowStockService "github.com/bogdanovds/rocket_factory/inventory/internal/service/lowstock"
+	manufacturerService "github.com/bogdanovds/rocket_factory/inventory/internal/service/manufacturer"
 	partService "github.com/bogdanovds/rocket_factory/inventory/internal/service/part"
+	warehouseService "github.com/bogdanovds/rocket_factory/inventory/internal/service/warehouse"
 	"github.com/bogdanovds/rocket_factory/platform/pkg/closer"
 	"github.com/bogdanovds/rocket_factory/platform/pkg/logger"
 	inventoryV1 "github.com/bogdanovds/rocket_factory/shared/pkg/proto/inventory/v1"
 )
 
 type diContainer struct {
-	inventoryV1API inventoryV1.InventoryServiceServer
+	inventoryV1API     inventoryV1.InventoryServiceServer
+	inventoryV1Gateway *gateway.Gateway
 
-	partService service.PartService
+	partService         service.PartService
+	manufacturerService service.ManufacturerService
+	categoryService     service.CategoryService
+	lowStockService     service.LowStockService
+	warehouseService    service.WarehouseService
 
-	partRepository repository.PartRepository
+	lowStockNotifier notifier.LowStockNotifier
+
+	catalogRepositories *CatalogRepositories
+
+	// persistentPartRepository - репозиторий деталей в памяти с сохранением на диск, если он выбран
+	persistentPartRepository *partRepo.Repository
 
 	mongoDBClient *mongo.Client
 	mongoDBHandle *mongo.Database
@@ -38,36 +61,260 @@ func newDIContainer() *diContainer {
 // InventoryV1API возвращает gRPC API сервер
 func (d *diContainer) InventoryV1API(ctx context.Context) inventoryV1.InventoryServiceServer {
 	if d.inventoryV1API == nil {
-		d.inventoryV1API = api.NewInventoryAPI(d.PartService(ctx))
+		d.inventoryV1API = api.NewInventoryAPI(
+			d.PartService(ctx),
+			d.ManufacturerService(ctx),
+			d.CategoryService(ctx),
+			d.WarehouseService(ctx),
+		)
 	}
 
 	return d.inventoryV1API
 }
 
+// InventoryV1Gateway возвращает HTTP/JSON шлюз к gRPC API
+func (d *diContainer) InventoryV1Gateway(ctx context.Context) *gateway.Gateway {
+	if d.inventoryV1Gateway == nil {
+		d.inventoryV1Gateway = gateway.New(d.InventoryV1API(ctx))
+	}
+
+	return d.inventoryV1Gateway
+}
+
 // PartService возвращает сервис деталей
 func (d *diContainer) PartService(ctx context.Context) service.PartService {
 	if d.partService == nil {
-		d.partService = partService.NewPartService(d.PartRepository(ctx))
+		paginationCfg := config.AppConfig().Pagination
+		if paginationCfg.IsPageTokenSecretGenerated() {
+			logger.Warn(ctx, "PAGE_TOKEN_SECRET is not set, page tokens will not survive a restart")
+		}
+
+		d.partService = partService.NewPartService(
+			d.PartRepository(ctx),
+			d.ManufacturerRepository(ctx),
+			d.CategoryRepository(ctx),
+			d.WarehouseRepository(ctx),
+			paginationCfg.PageTokenSecret(),
+		)
+
+		// Заполняем начальные данные
+		if err := d.seedCatalog(ctx); err != nil {
+			// Логируем, но не падаем - сервис работает и с пустым каталогом
+			logger.Warn(ctx, "Failed to seed parts", zap.Error(err))
+		}
 	}
 
 	return d.partService
 }
 
-// PartRepository возвращает репозиторий деталей
-func (d *diContainer) PartRepository(ctx context.Context) repository.PartRepository {
-	if d.partRepository == nil {
-		repo := mongoRepo.NewRepository(d.MongoDBClient(ctx), config.AppConfig().Mongo.DatabaseName())
+// ManufacturerService возвращает сервис справочника производителей
+func (d *diContainer) ManufacturerService(ctx context.Context) service.ManufacturerService {
+	if d.manufacturerService == nil {
+		d.manufacturerService = manufacturerService.NewManufacturerService(d.ManufacturerRepository(ctx), d.PartRepository(ctx))
+	}
 
-		// Заполняем начальные данные
-		if err := repo.SeedParts(ctx); err != nil {
-			// Логируем, но не падаем - данные могут уже существовать
-			logger.Warn(ctx, "Failed to seed parts", zap.Error(err))
+	return d.manufacturerService
+}
+
+// CategoryService возвращает сервис дерева категорий
+func (d *diContainer) CategoryService(ctx context.Context) service.CategoryService {
+	if d.categoryService == nil {
+		d.categoryService = categoryService.NewCategoryService(d.CategoryRepository(ctx), d.PartRepository(ctx))
+	}
+
+	return d.categoryService
+}
+
+// WarehouseService возвращает сервис складов
+func (d *diContainer) WarehouseService(ctx context.Context) service.WarehouseService {
+	if d.warehouseService == nil {
+		d.warehouseService = warehouseService.NewWarehouseService(d.WarehouseRepository(ctx))
+	}
+
+	return d.warehouseService
+}
+
+// LowStockService возвращает сервис оповещений о низком остатке
+func (d *diContainer) LowStockService(ctx context.Context) service.LowStockService {
+	if d.lowStockService == nil {
+		d.lowStockService = lowStockService.NewLowStockService(d.PartRepository(ctx), d.LowStockNotifier(ctx))
+	}
+
+	return d.lowStockService
+}
+
+// LowStockNotifier возвращает оповещатель о низком остатке, выбранный LOW_STOCK_NOTIFIER
+func (d *diContainer) LowStockNotifier(_ context.Context) notifier.LowStockNotifier {
+	if d.lowStockNotifier == nil {
+		cfg := config.AppConfig().LowStock
+
+		switch cfg.Notifier() {
+		case "log":
+			d.lowStockNotifier = loggingNotifier.NewNotifier()
+		case "webhook":
+			if cfg.WebhookURL() == "" {
+				panic("LOW_STOCK_WEBHOOK_URL is required for the webhook notifier")
+			}
+			d.lowStockNotifier = webhookNotifier.NewNotifier(cfg.WebhookURL(), cfg.WebhookTimeout())
+		default:
+			panic(fmt.Sprintf("unknown low stock notifier %q", cfg.Notifier()))
+		}
+	}
+
+	return d.lowStockNotifier
+}
+
+// CatalogRepositories возвращает репозитории каталога, подготовленные PrepareCatalog
+func (d *diContainer) CatalogRepositories(ctx context.Context) *CatalogRepositories {
+	if d.catalogRepositories == nil {
+		repos := &CatalogRepositories{
+			Parts:         d.newPartRepository(ctx),
+			Manufacturers: d.newManufacturerRepository(ctx),
+			Categories:    d.newCategoryRepository(ctx),
+			Warehouses:    d.newWarehouseRepository(ctx),
+		}
+
+		if err := PrepareCatalog(ctx, repos); err != nil {
+			panic(fmt.Sprintf("failed to prepare catalog storage: %v", err))
 		}
 
-		d.partRepository = repo
+		d.catalogRepositories = repos
+	}
+
+	return d.catalogRepositories
+}
+
+// PartRepository возвращает репозиторий деталей, выбранный PARTS_STORAGE
+func (d *diContainer) PartRepository(ctx context.Context) repository.PartRepository {
+	return d.CatalogRepositories(ctx).Parts
+}
+
+// PersistentPartRepository возвращает репозиторий деталей в памяти с сохранением на диск
+// или nil, если детали хранятся иначе
+func (d *diContainer) PersistentPartRepository(ctx context.Context) *partRepo.Repository {
+	d.PartRepository(ctx)
+	return d.persistentPartRepository
+}
+
+// ManufacturerRepository возвращает репозиторий справочника производителей
+func (d *diContainer) ManufacturerRepository(ctx context.Context) repository.ManufacturerRepository {
+	return d.CatalogRepositories(ctx).Manufacturers
+}
+
+// CategoryRepository возвращает репозиторий дерева категорий
+func (d *diContainer) CategoryRepository(ctx context.Context) repository.CategoryRepository {
+	return d.CatalogRepositories(ctx).Categories
+}
+
+// WarehouseRepository возвращает репозиторий складов
+func (d *diContainer) WarehouseRepository(ctx context.Context) repository.WarehouseRepository {
+	return d.CatalogRepositories(ctx).Warehouses
+}
+
+func (d *diContainer) newPartRepository(ctx context.Context) repository.PartRepository {
+	switch backend := config.AppConfig().Parts.Backend(); backend {
+	case "mongo":
+		return d.mongoPartRepository(ctx)
+	case "memory":
+		return d.memoryPartRepository(ctx)
+	default:
+		panic(fmt.Sprintf("unknown parts storage %q", backend))
+	}
+}
+
+func (d *diContainer) mongoPartRepository(ctx context.Context) repository.PartRepository {
+	repo := mongoRepo.NewRepository(d.MongoDBClient(ctx), config.AppConfig().Mongo.DatabaseName())
+
+	// Без pre-images WatchParts не сообщает об удалениях, но остальной сервис работает
+	if err := repo.EnableChangeStreamPreImages(ctx); err != nil {
+		logger.Warn(ctx, "WatchParts will skip delete events", zap.Error(err))
+	}
+
+	return repo
+}
+
+func (d *diContainer) memoryPartRepository(ctx context.Context) repository.PartRepository {
+	dir := config.AppConfig().Parts.DataDir()
+	if dir == "" {
+		logger.Warn(ctx, "PARTS_DATA_DIR is not set, parts will not survive a restart")
+		return partRepo.NewPartRepository()
+	}
+
+	repo, err := partRepo.NewPersistentPartRepository(dir)
+	if err != nil {
+		panic(fmt.Sprintf("failed to restore parts from %s: %v", dir, err))
+	}
+
+	// Снимок при остановке: следующий запуск не проигрывает журнал предзаписи
+	closer.AddNamed("in-memory parts repository", func(ctx context.Context) error {
+		return repo.Close()
+	})
+
+	d.persistentPartRepository = repo
+	return repo
+}
+
+// inMemoryReferenceData сообщает, что справочники (производители, категории, склады) хранятся в памяти.
+// Так бывает только при PARTS_STORAGE=memory без PARTS_DATA_DIR: детали не переживают перезапуск,
+// и сервис работает без MongoDB. С PARTS_DATA_DIR справочники остаются в MongoDB, чтобы после
+// перезапуска восстановленные детали не ссылались на потерянных производителей и категории.
+func inMemoryReferenceData() bool {
+	parts := config.AppConfig().Parts
+	return parts.Backend() == "memory" && parts.DataDir() == ""
+}
+
+func (d *diContainer) newManufacturerRepository(ctx context.Context) repository.ManufacturerRepository {
+	if inMemoryReferenceData() {
+		return manufacturerRepo.NewManufacturerRepository()
+	}
+
+	return mongoRepo.NewManufacturerRepository(d.MongoDBClient(ctx), config.AppConfig().Mongo.DatabaseName())
+}
+
+func (d *diContainer) newCategoryRepository(ctx context.Context) repository.CategoryRepository {
+	if inMemoryReferenceData() {
+		return categoryRepo.NewCategoryRepository()
+	}
+
+	return mongoRepo.NewCategoryRepository(d.MongoDBClient(ctx), config.AppConfig().Mongo.DatabaseName())
+}
+
+func (d *diContainer) newWarehouseRepository(ctx context.Context) repository.WarehouseRepository {
+	if inMemoryReferenceData() {
+		return warehouseRepo.NewWarehouseRepository()
+	}
+
+	return mongoRepo.NewWarehouseRepository(d.MongoDBClient(ctx), config.AppConfig().Mongo.DatabaseName())
+}
+
+// seedCatalog заполняет пустой каталог деталями из файла SEED_FILE через сервис деталей
+func (d *diContainer) seedCatalog(ctx context.Context) error {
+	path := config.AppConfig().Seed.FilePath()
+	if path == "" {
+		return nil
+	}
+
+	count, err := d.PartRepository(ctx).Count(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		logger.Info(ctx, "ℹ️ Parts collection is not empty, skipping seed", zap.Int("count", count))
+		return nil
+	}
+
+	rows, err := catalog.DecodeFile(path)
+	if err != nil {
+		return err
+	}
+
+	report, err := catalog.NewImporter(d.partService).Import(ctx, rows, catalog.ImportOptions{})
+	if err != nil {
+		return err
 	}
 
-	return d.partRepository
+	logger.Info(ctx, "✅ Seeded parts", zap.Int("count", report.Created), zap.String("file", path))
+	return nil
 }
 
 // MongoDBClient возвращает клиент MongoDB
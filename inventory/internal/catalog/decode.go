@@ -0,0 +1,389 @@
+package catalog
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+// Row - деталь из файла каталога с номером строки, на которой начинается её запись
+type Row struct {
+	Line int
+	Part *model.Part
+}
+
+// LineError - ошибка в записи каталога с номером строки файла
+type LineError struct {
+	Line int
+	Err  error
+}
+
+func (e *LineError) Error() string {
+	return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+}
+
+func (e *LineError) Unwrap() error {
+	return e.Err
+}
+
+// DecodeFile читает файл каталога, определяя формат по расширению
+func DecodeFile(path string) ([]Row, error) {
+	format, err := FormatFromPath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open catalog file: %w", err)
+	}
+	defer func() { _ = f.Close() }()
+
+	return Decode(f, format)
+}
+
+// Decode читает детали из файла каталога. Ошибки разбора отдельных записей собираются
+// в одну ошибку (errors.Join из *LineError), чтобы исправить файл за один проход.
+// Бизнес-ограничения проверяет Validate.
+func Decode(r io.Reader, format Format) ([]Row, error) {
+	switch format {
+	case FormatJSON:
+		return decodeJSON(r)
+	case FormatCSV:
+		return decodeCSV(r)
+	case FormatYAML:
+		return decodeYAML(r)
+	default:
+		return nil, fmt.Errorf("unsupported catalog format %q", format)
+	}
+}
+
+// rowDecoder накапливает разобранные записи и ошибки
+type rowDecoder struct {
+	rows []Row
+	errs []error
+}
+
+func (d *rowDecoder) add(line int, rec *partRecord, err error) {
+	if err == nil {
+		var part *model.Part
+		part, err = rec.toModel()
+		if err == nil {
+			d.rows = append(d.rows, Row{Line: line, Part: part})
+			return
+		}
+	}
+
+	d.errs = append(d.errs, &LineError{Line: line, Err: err})
+}
+
+func (d *rowDecoder) result() ([]Row, error) {
+	if len(d.errs) > 0 {
+		return nil, errors.Join(d.errs...)
+	}
+	return d.rows, nil
+}
+
+// decodeJSON читает массив деталей. Номер строки записи - строка её открывающей скобки.
+func decodeJSON(r io.Reader) ([]Row, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read catalog: %w", err)
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('[') {
+		return nil, &LineError{Line: lineAt(data, 0), Err: errors.New("expected a JSON array of parts")}
+	}
+
+	var d rowDecoder
+	for dec.More() {
+		line := lineAt(data, dec.InputOffset())
+
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			// После синтаксической ошибки продолжить разбор нельзя
+			var syntaxErr *json.SyntaxError
+			if errors.As(err, &syntaxErr) {
+				line = lineAt(data, syntaxErr.Offset)
+			}
+			d.errs = append(d.errs, &LineError{Line: line, Err: err})
+			return d.result()
+		}
+
+		recDec := json.NewDecoder(bytes.NewReader(raw))
+		recDec.DisallowUnknownFields()
+
+		var rec partRecord
+		d.add(line, &rec, recDec.Decode(&rec))
+	}
+
+	return d.result()
+}
+
+// lineAt возвращает номер строки первого значимого символа, начиная со смещения offset
+func lineAt(data []byte, offset int64) int {
+	pos := int(min(offset, int64(len(data))))
+	for pos < len(data) && strings.ContainsRune(" \t\r\n,", rune(data[pos])) {
+		pos++
+	}
+
+	return bytes.Count(data[:pos], []byte("\n")) + 1
+}
+
+// Колонки CSV. Теги разделяются ";", метаданные записываются JSON-объектом.
+const (
+	colUuid                = "uuid"
+	colName                = "name"
+	colDescription         = "description"
+	colPrice               = "price"
+	colStockQuantity       = "stock_quantity"
+	colReorderThreshold    = "reorder_threshold"
+	colCategory            = "category"
+	colCategoryUuid        = "category_uuid"
+	colLength              = "length"
+	colWidth               = "width"
+	colHeight              = "height"
+	colWeight              = "weight"
+	colManufacturerName    = "manufacturer_name"
+	colManufacturerCountry = "manufacturer_country"
+	colManufacturerWebsite = "manufacturer_website"
+	colManufacturerUuid    = "manufacturer_uuid"
+	colTags                = "tags"
+	colMetadata            = "metadata"
+	colComponents          = "components"
+	colLifecycleState      = "lifecycle_state"
+	colReplacementPartUuid = "replacement_part_uuid"
+
+	csvTagSeparator = ";"
+	// csvComponentSeparator отделяет uuid компонента от количества: "uuid:2;uuid:4"
+	csvComponentSeparator = ":"
+)
+
+var csvColumns = []string{
+	colUuid, colName, colDescription, colPrice, colStockQuantity, colReorderThreshold, colCategory, colCategoryUuid,
+	colLength, colWidth, colHeight, colWeight,
+	colManufacturerName, colManufacturerCountry, colManufacturerWebsite, colManufacturerUuid,
+	colTags, colMetadata, colComponents,
+	colLifecycleState, colReplacementPartUuid,
+}
+
+var csvRequiredColumns = []string{colName, colPrice}
+
+func decodeCSV(r io.Reader) ([]Row, error) {
+	cr := csv.NewReader(r)
+
+	header, err := cr.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, nil
+		}
+		return nil, &LineError{Line: 1, Err: err}
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.TrimSpace(name)
+		if !slices.Contains(csvColumns, name) {
+			return nil, &LineError{Line: 1, Err: fmt.Errorf("unknown column %q", name)}
+		}
+		columns[name] = i
+	}
+	for _, name := range csvRequiredColumns {
+		if _, ok := columns[name]; !ok {
+			return nil, &LineError{Line: 1, Err: fmt.Errorf("missing required column %q", name)}
+		}
+	}
+
+	var d rowDecoder
+	for {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			var parseErr *csv.ParseError
+			if errors.As(err, &parseErr) && errors.Is(parseErr.Err, csv.ErrFieldCount) {
+				d.errs = append(d.errs, &LineError{Line: parseErr.StartLine, Err: parseErr.Err})
+				continue
+			}
+			d.errs = append(d.errs, err)
+			return d.result()
+		}
+
+		line, _ := cr.FieldPos(0)
+		rec, err := parseCSVRecord(record, columns)
+		d.add(line, rec, err)
+	}
+
+	return d.result()
+}
+
+func parseCSVRecord(record []string, columns map[string]int) (*partRecord, error) {
+	cell := func(name string) string {
+		if i, ok := columns[name]; ok {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	var errs []error
+	float := func(name string) float64 {
+		v := cell(name)
+		if v == "" {
+			return 0
+		}
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("column %s: invalid number %q", name, v))
+		}
+		return f
+	}
+	integer := func(name string) int64 {
+		v := cell(name)
+		if v == "" {
+			return 0
+		}
+		i, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("column %s: invalid integer %q", name, v))
+		}
+		return i
+	}
+
+	rec := &partRecord{
+		Uuid:             cell(colUuid),
+		Name:             cell(colName),
+		Description:      cell(colDescription),
+		Price:            float(colPrice),
+		StockQuantity:    integer(colStockQuantity),
+		ReorderThreshold: integer(colReorderThreshold),
+		Category:         cell(colCategory),
+		CategoryUuid:     cell(colCategoryUuid),
+
+		LifecycleState:      cell(colLifecycleState),
+		ReplacementPartUuid: cell(colReplacementPartUuid),
+	}
+
+	if cell(colLength) != "" || cell(colWidth) != "" || cell(colHeight) != "" || cell(colWeight) != "" {
+		rec.Dimensions = &dimensionsRecord{
+			Length: float(colLength),
+			Width:  float(colWidth),
+			Height: float(colHeight),
+			Weight: float(colWeight),
+		}
+	}
+
+	if cell(colManufacturerName) != "" || cell(colManufacturerCountry) != "" || cell(colManufacturerWebsite) != "" ||
+		cell(colManufacturerUuid) != "" {
+		rec.Manufacturer = &manufacturerRecord{
+			Uuid:    cell(colManufacturerUuid),
+			Name:    cell(colManufacturerName),
+			Country: cell(colManufacturerCountry),
+			Website: cell(colManufacturerWebsite),
+		}
+	}
+
+	for _, tag := range strings.Split(cell(colTags), csvTagSeparator) {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			rec.Tags = append(rec.Tags, tag)
+		}
+	}
+
+	if v := cell(colMetadata); v != "" {
+		if err := json.Unmarshal([]byte(v), &rec.Metadata); err != nil {
+			errs = append(errs, fmt.Errorf("column %s: %w", colMetadata, err))
+		}
+	}
+
+	for _, item := range strings.Split(cell(colComponents), csvTagSeparator) {
+		if item = strings.TrimSpace(item); item == "" {
+			continue
+		}
+		uuid, qty, _ := strings.Cut(item, csvComponentSeparator)
+		quantity, err := strconv.ParseInt(strings.TrimSpace(qty), 10, 64)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("column %s: invalid component %q, expected uuid:quantity", colComponents, item))
+			continue
+		}
+		rec.Components = append(rec.Components, componentRecord{PartUuid: strings.TrimSpace(uuid), Quantity: quantity})
+	}
+
+	return rec, errors.Join(errs...)
+}
+
+// decodeYAML читает последовательность деталей. Номер строки записи - строка её первого поля.
+func decodeYAML(r io.Reader) ([]Row, error) {
+	var root yaml.Node
+	if err := yaml.NewDecoder(r).Decode(&root); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	doc := root.Content[0]
+	if doc.Kind != yaml.SequenceNode {
+		return nil, &LineError{Line: doc.Line, Err: errors.New("expected a YAML sequence of parts")}
+	}
+
+	var d rowDecoder
+	for _, item := range doc.Content {
+		var rec partRecord
+		err := checkYAMLFields(item, reflect.TypeOf(rec))
+		if err == nil {
+			err = item.Decode(&rec)
+		}
+		d.add(item.Line, &rec, err)
+	}
+
+	return d.result()
+}
+
+// checkYAMLFields отклоняет неизвестные поля записи: yaml.Node.Decode, в отличие
+// от yaml.Decoder, не поддерживает KnownFields
+func checkYAMLFields(node *yaml.Node, t reflect.Type) error {
+	if node.Kind != yaml.MappingNode {
+		return fmt.Errorf("line %d: expected a mapping", node.Line)
+	}
+
+	fields := make(map[string]reflect.Type, t.NumField())
+	for i := range t.NumField() {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("yaml"), ",")
+		fields[name] = t.Field(i).Type
+	}
+
+	for i := 0; i < len(node.Content); i += 2 {
+		key, value := node.Content[i], node.Content[i+1]
+		fieldType, ok := fields[key.Value]
+		if !ok {
+			return fmt.Errorf("line %d: unknown field %q", key.Line, key.Value)
+		}
+		if fieldType.Kind() == reflect.Pointer && fieldType.Elem().Kind() == reflect.Struct && value.Kind == yaml.MappingNode {
+			if err := checkYAMLFields(value, fieldType.Elem()); err != nil {
+				return err
+			}
+		}
+		if fieldType.Kind() == reflect.Slice && fieldType.Elem().Kind() == reflect.Struct && value.Kind == yaml.SequenceNode {
+			for _, elem := range value.Content {
+				if err := checkYAMLFields(elem, fieldType.Elem()); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,204 @@
+package catalog
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+type CodecTestSuite struct {
+	suite.Suite
+}
+
+func testParts() []*model.Part {
+	return []*model.Part{
+		{
+			Uuid:          "6ba7b810-9dad-11d1-80b4-00c04fd430c9",
+			Name:          "Main Engine",
+			Description:   "Primary propulsion system, \"v2\"",
+			Price:         2500000.99,
+			StockQuantity: 5,
+			Category:      model.CategoryEngine,
+			Dimensions:    &model.Dimensions{Length: 450, Width: 200, Height: 300, Weight: 8500},
+			Manufacturer:  &model.Manufacturer{Name: "SpaceTech", Country: "USA", Website: "spacetech.com"},
+			Tags:          []string{"propulsion", "primary"},
+			Metadata: map[string]interface{}{
+				"thrust":    float64(1200),
+				"stages":    int64(2),
+				"reusable":  true,
+				"fuel_type": "42",
+			},
+		},
+		{
+			Name:       "Porthole",
+			Price:      150,
+			Category:   model.CategoryPorthole,
+			Components: []model.Component{{PartUuid: "6ba7b810-9dad-11d1-80b4-00c04fd430c9", Quantity: 2}},
+		},
+	}
+}
+
+func (s *CodecTestSuite) TestRoundTrip() {
+	for _, format := range []Format{FormatJSON, FormatCSV, FormatYAML} {
+		var buf bytes.Buffer
+		s.Require().NoError(Encode(&buf, format, testParts()), format)
+
+		rows, err := Decode(&buf, format)
+		s.Require().NoError(err, format)
+		s.Require().Len(rows, 2, format)
+
+		s.Equal(testParts()[0], rows[0].Part, format)
+		s.Equal(testParts()[1], rows[1].Part, format)
+		s.Less(rows[0].Line, rows[1].Line, format)
+	}
+}
+
+func (s *CodecTestSuite) TestRoundTrip_LifecycleState() {
+	parts := testParts()
+	parts[0].LifecycleState = model.LifecycleStateRecalled
+	parts[0].ReplacementPartUuid = "6ba7b810-9dad-11d1-80b4-00c04fd430d1"
+	parts[1].LifecycleState = model.LifecycleStateDraft
+
+	for _, format := range []Format{FormatJSON, FormatCSV, FormatYAML} {
+		var buf bytes.Buffer
+		s.Require().NoError(Encode(&buf, format, parts), format)
+
+		rows, err := Decode(&buf, format)
+		s.Require().NoError(err, format)
+		s.Require().Len(rows, 2, format)
+
+		s.Equal(parts[0], rows[0].Part, format)
+		s.Equal(parts[1], rows[1].Part, format)
+	}
+
+	_, err := Decode(strings.NewReader(`[{"name": "A", "price": 1, "lifecycle_state": "RETIRED"}]`), FormatJSON)
+	s.Equal([]int{1}, errorLines(err))
+}
+
+func (s *CodecTestSuite) TestLineNumbers() {
+	cases := map[Format]string{
+		FormatJSON: `[
+  {"name": "A", "price": 1, "category": "ENGINE"},
+  {
+    "name": "B", "price": "cheap", "category": "FUEL"
+  },
+  {"name": "C", "price": 1, "category": "ENGINE", "colour": "red"},
+  {"name": "D", "price": 1, "category": "ROCKET"}
+]`,
+		FormatCSV: `name,price,category
+A,1,ENGINE
+B,cheap,FUEL
+"C
+with newline",1,ENGINE,extra
+D,1,ROCKET
+`,
+		FormatYAML: `- name: A
+  price: 1
+  category: ENGINE
+- name: B
+  price: cheap
+  category: FUEL
+- name: C
+  price: 1
+  category: ENGINE
+  colour: red
+- name: D
+  price: 1
+  category: ROCKET
+`,
+	}
+	expected := map[Format][]int{
+		FormatJSON: {3, 6, 7},
+		FormatCSV:  {3, 4, 6},
+		FormatYAML: {4, 7, 11},
+	}
+
+	for format, input := range cases {
+		rows, err := Decode(strings.NewReader(input), format)
+
+		s.Nil(rows, format)
+		s.Equal(expected[format], errorLines(err), format)
+	}
+}
+
+func (s *CodecTestSuite) TestTypedMetadata() {
+	rows, err := Decode(strings.NewReader(`[{"name": "A", "price": 1, "category": "wing",
+		"metadata": {"int": 5, "float": 5.0, "exp": 1e3, "text": "5", "flag": false}}]`), FormatJSON)
+	s.Require().NoError(err)
+
+	s.Equal(map[string]interface{}{
+		"int": int64(5), "float": 5.0, "exp": 1000.0, "text": "5", "flag": false,
+	}, rows[0].Part.Metadata)
+	s.Equal(model.CategoryWing, rows[0].Part.Category)
+
+	_, err = Decode(strings.NewReader(`[{"name": "A", "price": 1, "category": "WING", "metadata": {"nested": {"a": 1}}}]`), FormatJSON)
+	s.Equal([]int{1}, errorLines(err))
+}
+
+func (s *CodecTestSuite) TestValidate() {
+	parts := testParts()
+	rows := []Row{
+		{Line: 2, Part: parts[0]},
+		{Line: 5, Part: &model.Part{Name: "No price", Category: model.CategoryFuel}},
+		{Line: 7, Part: parts[0].Clone()},
+		{Line: 9, Part: &model.Part{Uuid: "not-a-uuid", Name: "X", Price: 1, Category: model.CategoryFuel}},
+		{Line: 11, Part: &model.Part{Name: "Y", Price: 1, Category: model.CategoryFuel, Metadata: map[string]interface{}{"a.b": 1}}},
+		{Line: 13, Part: parts[1]},
+		{Line: 15, Part: &model.Part{Name: "Z", Price: 1, Category: model.CategoryFuel, ReplacementPartUuid: parts[0].Uuid}},
+	}
+
+	err := Validate(rows)
+
+	s.Equal([]int{5, 7, 9, 11, 15}, errorLines(err))
+	s.ErrorIs(err, model.ErrInvalidPart)
+	s.ErrorContains(err, "already used on line 2")
+}
+
+func (s *CodecTestSuite) TestSeedFileIsValid() {
+	rows, err := DecodeFile("../../seed/parts.yaml")
+	s.Require().NoError(err)
+
+	s.Len(rows, 4)
+	s.NoError(Validate(rows))
+}
+
+func (s *CodecTestSuite) TestFormatFromPath() {
+	for path, expected := range map[string]Format{"a.json": FormatJSON, "b.CSV": FormatCSV, "c.yml": FormatYAML, "d.yaml": FormatYAML} {
+		format, err := FormatFromPath(path)
+		s.NoError(err)
+		s.Equal(expected, format)
+	}
+
+	_, err := FormatFromPath("parts.xml")
+	s.Error(err)
+}
+
+// errorLines возвращает номера строк всех *LineError из errors.Join
+func errorLines(err error) []int {
+	var joined interface{ Unwrap() []error }
+	if !errors.As(err, &joined) {
+		var lineErr *LineError
+		if errors.As(err, &lineErr) {
+			return []int{lineErr.Line}
+		}
+		return nil
+	}
+
+	var lines []int
+	for _, e := range joined.Unwrap() {
+		var lineErr *LineError
+		if errors.As(e, &lineErr) {
+			lines = append(lines, lineErr.Line)
+		}
+	}
+	return lines
+}
+
+func TestCodecTestSuite(t *testing.T) {
+	suite.Run(t, new(CodecTestSuite))
+}
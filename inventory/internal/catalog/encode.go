@@ -0,0 +1,107 @@
+package catalog
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+// Encode записывает детали в формате каталога, который читает Decode
+func Encode(w io.Writer, format Format, parts []*model.Part) error {
+	records := make([]*partRecord, len(parts))
+	for i, p := range parts {
+		records[i] = toRecord(p)
+	}
+
+	switch format {
+	case FormatJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(records)
+	case FormatYAML:
+		enc := yaml.NewEncoder(w)
+		enc.SetIndent(2)
+		if err := enc.Encode(records); err != nil {
+			return err
+		}
+		return enc.Close()
+	case FormatCSV:
+		return encodeCSV(w, records)
+	default:
+		return fmt.Errorf("unsupported catalog format %q", format)
+	}
+}
+
+func encodeCSV(w io.Writer, records []*partRecord) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvColumns); err != nil {
+		return err
+	}
+
+	float := func(f float64) string {
+		return strconv.FormatFloat(f, 'f', -1, 64)
+	}
+
+	for _, rec := range records {
+		row := map[string]string{
+			colUuid:          rec.Uuid,
+			colName:          rec.Name,
+			colDescription:   rec.Description,
+			colPrice:         float(rec.Price),
+			colStockQuantity: strconv.FormatInt(rec.StockQuantity, 10),
+			colCategory:      rec.Category,
+			colCategoryUuid:  rec.CategoryUuid,
+			colTags:          strings.Join(rec.Tags, csvTagSeparator),
+
+			colLifecycleState:      rec.LifecycleState,
+			colReplacementPartUuid: rec.ReplacementPartUuid,
+		}
+		if rec.ReorderThreshold != 0 {
+			row[colReorderThreshold] = strconv.FormatInt(rec.ReorderThreshold, 10)
+		}
+		if d := rec.Dimensions; d != nil {
+			row[colLength] = float(d.Length)
+			row[colWidth] = float(d.Width)
+			row[colHeight] = float(d.Height)
+			row[colWeight] = float(d.Weight)
+		}
+		if m := rec.Manufacturer; m != nil {
+			row[colManufacturerName] = m.Name
+			row[colManufacturerCountry] = m.Country
+			row[colManufacturerWebsite] = m.Website
+			row[colManufacturerUuid] = m.Uuid
+		}
+		if len(rec.Metadata) > 0 {
+			metadata, err := json.Marshal(rec.Metadata)
+			if err != nil {
+				return fmt.Errorf("part %s: %w", rec.Uuid, err)
+			}
+			row[colMetadata] = string(metadata)
+		}
+		if len(rec.Components) > 0 {
+			components := make([]string, len(rec.Components))
+			for i, c := range rec.Components {
+				components[i] = c.PartUuid + csvComponentSeparator + strconv.FormatInt(c.Quantity, 10)
+			}
+			row[colComponents] = strings.Join(components, csvTagSeparator)
+		}
+
+		values := make([]string, len(csvColumns))
+		for i, col := range csvColumns {
+			values[i] = row[col]
+		}
+		if err := cw.Write(values); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
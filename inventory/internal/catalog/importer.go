@@ -0,0 +1,274 @@
+package catalog
+
+import (
+	"context"
+	"errors"
+	"io"
+	"reflect"
+	"time"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+	"github.com/bogdanovds/rocket_factory/inventory/internal/service"
+)
+
+// importReferenceID - reference_id движений остатка, которыми импорт выравнивает остаток
+const importReferenceID = "catalog-import"
+
+// exportPageSize - размер страницы ListParts при выгрузке (максимальный, который принимает сервис)
+const exportPageSize = 1000
+
+// exportLifecycleStates - выгружаются детали во всех состояниях, а не только действующие,
+// которые ListParts возвращает по умолчанию
+var exportLifecycleStates = []model.LifecycleState{
+	model.LifecycleStateDraft,
+	model.LifecycleStateActive,
+	model.LifecycleStateDiscontinued,
+	model.LifecycleStateRecalled,
+}
+
+// ImportOptions - режимы импорта
+type ImportOptions struct {
+	// DryRun - только проверить файл и посчитать изменения, ничего не сохраняя
+	DryRun bool
+	// Upsert - обновлять детали с существующим UUID вместо ошибки
+	Upsert bool
+}
+
+// ImportReport - итог импорта. При DryRun - изменения, которые были бы сделаны.
+type ImportReport struct {
+	Created   int
+	Updated   int
+	Unchanged int
+}
+
+// Importer загружает и выгружает каталог через сервис деталей, поэтому к импорту
+// применяются те же проверки, что и к CreatePart/UpdatePart
+type Importer struct {
+	parts service.PartService
+}
+
+func NewImporter(parts service.PartService) *Importer {
+	return &Importer{parts: parts}
+}
+
+// Import сохраняет детали из файла. Если хотя бы одна запись не проходит Validate, ничего
+// не сохраняется. Ошибки сохранения отдельных записей не прерывают импорт и возвращаются
+// вместе с отчётом (errors.Join из *LineError).
+//
+// Деталь без UUID создаётся с новым UUID. Деталь с существующим UUID в режиме Upsert
+// заменяется целиком, а разница в остатке записывается движением CORRECTION.
+// Записи сохраняются в порядке файла, поэтому компоненты сборки должны стоять раньше неё.
+// Состояние жизненного цикла меняется после сохранения всех записей через ChangePartLifecycleState:
+// снятая с производства или отозванная деталь создаётся действующей, а её замена может стоять
+// в файле позже неё.
+func (i *Importer) Import(ctx context.Context, rows []Row, opts ImportOptions) (*ImportReport, error) {
+	if err := Validate(rows); err != nil {
+		return nil, err
+	}
+
+	report := &ImportReport{}
+	var errs []error
+	var changes []lifecycleUpdate
+	for _, row := range rows {
+		change, err := i.importRow(ctx, row, opts, report)
+		if err != nil {
+			errs = append(errs, &LineError{Line: row.Line, Err: err})
+			continue
+		}
+		if change != nil {
+			changes = append(changes, lifecycleUpdate{line: row.Line, change: change})
+		}
+	}
+
+	for _, u := range orderLifecycleUpdates(changes) {
+		if _, err := i.parts.ChangeLifecycleState(ctx, u.change); err != nil {
+			errs = append(errs, &LineError{Line: u.line, Err: err})
+		}
+	}
+
+	return report, errors.Join(errs...)
+}
+
+// importRow сохраняет запись и возвращает смену состояния, которую нужно применить после
+// сохранения всех записей, или nil
+func (i *Importer) importRow(ctx context.Context, row Row, opts ImportOptions, report *ImportReport) (*model.LifecycleChange, error) {
+	var existing *model.Part
+	if row.Part.Uuid != "" {
+		part, err := i.parts.GetPart(ctx, row.Part.Uuid, time.Time{})
+		if err != nil && !errors.Is(err, model.ErrPartNotFound) {
+			return nil, err
+		}
+		existing = part
+	}
+
+	switch {
+	case existing == nil:
+		report.Created++
+		if opts.DryRun {
+			return nil, nil
+		}
+		return i.create(ctx, row.Part)
+	case !opts.Upsert:
+		return nil, model.ErrPartAlreadyExists
+	case reflect.DeepEqual(toRecord(existing), toRecord(withResolvedReferences(row.Part, existing))):
+		report.Unchanged++
+		return nil, nil
+	default:
+		report.Updated++
+		if opts.DryRun {
+			return nil, nil
+		}
+		return lifecycleChange(row.Part, existing), i.update(ctx, existing, row.Part)
+	}
+}
+
+// create создаёт деталь. CreatePart принимает только DRAFT и ACTIVE, поэтому выведенная из продажи
+// деталь создаётся действующей и возвращается смена её состояния.
+func (i *Importer) create(ctx context.Context, part *model.Part) (*model.LifecycleChange, error) {
+	if !part.LifecycleState.IsWithdrawn() {
+		_, err := i.parts.CreatePart(ctx, part)
+		return nil, err
+	}
+
+	active := part.Clone()
+	active.LifecycleState = model.LifecycleStateActive
+	active.ReplacementPartUuid = ""
+
+	created, err := i.parts.CreatePart(ctx, active)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.LifecycleChange{
+		PartUuid:            created.Uuid,
+		State:               part.LifecycleState,
+		ReplacementPartUuid: part.ReplacementPartUuid,
+	}, nil
+}
+
+// withResolvedReferences дополняет запись так, как её дополнил бы сервис при сохранении: категорию -
+// по значению enum или узлу дерева сохранённой детали, производителя справочника - его копией.
+// Иначе деталь, сохранённая из того же файла, считалась бы изменённой.
+func withResolvedReferences(part, existing *model.Part) *model.Part {
+	resolved := part.Clone()
+
+	switch {
+	case resolved.CategoryUuid == "" && existing.CategoryUuid == model.BuiltinCategoryUuid(resolved.Category):
+		resolved.CategoryUuid = existing.CategoryUuid
+	case resolved.Category == model.CategoryUnspecified && resolved.CategoryUuid == existing.CategoryUuid:
+		resolved.Category = existing.Category
+	}
+
+	if resolved.LifecycleState == model.LifecycleStateUnspecified {
+		resolved.LifecycleState = existing.LifecycleState
+		resolved.ReplacementPartUuid = existing.ReplacementPartUuid
+	}
+
+	if resolved.Manufacturer.IsReference() && existing.Manufacturer.IsReference() &&
+		resolved.Manufacturer.Uuid == existing.Manufacturer.Uuid {
+		resolved.Manufacturer = existing.Clone().Manufacturer
+	}
+
+	return resolved
+}
+
+// lifecycleChange возвращает смену состояния существующей детали, если запись задаёт другое состояние
+// или другую замену, иначе nil
+func lifecycleChange(part, existing *model.Part) *model.LifecycleChange {
+	if part.LifecycleState == model.LifecycleStateUnspecified ||
+		(part.LifecycleState == existing.LifecycleState && part.ReplacementPartUuid == existing.ReplacementPartUuid) {
+		return nil
+	}
+
+	return &model.LifecycleChange{
+		PartUuid:            existing.Uuid,
+		State:               part.LifecycleState,
+		ReplacementPartUuid: part.ReplacementPartUuid,
+	}
+}
+
+// lifecycleUpdate - отложенная смена состояния с номером строки записи для ошибки
+type lifecycleUpdate struct {
+	line   int
+	change *model.LifecycleChange
+}
+
+// orderLifecycleUpdates упорядочивает смены состояния так, чтобы замена была действующей в момент
+// вывода детали: сначала возвраты в продажу, затем вывод деталей, причём деталь, которая служит
+// заменой другой выводимой детали, выводится после неё. При взаимных ссылках оставшиеся смены идут
+// в порядке файла, и ошибку вернёт сервис.
+func orderLifecycleUpdates(updates []lifecycleUpdate) []lifecycleUpdate {
+	ordered := make([]lifecycleUpdate, 0, len(updates))
+	var withdrawals []lifecycleUpdate
+	for _, u := range updates {
+		if u.change.State.IsWithdrawn() {
+			withdrawals = append(withdrawals, u)
+		} else {
+			ordered = append(ordered, u)
+		}
+	}
+
+	for len(withdrawals) > 0 {
+		replacements := make(map[string]bool, len(withdrawals))
+		for _, u := range withdrawals {
+			replacements[u.change.ReplacementPartUuid] = true
+		}
+
+		var rest []lifecycleUpdate
+		for _, u := range withdrawals {
+			if replacements[u.change.PartUuid] {
+				rest = append(rest, u)
+			} else {
+				ordered = append(ordered, u)
+			}
+		}
+		if len(rest) == len(withdrawals) {
+			return append(ordered, rest...)
+		}
+		withdrawals = rest
+	}
+
+	return ordered
+}
+
+func (i *Importer) update(ctx context.Context, existing, part *model.Part) error {
+	if _, err := i.parts.UpdatePart(ctx, &model.PartUpdate{Part: part}); err != nil {
+		return err
+	}
+
+	// UpdatePart не меняет остаток: он меняется только через журнал движений
+	if delta := part.StockQuantity - existing.StockQuantity; delta != 0 {
+		_, err := i.parts.AdjustStock(ctx, &model.StockAdjustment{
+			PartUuid:    part.Uuid,
+			Delta:       delta,
+			Reason:      model.StockMovementReasonCorrection,
+			ReferenceID: importReferenceID,
+		})
+		return err
+	}
+
+	return nil
+}
+
+// Export выгружает весь каталог в формате format
+func (i *Importer) Export(ctx context.Context, w io.Writer, format Format) error {
+	var parts []*model.Part
+	params := &model.ListPartsParams{
+		Filter:   &model.PartsFilter{LifecycleStates: exportLifecycleStates},
+		PageSize: exportPageSize,
+	}
+	for {
+		page, err := i.parts.ListParts(ctx, params)
+		if err != nil {
+			return err
+		}
+		parts = append(parts, page.Parts...)
+
+		if page.NextPageToken == "" {
+			break
+		}
+		params.PageToken = page.NextPageToken
+	}
+
+	return Encode(w, format, parts)
+}
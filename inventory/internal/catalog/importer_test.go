@@ -0,0 +1,245 @@
+package catalog
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+	"github.com/bogdanovds/rocket_factory/inventory/internal/service/mocks"
+)
+
+type ImporterTestSuite struct {
+	suite.Suite
+	ctx      context.Context
+	service  *mocks.MockPartService
+	importer *Importer
+}
+
+func (s *ImporterTestSuite) SetupTest() {
+	s.ctx = context.Background()
+	s.service = mocks.NewMockPartService()
+	s.importer = NewImporter(s.service)
+}
+
+func (s *ImporterTestSuite) TearDownTest() {
+	s.service.AssertExpectations(s.T())
+}
+
+func (s *ImporterTestSuite) rows() []Row {
+	parts := testParts()
+	return []Row{{Line: 2, Part: parts[0]}, {Line: 20, Part: parts[1]}}
+}
+
+func (s *ImporterTestSuite) TestImport_CreatesParts() {
+	rows := s.rows()
+	s.service.On("GetPart", s.ctx, rows[0].Part.Uuid, time.Time{}).Return(nil, model.ErrPartNotFound)
+	s.service.On("CreatePart", s.ctx, rows[0].Part).Return(rows[0].Part, nil)
+	s.service.On("CreatePart", s.ctx, rows[1].Part).Return(rows[1].Part, nil)
+
+	report, err := s.importer.Import(s.ctx, rows, ImportOptions{})
+
+	s.NoError(err)
+	s.Equal(&ImportReport{Created: 2}, report)
+}
+
+func (s *ImporterTestSuite) TestImport_DryRunDoesNotWrite() {
+	rows := s.rows()
+	existing := rows[0].Part.Clone()
+	existing.Price = 1
+	s.service.On("GetPart", s.ctx, rows[0].Part.Uuid, time.Time{}).Return(existing, nil)
+
+	report, err := s.importer.Import(s.ctx, rows, ImportOptions{DryRun: true, Upsert: true})
+
+	s.NoError(err)
+	s.Equal(&ImportReport{Created: 1, Updated: 1}, report)
+}
+
+func (s *ImporterTestSuite) TestImport_ExistingWithoutUpsert() {
+	rows := s.rows()
+	s.service.On("GetPart", s.ctx, rows[0].Part.Uuid, time.Time{}).Return(rows[0].Part.Clone(), nil)
+	s.service.On("CreatePart", s.ctx, rows[1].Part).Return(rows[1].Part, nil)
+
+	report, err := s.importer.Import(s.ctx, rows, ImportOptions{})
+
+	s.Equal(&ImportReport{Created: 1}, report)
+	s.ErrorIs(err, model.ErrPartAlreadyExists)
+	s.Equal([]int{2}, errorLines(err))
+}
+
+func (s *ImporterTestSuite) TestImport_UpsertAdjustsStock() {
+	rows := s.rows()[:1]
+	existing := rows[0].Part.Clone()
+	existing.Name = "Old Engine"
+	existing.StockQuantity = 8
+	s.service.On("GetPart", s.ctx, existing.Uuid, time.Time{}).Return(existing, nil)
+	s.service.On("UpdatePart", s.ctx, &model.PartUpdate{Part: rows[0].Part}).Return(rows[0].Part, nil)
+	s.service.On("AdjustStock", s.ctx, &model.StockAdjustment{
+		PartUuid:    existing.Uuid,
+		Delta:       -3,
+		Reason:      model.StockMovementReasonCorrection,
+		ReferenceID: importReferenceID,
+	}).Return(&model.StockMovement{}, nil)
+
+	report, err := s.importer.Import(s.ctx, rows, ImportOptions{Upsert: true})
+
+	s.NoError(err)
+	s.Equal(&ImportReport{Updated: 1}, report)
+}
+
+func (s *ImporterTestSuite) TestImport_UpsertSkipsUnchanged() {
+	rows := s.rows()[:1]
+	s.service.On("GetPart", s.ctx, rows[0].Part.Uuid, time.Time{}).Return(rows[0].Part.Clone(), nil)
+
+	report, err := s.importer.Import(s.ctx, rows, ImportOptions{Upsert: true})
+
+	s.NoError(err)
+	s.Equal(&ImportReport{Unchanged: 1}, report)
+}
+
+func (s *ImporterTestSuite) TestImport_UpsertIgnoresReferencedManufacturerCopy() {
+	rows := s.rows()[:1]
+	existing := rows[0].Part.Clone()
+	existing.Manufacturer = &model.Manufacturer{Uuid: "6ba7b811-9dad-11d1-80b4-00c04fd430c8", Name: "SpaceTech Inc", Country: "USA"}
+	rows[0].Part.Manufacturer = &model.Manufacturer{Uuid: existing.Manufacturer.Uuid}
+	s.service.On("GetPart", s.ctx, rows[0].Part.Uuid, time.Time{}).Return(existing, nil)
+
+	report, err := s.importer.Import(s.ctx, rows, ImportOptions{Upsert: true})
+
+	s.NoError(err)
+	s.Equal(&ImportReport{Unchanged: 1}, report)
+}
+
+func (s *ImporterTestSuite) TestImport_UpsertIgnoresResolvedBuiltinCategory() {
+	rows := s.rows()[:1]
+	existing := rows[0].Part.Clone()
+	existing.CategoryUuid = model.BuiltinCategoryUuid(existing.Category)
+	s.service.On("GetPart", s.ctx, rows[0].Part.Uuid, time.Time{}).Return(existing, nil)
+
+	report, err := s.importer.Import(s.ctx, rows, ImportOptions{Upsert: true})
+
+	s.NoError(err)
+	s.Equal(&ImportReport{Unchanged: 1}, report)
+}
+
+func (s *ImporterTestSuite) TestImport_InvalidRowsBlockImport() {
+	rows := append(s.rows(), Row{Line: 30, Part: &model.Part{Name: "Free", Category: model.CategoryWing}})
+
+	report, err := s.importer.Import(s.ctx, rows, ImportOptions{})
+
+	s.Nil(report)
+	s.Equal([]int{30}, errorLines(err))
+}
+
+func (s *ImporterTestSuite) TestImport_WithdrawnPartChangedAfterAllRows() {
+	const replacementUuid = "6ba7b810-9dad-11d1-80b4-00c04fd430d1"
+	discontinued := testParts()[0]
+	discontinued.LifecycleState = model.LifecycleStateDiscontinued
+	discontinued.ReplacementPartUuid = replacementUuid
+	replacement := &model.Part{Uuid: replacementUuid, Name: "Engine v3", Price: 100, Category: model.CategoryEngine}
+	rows := []Row{{Line: 2, Part: discontinued}, {Line: 20, Part: replacement}}
+
+	created := discontinued.Clone()
+	created.LifecycleState = model.LifecycleStateActive
+	created.ReplacementPartUuid = ""
+
+	s.service.On("GetPart", s.ctx, discontinued.Uuid, time.Time{}).Return(nil, model.ErrPartNotFound)
+	s.service.On("GetPart", s.ctx, replacementUuid, time.Time{}).Return(nil, model.ErrPartNotFound)
+	s.service.On("CreatePart", s.ctx, created).Return(created, nil)
+	s.service.On("CreatePart", s.ctx, replacement).Return(replacement, nil)
+	s.service.On("ChangeLifecycleState", s.ctx, &model.LifecycleChange{
+		PartUuid:            discontinued.Uuid,
+		State:               model.LifecycleStateDiscontinued,
+		ReplacementPartUuid: replacementUuid,
+	}).Return(discontinued, nil)
+
+	report, err := s.importer.Import(s.ctx, rows, ImportOptions{})
+
+	s.NoError(err)
+	s.Equal(&ImportReport{Created: 2}, report)
+}
+
+func (s *ImporterTestSuite) TestImport_UpsertChangesLifecycleState() {
+	rows := s.rows()[:1]
+	rows[0].Part.LifecycleState = model.LifecycleStateRecalled
+	existing := rows[0].Part.Clone()
+	existing.LifecycleState = model.LifecycleStateActive
+
+	s.service.On("GetPart", s.ctx, existing.Uuid, time.Time{}).Return(existing, nil)
+	s.service.On("UpdatePart", s.ctx, &model.PartUpdate{Part: rows[0].Part}).Return(existing, nil)
+	s.service.On("ChangeLifecycleState", s.ctx, &model.LifecycleChange{
+		PartUuid: existing.Uuid,
+		State:    model.LifecycleStateRecalled,
+	}).Return(nil, model.ErrLifecycleTransitionNotAllowed)
+
+	report, err := s.importer.Import(s.ctx, rows, ImportOptions{Upsert: true})
+
+	s.Equal(&ImportReport{Updated: 1}, report)
+	s.ErrorIs(err, model.ErrLifecycleTransitionNotAllowed)
+	s.Equal([]int{2}, errorLines(err))
+}
+
+func (s *ImporterTestSuite) TestImport_UpsertKeepsStateMissingInRecord() {
+	rows := s.rows()[:1]
+	existing := rows[0].Part.Clone()
+	existing.LifecycleState = model.LifecycleStateDiscontinued
+	existing.ReplacementPartUuid = "6ba7b810-9dad-11d1-80b4-00c04fd430d1"
+	s.service.On("GetPart", s.ctx, existing.Uuid, time.Time{}).Return(existing, nil)
+
+	report, err := s.importer.Import(s.ctx, rows, ImportOptions{Upsert: true})
+
+	s.NoError(err)
+	s.Equal(&ImportReport{Unchanged: 1}, report)
+}
+
+func (s *ImporterTestSuite) TestOrderLifecycleUpdates() {
+	update := func(line int, part string, state model.LifecycleState, replacement string) lifecycleUpdate {
+		return lifecycleUpdate{line: line, change: &model.LifecycleChange{PartUuid: part, State: state, ReplacementPartUuid: replacement}}
+	}
+
+	// c служит заменой b, b - заменой a: a выводится первым, пока b действующая
+	ordered := orderLifecycleUpdates([]lifecycleUpdate{
+		update(1, "c", model.LifecycleStateDiscontinued, "d"),
+		update(2, "b", model.LifecycleStateRecalled, "c"),
+		update(3, "a", model.LifecycleStateDiscontinued, "b"),
+		update(4, "d", model.LifecycleStateActive, ""),
+	})
+
+	lines := make([]int, len(ordered))
+	for i, u := range ordered {
+		lines[i] = u.line
+	}
+	s.Equal([]int{4, 3, 2, 1}, lines)
+}
+
+func (s *ImporterTestSuite) TestExport_AllLifecycleStates() {
+	s.service.On("ListParts", s.ctx, mock.MatchedBy(func(p *model.ListPartsParams) bool {
+		return p.Filter != nil && len(p.Filter.LifecycleStates) == 4
+	})).Return(&model.PartsPage{Parts: testParts()}, nil)
+
+	var buf bytes.Buffer
+	s.Require().NoError(s.importer.Export(s.ctx, &buf, FormatJSON))
+}
+
+func (s *ImporterTestSuite) TestExport_AllPages() {
+	parts := testParts()
+	s.service.On("ListParts", s.ctx, mock.MatchedBy(func(p *model.ListPartsParams) bool { return p.PageToken == "" })).
+		Return(&model.PartsPage{Parts: parts[:1], NextPageToken: "next"}, nil)
+	s.service.On("ListParts", s.ctx, mock.MatchedBy(func(p *model.ListPartsParams) bool { return p.PageToken == "next" })).
+		Return(&model.PartsPage{Parts: parts[1:]}, nil)
+
+	var buf bytes.Buffer
+	s.Require().NoError(s.importer.Export(s.ctx, &buf, FormatYAML))
+
+	rows, err := Decode(&buf, FormatYAML)
+	s.Require().NoError(err)
+	s.Len(rows, 2)
+}
+
+func TestImporterTestSuite(t *testing.T) {
+	suite.Run(t, new(ImporterTestSuite))
+}
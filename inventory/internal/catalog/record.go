@@ -0,0 +1,298 @@
+package catalog
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+// partRecord - деталь в файле каталога. Время создания и изменения не переносится:
+// его назначает сервис, а остаток при обновлении меняется движением CORRECTION.
+// Состояние жизненного цикла без значения - ACTIVE для новой детали и прежнее для существующей.
+type partRecord struct {
+	Uuid             string                   `json:"uuid,omitempty" yaml:"uuid,omitempty"`
+	Name             string                   `json:"name" yaml:"name"`
+	Description      string                   `json:"description,omitempty" yaml:"description,omitempty"`
+	Price            float64                  `json:"price" yaml:"price"`
+	StockQuantity    int64                    `json:"stock_quantity" yaml:"stock_quantity"`
+	ReorderThreshold int64                    `json:"reorder_threshold,omitempty" yaml:"reorder_threshold,omitempty"`
+	Category         string                   `json:"category,omitempty" yaml:"category,omitempty"`
+	CategoryUuid     string                   `json:"category_uuid,omitempty" yaml:"category_uuid,omitempty"`
+	Dimensions       *dimensionsRecord        `json:"dimensions,omitempty" yaml:"dimensions,omitempty"`
+	Manufacturer     *manufacturerRecord      `json:"manufacturer,omitempty" yaml:"manufacturer,omitempty"`
+	Tags             []string                 `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Metadata         map[string]metadataValue `json:"metadata,omitempty" yaml:"metadata,omitempty"`
+	Components       []componentRecord        `json:"components,omitempty" yaml:"components,omitempty"`
+
+	LifecycleState      string `json:"lifecycle_state,omitempty" yaml:"lifecycle_state,omitempty"`
+	ReplacementPartUuid string `json:"replacement_part_uuid,omitempty" yaml:"replacement_part_uuid,omitempty"`
+}
+
+type dimensionsRecord struct {
+	Length float64 `json:"length" yaml:"length"`
+	Width  float64 `json:"width" yaml:"width"`
+	Height float64 `json:"height" yaml:"height"`
+	Weight float64 `json:"weight" yaml:"weight"`
+}
+
+type componentRecord struct {
+	PartUuid string `json:"part_uuid" yaml:"part_uuid"`
+	Quantity int64  `json:"quantity" yaml:"quantity"`
+}
+
+// manufacturerRecord - производитель детали. С uuid остальные поля берутся из справочника производителей.
+type manufacturerRecord struct {
+	Uuid    string `json:"uuid,omitempty" yaml:"uuid,omitempty"`
+	Name    string `json:"name" yaml:"name"`
+	Country string `json:"country,omitempty" yaml:"country,omitempty"`
+	Website string `json:"website,omitempty" yaml:"website,omitempty"`
+}
+
+// categoryNames - названия категорий в файлах каталога, совпадают с enum Category из proto без префикса
+var categoryNames = map[model.Category]string{
+	model.CategoryEngine:   "ENGINE",
+	model.CategoryFuel:     "FUEL",
+	model.CategoryPorthole: "PORTHOLE",
+	model.CategoryWing:     "WING",
+}
+
+// parseCategory разбирает название категории; пустое название допустимо, если у детали задан category_uuid
+func parseCategory(name string) (model.Category, error) {
+	name = strings.ToUpper(strings.TrimSpace(name))
+	if name == "" {
+		return model.CategoryUnspecified, nil
+	}
+	for category, n := range categoryNames {
+		if n == name {
+			return category, nil
+		}
+	}
+
+	return model.CategoryUnspecified, fmt.Errorf("unknown category %q", name)
+}
+
+// lifecycleStateNames - названия состояний жизненного цикла в файлах каталога, совпадают
+// с enum PartLifecycleState из proto без префикса
+var lifecycleStateNames = map[model.LifecycleState]string{
+	model.LifecycleStateDraft:        "DRAFT",
+	model.LifecycleStateActive:       "ACTIVE",
+	model.LifecycleStateDiscontinued: "DISCONTINUED",
+	model.LifecycleStateRecalled:     "RECALLED",
+}
+
+// parseLifecycleState разбирает название состояния; пустое название - состояние не указано
+func parseLifecycleState(name string) (model.LifecycleState, error) {
+	name = strings.ToUpper(strings.TrimSpace(name))
+	if name == "" {
+		return model.LifecycleStateUnspecified, nil
+	}
+	for state, n := range lifecycleStateNames {
+		if n == name {
+			return state, nil
+		}
+	}
+
+	return model.LifecycleStateUnspecified, fmt.Errorf("unknown lifecycle state %q", name)
+}
+
+// metadataValue - типизированное значение метаданных: string, int64, float64 или bool.
+// Целое и дробное число различаются по записи: 5 - int64, 5.0 - float64.
+type metadataValue struct {
+	value interface{}
+}
+
+func (v metadataValue) MarshalJSON() ([]byte, error) {
+	if f, ok := v.value.(float64); ok {
+		s, err := formatFloat(f)
+		if err != nil {
+			return nil, err
+		}
+		return []byte(s), nil
+	}
+
+	return json.Marshal(v.value)
+}
+
+func (v *metadataValue) UnmarshalJSON(data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var raw interface{}
+	if err := dec.Decode(&raw); err != nil {
+		return err
+	}
+
+	switch val := raw.(type) {
+	case string, bool:
+		v.value = val
+	case json.Number:
+		number, err := parseNumber(val.String())
+		if err != nil {
+			return err
+		}
+		v.value = number
+	default:
+		return fmt.Errorf("unsupported metadata value %s, expected string, number or bool", data)
+	}
+
+	return nil
+}
+
+func (v metadataValue) MarshalYAML() (interface{}, error) {
+	if f, ok := v.value.(float64); ok {
+		s, err := formatFloat(f)
+		if err != nil {
+			return nil, err
+		}
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!float", Value: s}, nil
+	}
+
+	return v.value, nil
+}
+
+func (v *metadataValue) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind != yaml.ScalarNode {
+		return fmt.Errorf("line %d: unsupported metadata value, expected string, number or bool", node.Line)
+	}
+
+	switch node.ShortTag() {
+	case "!!str":
+		v.value = node.Value
+	case "!!bool":
+		var b bool
+		if err := node.Decode(&b); err != nil {
+			return err
+		}
+		v.value = b
+	case "!!int":
+		i, err := strconv.ParseInt(node.Value, 10, 64)
+		if err != nil {
+			return fmt.Errorf("line %d: invalid integer metadata value %q", node.Line, node.Value)
+		}
+		v.value = i
+	case "!!float":
+		f, err := strconv.ParseFloat(node.Value, 64)
+		if err != nil || math.IsInf(f, 0) || math.IsNaN(f) {
+			return fmt.Errorf("line %d: invalid number metadata value %q", node.Line, node.Value)
+		}
+		v.value = f
+	default:
+		return fmt.Errorf("line %d: unsupported metadata value %q", node.Line, node.Value)
+	}
+
+	return nil
+}
+
+// parseNumber возвращает int64 для записи без дробной части и экспоненты, иначе float64
+func parseNumber(s string) (interface{}, error) {
+	if !strings.ContainsAny(s, ".eE") {
+		i, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid integer metadata value %q", s)
+		}
+		return i, nil
+	}
+
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil || math.IsInf(f, 0) || math.IsNaN(f) {
+		return nil, fmt.Errorf("invalid number metadata value %q", s)
+	}
+	return f, nil
+}
+
+// formatFloat записывает дробное число так, чтобы при чтении оно не превратилось в целое
+func formatFloat(f float64) (string, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return "", fmt.Errorf("metadata value %v cannot be exported", f)
+	}
+
+	s := strconv.FormatFloat(f, 'g', -1, 64)
+	if !strings.ContainsAny(s, ".eE") {
+		s += ".0"
+	}
+	return s, nil
+}
+
+func toRecord(p *model.Part) *partRecord {
+	r := &partRecord{
+		Uuid:             p.Uuid,
+		Name:             p.Name,
+		Description:      p.Description,
+		Price:            p.Price,
+		StockQuantity:    p.StockQuantity,
+		ReorderThreshold: p.ReorderThreshold,
+		Category:         categoryNames[p.Category],
+		CategoryUuid:     p.CategoryUuid,
+		Tags:             p.Tags,
+
+		LifecycleState:      lifecycleStateNames[p.LifecycleState],
+		ReplacementPartUuid: p.ReplacementPartUuid,
+	}
+	if d := p.Dimensions; d != nil {
+		r.Dimensions = &dimensionsRecord{Length: d.Length, Width: d.Width, Height: d.Height, Weight: d.Weight}
+	}
+	if m := p.Manufacturer; m != nil {
+		r.Manufacturer = &manufacturerRecord{Uuid: m.Uuid, Name: m.Name, Country: m.Country, Website: m.Website}
+	}
+	if len(p.Metadata) > 0 {
+		r.Metadata = make(map[string]metadataValue, len(p.Metadata))
+		for k, v := range p.Metadata {
+			r.Metadata[k] = metadataValue{value: v}
+		}
+	}
+	for _, c := range p.Components {
+		r.Components = append(r.Components, componentRecord{PartUuid: c.PartUuid, Quantity: c.Quantity})
+	}
+
+	return r
+}
+
+func (r *partRecord) toModel() (*model.Part, error) {
+	category, err := parseCategory(r.Category)
+	if err != nil {
+		return nil, err
+	}
+	state, err := parseLifecycleState(r.LifecycleState)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &model.Part{
+		Uuid:             strings.TrimSpace(r.Uuid),
+		Name:             r.Name,
+		Description:      r.Description,
+		Price:            r.Price,
+		StockQuantity:    r.StockQuantity,
+		ReorderThreshold: r.ReorderThreshold,
+		Category:         category,
+		CategoryUuid:     strings.TrimSpace(r.CategoryUuid),
+		Tags:             r.Tags,
+
+		LifecycleState:      state,
+		ReplacementPartUuid: strings.TrimSpace(r.ReplacementPartUuid),
+	}
+	if d := r.Dimensions; d != nil {
+		p.Dimensions = &model.Dimensions{Length: d.Length, Width: d.Width, Height: d.Height, Weight: d.Weight}
+	}
+	if m := r.Manufacturer; m != nil {
+		p.Manufacturer = &model.Manufacturer{Uuid: strings.TrimSpace(m.Uuid), Name: m.Name, Country: m.Country, Website: m.Website}
+	}
+	if len(r.Metadata) > 0 {
+		p.Metadata = make(map[string]interface{}, len(r.Metadata))
+		for k, v := range r.Metadata {
+			p.Metadata[k] = v.value
+		}
+	}
+	for _, c := range r.Components {
+		p.Components = append(p.Components, model.Component{PartUuid: strings.TrimSpace(c.PartUuid), Quantity: c.Quantity})
+	}
+
+	return p, nil
+}
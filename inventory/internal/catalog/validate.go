@@ -0,0 +1,61 @@
+package catalog
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// Validate проверяет детали по тем же правилам, что и сервис при сохранении, а также
+// уникальность UUID внутри файла. Возвращает все найденные ошибки (errors.Join из *LineError).
+func Validate(rows []Row) error {
+	var errs []error
+	seen := make(map[string]int, len(rows))
+
+	for _, row := range rows {
+		if err := validateRow(row, seen); err != nil {
+			errs = append(errs, &LineError{Line: row.Line, Err: err})
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func validateRow(row Row, seen map[string]int) error {
+	if id := row.Part.Uuid; id != "" {
+		if _, err := uuid.Parse(id); err != nil {
+			return fmt.Errorf("uuid %q is not a valid UUID", id)
+		}
+		if line, ok := seen[id]; ok {
+			return fmt.Errorf("uuid %s is already used on line %d", id, line)
+		}
+		seen[id] = row.Line
+	}
+
+	if err := row.Part.Validate(); err != nil {
+		return err
+	}
+
+	if id := row.Part.ReplacementPartUuid; id != "" {
+		if _, err := uuid.Parse(id); err != nil {
+			return fmt.Errorf("replacement_part_uuid %q is not a valid UUID", id)
+		}
+		if !row.Part.LifecycleState.IsWithdrawn() {
+			return errors.New("replacement_part_uuid is allowed only for DISCONTINUED or RECALLED parts")
+		}
+		if id == row.Part.Uuid {
+			return errors.New("part cannot replace itself")
+		}
+	}
+
+	// Ключ становится частью пути в документе MongoDB, поэтому точки и "$" недопустимы
+	for key := range row.Part.Metadata {
+		if key == "" || strings.Contains(key, ".") || strings.HasPrefix(key, "$") {
+			return fmt.Errorf("invalid metadata key %q", key)
+		}
+	}
+
+	return nil
+}
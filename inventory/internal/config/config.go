@@ -11,9 +11,15 @@ import (
 var appConfig *config
 
 type config struct {
-	Logger LoggerConfig
-	GRPC   GRPCConfig
-	Mongo  MongoConfig
+	Logger     LoggerConfig
+	GRPC       GRPCConfig
+	HTTP       HTTPConfig
+	Mongo      MongoConfig
+	Pagination PaginationConfig
+	Seed       SeedConfig
+	Pricing    PricingConfig
+	LowStock   LowStockConfig
+	Parts      PartsStorageConfig
 }
 
 // Load загружает конфигурацию из .env файла
@@ -33,15 +39,51 @@ func Load(path ...string) error {
 		return err
 	}
 
+	httpCfg, err := env.NewHTTPConfig()
+	if err != nil {
+		return err
+	}
+
 	mongoCfg, err := env.NewMongoConfig()
 	if err != nil {
 		return err
 	}
 
+	paginationCfg, err := env.NewPaginationConfig()
+	if err != nil {
+		return err
+	}
+
+	seedCfg, err := env.NewSeedConfig()
+	if err != nil {
+		return err
+	}
+
+	pricingCfg, err := env.NewPricingConfig()
+	if err != nil {
+		return err
+	}
+
+	lowStockCfg, err := env.NewLowStockConfig()
+	if err != nil {
+		return err
+	}
+
+	partsStorageCfg, err := env.NewPartsStorageConfig()
+	if err != nil {
+		return err
+	}
+
 	appConfig = &config{
-		Logger: loggerCfg,
-		GRPC:   grpcCfg,
-		Mongo:  mongoCfg,
+		Logger:     loggerCfg,
+		GRPC:       grpcCfg,
+		HTTP:       httpCfg,
+		Mongo:      mongoCfg,
+		Pagination: paginationCfg,
+		Seed:       seedCfg,
+		Pricing:    pricingCfg,
+		LowStock:   lowStockCfg,
+		Parts:      partsStorageCfg,
 	}
 
 	return nil
@@ -0,0 +1,36 @@
+package env
+
+import (
+	"net"
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type httpEnvConfig struct {
+	Host        string        `env:"HTTP_HOST" envDefault:"0.0.0.0"`
+	Port        string        `env:"HTTP_PORT" envDefault:"8082"`
+	ReadTimeout time.Duration `env:"HTTP_READ_TIMEOUT" envDefault:"5s"`
+}
+
+type httpConfig struct {
+	raw httpEnvConfig
+}
+
+// NewHTTPConfig создаёт конфигурацию HTTP/JSON шлюза из переменных окружения
+func NewHTTPConfig() (*httpConfig, error) {
+	var raw httpEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &httpConfig{raw: raw}, nil
+}
+
+func (cfg *httpConfig) Address() string {
+	return net.JoinHostPort(cfg.raw.Host, cfg.raw.Port)
+}
+
+func (cfg *httpConfig) ReadTimeout() time.Duration {
+	return cfg.raw.ReadTimeout
+}
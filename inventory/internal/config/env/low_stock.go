@@ -0,0 +1,44 @@
+package env
+
+import (
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type lowStockEnvConfig struct {
+	CheckInterval  time.Duration `env:"LOW_STOCK_CHECK_INTERVAL" envDefault:"1m"`
+	Notifier       string        `env:"LOW_STOCK_NOTIFIER" envDefault:"log"`
+	WebhookURL     string        `env:"LOW_STOCK_WEBHOOK_URL"`
+	WebhookTimeout time.Duration `env:"LOW_STOCK_WEBHOOK_TIMEOUT" envDefault:"5s"`
+}
+
+type lowStockConfig struct {
+	raw lowStockEnvConfig
+}
+
+// NewLowStockConfig создаёт конфигурацию оповещений о низком остатке из переменных окружения
+func NewLowStockConfig() (*lowStockConfig, error) {
+	var raw lowStockEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &lowStockConfig{raw: raw}, nil
+}
+
+func (cfg *lowStockConfig) CheckInterval() time.Duration {
+	return cfg.raw.CheckInterval
+}
+
+func (cfg *lowStockConfig) Notifier() string {
+	return cfg.raw.Notifier
+}
+
+func (cfg *lowStockConfig) WebhookURL() string {
+	return cfg.raw.WebhookURL
+}
+
+func (cfg *lowStockConfig) WebhookTimeout() time.Duration {
+	return cfg.raw.WebhookTimeout
+}
@@ -32,7 +32,7 @@ func NewMongoConfig() (*mongoConfig, error) {
 
 func (cfg *mongoConfig) URI() string {
 	return fmt.Sprintf(
-		"mongodb://%s:%s@%s:%s/%s?authSource=%s",
+		"mongodb://%s:%s@%s:%s/%s?authSource=%s&directConnection=true",
 		cfg.raw.User,
 		cfg.raw.Password,
 		cfg.raw.Host,
@@ -0,0 +1,40 @@
+package env
+
+import (
+	"crypto/rand"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type paginationEnvConfig struct {
+	PageTokenSecret string `env:"PAGE_TOKEN_SECRET"`
+}
+
+type paginationConfig struct {
+	raw    paginationEnvConfig
+	secret []byte
+}
+
+// NewPaginationConfig создаёт конфигурацию постраничной выдачи из переменных окружения.
+// Если секрет не задан, генерируется случайный: токены страниц не переживут перезапуск сервиса.
+func NewPaginationConfig() (*paginationConfig, error) {
+	var raw paginationEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	secret := []byte(raw.PageTokenSecret)
+	if len(secret) == 0 {
+		secret = []byte(rand.Text())
+	}
+
+	return &paginationConfig{raw: raw, secret: secret}, nil
+}
+
+func (cfg *paginationConfig) PageTokenSecret() []byte {
+	return cfg.secret
+}
+
+func (cfg *paginationConfig) IsPageTokenSecretGenerated() bool {
+	return cfg.raw.PageTokenSecret == ""
+}
@@ -0,0 +1,39 @@
+package env
+
+import (
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type partsStorageEnvConfig struct {
+	Backend          string        `env:"PARTS_STORAGE" envDefault:"mongo"`
+	DataDir          string        `env:"PARTS_DATA_DIR"`
+	SnapshotInterval time.Duration `env:"PARTS_SNAPSHOT_INTERVAL" envDefault:"5m"`
+}
+
+type partsStorageConfig struct {
+	raw partsStorageEnvConfig
+}
+
+// NewPartsStorageConfig создаёт конфигурацию хранилища деталей из переменных окружения
+func NewPartsStorageConfig() (*partsStorageConfig, error) {
+	var raw partsStorageEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &partsStorageConfig{raw: raw}, nil
+}
+
+func (cfg *partsStorageConfig) Backend() string {
+	return cfg.raw.Backend
+}
+
+func (cfg *partsStorageConfig) DataDir() string {
+	return cfg.raw.DataDir
+}
+
+func (cfg *partsStorageConfig) SnapshotInterval() time.Duration {
+	return cfg.raw.SnapshotInterval
+}
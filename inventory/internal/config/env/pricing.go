@@ -0,0 +1,29 @@
+package env
+
+import (
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type pricingEnvConfig struct {
+	ScheduleCheckInterval time.Duration `env:"PRICE_SCHEDULE_CHECK_INTERVAL" envDefault:"1m"`
+}
+
+type pricingConfig struct {
+	raw pricingEnvConfig
+}
+
+// NewPricingConfig создаёт конфигурацию цен из переменных окружения
+func NewPricingConfig() (*pricingConfig, error) {
+	var raw pricingEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &pricingConfig{raw: raw}, nil
+}
+
+func (cfg *pricingConfig) ScheduleCheckInterval() time.Duration {
+	return cfg.raw.ScheduleCheckInterval
+}
@@ -0,0 +1,27 @@
+package env
+
+import (
+	"github.com/caarlos0/env/v11"
+)
+
+type seedEnvConfig struct {
+	FilePath string `env:"SEED_FILE"`
+}
+
+type seedConfig struct {
+	raw seedEnvConfig
+}
+
+// NewSeedConfig создаёт конфигурацию начальных данных каталога из переменных окружения
+func NewSeedConfig() (*seedConfig, error) {
+	var raw seedEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &seedConfig{raw: raw}, nil
+}
+
+func (cfg *seedConfig) FilePath() string {
+	return cfg.raw.FilePath
+}
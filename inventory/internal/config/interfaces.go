@@ -1,5 +1,9 @@
 package config
 
+import (
+	"time"
+)
+
 // LoggerConfig интерфейс для настроек логгера
 type LoggerConfig interface {
 	Level() string
@@ -11,8 +15,57 @@ type GRPCConfig interface {
 	Address() string
 }
 
+// HTTPConfig интерфейс для настроек HTTP/JSON шлюза
+type HTTPConfig interface {
+	Address() string
+	// ReadTimeout - ограничение времени чтения заголовков запроса
+	ReadTimeout() time.Duration
+}
+
 // MongoConfig интерфейс для настроек MongoDB
 type MongoConfig interface {
 	URI() string
 	DatabaseName() string
 }
+
+// PaginationConfig интерфейс для настроек постраничной выдачи
+type PaginationConfig interface {
+	PageTokenSecret() []byte
+	IsPageTokenSecretGenerated() bool
+}
+
+// SeedConfig интерфейс для настроек начальных данных каталога
+type SeedConfig interface {
+	// FilePath - путь к файлу каталога (JSON, CSV или YAML), которым заполняется пустой каталог.
+	// Пустой - каталог не заполняется.
+	FilePath() string
+}
+
+// PricingConfig интерфейс для настроек цен
+type PricingConfig interface {
+	// ScheduleCheckInterval - период, с которым наступившие запланированные цены переносятся в детали
+	ScheduleCheckInterval() time.Duration
+}
+
+// LowStockConfig интерфейс для настроек оповещений о низком остатке
+type LowStockConfig interface {
+	// CheckInterval - период проверки остатков деталей относительно порога дозаказа
+	CheckInterval() time.Duration
+	// Notifier - способ доставки оповещений: log или webhook
+	Notifier() string
+	// WebhookURL - адрес, на который notifier webhook отправляет оповещения
+	WebhookURL() string
+	// WebhookTimeout - ограничение времени одного запроса notifier webhook
+	WebhookTimeout() time.Duration
+}
+
+// PartsStorageConfig интерфейс для настроек хранилища деталей
+type PartsStorageConfig interface {
+	// Backend - где хранятся детали: mongo или memory
+	Backend() string
+	// DataDir - каталог снимка и журнала предзаписи деталей для memory. Пустой - детали
+	// хранятся только в памяти и теряются при перезапуске.
+	DataDir() string
+	// SnapshotInterval - период сохранения снимка деталей для memory с DataDir
+	SnapshotInterval() time.Duration
+}
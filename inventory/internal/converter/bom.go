@@ -0,0 +1,31 @@
+package converter
+
+import (
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+	inventoryV1 "github.com/bogdanovds/rocket_factory/shared/pkg/proto/inventory/v1"
+)
+
+func ToProtoExpandBomResponse(bom *model.Bom) *inventoryV1.ExpandBomResponse {
+	return &inventoryV1.ExpandBomResponse{
+		Root:              toProtoBomNode(bom.Root),
+		TotalPrice:        bom.Root.RolledUpPrice * float64(bom.Root.Quantity),
+		TotalWeight:       bom.Root.RolledUpWeight * float64(bom.Root.Quantity),
+		BuildableQuantity: bom.BuildableQuantity,
+	}
+}
+
+func toProtoBomNode(n *model.BomNode) *inventoryV1.BomNode {
+	components := make([]*inventoryV1.BomNode, len(n.Components))
+	for i, c := range n.Components {
+		components[i] = toProtoBomNode(c)
+	}
+
+	return &inventoryV1.BomNode{
+		Part:           ToProtoPart(n.Part),
+		Quantity:       n.Quantity,
+		TotalQuantity:  n.TotalQuantity,
+		RolledUpPrice:  n.RolledUpPrice,
+		RolledUpWeight: n.RolledUpWeight,
+		Components:     components,
+	}
+}
@@ -0,0 +1,70 @@
+package converter
+
+import (
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+	inventoryV1 "github.com/bogdanovds/rocket_factory/shared/pkg/proto/inventory/v1"
+)
+
+func ToProtoCategoryNode(n *model.CategoryNode) *inventoryV1.CategoryNode {
+	return &inventoryV1.CategoryNode{
+		Uuid:           n.Uuid,
+		Slug:           n.Slug,
+		DisplayName:    n.DisplayName,
+		ParentUuid:     n.ParentUuid,
+		LegacyCategory: inventoryV1.Category(n.Legacy),
+		MetadataSchema: ToProtoMetadataFields(n.MetadataSchema),
+	}
+}
+
+// ToModelCategoryNode не переносит legacy_category и metadata_schema: первое вычисляет сервис,
+// второе задаётся отдельным методом
+func ToModelCategoryNode(n *inventoryV1.CategoryNode) *model.CategoryNode {
+	return &model.CategoryNode{
+		Uuid:        n.GetUuid(),
+		Slug:        n.GetSlug(),
+		DisplayName: n.GetDisplayName(),
+		ParentUuid:  n.GetParentUuid(),
+	}
+}
+
+func ToProtoCategoryNodes(nodes []*model.CategoryNode) []*inventoryV1.CategoryNode {
+	result := make([]*inventoryV1.CategoryNode, len(nodes))
+	for i, n := range nodes {
+		result[i] = ToProtoCategoryNode(n)
+	}
+	return result
+}
+
+func ToProtoMetadataFields(fields []model.MetadataField) []*inventoryV1.MetadataField {
+	result := make([]*inventoryV1.MetadataField, len(fields))
+	for i, f := range fields {
+		result[i] = &inventoryV1.MetadataField{
+			Key:          f.Key,
+			Type:         inventoryV1.MetadataType(f.Type),
+			Unit:         f.Unit,
+			Description:  f.Description,
+			Required:     f.Required,
+			CategoryUuid: f.CategoryUuid,
+		}
+		if !f.Range.IsEmpty() {
+			result[i].Range = &inventoryV1.DoubleRange{Min: f.Range.Min, Max: f.Range.Max}
+		}
+	}
+	return result
+}
+
+// ToModelMetadataFields не переносит category_uuid: в собственной схеме категории он не хранится
+func ToModelMetadataFields(fields []*inventoryV1.MetadataField) []model.MetadataField {
+	result := make([]model.MetadataField, len(fields))
+	for i, f := range fields {
+		result[i] = model.MetadataField{
+			Key:         f.GetKey(),
+			Type:        model.MetadataType(f.GetType()),
+			Unit:        f.GetUnit(),
+			Description: f.GetDescription(),
+			Required:    f.GetRequired(),
+			Range:       toModelFloatRange(f.GetRange()),
+		}
+	}
+	return result
+}
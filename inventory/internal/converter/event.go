@@ -0,0 +1,26 @@
+package converter
+
+import (
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+	inventoryV1 "github.com/bogdanovds/rocket_factory/shared/pkg/proto/inventory/v1"
+)
+
+func ToModelWatchPartsParams(req *inventoryV1.WatchPartsRequest) *model.WatchPartsParams {
+	return &model.WatchPartsParams{
+		Filter:      ToProtoPartsFilter(req.GetFilter()),
+		ResumeToken: req.GetResumeToken(),
+	}
+}
+
+func ToProtoPartEvent(e *model.PartEvent) *inventoryV1.WatchPartsResponse {
+	resp := &inventoryV1.WatchPartsResponse{
+		Type:        inventoryV1.PartEventType(e.Type),
+		PartUuid:    e.PartUuid,
+		ResumeToken: e.ResumeToken,
+	}
+	if e.Part != nil {
+		resp.Part = ToProtoPart(e.Part)
+	}
+
+	return resp
+}
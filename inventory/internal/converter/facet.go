@@ -0,0 +1,31 @@
+package converter
+
+import (
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+	inventoryV1 "github.com/bogdanovds/rocket_factory/shared/pkg/proto/inventory/v1"
+)
+
+func ToProtoGetPartFacetsResponse(f *model.PartFacets) *inventoryV1.GetPartFacetsResponse {
+	categories := make([]*inventoryV1.CategoryFacetCount, len(f.Categories))
+	for i, c := range f.Categories {
+		categories[i] = &inventoryV1.CategoryFacetCount{
+			CategoryUuid: c.CategoryUuid,
+			Category:     inventoryV1.Category(c.Category),
+			Count:        int32(c.Count),
+		}
+	}
+
+	return &inventoryV1.GetPartFacetsResponse{
+		Categories:            categories,
+		ManufacturerCountries: toProtoFacetCounts(f.ManufacturerCountries),
+		Tags:                  toProtoFacetCounts(f.Tags),
+	}
+}
+
+func toProtoFacetCounts(counts []model.FacetCount) []*inventoryV1.FacetCount {
+	result := make([]*inventoryV1.FacetCount, len(counts))
+	for i, c := range counts {
+		result[i] = &inventoryV1.FacetCount{Value: c.Value, Count: int32(c.Count)}
+	}
+	return result
+}
@@ -0,0 +1,32 @@
+package converter
+
+import (
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+	inventoryV1 "github.com/bogdanovds/rocket_factory/shared/pkg/proto/inventory/v1"
+)
+
+func ToProtoManufacturer(m *model.Manufacturer) *inventoryV1.Manufacturer {
+	return &inventoryV1.Manufacturer{
+		Uuid:    m.Uuid,
+		Name:    m.Name,
+		Country: m.Country,
+		Website: m.Website,
+	}
+}
+
+func ToModelManufacturer(m *inventoryV1.Manufacturer) *model.Manufacturer {
+	return &model.Manufacturer{
+		Uuid:    m.GetUuid(),
+		Name:    m.GetName(),
+		Country: m.GetCountry(),
+		Website: m.GetWebsite(),
+	}
+}
+
+func ToProtoManufacturers(manufacturers []*model.Manufacturer) []*inventoryV1.Manufacturer {
+	result := make([]*inventoryV1.Manufacturer, len(manufacturers))
+	for i, m := range manufacturers {
+		result[i] = ToProtoManufacturer(m)
+	}
+	return result
+}
@@ -22,28 +22,42 @@ func ToProtoPart(p *model.Part) *inventoryV1.Part {
 		updatedAt = timestamppb.New(*p.UpdatedAt)
 	}
 
+	// Детали, загруженные из файла каталога, могут быть без размеров и производителя
+	var dimensions *inventoryV1.Dimensions
+	if d := p.Dimensions; d != nil {
+		dimensions = &inventoryV1.Dimensions{Length: d.Length, Width: d.Width, Height: d.Height, Weight: d.Weight}
+	}
+	var manufacturer *inventoryV1.Manufacturer
+	if p.Manufacturer != nil {
+		manufacturer = ToProtoManufacturer(p.Manufacturer)
+	}
+
+	components := make([]*inventoryV1.BomComponent, len(p.Components))
+	for i, c := range p.Components {
+		components[i] = &inventoryV1.BomComponent{PartUuid: c.PartUuid, Quantity: c.Quantity}
+	}
+
 	return &inventoryV1.Part{
-		Uuid:          p.Uuid,
-		Name:          p.Name,
-		Description:   p.Description,
-		Price:         p.Price,
-		StockQuantity: p.StockQuantity,
-		Category:      inventoryV1.Category(p.Category),
-		Dimensions: &inventoryV1.Dimensions{
-			Length: p.Dimensions.Length,
-			Width:  p.Dimensions.Width,
-			Height: p.Dimensions.Height,
-			Weight: p.Dimensions.Weight,
-		},
-		Manufacturer: &inventoryV1.Manufacturer{
-			Name:    p.Manufacturer.Name,
-			Country: p.Manufacturer.Country,
-			Website: p.Manufacturer.Website,
-		},
-		Tags:      p.Tags,
-		Metadata:  metadata,
-		CreatedAt: createdAt,
-		UpdatedAt: updatedAt,
+		Uuid:             p.Uuid,
+		Name:             p.Name,
+		Description:      p.Description,
+		Price:            p.Price,
+		StockQuantity:    p.StockQuantity,
+		Category:         inventoryV1.Category(p.Category),
+		Dimensions:       dimensions,
+		Manufacturer:     manufacturer,
+		Tags:             p.Tags,
+		Metadata:         metadata,
+		CreatedAt:        createdAt,
+		UpdatedAt:        updatedAt,
+		SearchScore:      p.SearchScore,
+		Components:       components,
+		CategoryUuid:     p.CategoryUuid,
+		ReorderThreshold: p.ReorderThreshold,
+		StockLevels:      toProtoStockLevels(p.StockLevels),
+
+		LifecycleState:      inventoryV1.PartLifecycleState(p.LifecycleState),
+		ReplacementPartUuid: p.ReplacementPartUuid,
 	}
 }
 
@@ -53,6 +67,11 @@ func ToModelPart(p *inventoryV1.Part) *model.Part {
 		metadata[k] = toModelValue(v)
 	}
 
+	var components []model.Component
+	for _, c := range p.GetComponents() {
+		components = append(components, model.Component{PartUuid: c.GetPartUuid(), Quantity: c.GetQuantity()})
+	}
+
 	return &model.Part{
 		Uuid:          p.GetUuid(),
 		Name:          p.GetName(),
@@ -66,15 +85,26 @@ func ToModelPart(p *inventoryV1.Part) *model.Part {
 			Height: p.GetDimensions().GetHeight(),
 			Weight: p.GetDimensions().GetWeight(),
 		},
-		Manufacturer: &model.Manufacturer{
-			Name:    p.GetManufacturer().GetName(),
-			Country: p.GetManufacturer().GetCountry(),
-			Website: p.GetManufacturer().GetWebsite(),
-		},
-		Tags:      p.GetTags(),
-		Metadata:  metadata,
-		CreatedAt: lo.ToPtr(p.GetCreatedAt().AsTime()),
-		UpdatedAt: lo.ToPtr(p.GetUpdatedAt().AsTime()),
+		Manufacturer:     ToModelManufacturer(p.GetManufacturer()),
+		Tags:             p.GetTags(),
+		Metadata:         metadata,
+		CreatedAt:        lo.ToPtr(p.GetCreatedAt().AsTime()),
+		UpdatedAt:        lo.ToPtr(p.GetUpdatedAt().AsTime()),
+		Components:       components,
+		CategoryUuid:     p.GetCategoryUuid(),
+		ReorderThreshold: p.GetReorderThreshold(),
+		StockLevels:      toModelStockLevels(p.GetStockLevels()),
+
+		LifecycleState:      model.LifecycleState(p.GetLifecycleState()),
+		ReplacementPartUuid: p.GetReplacementPartUuid(),
+	}
+}
+
+func ToModelLifecycleChange(req *inventoryV1.ChangePartLifecycleStateRequest) *model.LifecycleChange {
+	return &model.LifecycleChange{
+		PartUuid:            req.GetPartUuid(),
+		State:               model.LifecycleState(req.GetState()),
+		ReplacementPartUuid: req.GetReplacementPartUuid(),
 	}
 }
 
@@ -118,11 +148,100 @@ func ToProtoPartsFilter(f *inventoryV1.PartsFilter) *model.PartsFilter {
 		categories = append(categories, model.Category(c))
 	}
 
+	var lifecycleStates []model.LifecycleState
+	for _, state := range f.GetLifecycleStates() {
+		lifecycleStates = append(lifecycleStates, model.LifecycleState(state))
+	}
+
 	return &model.PartsFilter{
 		Uuids:                 f.GetUuids(),
 		Names:                 f.GetNames(),
 		Categories:            categories,
 		ManufacturerCountries: f.GetManufacturerCountries(),
 		Tags:                  f.GetTags(),
+		Query:                 f.GetQuery(),
+		Price:                 toModelFloatRange(f.GetPrice()),
+		InStockOnly:           f.GetInStockOnly(),
+		Length:                toModelFloatRange(f.GetDimensions().GetLength()),
+		Width:                 toModelFloatRange(f.GetDimensions().GetWidth()),
+		Height:                toModelFloatRange(f.GetDimensions().GetHeight()),
+		Weight:                toModelFloatRange(f.GetDimensions().GetWeight()),
+		CreatedAt:             toModelTimeRange(f.GetCreatedAt()),
+		UpdatedAt:             toModelTimeRange(f.GetUpdatedAt()),
+		Metadata:              toModelMetadataPredicates(f.GetMetadata()),
+		ComponentUuids:        f.GetComponentUuids(),
+		ManufacturerUuids:     f.GetManufacturerUuids(),
+		CategoryUuids:         f.GetCategoryUuids(),
+		LowStock:              f.GetLowStock(),
+		LifecycleStates:       lifecycleStates,
+	}
+}
+
+func toModelFloatRange(r *inventoryV1.DoubleRange) *model.FloatRange {
+	if r == nil {
+		return nil
+	}
+
+	return &model.FloatRange{Min: r.Min, Max: r.Max}
+}
+
+func toModelTimeRange(r *inventoryV1.TimestampRange) *model.TimeRange {
+	if r == nil {
+		return nil
+	}
+
+	var tr model.TimeRange
+	if r.GetFrom() != nil {
+		tr.From = lo.ToPtr(r.GetFrom().AsTime())
+	}
+	if r.GetTo() != nil {
+		tr.To = lo.ToPtr(r.GetTo().AsTime())
+	}
+
+	return &tr
+}
+
+func toModelMetadataPredicates(predicates []*inventoryV1.MetadataPredicate) []model.MetadataPredicate {
+	if len(predicates) == 0 {
+		return nil
+	}
+
+	result := make([]model.MetadataPredicate, len(predicates))
+	for i, p := range predicates {
+		result[i] = model.MetadataPredicate{
+			Key:      p.GetKey(),
+			Operator: model.MetadataOperator(p.GetOperator()),
+		}
+		if p.GetValue().GetValue() != nil {
+			result[i].Value = toModelValue(p.GetValue())
+		}
+	}
+
+	return result
+}
+
+func ToModelListPartsParams(req *inventoryV1.ListPartsRequest) *model.ListPartsParams {
+	return &model.ListPartsParams{
+		Filter: ToProtoPartsFilter(req.GetFilter()),
+		Order: model.PartsOrder{
+			Field:      model.PartsOrderField(req.GetOrderBy().GetField()),
+			Descending: req.GetOrderBy().GetDescending(),
+		},
+		PageSize:  int(req.GetPageSize()),
+		PageToken: req.GetPageToken(),
+		AsOf:      ToModelAsOf(req.GetAsOf()),
+	}
+}
+
+func ToProtoBatchGetPartsResponse(b *model.PartsBatch) *inventoryV1.BatchGetPartsResponse {
+	parts := make(map[string]*inventoryV1.Part, len(b.Parts))
+	for id, part := range b.Parts {
+		parts[id] = ToProtoPart(part)
+	}
+
+	return &inventoryV1.BatchGetPartsResponse{
+		Parts:         parts,
+		NotFoundUuids: b.NotFoundUuids,
+		InvalidUuids:  b.InvalidUuids,
 	}
 }
@@ -0,0 +1,36 @@
+package converter
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+	inventoryV1 "github.com/bogdanovds/rocket_factory/shared/pkg/proto/inventory/v1"
+)
+
+// ToModelAsOf конвертирует момент as_of запроса; не указанный - нулевое время, то есть текущий момент
+func ToModelAsOf(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+
+	return ts.AsTime()
+}
+
+func ToModelPriceChange(req *inventoryV1.SchedulePriceChangeRequest) *model.PriceChange {
+	return &model.PriceChange{
+		PartUuid:      req.GetPartUuid(),
+		Price:         req.GetPrice(),
+		EffectiveFrom: req.GetEffectiveFrom().AsTime(),
+	}
+}
+
+func ToProtoPriceVersion(v *model.PriceVersion) *inventoryV1.PriceVersion {
+	return &inventoryV1.PriceVersion{
+		Uuid:          v.Uuid,
+		Price:         v.Price,
+		EffectiveFrom: timestamppb.New(v.EffectiveFrom),
+		CreatedAt:     timestamppb.New(v.CreatedAt),
+	}
+}
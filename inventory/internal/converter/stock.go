@@ -0,0 +1,72 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+	inventoryV1 "github.com/bogdanovds/rocket_factory/shared/pkg/proto/inventory/v1"
+)
+
+func ToModelStockAdjustment(req *inventoryV1.AdjustStockRequest) *model.StockAdjustment {
+	return &model.StockAdjustment{
+		PartUuid:      req.GetPartUuid(),
+		WarehouseUuid: req.GetWarehouseUuid(),
+		Delta:         req.GetDelta(),
+		Reason:        model.StockMovementReason(req.GetReason()),
+		ReferenceID:   req.GetReferenceId(),
+	}
+}
+
+func ToModelStockTransfer(req *inventoryV1.TransferStockRequest) *model.StockTransfer {
+	return &model.StockTransfer{
+		PartUuid:          req.GetPartUuid(),
+		FromWarehouseUuid: req.GetFromWarehouseUuid(),
+		ToWarehouseUuid:   req.GetToWarehouseUuid(),
+		Quantity:          req.GetQuantity(),
+		ReferenceID:       req.GetReferenceId(),
+	}
+}
+
+func ToModelStockFulfillment(req *inventoryV1.FulfillStockRequest) *model.StockFulfillment {
+	items := make([]model.FulfillmentItem, len(req.GetItems()))
+	for i, item := range req.GetItems() {
+		items[i] = model.FulfillmentItem{PartUuid: item.GetPartUuid(), Quantity: item.GetQuantity()}
+	}
+
+	return &model.StockFulfillment{
+		ReferenceID:            req.GetReferenceId(),
+		PreferredWarehouseUuid: req.GetPreferredWarehouseUuid(),
+		Items:                  items,
+	}
+}
+
+func ToModelListStockMovementsParams(req *inventoryV1.ListStockMovementsRequest) *model.ListStockMovementsParams {
+	return &model.ListStockMovementsParams{
+		PartUuid:  req.GetPartUuid(),
+		PageSize:  int(req.GetPageSize()),
+		PageToken: req.GetPageToken(),
+	}
+}
+
+// ToModelListLowStockPartsParams строит запрос ListParts с фильтром low_stock, начиная с наименьшего остатка
+func ToModelListLowStockPartsParams(req *inventoryV1.ListLowStockPartsRequest) *model.ListPartsParams {
+	return &model.ListPartsParams{
+		Filter:    &model.PartsFilter{LowStock: true},
+		Order:     model.PartsOrder{Field: model.PartsOrderFieldStockQuantity},
+		PageSize:  int(req.GetPageSize()),
+		PageToken: req.GetPageToken(),
+	}
+}
+
+func ToProtoStockMovement(m *model.StockMovement) *inventoryV1.StockMovement {
+	return &inventoryV1.StockMovement{
+		Uuid:          m.Uuid,
+		PartUuid:      m.PartUuid,
+		WarehouseUuid: m.WarehouseUuid,
+		Delta:         m.Delta,
+		QuantityAfter: m.QuantityAfter,
+		Reason:        inventoryV1.StockMovementReason(m.Reason),
+		ReferenceId:   m.ReferenceID,
+		CreatedAt:     timestamppb.New(m.CreatedAt),
+	}
+}
@@ -0,0 +1,48 @@
+package converter
+
+import (
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+	inventoryV1 "github.com/bogdanovds/rocket_factory/shared/pkg/proto/inventory/v1"
+)
+
+func ToProtoWarehouse(w *model.Warehouse) *inventoryV1.Warehouse {
+	return &inventoryV1.Warehouse{
+		Uuid:     w.Uuid,
+		Code:     w.Code,
+		Name:     w.Name,
+		Location: w.Location,
+	}
+}
+
+func ToModelWarehouse(w *inventoryV1.Warehouse) *model.Warehouse {
+	return &model.Warehouse{
+		Uuid:     w.GetUuid(),
+		Code:     w.GetCode(),
+		Name:     w.GetName(),
+		Location: w.GetLocation(),
+	}
+}
+
+func ToProtoWarehouses(warehouses []*model.Warehouse) []*inventoryV1.Warehouse {
+	result := make([]*inventoryV1.Warehouse, len(warehouses))
+	for i, w := range warehouses {
+		result[i] = ToProtoWarehouse(w)
+	}
+	return result
+}
+
+func toProtoStockLevels(levels []model.StockLevel) []*inventoryV1.StockLevel {
+	result := make([]*inventoryV1.StockLevel, len(levels))
+	for i, l := range levels {
+		result[i] = &inventoryV1.StockLevel{WarehouseUuid: l.WarehouseUuid, Quantity: l.Quantity}
+	}
+	return result
+}
+
+func toModelStockLevels(levels []*inventoryV1.StockLevel) []model.StockLevel {
+	var result []model.StockLevel
+	for _, l := range levels {
+		result = append(result, model.StockLevel{WarehouseUuid: l.GetWarehouseUuid(), Quantity: l.GetQuantity()})
+	}
+	return result
+}
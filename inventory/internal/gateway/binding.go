@@ -0,0 +1,270 @@
+package gateway
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/go-chi/chi/v5"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// scalarMessages - сообщения, которые в JSON и строке запроса записываются строкой
+var scalarMessages = map[protoreflect.FullName]bool{
+	"google.protobuf.Timestamp": true,
+	"google.protobuf.Duration":  true,
+	"google.protobuf.FieldMask": true,
+}
+
+// bind заполняет запрос из тела, строки запроса и параметров пути. Параметры пути
+// применяются последними и перекрывают одноимённые поля тела.
+func bind(r *http.Request, rt route, req protoreflect.Message) error {
+	if rt.body != "" {
+		if err := bindBody(r, rt.body, req); err != nil {
+			return err
+		}
+	}
+
+	if err := bindQuery(req, r.URL.Query(), rt.queryRoot); err != nil {
+		return err
+	}
+
+	for _, param := range rt.pathParams() {
+		value, err := url.PathUnescape(chi.URLParam(r, param))
+		if err != nil {
+			return fmt.Errorf("path parameter %q: %w", param, err)
+		}
+		if err := setField(req, strings.Split(rt.pathField(param), "."), []string{value}); err != nil {
+			return fmt.Errorf("path parameter %q: %w", param, err)
+		}
+	}
+
+	return nil
+}
+
+func bindBody(r *http.Request, field string, req protoreflect.Message) error {
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			return fmt.Errorf("request body exceeds %d bytes", maxBodySize)
+		}
+		return fmt.Errorf("failed to read request body: %w", err)
+	}
+	if len(strings.TrimSpace(string(data))) == 0 {
+		return nil
+	}
+
+	target := req
+	if field != "*" {
+		fd := req.Descriptor().Fields().ByName(protoreflect.Name(field))
+		target = req.Mutable(fd).Message()
+	}
+
+	if err := protojson.Unmarshal(data, target.Interface()); err != nil {
+		return fmt.Errorf("invalid request body: %w", err)
+	}
+
+	return nil
+}
+
+// bindQuery заполняет запрос из строки запроса. Имя параметра - путь к полю через точку
+// (filter.price.min), имена полей - как в proto или в JSON. Повторяющиеся поля задаются
+// повторением параметра. Повторяющиеся сообщения и map строкой запроса не задаются.
+func bindQuery(req protoreflect.Message, query url.Values, root string) error {
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		path := strings.Split(key, ".")
+		if root != "" && findField(req.Descriptor(), path[0]) == nil {
+			path = append([]string{root}, path...)
+		}
+		if err := setField(req, path, query[key]); err != nil {
+			return fmt.Errorf("query parameter %q: %w", key, err)
+		}
+	}
+
+	return nil
+}
+
+func findField(md protoreflect.MessageDescriptor, name string) protoreflect.FieldDescriptor {
+	if fd := md.Fields().ByName(protoreflect.Name(name)); fd != nil {
+		return fd
+	}
+	return md.Fields().ByJSONName(name)
+}
+
+// setField записывает значения в поле по пути, создавая промежуточные сообщения
+func setField(m protoreflect.Message, path, values []string) error {
+	for i, name := range path {
+		fd := findField(m.Descriptor(), name)
+		if fd == nil {
+			return fmt.Errorf("unknown field %q", name)
+		}
+
+		if i < len(path)-1 {
+			if fd.Message() == nil || fd.IsList() || fd.IsMap() || scalarMessages[fd.Message().FullName()] {
+				return fmt.Errorf("field %q has no nested fields", name)
+			}
+			m = m.Mutable(fd).Message()
+			continue
+		}
+
+		return setValues(m, fd, values)
+	}
+
+	return nil
+}
+
+func setValues(m protoreflect.Message, fd protoreflect.FieldDescriptor, values []string) error {
+	if fd.IsMap() || (fd.Message() != nil && (fd.IsList() || !scalarMessages[fd.Message().FullName()])) {
+		return fmt.Errorf("field %q cannot be set from a string", fd.Name())
+	}
+
+	if fd.IsList() {
+		list := m.Mutable(fd).List()
+		for _, s := range values {
+			v, err := parseScalar(fd, s)
+			if err != nil {
+				return err
+			}
+			list.Append(v)
+		}
+		return nil
+	}
+
+	if len(values) != 1 {
+		return fmt.Errorf("field %q takes a single value", fd.Name())
+	}
+
+	if fd.Message() != nil {
+		msg := m.NewField(fd).Message()
+		quoted, _ := json.Marshal(values[0])
+		if err := protojson.Unmarshal(quoted, msg.Interface()); err != nil {
+			return fmt.Errorf("invalid value %q for field %q", values[0], fd.Name())
+		}
+		m.Set(fd, protoreflect.ValueOfMessage(msg))
+		return nil
+	}
+
+	v, err := parseScalar(fd, values[0])
+	if err != nil {
+		return err
+	}
+	m.Set(fd, v)
+
+	return nil
+}
+
+func parseScalar(fd protoreflect.FieldDescriptor, s string) (protoreflect.Value, error) {
+	invalid := func() (protoreflect.Value, error) {
+		return protoreflect.Value{}, fmt.Errorf("invalid %s value %q for field %q", fd.Kind(), s, fd.Name())
+	}
+
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		v, err := strconv.ParseBool(s)
+		if err != nil {
+			return invalid()
+		}
+		return protoreflect.ValueOfBool(v), nil
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		v, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return invalid()
+		}
+		return protoreflect.ValueOfInt32(int32(v)), nil
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		v, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return invalid()
+		}
+		return protoreflect.ValueOfInt64(v), nil
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		v, err := strconv.ParseUint(s, 10, 32)
+		if err != nil {
+			return invalid()
+		}
+		return protoreflect.ValueOfUint32(uint32(v)), nil
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		v, err := strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			return invalid()
+		}
+		return protoreflect.ValueOfUint64(v), nil
+	case protoreflect.FloatKind:
+		v, err := strconv.ParseFloat(s, 32)
+		if err != nil {
+			return invalid()
+		}
+		return protoreflect.ValueOfFloat32(float32(v)), nil
+	case protoreflect.DoubleKind:
+		v, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return invalid()
+		}
+		return protoreflect.ValueOfFloat64(v), nil
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(s), nil
+	case protoreflect.BytesKind:
+		v, err := base64.StdEncoding.DecodeString(s)
+		if err != nil {
+			v, err = base64.URLEncoding.DecodeString(s)
+		}
+		if err != nil {
+			return invalid()
+		}
+		return protoreflect.ValueOfBytes(v), nil
+	case protoreflect.EnumKind:
+		if ev := findEnumValue(fd.Enum(), s); ev != nil {
+			return protoreflect.ValueOfEnum(ev.Number()), nil
+		}
+		return invalid()
+	default:
+		return invalid()
+	}
+}
+
+// findEnumValue ищет значение enum по полному имени (CATEGORY_ENGINE), имени без префикса
+// типа (ENGINE, без учёта регистра) или номеру
+func findEnumValue(ed protoreflect.EnumDescriptor, s string) protoreflect.EnumValueDescriptor {
+	values := ed.Values()
+	name := strings.ToUpper(s)
+	if ev := values.ByName(protoreflect.Name(name)); ev != nil {
+		return ev
+	}
+	if ev := values.ByName(protoreflect.Name(enumPrefix(ed) + name)); ev != nil {
+		return ev
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		return values.ByNumber(protoreflect.EnumNumber(n))
+	}
+	return nil
+}
+
+// enumPrefix возвращает префикс значений enum по соглашению proto: PartsOrderField - PARTS_ORDER_FIELD_
+func enumPrefix(ed protoreflect.EnumDescriptor) string {
+	var b strings.Builder
+	runes := []rune(string(ed.Name()))
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) && !unicode.IsUpper(runes[i-1]) {
+			b.WriteByte('_')
+		}
+		b.WriteRune(unicode.ToUpper(r))
+	}
+	b.WriteByte('_')
+	return b.String()
+}
@@ -0,0 +1,137 @@
+// Package gateway отдаёт InventoryService по HTTP/JSON. Маршруты описаны таблицей routes,
+// запросы и ответы преобразуются через protojson, а документ OpenAPI строится по дескрипторам proto.
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	inventoryV1 "github.com/bogdanovds/rocket_factory/shared/pkg/proto/inventory/v1"
+)
+
+const (
+	// apiPrefix - общий префикс маршрутов шлюза
+	apiPrefix = "/api/v1"
+	// openAPIPath - путь документа OpenAPI относительно apiPrefix
+	openAPIPath = "/openapi.json"
+	// maxBodySize - наибольший размер тела запроса, совпадает с ограничением сообщения gRPC по умолчанию
+	maxBodySize = 4 << 20
+)
+
+// marshalOptions - ответы с именами полей как в proto и с нулевыми значениями скаляров,
+// чтобы клиенту не приходилось достраивать пропущенные поля
+var marshalOptions = protojson.MarshalOptions{UseProtoNames: true, EmitDefaultValues: true}
+
+// methodHandler - обработчик унарного метода из grpc.ServiceDesc
+type methodHandler = func(srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor) (any, error)
+
+// Gateway - HTTP-обработчик, вызывающий методы InventoryService в том же процессе
+type Gateway struct {
+	server inventoryV1.InventoryServiceServer
+	router chi.Router
+}
+
+// New создаёт шлюз к server. Маршрут на отсутствующий метод - ошибка программы, поэтому New паникует.
+func New(server inventoryV1.InventoryServiceServer) *Gateway {
+	g := &Gateway{server: server}
+
+	service := serviceDescriptor()
+	handlers := make(map[string]methodHandler, len(inventoryV1.InventoryService_ServiceDesc.Methods))
+	for _, m := range inventoryV1.InventoryService_ServiceDesc.Methods {
+		handlers[m.MethodName] = m.Handler
+	}
+
+	doc, err := buildOpenAPI(service, routes)
+	if err != nil {
+		panic(fmt.Sprintf("failed to build OpenAPI document: %v", err))
+	}
+
+	r := chi.NewRouter()
+	r.NotFound(func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, status.Errorf(codes.NotFound, "no route for %s %s", r.Method, r.URL.Path))
+	})
+	r.MethodNotAllowed(func(w http.ResponseWriter, r *http.Request) {
+		writeStatus(w, http.StatusMethodNotAllowed, status.Newf(codes.Unimplemented, "method %s is not allowed for %s", r.Method, r.URL.Path))
+	})
+
+	r.Route(apiPrefix, func(r chi.Router) {
+		r.Get(openAPIPath, func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write(doc)
+		})
+
+		for _, rt := range routes {
+			desc := service.Methods().ByName(protoreflect.Name(rt.rpc))
+			handler, ok := handlers[rt.rpc]
+			if desc == nil || !ok || desc.IsStreamingServer() || desc.IsStreamingClient() {
+				panic(fmt.Sprintf("route %s %s: unary method %s not found", rt.method, rt.path, rt.rpc))
+			}
+			r.Method(rt.method, rt.path, g.handle(rt, desc, handler))
+		}
+	})
+
+	g.router = r
+
+	return g
+}
+
+func serviceDescriptor() protoreflect.ServiceDescriptor {
+	return inventoryV1.File_inventory_v1_inventory_proto.Services().ByName("InventoryService")
+}
+
+func (g *Gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	g.router.ServeHTTP(w, r)
+}
+
+func (g *Gateway) handle(rt route, desc protoreflect.MethodDescriptor, handler methodHandler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		reqType, err := protoregistry.GlobalTypes.FindMessageByName(desc.Input().FullName())
+		if err != nil {
+			writeError(w, status.Errorf(codes.Internal, "request type %s is not registered", desc.Input().FullName()))
+			return
+		}
+
+		req := reqType.New()
+		r.Body = http.MaxBytesReader(w, r.Body, maxBodySize)
+		if err := bind(r, rt, req); err != nil {
+			writeError(w, status.Error(codes.InvalidArgument, err.Error()))
+			return
+		}
+
+		// dec копирует уже разобранный запрос в сообщение, созданное сгенерированным обработчиком
+		dec := func(v any) error {
+			proto.Merge(v.(proto.Message), req.Interface())
+			return nil
+		}
+
+		resp, err := handler(g.server, r.Context(), dec, nil)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+
+		writeMessage(w, http.StatusOK, resp.(proto.Message))
+	}
+}
+
+func writeMessage(w http.ResponseWriter, httpStatus int, m proto.Message) {
+	data, err := marshalOptions.Marshal(m)
+	if err != nil {
+		httpStatus = http.StatusInternalServerError
+		data, _ = marshalOptions.Marshal(status.New(codes.Internal, "failed to encode response").Proto())
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatus)
+	_, _ = w.Write(data)
+}
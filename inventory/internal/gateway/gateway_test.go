@@ -0,0 +1,280 @@
+package gateway
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	api "github.com/bogdanovds/rocket_factory/inventory/internal/api/inventory/v1"
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+	"github.com/bogdanovds/rocket_factory/inventory/internal/service/mocks"
+)
+
+const testPartUuid = "550e8400-e29b-41d4-a716-446655440000"
+
+type GatewayTestSuite struct {
+	suite.Suite
+	parts   *mocks.MockPartService
+	gateway *Gateway
+}
+
+func (s *GatewayTestSuite) SetupTest() {
+	s.parts = mocks.NewMockPartService()
+	s.gateway = New(api.NewInventoryAPI(s.parts, nil, nil, nil))
+}
+
+func (s *GatewayTestSuite) TearDownTest() {
+	s.parts.AssertExpectations(s.T())
+}
+
+func (s *GatewayTestSuite) do(method, target, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	s.gateway.ServeHTTP(rec, req)
+	return rec
+}
+
+func (s *GatewayTestSuite) decode(rec *httptest.ResponseRecorder) map[string]any {
+	var body map[string]any
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &body), rec.Body.String())
+	return body
+}
+
+func testPart() *model.Part {
+	return &model.Part{
+		Uuid:          testPartUuid,
+		Name:          "Main Engine",
+		Price:         1500,
+		StockQuantity: 4,
+		Category:      model.CategoryEngine,
+		CreatedAt:     lo.ToPtr(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)),
+		UpdatedAt:     lo.ToPtr(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)),
+	}
+}
+
+func (s *GatewayTestSuite) TestListParts_QueryFilter() {
+	s.parts.On("ListParts", mock.Anything, mock.MatchedBy(func(p *model.ListPartsParams) bool {
+		f := p.Filter
+		return p.PageSize == 5 &&
+			p.Order.Field == model.PartsOrderField(2) && p.Order.Descending &&
+			f.InStockOnly &&
+			len(f.Categories) == 2 && f.Categories[0] == model.CategoryEngine && f.Categories[1] == model.CategoryWing &&
+			len(f.Tags) == 2 && f.Tags[0] == "heavy" && f.Tags[1] == "orbital" &&
+			f.Price != nil && *f.Price.Min == 10 && f.Price.Max == nil &&
+			f.CreatedAt != nil && f.CreatedAt.From.Equal(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	})).Return(&model.PartsPage{Parts: []*model.Part{testPart()}, NextPageToken: "next"}, nil)
+
+	rec := s.do(http.MethodGet, "/api/v1/parts?categories=ENGINE&categories=CATEGORY_WING&tags=heavy&tags=orbital"+
+		"&price.min=10&inStockOnly=true&created_at.from=2026-01-01T00:00:00Z"+
+		"&page_size=5&order_by.field=2&order_by.descending=true", "")
+
+	s.Equal(http.StatusOK, rec.Code)
+	body := s.decode(rec)
+	s.Equal("next", body["next_page_token"])
+	part := body["parts"].([]any)[0].(map[string]any)
+	s.Equal("Main Engine", part["name"])
+	s.Equal("CATEGORY_ENGINE", part["category"])
+	// 64-битные целые protojson записывает строкой
+	s.Equal("4", part["stock_quantity"])
+	s.Equal("0", part["reorder_threshold"])
+}
+
+func (s *GatewayTestSuite) TestListParts_FullFilterPath() {
+	s.parts.On("ListParts", mock.Anything, mock.MatchedBy(func(p *model.ListPartsParams) bool {
+		return len(p.Filter.Names) == 1 && p.Filter.Names[0] == "Main Engine"
+	})).Return(&model.PartsPage{}, nil)
+
+	rec := s.do(http.MethodGet, "/api/v1/parts?filter.names=Main+Engine", "")
+
+	s.Equal(http.StatusOK, rec.Code)
+	s.Equal([]any{}, s.decode(rec)["parts"])
+}
+
+func (s *GatewayTestSuite) TestListParts_InvalidQuery() {
+	for _, query := range []string{
+		"unknown=1",
+		"categories=PLUTONIUM",
+		"page_size=many",
+		"page_size=1&page_size=2",
+		"metadata=x",
+		"price=10",
+	} {
+		rec := s.do(http.MethodGet, "/api/v1/parts?"+query, "")
+
+		s.Equal(http.StatusBadRequest, rec.Code, query)
+		s.EqualValues(3, s.decode(rec)["code"], query)
+	}
+}
+
+func (s *GatewayTestSuite) TestGetPartFacets() {
+	s.parts.On("GetPartFacets", mock.Anything, mock.MatchedBy(func(f *model.PartsFilter) bool {
+		return len(f.Tags) == 1 && f.Tags[0] == "heavy"
+	})).Return(&model.PartFacets{
+		Categories:            []model.CategoryFacetCount{{Category: model.CategoryEngine, Count: 2}},
+		ManufacturerCountries: []model.FacetCount{{Value: "US", Count: 2}},
+		Tags:                  []model.FacetCount{},
+	}, nil)
+
+	rec := s.do(http.MethodGet, "/api/v1/parts/facets?tags=heavy", "")
+
+	s.Equal(http.StatusOK, rec.Code, rec.Body.String())
+	body := s.decode(rec)
+	s.Equal("CATEGORY_ENGINE", body["categories"].([]any)[0].(map[string]any)["category"])
+	s.EqualValues(2, body["manufacturer_countries"].([]any)[0].(map[string]any)["count"])
+	s.Equal([]any{}, body["tags"])
+}
+
+func (s *GatewayTestSuite) TestBatchGetParts() {
+	s.parts.On("BatchGetParts", mock.Anything, []string{testPartUuid, "missing"}, time.Time{}).Return(&model.PartsBatch{
+		Parts:         map[string]*model.Part{testPartUuid: testPart()},
+		NotFoundUuids: []string{},
+		InvalidUuids:  []string{"missing"},
+	}, nil)
+
+	rec := s.do(http.MethodPost, "/api/v1/parts/batch-get", `{"uuids": ["`+testPartUuid+`", "missing"]}`)
+
+	s.Equal(http.StatusOK, rec.Code, rec.Body.String())
+	body := s.decode(rec)
+	s.Equal("Main Engine", body["parts"].(map[string]any)[testPartUuid].(map[string]any)["name"])
+	s.Equal([]any{}, body["not_found_uuids"])
+	s.Equal([]any{"missing"}, body["invalid_uuids"])
+}
+
+func (s *GatewayTestSuite) TestGetPart() {
+	s.parts.On("GetPart", mock.Anything, testPartUuid, time.Time{}).Return(testPart(), nil)
+
+	rec := s.do(http.MethodGet, "/api/v1/parts/"+testPartUuid, "")
+
+	s.Equal(http.StatusOK, rec.Code)
+	s.Equal("application/json", rec.Header().Get("Content-Type"))
+	s.Equal(testPartUuid, s.decode(rec)["part"].(map[string]any)["uuid"])
+}
+
+func (s *GatewayTestSuite) TestGetPart_NotFound() {
+	s.parts.On("GetPart", mock.Anything, testPartUuid, time.Time{}).Return(nil, model.ErrPartNotFound)
+
+	rec := s.do(http.MethodGet, "/api/v1/parts/"+testPartUuid, "")
+
+	s.Equal(http.StatusNotFound, rec.Code)
+	body := s.decode(rec)
+	s.EqualValues(5, body["code"])
+	s.Contains(body["message"], testPartUuid)
+}
+
+func (s *GatewayTestSuite) TestBatchGetParts_InvalidBody() {
+	rec := s.do(http.MethodPost, "/api/v1/parts/batch-get", `{"uuids": 42}`)
+
+	s.Equal(http.StatusBadRequest, rec.Code)
+	s.Contains(s.decode(rec)["message"], "invalid request body")
+}
+
+func (s *GatewayTestSuite) TestWriteRoutesNotExposed() {
+	// Шлюз без проверки подлинности не должен пропускать изменения каталога
+	for target, method := range map[string]string{
+		"/api/v1/parts":                                        http.MethodPost,
+		"/api/v1/parts/" + testPartUuid:                        http.MethodPatch,
+		"/api/v1/parts/" + testPartUuid + "/lifecycle-state":   http.MethodPost,
+		"/api/v1/parts/" + testPartUuid + "/stock-adjustments": http.MethodPost,
+		"/api/v1/stock-fulfillments":                           http.MethodPost,
+		"/api/v1/categories/" + testPartUuid:                   http.MethodDelete,
+	} {
+		rec := s.do(method, target, `{}`)
+
+		s.Contains([]int{http.StatusNotFound, http.StatusMethodNotAllowed}, rec.Code, method+" "+target)
+	}
+}
+
+func (s *GatewayTestSuite) TestUnknownRoute() {
+	rec := s.do(http.MethodGet, "/api/v1/rockets", "")
+	s.Equal(http.StatusNotFound, rec.Code)
+	s.EqualValues(5, s.decode(rec)["code"])
+
+	rec = s.do(http.MethodPut, "/api/v1/parts", "")
+	s.Equal(http.StatusMethodNotAllowed, rec.Code)
+	s.EqualValues(12, s.decode(rec)["code"])
+}
+
+func (s *GatewayTestSuite) TestOpenAPI() {
+	rec := s.do(http.MethodGet, "/api/v1/openapi.json", "")
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var doc struct {
+		OpenAPI string `json:"openapi"`
+		Paths   map[string]map[string]struct {
+			OperationID string `json:"operationId"`
+			Parameters  []struct {
+				Name string `json:"name"`
+				In   string `json:"in"`
+			} `json:"parameters"`
+			RequestBody *struct {
+				Content map[string]struct {
+					Schema map[string]string `json:"schema"`
+				} `json:"content"`
+			} `json:"requestBody"`
+		} `json:"paths"`
+		Components struct {
+			Schemas map[string]json.RawMessage `json:"schemas"`
+		} `json:"components"`
+	}
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &doc))
+
+	s.Equal("3.0.3", doc.OpenAPI)
+	s.Len(doc.Paths, 16)
+	s.Equal("GetPart", doc.Paths["/api/v1/parts/{uuid}"]["get"].OperationID)
+	s.NotContains(doc.Paths["/api/v1/parts/{uuid}"], "patch")
+	s.Equal("#/components/schemas/BatchGetPartsRequest",
+		doc.Paths["/api/v1/parts/batch-get"]["post"].RequestBody.Content["application/json"].Schema["$ref"])
+
+	var listParams []string
+	for _, p := range doc.Paths["/api/v1/parts"]["get"].Parameters {
+		listParams = append(listParams, p.In+":"+p.Name)
+	}
+	s.Subset(listParams, []string{"query:categories", "query:price.min", "query:created_at.from", "query:page_size", "query:order_by.field"})
+	s.NotContains(listParams, "query:metadata")
+
+	var getParams []string
+	for _, p := range doc.Paths["/api/v1/parts/{uuid}"]["get"].Parameters {
+		getParams = append(getParams, p.In+":"+p.Name)
+	}
+	s.Equal([]string{"path:uuid", "query:as_of"}, getParams)
+
+	s.Contains(doc.Components.Schemas, "Part")
+	s.Contains(doc.Components.Schemas, "Category")
+	s.Contains(doc.Components.Schemas, "Status")
+}
+
+func (s *GatewayTestSuite) TestRoutesCoverReadMethods() {
+	// Каждый унарный метод чтения доступен по HTTP, методы изменения - только по gRPC
+	grpcOnly := map[string]bool{
+		"CreatePart": true, "UpdatePart": true, "DeletePart": true, "ChangePartLifecycleState": true,
+		"AdjustStock": true, "TransferStock": true, "SchedulePriceChange": true, "FulfillStock": true,
+		"CreateManufacturer": true, "UpdateManufacturer": true, "DeleteManufacturer": true,
+		"CreateCategory": true, "UpdateCategory": true, "DeleteCategory": true, "SetCategoryMetadataSchema": true,
+		"CreateWarehouse": true,
+	}
+
+	mapped := make(map[string]bool, len(routes))
+	for _, rt := range routes {
+		mapped[rt.rpc] = true
+	}
+
+	methods := serviceDescriptor().Methods()
+	for i := range methods.Len() {
+		m := methods.Get(i)
+		if m.IsStreamingServer() || m.IsStreamingClient() {
+			continue
+		}
+		s.Equal(!grpcOnly[string(m.Name())], mapped[string(m.Name())], m.Name())
+	}
+}
+
+func TestGatewayTestSuite(t *testing.T) {
+	suite.Run(t, new(GatewayTestSuite))
+}
@@ -0,0 +1,267 @@
+package gateway
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// object - узел документа OpenAPI. encoding/json сортирует ключи map, поэтому документ детерминирован.
+type object = map[string]any
+
+// openAPIBuilder строит документ OpenAPI 3.0 по таблице маршрутов и дескрипторам proto.
+// Схемы сообщений соответствуют protojson: имена полей как в proto, int64 - строкой, enum - именем значения.
+type openAPIBuilder struct {
+	schemas object
+}
+
+func buildOpenAPI(service protoreflect.ServiceDescriptor, routes []route) ([]byte, error) {
+	b := &openAPIBuilder{schemas: object{"Status": statusSchema}}
+	errorResponse := object{
+		"description": "Ошибка в формате google.rpc.Status",
+		"content":     object{"application/json": object{"schema": object{"$ref": "#/components/schemas/Status"}}},
+	}
+
+	paths := object{}
+	pathItem := func(path string) object {
+		if item, ok := paths[path].(object); ok {
+			return item
+		}
+		item := object{}
+		paths[path] = item
+		return item
+	}
+
+	for _, rt := range routes {
+		method := service.Methods().ByName(protoreflect.Name(rt.rpc))
+		if method == nil {
+			return nil, fmt.Errorf("route %s %s: method %s not found", rt.method, rt.path, rt.rpc)
+		}
+
+		parameters, err := b.parameters(rt, method.Input())
+		if err != nil {
+			return nil, fmt.Errorf("route %s %s: %w", rt.method, rt.path, err)
+		}
+
+		op := object{
+			"operationId": rt.rpc,
+			"summary":     rt.summary,
+			"tags":        []string{strings.Split(strings.TrimPrefix(rt.path, "/"), "/")[0]},
+			"responses": object{
+				"200": object{
+					"description": "OK",
+					"content":     object{"application/json": object{"schema": b.messageSchema(method.Output())}},
+				},
+				"default": errorResponse,
+			},
+		}
+		if len(parameters) > 0 {
+			op["parameters"] = parameters
+		}
+
+		if rt.body != "" {
+			body := method.Input()
+			if rt.body != "*" {
+				body = body.Fields().ByName(protoreflect.Name(rt.body)).Message()
+			}
+			op["requestBody"] = object{
+				"required": true,
+				"content":  object{"application/json": object{"schema": b.messageSchema(body)}},
+			}
+		}
+
+		pathItem(apiPrefix + rt.path)[strings.ToLower(rt.method)] = op
+	}
+
+	pathItem(apiPrefix + openAPIPath)["get"] = object{
+		"operationId": "GetOpenAPI",
+		"summary":     "Этот документ",
+		"tags":        []string{"meta"},
+		"responses": object{
+			"200": object{"description": "OK", "content": object{"application/json": object{"schema": object{"type": "object"}}}},
+		},
+	}
+
+	return json.MarshalIndent(object{
+		"openapi": "3.0.3",
+		"info": object{
+			"title":       "Inventory HTTP/JSON API",
+			"version":     "v1",
+			"description": "HTTP/JSON шлюз к gRPC InventoryService. Документ строится из дескрипторов proto при запуске.",
+		},
+		"paths":      paths,
+		"components": object{"schemas": b.schemas},
+	}, "", "  ")
+}
+
+// statusSchema - тело ошибки, google.rpc.Status
+var statusSchema = object{
+	"type": "object",
+	"properties": object{
+		"code":    object{"type": "integer", "format": "int32", "description": "Код gRPC"},
+		"message": object{"type": "string"},
+		"details": object{"type": "array", "items": object{"type": "object"}},
+	},
+}
+
+// parameters возвращает параметры пути и строки запроса маршрута. В строку запроса выносятся
+// поля, не занятые телом и путём; поля queryRoot перечисляются без префикса.
+func (b *openAPIBuilder) parameters(rt route, input protoreflect.MessageDescriptor) ([]object, error) {
+	var params []object
+
+	bound := make(map[string]bool)
+	for _, param := range rt.pathParams() {
+		field := rt.pathField(param)
+		bound[field] = true
+
+		fd, err := fieldByPath(input, field)
+		if err != nil {
+			return nil, err
+		}
+		params = append(params, object{"name": param, "in": "path", "required": true, "schema": b.fieldSchema(fd)})
+	}
+
+	if rt.body == "*" {
+		return params, nil
+	}
+	bound[rt.body] = true
+
+	var walk func(md protoreflect.MessageDescriptor, prefix, name string, seen map[protoreflect.FullName]bool)
+	walk = func(md protoreflect.MessageDescriptor, prefix, name string, seen map[protoreflect.FullName]bool) {
+		fields := md.Fields()
+		for i := range fields.Len() {
+			fd := fields.Get(i)
+			path := prefix + string(fd.Name())
+			param := name + string(fd.Name())
+			if bound[path] || fd.IsMap() {
+				continue
+			}
+
+			if fd.Message() != nil && !scalarMessages[fd.Message().FullName()] {
+				// Повторяющиеся сообщения строкой запроса не задаются, рекурсивные - обходятся один раз
+				if fd.IsList() || seen[fd.Message().FullName()] {
+					continue
+				}
+				seen[fd.Message().FullName()] = true
+				if path == rt.queryRoot {
+					walk(fd.Message(), path+".", name, seen)
+				} else {
+					walk(fd.Message(), path+".", param+".", seen)
+				}
+				delete(seen, fd.Message().FullName())
+				continue
+			}
+
+			p := object{"name": param, "in": "query", "schema": b.fieldSchema(fd)}
+			if fd.IsList() {
+				p["explode"] = true
+			}
+			params = append(params, p)
+		}
+	}
+	walk(input, "", "", map[protoreflect.FullName]bool{input.FullName(): true})
+
+	return params, nil
+}
+
+func fieldByPath(md protoreflect.MessageDescriptor, path string) (protoreflect.FieldDescriptor, error) {
+	var fd protoreflect.FieldDescriptor
+	for _, name := range strings.Split(path, ".") {
+		if md == nil {
+			return nil, fmt.Errorf("field %q has no nested fields", path)
+		}
+		fd = md.Fields().ByName(protoreflect.Name(name))
+		if fd == nil {
+			return nil, fmt.Errorf("unknown field %q", path)
+		}
+		md = fd.Message()
+	}
+	return fd, nil
+}
+
+func (b *openAPIBuilder) fieldSchema(fd protoreflect.FieldDescriptor) object {
+	switch {
+	case fd.IsMap():
+		return object{"type": "object", "additionalProperties": b.singularSchema(fd.MapValue())}
+	case fd.IsList():
+		return object{"type": "array", "items": b.singularSchema(fd)}
+	default:
+		return b.singularSchema(fd)
+	}
+}
+
+func (b *openAPIBuilder) singularSchema(fd protoreflect.FieldDescriptor) object {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return object{"type": "boolean"}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return object{"type": "integer", "format": "int32"}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return object{"type": "integer", "format": "int64", "minimum": 0}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		// protojson записывает 64-битные целые строкой, чтобы не терять точность в JavaScript
+		return object{"type": "string", "format": "int64"}
+	case protoreflect.FloatKind:
+		return object{"type": "number", "format": "float"}
+	case protoreflect.DoubleKind:
+		return object{"type": "number", "format": "double"}
+	case protoreflect.BytesKind:
+		return object{"type": "string", "format": "byte"}
+	case protoreflect.EnumKind:
+		return b.enumSchema(fd.Enum())
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return b.messageSchema(fd.Message())
+	default:
+		return object{"type": "string"}
+	}
+}
+
+func (b *openAPIBuilder) enumSchema(ed protoreflect.EnumDescriptor) object {
+	name := schemaName(ed)
+	if _, ok := b.schemas[name]; !ok {
+		values := make([]string, ed.Values().Len())
+		for i := range values {
+			values[i] = string(ed.Values().Get(i).Name())
+		}
+		b.schemas[name] = object{"type": "string", "enum": values}
+	}
+
+	return object{"$ref": "#/components/schemas/" + name}
+}
+
+func (b *openAPIBuilder) messageSchema(md protoreflect.MessageDescriptor) object {
+	switch md.FullName() {
+	case "google.protobuf.Timestamp":
+		return object{"type": "string", "format": "date-time"}
+	case "google.protobuf.Duration":
+		return object{"type": "string", "example": "1.5s"}
+	case "google.protobuf.FieldMask":
+		return object{"type": "string", "description": "Имена полей через запятую", "example": "name,price"}
+	}
+
+	name := schemaName(md)
+	if _, ok := b.schemas[name]; !ok {
+		// Схема регистрируется до обхода полей, чтобы рекурсивные сообщения ссылались на неё
+		schema := object{"type": "object"}
+		b.schemas[name] = schema
+
+		properties := object{}
+		fields := md.Fields()
+		for i := range fields.Len() {
+			properties[string(fields.Get(i).Name())] = b.fieldSchema(fields.Get(i))
+		}
+		if len(properties) > 0 {
+			schema["properties"] = properties
+		}
+	}
+
+	return object{"$ref": "#/components/schemas/" + name}
+}
+
+// schemaName - имя схемы: полное имя типа без пакета, вложенные типы через точку
+func schemaName(d protoreflect.Descriptor) string {
+	return strings.TrimPrefix(string(d.FullName()), string(d.ParentFile().Package())+".")
+}
@@ -0,0 +1,67 @@
+package gateway
+
+import (
+	"net/http"
+	"regexp"
+)
+
+// route - соответствие HTTP-метода и пути методу InventoryService
+type route struct {
+	method string
+	// path - шаблон chi относительно /api/v1
+	path string
+	// rpc - имя метода InventoryService
+	rpc string
+	// body - поле запроса, в которое читается тело: "*" - весь запрос, пустое - тело не читается
+	body string
+	// pathFields - поля запроса для параметров пути, имя которых не совпадает с именем поля
+	pathFields map[string]string
+	// queryRoot - поле запроса, в котором ищутся параметры строки запроса, не найденные в самом запросе.
+	// Так фильтр ListParts задаётся как ?categories=ENGINE вместо ?filter.categories=ENGINE.
+	queryRoot string
+	summary   string
+}
+
+// routes - REST-отображение InventoryService для витрины. Шлюз не проверяет подлинность
+// вызывающего, поэтому отображаются только методы чтения: изменения каталога, остатков и цен
+// доступны лишь по gRPC. WatchParts не отображается: HTTP/JSON шлюз не поддерживает серверные потоки.
+var routes = []route{
+	{method: http.MethodGet, path: "/parts", rpc: "ListParts", queryRoot: "filter", summary: "Список деталей с фильтром и постраничной выдачей"},
+	{method: http.MethodGet, path: "/parts/low-stock", rpc: "ListLowStockParts", summary: "Детали с остатком не выше порога дозаказа"},
+	{method: http.MethodPost, path: "/parts/batch-get", rpc: "BatchGetParts", body: "*", summary: "Детали по списку UUID с перечнем ненайденных и некорректных"},
+	{method: http.MethodGet, path: "/parts/facets", rpc: "GetPartFacets", queryRoot: "filter", summary: "Количество деталей по категориям, странам производителей и тегам"},
+	{method: http.MethodGet, path: "/parts/{uuid}", rpc: "GetPart", summary: "Деталь по UUID"},
+	{method: http.MethodGet, path: "/parts/{part_uuid}/bom", rpc: "ExpandBom", summary: "Развёрнутая спецификация сборки"},
+	{method: http.MethodGet, path: "/parts/{part_uuid}/stock-movements", rpc: "ListStockMovements", summary: "Журнал движений остатка детали"},
+	{method: http.MethodGet, path: "/parts/{part_uuid}/price-history", rpc: "GetPriceHistory", summary: "История цены детали"},
+
+	{method: http.MethodGet, path: "/manufacturers", rpc: "ListManufacturers", summary: "Список производителей"},
+	{method: http.MethodGet, path: "/manufacturers/{uuid}", rpc: "GetManufacturer", summary: "Производитель по UUID"},
+
+	{method: http.MethodGet, path: "/categories", rpc: "ListCategories", summary: "Дерево категорий"},
+	{method: http.MethodGet, path: "/categories/{uuid}", rpc: "GetCategory", summary: "Категория по UUID"},
+	{method: http.MethodGet, path: "/categories/{category_uuid}/metadata-schema", rpc: "GetCategoryMetadataSchema", summary: "Действующая схема метаданных категории вместе со схемами предков"},
+
+	{method: http.MethodGet, path: "/warehouses", rpc: "ListWarehouses", summary: "Список складов"},
+	{method: http.MethodGet, path: "/warehouses/{uuid}", rpc: "GetWarehouse", summary: "Склад по UUID"},
+}
+
+var pathParamPattern = regexp.MustCompile(`\{(\w+)\}`)
+
+// pathParams возвращает имена параметров пути маршрута
+func (rt route) pathParams() []string {
+	matches := pathParamPattern.FindAllStringSubmatch(rt.path, -1)
+	params := make([]string, len(matches))
+	for i, m := range matches {
+		params[i] = m[1]
+	}
+	return params
+}
+
+// pathField возвращает путь к полю запроса, в которое записывается параметр пути
+func (rt route) pathField(param string) string {
+	if field, ok := rt.pathFields[param]; ok {
+		return field
+	}
+	return param
+}
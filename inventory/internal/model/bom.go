@@ -0,0 +1,39 @@
+package model
+
+// MaxComponentQuantity - наибольшее количество компонента на одну сборку
+const MaxComponentQuantity = 1_000_000
+
+// MaxBomDepth - наибольшая глубина вложенности сборок
+const MaxBomDepth = 32
+
+// Component - компонент сборки
+type Component struct {
+	PartUuid string
+	Quantity int64
+}
+
+// BomNode - узел разузлованной спецификации
+type BomNode struct {
+	Part *Part
+	// Quantity - количество на единицу родителя, для корня - запрошенное количество
+	Quantity int64
+	// TotalQuantity - количество на всё запрошенное количество корня
+	TotalQuantity int64
+	// RolledUpPrice - стоимость единицы: для сборки - сумма по компонентам, для детали - её цена
+	RolledUpPrice float64
+	// RolledUpWeight - вес единицы: для сборки - сумма по компонентам, для детали - её вес
+	RolledUpWeight float64
+	Components     []*BomNode
+}
+
+// Bom - разузлованная спецификация сборки
+type Bom struct {
+	Root *BomNode
+	// BuildableQuantity - сколько единиц корня можно собрать из текущих остатков
+	BuildableQuantity int64
+}
+
+// IsAssembly сообщает, что деталь собирается из других деталей
+func (p *Part) IsAssembly() bool {
+	return len(p.Components) > 0
+}
@@ -0,0 +1,205 @@
+package model
+
+import (
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// CategoryNode - узел дерева категорий деталей
+type CategoryNode struct {
+	Uuid        string
+	Slug        string
+	DisplayName string
+	// ParentUuid - родительская категория, пусто для корневой
+	ParentUuid string
+	// Legacy - значение enum Category ближайшей встроенной категории среди предков
+	// (CategoryUnspecified вне встроенных). Наследуется от родителя при создании и не меняется:
+	// перенос, который изменил бы его, запрещён, поэтому значение у деталей остаётся верным.
+	Legacy Category
+	// MetadataSchema - собственная схема метаданных деталей категории. Действует вместе со схемами
+	// предков (см. CategoryTree.MetadataSchema), поэтому ключи в ветке дерева не повторяются.
+	MetadataSchema []MetadataField
+}
+
+// Clone возвращает глубокую копию категории
+func (n *CategoryNode) Clone() *CategoryNode {
+	clone := *n
+	clone.MetadataSchema = cloneMetadataSchema(n.MetadataSchema)
+	return &clone
+}
+
+// BuiltinCategories - корневые категории, соответствующие значениям enum Category.
+// UUID постоянны: по ним детали со старым enum связываются с деревом.
+var BuiltinCategories = []*CategoryNode{
+	{Uuid: "0d5e3c1a-6f1b-4c55-9a01-000000000001", Slug: "engine", DisplayName: "Двигатели", Legacy: CategoryEngine},
+	{Uuid: "0d5e3c1a-6f1b-4c55-9a01-000000000002", Slug: "fuel", DisplayName: "Топливо", Legacy: CategoryFuel},
+	{Uuid: "0d5e3c1a-6f1b-4c55-9a01-000000000003", Slug: "porthole", DisplayName: "Иллюминаторы", Legacy: CategoryPorthole},
+	{Uuid: "0d5e3c1a-6f1b-4c55-9a01-000000000004", Slug: "wing", DisplayName: "Крылья", Legacy: CategoryWing},
+}
+
+// BuiltinCategoryUuid возвращает UUID встроенной категории для значения enum (пусто для неизвестного)
+func BuiltinCategoryUuid(category Category) string {
+	for _, node := range BuiltinCategories {
+		if node.Legacy == category {
+			return node.Uuid
+		}
+	}
+	return ""
+}
+
+// IsBuiltin сообщает, что категория встроенная и соответствует значению enum Category
+func (n *CategoryNode) IsBuiltin() bool {
+	return slices.ContainsFunc(BuiltinCategories, func(b *CategoryNode) bool { return b.Uuid == n.Uuid })
+}
+
+var categorySlugPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// maxCategorySlugLength - наибольшая длина slug категории
+const maxCategorySlugLength = 64
+
+// Validate проверяет поля категории без учёта дерева
+func (n *CategoryNode) Validate() error {
+	if len(n.Slug) > maxCategorySlugLength || !categorySlugPattern.MatchString(n.Slug) {
+		return fmt.Errorf("%w: slug must be lowercase latin letters and digits separated by dashes, up to %d characters",
+			ErrInvalidCategory, maxCategorySlugLength)
+	}
+
+	if strings.TrimSpace(n.DisplayName) == "" {
+		return fmt.Errorf("%w: display name is required", ErrInvalidCategory)
+	}
+
+	if n.ParentUuid == n.Uuid {
+		return fmt.Errorf("%w: category cannot be its own parent", ErrInvalidCategory)
+	}
+
+	return nil
+}
+
+// CategoryTree - дерево категорий, построенное из всех узлов
+type CategoryTree struct {
+	nodes    map[string]*CategoryNode
+	children map[string][]string
+}
+
+func NewCategoryTree(nodes []*CategoryNode) *CategoryTree {
+	t := &CategoryTree{
+		nodes:    make(map[string]*CategoryNode, len(nodes)),
+		children: make(map[string][]string),
+	}
+	for _, n := range nodes {
+		t.nodes[n.Uuid] = n
+		t.children[n.ParentUuid] = append(t.children[n.ParentUuid], n.Uuid)
+	}
+	for _, children := range t.children {
+		slices.Sort(children)
+	}
+
+	return t
+}
+
+// Get возвращает узел по UUID
+func (t *CategoryTree) Get(uuid string) (*CategoryNode, bool) {
+	n, ok := t.nodes[uuid]
+	return n, ok
+}
+
+// Children возвращает UUID прямых потомков категории
+func (t *CategoryTree) Children(uuid string) []string {
+	return t.children[uuid]
+}
+
+// Subtree возвращает UUID категории и всех её потомков в порядке обхода в ширину.
+// Для пустого uuid возвращает все категории дерева.
+func (t *CategoryTree) Subtree(uuid string) []string {
+	queue := []string{uuid}
+	seen := map[string]bool{uuid: true}
+	for i := 0; i < len(queue); i++ {
+		for _, child := range t.children[queue[i]] {
+			if !seen[child] {
+				seen[child] = true
+				queue = append(queue, child)
+			}
+		}
+	}
+	if uuid == "" {
+		return queue[1:]
+	}
+	return queue
+}
+
+// IsDescendant сообщает, что категория uuid лежит в поддереве ancestor (или совпадает с ней)
+func (t *CategoryTree) IsDescendant(uuid, ancestor string) bool {
+	for seen := 0; uuid != "" && seen <= len(t.nodes); seen++ {
+		if uuid == ancestor {
+			return true
+		}
+		n, ok := t.nodes[uuid]
+		if !ok {
+			return false
+		}
+		uuid = n.ParentUuid
+	}
+	return false
+}
+
+// MetadataSchema возвращает действующую схему метаданных категории: поля схем её предков,
+// начиная с корня, и её собственные. У каждого поля заполнен CategoryUuid.
+func (t *CategoryTree) MetadataSchema(uuid string) []MetadataField {
+	var branch []*CategoryNode
+	for seen := 0; uuid != "" && seen <= len(t.nodes); seen++ {
+		n, ok := t.nodes[uuid]
+		if !ok {
+			break
+		}
+		branch = append(branch, n)
+		uuid = n.ParentUuid
+	}
+
+	var schema []MetadataField
+	for i := len(branch) - 1; i >= 0; i-- {
+		for _, f := range cloneMetadataSchema(branch[i].MetadataSchema) {
+			f.CategoryUuid = branch[i].Uuid
+			schema = append(schema, f)
+		}
+	}
+	return schema
+}
+
+// CheckMetadataSchema проверяет, что ключи схемы own категории uuid и схем её потомков не совпадают
+// с ключами действующей схемы родителя parentUuid, а ключи own - с ключами потомков. Так проверяются
+// и замена схемы категории, и её перенос к другому родителю.
+func (t *CategoryTree) CheckMetadataSchema(uuid, parentUuid string, own []MetadataField) error {
+	inherited := make(map[string]string)
+	for _, f := range t.MetadataSchema(parentUuid) {
+		inherited[f.Key] = f.CategoryUuid
+	}
+
+	descendants := make(map[string]string)
+	for _, descendant := range t.Subtree(uuid)[1:] {
+		if n, ok := t.nodes[descendant]; ok {
+			for _, f := range n.MetadataSchema {
+				descendants[f.Key] = descendant
+			}
+		}
+	}
+
+	for _, f := range own {
+		if owner, ok := inherited[f.Key]; ok {
+			return fmt.Errorf("%w: metadata key %q is already defined by ancestor category %s", ErrInvalidCategory, f.Key, owner)
+		}
+		if owner, ok := descendants[f.Key]; ok {
+			return fmt.Errorf("%w: metadata key %q is already defined by subcategory %s", ErrInvalidCategory, f.Key, owner)
+		}
+	}
+	for _, key := range slices.Sorted(maps.Keys(descendants)) {
+		if ancestor, ok := inherited[key]; ok {
+			return fmt.Errorf("%w: metadata key %q of subcategory %s is already defined by category %s",
+				ErrInvalidCategory, key, descendants[key], ancestor)
+		}
+	}
+
+	return nil
+}
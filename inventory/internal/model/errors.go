@@ -9,4 +9,56 @@ var (
 	ErrInvalidPart            = errors.New("invalid part data")
 	ErrRepositoryOperation    = errors.New("repository operation failed")
 	ErrConcurrentModification = errors.New("concurrent modification detected")
+	ErrPartAlreadyExists      = errors.New("part already exists")
+)
+
+var (
+	ErrInvalidPageToken = errors.New("invalid page token")
+	ErrInvalidListQuery = errors.New("invalid list parts query")
+	ErrInvalidBatchGet  = errors.New("invalid batch get parts request")
+)
+
+var (
+	ErrInvalidStockAdjustment = errors.New("invalid stock adjustment")
+	ErrInsufficientStock      = errors.New("insufficient stock")
+	ErrInvalidStockTransfer   = errors.New("invalid stock transfer")
+	ErrInvalidFulfillment     = errors.New("invalid fulfillment request")
+)
+
+var (
+	ErrInvalidResumeToken = errors.New("invalid resume token")
+	ErrResumeTokenExpired = errors.New("resume token expired")
+)
+
+var (
+	ErrInvalidBom        = errors.New("invalid bill of materials")
+	ErrInvalidBomRequest = errors.New("invalid bill of materials request")
+	ErrPartInUse         = errors.New("part is a component of an assembly")
+)
+
+var (
+	ErrManufacturerNotFound      = errors.New("manufacturer not found")
+	ErrInvalidManufacturer       = errors.New("invalid manufacturer data")
+	ErrManufacturerAlreadyExists = errors.New("manufacturer already exists")
+	ErrManufacturerInUse         = errors.New("manufacturer is referenced by parts")
+)
+
+var (
+	ErrCategoryNotFound      = errors.New("category not found")
+	ErrInvalidCategory       = errors.New("invalid category data")
+	ErrCategoryAlreadyExists = errors.New("category already exists")
+	ErrCategoryInUse         = errors.New("category has subcategories or parts")
+)
+
+var (
+	ErrWarehouseNotFound      = errors.New("warehouse not found")
+	ErrInvalidWarehouse       = errors.New("invalid warehouse data")
+	ErrWarehouseAlreadyExists = errors.New("warehouse already exists")
+)
+
+var ErrInvalidPriceChange = errors.New("invalid price change")
+
+var (
+	ErrInvalidLifecycleChange        = errors.New("invalid part lifecycle change")
+	ErrLifecycleTransitionNotAllowed = errors.New("part lifecycle transition not allowed")
 )
@@ -0,0 +1,30 @@
+package model
+
+// PartEventType - тип изменения каталога
+type PartEventType int32
+
+const (
+	PartEventTypeUnspecified PartEventType = iota
+	PartEventTypeCreated
+	PartEventTypeUpdated
+	PartEventTypeDeleted
+)
+
+// PartEvent - событие изменения детали из потока изменений репозитория
+type PartEvent struct {
+	Type     PartEventType
+	PartUuid string
+	// Part - состояние после изменения; для удаления - последнее известное состояние или nil
+	Part *Part
+	// Previous - состояние до изменения, если репозиторий его знает. Нужно для фильтрации:
+	// подписчик должен узнать, что деталь перестала удовлетворять его фильтру.
+	Previous *Part
+	// ResumeToken - непрозрачный токен репозитория для продолжения потока после этого события
+	ResumeToken string
+}
+
+// WatchPartsParams - параметры подписки на изменения каталога
+type WatchPartsParams struct {
+	Filter      *PartsFilter
+	ResumeToken string
+}
@@ -0,0 +1,134 @@
+package model
+
+import (
+	"cmp"
+	"slices"
+)
+
+// PartFacets - количество деталей по значениям измерений фильтра. Количество по измерению
+// считается по фильтру без условий на это измерение (см. PartsFilter.WithoutCategories и др.),
+// чтобы клиент видел, сколько деталей даст выбор другого значения.
+type PartFacets struct {
+	Categories            []CategoryFacetCount
+	ManufacturerCountries []FacetCount
+	Tags                  []FacetCount
+}
+
+// CategoryFacetCount - количество деталей в категории
+type CategoryFacetCount struct {
+	// CategoryUuid - категория в дереве, пусто у деталей, созданных до появления дерева
+	CategoryUuid string
+	Category     Category
+	Count        int
+}
+
+// FacetCount - количество деталей со значением измерения
+type FacetCount struct {
+	Value string
+	Count int
+}
+
+// WithoutCategories возвращает копию фильтра без условий на категорию (enum и дерево)
+func (f *PartsFilter) WithoutCategories() *PartsFilter {
+	return f.without(func(c *PartsFilter) {
+		c.Categories = nil
+		c.CategoryUuids = nil
+	})
+}
+
+// WithoutManufacturerCountries возвращает копию фильтра без условия на страну производителя
+func (f *PartsFilter) WithoutManufacturerCountries() *PartsFilter {
+	return f.without(func(c *PartsFilter) { c.ManufacturerCountries = nil })
+}
+
+// WithoutTags возвращает копию фильтра без условия на теги
+func (f *PartsFilter) WithoutTags() *PartsFilter {
+	return f.without(func(c *PartsFilter) { c.Tags = nil })
+}
+
+func (f *PartsFilter) without(clear func(*PartsFilter)) *PartsFilter {
+	if f == nil {
+		return nil
+	}
+	c := *f
+	clear(&c)
+	return &c
+}
+
+// FacetCounter накапливает количество деталей по измерениям. Детали передаются в Add
+// отдельно для каждого измерения, уже отобранные фильтром без условий на это измерение.
+type FacetCounter struct {
+	categories map[CategoryFacetCount]int
+	countries  map[string]int
+	tags       map[string]int
+}
+
+func NewFacetCounter() *FacetCounter {
+	return &FacetCounter{
+		categories: make(map[CategoryFacetCount]int),
+		countries:  make(map[string]int),
+		tags:       make(map[string]int),
+	}
+}
+
+// AddCategory учитывает деталь в количестве по категориям
+func (c *FacetCounter) AddCategory(part *Part) {
+	c.categories[CategoryFacetCount{CategoryUuid: part.CategoryUuid, Category: part.Category}]++
+}
+
+// AddManufacturerCountry учитывает деталь в количестве по странам. Детали без страны пропускаются.
+func (c *FacetCounter) AddManufacturerCountry(part *Part) {
+	if part.Manufacturer != nil && part.Manufacturer.Country != "" {
+		c.countries[part.Manufacturer.Country]++
+	}
+}
+
+// AddTags учитывает деталь в количестве по каждому её тегу. Повторы тега в детали учитываются один раз.
+func (c *FacetCounter) AddTags(part *Part) {
+	seen := make(map[string]bool, len(part.Tags))
+	for _, tag := range part.Tags {
+		if !seen[tag] {
+			seen[tag] = true
+			c.tags[tag]++
+		}
+	}
+}
+
+// Facets возвращает накопленное количество, упорядоченное по убыванию количества, затем по значению
+func (c *FacetCounter) Facets() *PartFacets {
+	facets := &PartFacets{
+		Categories:            make([]CategoryFacetCount, 0, len(c.categories)),
+		ManufacturerCountries: countsOf(c.countries),
+		Tags:                  countsOf(c.tags),
+	}
+	for key, count := range c.categories {
+		key.Count = count
+		facets.Categories = append(facets.Categories, key)
+	}
+	SortCategoryFacetCounts(facets.Categories)
+
+	return facets
+}
+
+func countsOf(values map[string]int) []FacetCount {
+	counts := make([]FacetCount, 0, len(values))
+	for value, count := range values {
+		counts = append(counts, FacetCount{Value: value, Count: count})
+	}
+	SortFacetCounts(counts)
+	return counts
+}
+
+// SortFacetCounts упорядочивает значения по убыванию количества, затем по значению
+func SortFacetCounts(counts []FacetCount) {
+	slices.SortFunc(counts, func(a, b FacetCount) int {
+		return cmp.Or(cmp.Compare(b.Count, a.Count), cmp.Compare(a.Value, b.Value))
+	})
+}
+
+// SortCategoryFacetCounts упорядочивает категории по убыванию количества, затем по UUID и значению enum
+func SortCategoryFacetCounts(counts []CategoryFacetCount) {
+	slices.SortFunc(counts, func(a, b CategoryFacetCount) int {
+		return cmp.Or(cmp.Compare(b.Count, a.Count), cmp.Compare(a.CategoryUuid, b.CategoryUuid), cmp.Compare(a.Category, b.Category))
+	})
+}
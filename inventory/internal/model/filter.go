@@ -1,9 +1,164 @@
 package model
 
+import (
+	"slices"
+	"strings"
+)
+
+// PartsFilter - фильтр списка деталей. Заданные условия объединяются по AND,
+// значения внутри одного списочного поля - по OR.
 type PartsFilter struct {
 	Uuids                 []string
 	Names                 []string
 	Categories            []Category
 	ManufacturerCountries []string
 	Tags                  []string
+	// Query - полнотекстовый запрос по названию, описанию и тегам.
+	// Обрабатывается репозиторием и не учитывается в Matches.
+	Query string
+
+	Price       *FloatRange
+	InStockOnly bool
+	Length      *FloatRange
+	Width       *FloatRange
+	Height      *FloatRange
+	Weight      *FloatRange
+	CreatedAt   *TimeRange
+	UpdatedAt   *TimeRange
+	Metadata    []MetadataPredicate
+	// ComponentUuids - отбираются сборки, в состав которых напрямую входит любой из компонентов
+	ComponentUuids []string
+	// ManufacturerUuids - UUID производителей справочника, на которых ссылается деталь
+	ManufacturerUuids []string
+	// CategoryUuids - категории дерева. Сервис дополняет их потомками до обращения к репозиторию,
+	// поэтому Matches и репозиторий сравнивают категорию детали только с перечисленными.
+	CategoryUuids []string
+	// LowStock - только детали с остатком не выше порога дозаказа (см. Part.IsLowStock)
+	LowStock bool
+	// LifecycleStates - состояния жизненного цикла. Пустой список не ограничивает; ListParts
+	// подставляет действующие детали до обращения к репозиторию (WithDefaultLifecycleStates).
+	LifecycleStates []LifecycleState
+}
+
+// HasQuery сообщает, что фильтр содержит полнотекстовый запрос
+func (f *PartsFilter) HasQuery() bool {
+	return f != nil && strings.TrimSpace(f.Query) != ""
+}
+
+// IsEmpty сообщает, что фильтр не накладывает ограничений
+func (f *PartsFilter) IsEmpty() bool {
+	return f == nil ||
+		(len(f.Uuids) == 0 &&
+			len(f.Names) == 0 &&
+			len(f.Categories) == 0 &&
+			len(f.ManufacturerCountries) == 0 &&
+			len(f.Tags) == 0 &&
+			!f.HasQuery() &&
+			f.Price.IsEmpty() &&
+			!f.InStockOnly &&
+			f.Length.IsEmpty() &&
+			f.Width.IsEmpty() &&
+			f.Height.IsEmpty() &&
+			f.Weight.IsEmpty() &&
+			f.CreatedAt.IsEmpty() &&
+			f.UpdatedAt.IsEmpty() &&
+			len(f.Metadata) == 0 &&
+			len(f.ComponentUuids) == 0 &&
+			len(f.ManufacturerUuids) == 0 &&
+			len(f.CategoryUuids) == 0 &&
+			!f.LowStock &&
+			len(f.LifecycleStates) == 0)
+}
+
+// Matches проверяет, что деталь удовлетворяет структурным условиям фильтра (без Query)
+func (f *PartsFilter) Matches(part *Part) bool {
+	if f == nil {
+		return true
+	}
+
+	if len(f.Uuids) > 0 && !slices.Contains(f.Uuids, part.Uuid) {
+		return false
+	}
+
+	if len(f.Names) > 0 && !slices.Contains(f.Names, part.Name) {
+		return false
+	}
+
+	if len(f.Categories) > 0 && !slices.Contains(f.Categories, part.Category) {
+		return false
+	}
+
+	if len(f.CategoryUuids) > 0 && !slices.Contains(f.CategoryUuids, part.CategoryUuid) {
+		return false
+	}
+
+	if len(f.ManufacturerCountries) > 0 &&
+		(part.Manufacturer == nil || !slices.Contains(f.ManufacturerCountries, part.Manufacturer.Country)) {
+		return false
+	}
+
+	if len(f.ManufacturerUuids) > 0 &&
+		(!part.Manufacturer.IsReference() || !slices.Contains(f.ManufacturerUuids, part.Manufacturer.Uuid)) {
+		return false
+	}
+
+	if len(f.Tags) > 0 && !slices.ContainsFunc(part.Tags, func(tag string) bool {
+		return slices.Contains(f.Tags, tag)
+	}) {
+		return false
+	}
+
+	if !f.Price.Contains(part.Price) {
+		return false
+	}
+
+	if f.InStockOnly && part.StockQuantity <= 0 {
+		return false
+	}
+
+	if f.LowStock && !part.IsLowStock() {
+		return false
+	}
+
+	if len(f.LifecycleStates) > 0 && !slices.Contains(f.LifecycleStates, part.LifecycleState) {
+		return false
+	}
+
+	if !f.matchesDimensions(part.Dimensions) {
+		return false
+	}
+
+	if !f.CreatedAt.ContainsPtr(part.CreatedAt) || !f.UpdatedAt.ContainsPtr(part.UpdatedAt) {
+		return false
+	}
+
+	for _, p := range f.Metadata {
+		if !p.Matches(part.Metadata) {
+			return false
+		}
+	}
+
+	if len(f.ComponentUuids) > 0 && !slices.ContainsFunc(part.Components, func(c Component) bool {
+		return slices.Contains(f.ComponentUuids, c.PartUuid)
+	}) {
+		return false
+	}
+
+	return true
+}
+
+func (f *PartsFilter) matchesDimensions(d *Dimensions) bool {
+	if f.Length.IsEmpty() && f.Width.IsEmpty() && f.Height.IsEmpty() && f.Weight.IsEmpty() {
+		return true
+	}
+
+	// Детали без размеров не проходят заданные диапазоны
+	if d == nil {
+		return false
+	}
+
+	return f.Length.Contains(d.Length) &&
+		f.Width.Contains(d.Width) &&
+		f.Height.Contains(d.Height) &&
+		f.Weight.Contains(d.Weight)
 }
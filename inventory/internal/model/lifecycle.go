@@ -0,0 +1,76 @@
+package model
+
+import "slices"
+
+// LifecycleState - состояние детали в жизненном цикле
+type LifecycleState int32
+
+const (
+	LifecycleStateUnspecified LifecycleState = iota
+	LifecycleStateDraft
+	LifecycleStateActive
+	LifecycleStateDiscontinued
+	LifecycleStateRecalled
+)
+
+// lifecycleTransitions - допустимые переходы между состояниями. В черновик деталь не возвращается.
+var lifecycleTransitions = map[LifecycleState][]LifecycleState{
+	LifecycleStateDraft:        {LifecycleStateActive},
+	LifecycleStateActive:       {LifecycleStateDiscontinued, LifecycleStateRecalled},
+	LifecycleStateDiscontinued: {LifecycleStateActive, LifecycleStateRecalled, LifecycleStateDiscontinued},
+	LifecycleStateRecalled:     {LifecycleStateActive, LifecycleStateDiscontinued, LifecycleStateRecalled},
+}
+
+// IsKnown сообщает, что состояние - одно из поддерживаемых
+func (s LifecycleState) IsKnown() bool {
+	return s >= LifecycleStateDraft && s <= LifecycleStateRecalled
+}
+
+// IsWithdrawn сообщает, что деталь выведена из продажи и для неё может быть указана замена
+func (s LifecycleState) IsWithdrawn() bool {
+	return s == LifecycleStateDiscontinued || s == LifecycleStateRecalled
+}
+
+// CanTransitionTo сообщает, что деталь можно перевести в состояние next. Повторный перевод
+// выведенной из продажи детали в то же состояние допустим и меняет замену.
+func (s LifecycleState) CanTransitionTo(next LifecycleState) bool {
+	return slices.Contains(lifecycleTransitions[s], next)
+}
+
+func (s LifecycleState) String() string {
+	switch s {
+	case LifecycleStateDraft:
+		return "draft"
+	case LifecycleStateActive:
+		return "active"
+	case LifecycleStateDiscontinued:
+		return "discontinued"
+	case LifecycleStateRecalled:
+		return "recalled"
+	default:
+		return "unspecified"
+	}
+}
+
+// LifecycleChange - запрос на смену состояния жизненного цикла детали
+type LifecycleChange struct {
+	PartUuid string
+	State    LifecycleState
+	// ReplacementPartUuid - рекомендуемая замена, только для выведенных из продажи состояний
+	ReplacementPartUuid string
+}
+
+// WithDefaultLifecycleStates возвращает фильтр, который без условия на состояние отбирает
+// только действующие детали. Фильтр с условием на состояние возвращается без изменений.
+func (f *PartsFilter) WithDefaultLifecycleStates() *PartsFilter {
+	if f != nil && len(f.LifecycleStates) > 0 {
+		return f
+	}
+
+	var c PartsFilter
+	if f != nil {
+		c = *f
+	}
+	c.LifecycleStates = []LifecycleState{LifecycleStateActive}
+	return &c
+}
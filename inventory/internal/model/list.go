@@ -0,0 +1,132 @@
+package model
+
+import (
+	"cmp"
+	"strings"
+	"time"
+)
+
+// PartsBatch - детали, запрошенные списком UUID
+type PartsBatch struct {
+	// Parts - найденные детали по UUID
+	Parts map[string]*Part
+	// NotFoundUuids - корректные UUID без детали, в порядке первого упоминания в запросе
+	NotFoundUuids []string
+	// InvalidUuids - строки запроса, не являющиеся UUID, в порядке первого упоминания
+	InvalidUuids []string
+}
+
+// PartsOrderField - поле сортировки списка деталей
+type PartsOrderField int32
+
+const (
+	// PartsOrderFieldUUID - сортировка только по UUID
+	PartsOrderFieldUUID PartsOrderField = iota
+	PartsOrderFieldPrice
+	PartsOrderFieldName
+	PartsOrderFieldCreatedAt
+	PartsOrderFieldStockQuantity
+	// PartsOrderFieldRelevance - по релевантности полнотекстовому запросу, самые релевантные первыми
+	PartsOrderFieldRelevance
+)
+
+// IsKnown сообщает, что поле сортировки поддерживается
+func (f PartsOrderField) IsKnown() bool {
+	return f >= PartsOrderFieldUUID && f <= PartsOrderFieldRelevance
+}
+
+// PartsOrder - порядок сортировки. При равенстве ключа детали упорядочены по UUID в том же направлении,
+// поэтому порядок строгий и совпадает с индексом MongoDB (ключ, uuid).
+type PartsOrder struct {
+	Field      PartsOrderField
+	Descending bool
+}
+
+// Compare сравнивает детали в порядке сортировки
+func (o PartsOrder) Compare(a, b *Part) int {
+	return o.compareKeys(NewPartsCursor(a), NewPartsCursor(b))
+}
+
+// After сообщает, что деталь находится в порядке сортировки строго после курсора
+func (o PartsOrder) After(part *Part, cursor *PartsCursor) bool {
+	return cursor == nil || o.compareKeys(NewPartsCursor(part), cursor) > 0
+}
+
+func (o PartsOrder) compareKeys(a, b *PartsCursor) int {
+	var c int
+	switch o.Field {
+	case PartsOrderFieldPrice:
+		c = cmp.Compare(a.Price, b.Price)
+	case PartsOrderFieldName:
+		c = strings.Compare(a.Name, b.Name)
+	case PartsOrderFieldCreatedAt:
+		c = a.CreatedAt.Compare(b.CreatedAt)
+	case PartsOrderFieldStockQuantity:
+		c = cmp.Compare(a.StockQuantity, b.StockQuantity)
+	case PartsOrderFieldRelevance:
+		c = cmp.Compare(b.Score, a.Score)
+	}
+
+	if c == 0 {
+		c = strings.Compare(a.Uuid, b.Uuid)
+	}
+
+	if o.Descending {
+		return -c
+	}
+
+	return c
+}
+
+// PartsCursor - позиция в отсортированном списке: ключи сортировки последней выданной детали
+type PartsCursor struct {
+	Uuid          string
+	Price         float64
+	Name          string
+	CreatedAt     time.Time
+	StockQuantity int64
+	Score         float64
+}
+
+// NewPartsCursor создаёт курсор, указывающий на деталь
+func NewPartsCursor(part *Part) *PartsCursor {
+	cursor := &PartsCursor{
+		Uuid:          part.Uuid,
+		Price:         part.Price,
+		Name:          part.Name,
+		StockQuantity: part.StockQuantity,
+		Score:         part.SearchScore,
+	}
+	if part.CreatedAt != nil {
+		cursor.CreatedAt = *part.CreatedAt
+	}
+
+	return cursor
+}
+
+// PartsQuery - запрос страницы деталей к репозиторию
+type PartsQuery struct {
+	Filter *PartsFilter
+	Order  PartsOrder
+	// After - курсор, после которого начинается выборка (nil - с начала)
+	After *PartsCursor
+	// Limit - максимальное количество деталей (0 - без ограничения)
+	Limit int
+}
+
+// ListPartsParams - параметры постраничного получения деталей
+type ListPartsParams struct {
+	Filter    *PartsFilter
+	Order     PartsOrder
+	PageSize  int
+	PageToken string
+	// AsOf - момент, на который возвращаются цены деталей (нулевой - текущий)
+	AsOf time.Time
+}
+
+// PartsPage - страница списка деталей
+type PartsPage struct {
+	Parts         []*Part
+	NextPageToken string
+	TotalSize     int
+}
@@ -0,0 +1,26 @@
+package model
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// ManufacturersFilter - фильтр справочника производителей
+type ManufacturersFilter struct {
+	Countries []string
+}
+
+// Matches проверяет, что производитель удовлетворяет фильтру
+func (f *ManufacturersFilter) Matches(m *Manufacturer) bool {
+	return f == nil || len(f.Countries) == 0 || slices.Contains(f.Countries, m.Country)
+}
+
+// Validate проверяет запись справочника производителей перед сохранением
+func (m *Manufacturer) Validate() error {
+	if strings.TrimSpace(m.Name) == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidManufacturer)
+	}
+
+	return nil
+}
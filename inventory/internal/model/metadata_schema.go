@@ -0,0 +1,159 @@
+package model
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// MetadataType - тип значения поля метаданных
+type MetadataType int32
+
+const (
+	MetadataTypeUnspecified MetadataType = iota
+	MetadataTypeString
+	MetadataTypeInt64
+	MetadataTypeDouble
+	MetadataTypeBool
+)
+
+// IsKnown сообщает, что тип поддерживается схемой
+func (t MetadataType) IsKnown() bool {
+	return t >= MetadataTypeString && t <= MetadataTypeBool
+}
+
+// IsNumeric сообщает, что для значений типа можно задать диапазон
+func (t MetadataType) IsNumeric() bool {
+	return t == MetadataTypeInt64 || t == MetadataTypeDouble
+}
+
+func (t MetadataType) String() string {
+	switch t {
+	case MetadataTypeString:
+		return "string"
+	case MetadataTypeInt64:
+		return "int64"
+	case MetadataTypeDouble:
+		return "double"
+	case MetadataTypeBool:
+		return "bool"
+	default:
+		return "unspecified"
+	}
+}
+
+// MetadataField - поле схемы метаданных деталей категории
+type MetadataField struct {
+	Key         string
+	Type        MetadataType
+	Unit        string
+	Description string
+	Required    bool
+	// Range - допустимый диапазон значения числового поля, границы включаются
+	Range *FloatRange
+	// CategoryUuid - категория, в схеме которой задано поле. Заполняется в действующей схеме
+	// (CategoryTree.MetadataSchema), в собственной схеме категории пусто.
+	CategoryUuid string
+}
+
+// IsValidMetadataKey сообщает, что ключ можно использовать в метаданных. Ключ становится
+// частью пути в документе MongoDB, поэтому точки и "$" в начале недопустимы.
+func IsValidMetadataKey(key string) bool {
+	return key != "" && !strings.Contains(key, ".") && !strings.HasPrefix(key, "$")
+}
+
+// ValidateMetadataSchema проверяет собственную схему категории без учёта дерева
+func ValidateMetadataSchema(fields []MetadataField) error {
+	keys := make(map[string]bool, len(fields))
+	for _, f := range fields {
+		switch {
+		case !IsValidMetadataKey(f.Key):
+			return fmt.Errorf("%w: invalid metadata key %q", ErrInvalidCategory, f.Key)
+		case keys[f.Key]:
+			return fmt.Errorf("%w: metadata key %q is listed twice", ErrInvalidCategory, f.Key)
+		case !f.Type.IsKnown():
+			return fmt.Errorf("%w: unknown type of metadata field %q", ErrInvalidCategory, f.Key)
+		}
+		keys[f.Key] = true
+
+		if f.Range.IsEmpty() {
+			continue
+		}
+		if !f.Type.IsNumeric() {
+			return fmt.Errorf("%w: range is allowed only for numeric metadata field %q", ErrInvalidCategory, f.Key)
+		}
+		for _, bound := range []*float64{f.Range.Min, f.Range.Max} {
+			if bound != nil && (math.IsNaN(*bound) || math.IsInf(*bound, 0)) {
+				return fmt.Errorf("%w: range bound of metadata field %q must be finite", ErrInvalidCategory, f.Key)
+			}
+		}
+		if f.Range.Min != nil && f.Range.Max != nil && *f.Range.Min > *f.Range.Max {
+			return fmt.Errorf("%w: range min of metadata field %q is greater than max", ErrInvalidCategory, f.Key)
+		}
+	}
+
+	return nil
+}
+
+// ValidateMetadata проверяет метаданные детали по действующей схеме категории.
+// Ключи вне схемы не проверяются.
+func ValidateMetadata(schema []MetadataField, metadata map[string]any) error {
+	for _, f := range schema {
+		value, ok := metadata[f.Key]
+		if !ok || value == nil {
+			if f.Required {
+				return fmt.Errorf("%w: metadata %q is required", ErrInvalidPart, f.Key)
+			}
+			continue
+		}
+
+		if err := f.check(value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (f MetadataField) check(value any) error {
+	var matches bool
+	switch f.Type {
+	case MetadataTypeString:
+		_, matches = value.(string)
+	case MetadataTypeInt64:
+		switch value.(type) {
+		case int64, int32, int:
+			matches = true
+		}
+	case MetadataTypeDouble:
+		// Целое значение - тоже число: 5 и 5.0 для поля с плавающей точкой равнозначны
+		_, matches = metadataNumber(value)
+	case MetadataTypeBool:
+		_, matches = value.(bool)
+	}
+	if !matches {
+		return fmt.Errorf("%w: metadata %q must be of type %s", ErrInvalidPart, f.Key, f.Type)
+	}
+
+	if n, ok := metadataNumber(value); ok && (math.IsNaN(n) || !f.Range.Contains(n)) {
+		return fmt.Errorf("%w: metadata %q is out of range", ErrInvalidPart, f.Key)
+	}
+
+	return nil
+}
+
+func cloneMetadataSchema(fields []MetadataField) []MetadataField {
+	if fields == nil {
+		return nil
+	}
+
+	clone := make([]MetadataField, len(fields))
+	for i, f := range fields {
+		clone[i] = f
+		if f.Range != nil {
+			r := *f.Range
+			clone[i].Range = &r
+		}
+	}
+	return clone
+}
@@ -1,22 +1,55 @@
 package model
 
 import (
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"strings"
 	"time"
+
+	"github.com/samber/lo"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/textsearch"
 )
 
 type Part struct {
-	Uuid          string
-	Name          string
-	Description   string
-	Price         float64
+	Uuid        string
+	Name        string
+	Description string
+	Price       float64
+	// StockQuantity - общий остаток по всем складам, сумма StockLevels
 	StockQuantity int64
 	Category      Category
-	Dimensions    *Dimensions
-	Manufacturer  *Manufacturer
-	Tags          []string
-	Metadata      map[string]interface{}
-	CreatedAt     *time.Time
-	UpdatedAt     *time.Time
+	// CategoryUuid - категория в дереве категорий; Category - соответствующее ей значение enum
+	CategoryUuid string
+	Dimensions   *Dimensions
+	Manufacturer *Manufacturer
+	Tags         []string
+	Metadata     map[string]interface{}
+	CreatedAt    *time.Time
+	UpdatedAt    *time.Time
+	// SearchScore - релевантность полнотекстовому запросу ListParts (не хранится, 0 вне поиска)
+	SearchScore float64
+	// Components - состав сборки, пусто для детали, которая не собирается из других
+	Components []Component
+	// PriceHistory - версии цены в порядке начала действия, включая запланированные.
+	// Price - цена действующей версии на момент последнего сохранения детали.
+	PriceHistory []PriceVersion
+	// NextPriceChangeAt - начало ближайшей запланированной версии цены, ещё не перенесённой в Price
+	NextPriceChangeAt *time.Time
+	// ReorderThreshold - порог дозаказа (0 - не задан), см. IsLowStock
+	ReorderThreshold int64
+	// LowStockAlertedAt - когда отправлено оповещение о текущем падении остатка до порога.
+	// Сбрасывается, когда остаток поднимается выше порога, чтобы следующее падение снова оповещало.
+	LowStockAlertedAt *time.Time
+	// StockLevels - остатки по складам с ненулевым или когда-либо изменённым остатком, в порядке
+	// появления склада у детали
+	StockLevels []StockLevel
+	// LifecycleState - состояние жизненного цикла, изменяется только переходами (LifecycleState.CanTransitionTo)
+	LifecycleState LifecycleState
+	// ReplacementPartUuid - рекомендуемая замена выведенной из продажи детали, пусто - замены нет
+	ReplacementPartUuid string
 }
 
 type Dimensions struct {
@@ -26,7 +59,10 @@ type Dimensions struct {
 	Weight float64
 }
 
+// Manufacturer - производитель. В детали - копия записи справочника производителей (Uuid задан)
+// или производитель, не связанный со справочником (Uuid пуст).
 type Manufacturer struct {
+	Uuid    string
 	Name    string
 	Country string
 	Website string
@@ -45,3 +81,161 @@ const (
 type Value struct {
 	Value interface{}
 }
+
+// IsKnown сообщает, является ли категория одной из поддерживаемых
+func (c Category) IsKnown() bool {
+	return c >= CategoryEngine && c <= CategoryWing
+}
+
+// IsReference сообщает, что производитель детали ссылается на запись справочника
+func (m *Manufacturer) IsReference() bool {
+	return m != nil && m.Uuid != ""
+}
+
+// IsFinite сообщает, что число не NaN и не бесконечность: такие значения нельзя сравнивать,
+// складывать в суммы заказов и сериализовать в JSON
+func IsFinite(v float64) bool {
+	return !math.IsNaN(v) && !math.IsInf(v, 0)
+}
+
+// Validate проверяет бизнес-ограничения детали перед сохранением
+func (p *Part) Validate() error {
+	if strings.TrimSpace(p.Name) == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidPart)
+	}
+
+	if p.Price <= 0 || !IsFinite(p.Price) {
+		return fmt.Errorf("%w: price must be a positive finite number", ErrInvalidPart)
+	}
+
+	if p.StockQuantity < 0 {
+		return fmt.Errorf("%w: stock quantity must not be negative", ErrInvalidPart)
+	}
+
+	warehouses := make(map[string]bool, len(p.StockLevels))
+	for _, l := range p.StockLevels {
+		switch {
+		case l.WarehouseUuid == "":
+			return fmt.Errorf("%w: stock level warehouse uuid is required", ErrInvalidPart)
+		case warehouses[l.WarehouseUuid]:
+			return fmt.Errorf("%w: warehouse %s is listed twice in stock levels", ErrInvalidPart, l.WarehouseUuid)
+		case l.Quantity < 0:
+			return fmt.Errorf("%w: stock level of warehouse %s must not be negative", ErrInvalidPart, l.WarehouseUuid)
+		}
+		warehouses[l.WarehouseUuid] = true
+	}
+
+	if p.ReorderThreshold < 0 {
+		return fmt.Errorf("%w: reorder threshold must not be negative", ErrInvalidPart)
+	}
+
+	// Категория задаётся значением enum или узлом дерева категорий
+	if p.Category != CategoryUnspecified && !p.Category.IsKnown() {
+		return fmt.Errorf("%w: unknown category %d", ErrInvalidPart, p.Category)
+	}
+	if p.Category == CategoryUnspecified && p.CategoryUuid == "" {
+		return fmt.Errorf("%w: category or category uuid is required", ErrInvalidPart)
+	}
+
+	if d := p.Dimensions; d != nil {
+		for _, v := range []float64{d.Length, d.Width, d.Height, d.Weight} {
+			if v < 0 || !IsFinite(v) {
+				return fmt.Errorf("%w: dimensions must be non-negative finite numbers", ErrInvalidPart)
+			}
+		}
+	}
+
+	for key, value := range p.Metadata {
+		if v, ok := value.(float64); ok && !IsFinite(v) {
+			return fmt.Errorf("%w: metadata %q must be a finite number", ErrInvalidPart, key)
+		}
+	}
+
+	seen := make(map[string]bool, len(p.Components))
+	for _, c := range p.Components {
+		switch {
+		case c.PartUuid == "":
+			return fmt.Errorf("%w: component part uuid is required", ErrInvalidPart)
+		case c.PartUuid == p.Uuid:
+			return fmt.Errorf("%w: part cannot be its own component", ErrInvalidPart)
+		case seen[c.PartUuid]:
+			return fmt.Errorf("%w: component %s is listed twice", ErrInvalidPart, c.PartUuid)
+		case c.Quantity <= 0 || c.Quantity > MaxComponentQuantity:
+			return fmt.Errorf("%w: component %s quantity must be between 1 and %d", ErrInvalidPart, c.PartUuid, MaxComponentQuantity)
+		}
+		seen[c.PartUuid] = true
+	}
+
+	return nil
+}
+
+// LastModifiedAt возвращает время последнего изменения детали для проверки конкурентных изменений:
+// updated_at, а у деталей, сохранённых без него, - created_at
+func (p *Part) LastModifiedAt() time.Time {
+	switch {
+	case p.UpdatedAt != nil:
+		return *p.UpdatedAt
+	case p.CreatedAt != nil:
+		return *p.CreatedAt
+	default:
+		return time.Time{}
+	}
+}
+
+// Clone возвращает глубокую копию детали, чтобы хранилища в памяти не разделяли данные с вызывающим кодом
+func (p *Part) Clone() *Part {
+	if p == nil {
+		return nil
+	}
+
+	clone := *p
+	if p.Dimensions != nil {
+		clone.Dimensions = lo.ToPtr(*p.Dimensions)
+	}
+	if p.Manufacturer != nil {
+		clone.Manufacturer = lo.ToPtr(*p.Manufacturer)
+	}
+	if p.Tags != nil {
+		clone.Tags = slices.Clone(p.Tags)
+	}
+	if p.Metadata != nil {
+		clone.Metadata = maps.Clone(p.Metadata)
+	}
+	if p.CreatedAt != nil {
+		clone.CreatedAt = lo.ToPtr(*p.CreatedAt)
+	}
+	if p.UpdatedAt != nil {
+		clone.UpdatedAt = lo.ToPtr(*p.UpdatedAt)
+	}
+	if p.Components != nil {
+		clone.Components = slices.Clone(p.Components)
+	}
+	if p.PriceHistory != nil {
+		clone.PriceHistory = slices.Clone(p.PriceHistory)
+	}
+	if p.NextPriceChangeAt != nil {
+		clone.NextPriceChangeAt = lo.ToPtr(*p.NextPriceChangeAt)
+	}
+	if p.LowStockAlertedAt != nil {
+		clone.LowStockAlertedAt = lo.ToPtr(*p.LowStockAlertedAt)
+	}
+	if p.StockLevels != nil {
+		clone.StockLevels = slices.Clone(p.StockLevels)
+	}
+
+	return &clone
+}
+
+// TextScore оценивает релевантность детали словам полнотекстового запроса (см. textsearch.Terms)
+// с весами полей, совпадающими с весами текстового индекса MongoDB
+func (p *Part) TextScore(queryTerms []string) float64 {
+	fields := []textsearch.Field{
+		{Text: p.Name, Weight: textsearch.WeightName},
+		{Text: p.Description, Weight: textsearch.WeightDescription},
+	}
+	for _, tag := range p.Tags {
+		fields = append(fields, textsearch.Field{Text: tag, Weight: textsearch.WeightTags})
+	}
+
+	return textsearch.Score(queryTerms, fields...)
+}
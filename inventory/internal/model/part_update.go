@@ -0,0 +1,48 @@
+package model
+
+// Пути полей детали, которые можно изменить через UpdatePart (имена совпадают с полями Part в proto).
+// Остаток (stock_quantity) изменяется только через AdjustStock, чтобы каждое изменение попадало в журнал.
+const (
+	PartFieldName                = "name"
+	PartFieldDescription         = "description"
+	PartFieldPrice               = "price"
+	PartFieldCategory            = "category"
+	PartFieldCategoryUuid        = "category_uuid"
+	PartFieldDimensions          = "dimensions"
+	PartFieldDimensionsLength    = "dimensions.length"
+	PartFieldDimensionsWidth     = "dimensions.width"
+	PartFieldDimensionsHeight    = "dimensions.height"
+	PartFieldDimensionsWeight    = "dimensions.weight"
+	PartFieldManufacturer        = "manufacturer"
+	PartFieldManufacturerName    = "manufacturer.name"
+	PartFieldManufacturerCountry = "manufacturer.country"
+	PartFieldManufacturerWebsite = "manufacturer.website"
+	PartFieldManufacturerUuid    = "manufacturer.uuid"
+	PartFieldTags                = "tags"
+	PartFieldMetadata            = "metadata"
+	PartFieldComponents          = "components"
+	PartFieldReorderThreshold    = "reorder_threshold"
+)
+
+// PartUpdatableFields - поля, обновляемые при пустой маске
+var PartUpdatableFields = []string{
+	PartFieldName,
+	PartFieldDescription,
+	PartFieldPrice,
+	PartFieldCategory,
+	PartFieldCategoryUuid,
+	PartFieldDimensions,
+	PartFieldManufacturer,
+	PartFieldTags,
+	PartFieldMetadata,
+	PartFieldComponents,
+	PartFieldReorderThreshold,
+}
+
+// PartUpdate - запрос на обновление детали
+type PartUpdate struct {
+	// Part - новые значения полей, деталь определяется по Part.Uuid
+	Part *Part
+	// Paths - обновляемые поля. Пустой список означает все изменяемые поля.
+	Paths []string
+}
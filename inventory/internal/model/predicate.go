@@ -0,0 +1,148 @@
+package model
+
+import (
+	"time"
+)
+
+// FloatRange - диапазон чисел, границы включительно. Nil-граница не ограничивает.
+type FloatRange struct {
+	Min *float64
+	Max *float64
+}
+
+// IsEmpty сообщает, что диапазон не ограничивает значения
+func (r *FloatRange) IsEmpty() bool {
+	return r == nil || (r.Min == nil && r.Max == nil)
+}
+
+// Contains проверяет, что значение попадает в диапазон
+func (r *FloatRange) Contains(v float64) bool {
+	if r == nil {
+		return true
+	}
+
+	return (r.Min == nil || v >= *r.Min) && (r.Max == nil || v <= *r.Max)
+}
+
+// TimeRange - диапазон времени [From, To). Nil-граница не ограничивает.
+type TimeRange struct {
+	From *time.Time
+	To   *time.Time
+}
+
+// IsEmpty сообщает, что диапазон не ограничивает значения
+func (r *TimeRange) IsEmpty() bool {
+	return r == nil || (r.From == nil && r.To == nil)
+}
+
+// ContainsPtr проверяет, что время попадает в диапазон. Отсутствующее время не попадает в непустой диапазон.
+func (r *TimeRange) ContainsPtr(t *time.Time) bool {
+	if r.IsEmpty() {
+		return true
+	}
+	if t == nil {
+		return false
+	}
+
+	return (r.From == nil || !t.Before(*r.From)) && (r.To == nil || t.Before(*r.To))
+}
+
+// MetadataOperator - оператор условия на метаданные
+type MetadataOperator int32
+
+const (
+	MetadataOperatorUnspecified MetadataOperator = iota
+	MetadataOperatorEQ
+	MetadataOperatorNE
+	MetadataOperatorGT
+	MetadataOperatorGTE
+	MetadataOperatorLT
+	MetadataOperatorLTE
+	MetadataOperatorExists
+)
+
+// IsComparison сообщает, что оператор сравнивает числа по величине
+func (o MetadataOperator) IsComparison() bool {
+	return o >= MetadataOperatorGT && o <= MetadataOperatorLTE
+}
+
+// MetadataPredicate - условие на значение метаданных по ключу.
+// Value - string, int64, float64 или bool; для MetadataOperatorExists не используется.
+type MetadataPredicate struct {
+	Key      string
+	Operator MetadataOperator
+	Value    any
+}
+
+// Matches проверяет условие на метаданных детали. Семантика совпадает с запросами MongoDB:
+// числа сравниваются по значению независимо от типа, NE выбирает и детали без ключа.
+func (p MetadataPredicate) Matches(metadata map[string]any) bool {
+	actual, exists := metadata[p.Key]
+
+	switch p.Operator {
+	case MetadataOperatorExists:
+		return exists
+	case MetadataOperatorEQ:
+		return exists && metadataEqual(actual, p.Value)
+	case MetadataOperatorNE:
+		return !exists || !metadataEqual(actual, p.Value)
+	}
+
+	if !exists {
+		return false
+	}
+
+	a, aok := metadataNumber(actual)
+	b, bok := metadataNumber(p.Value)
+	if !aok || !bok {
+		return false
+	}
+
+	switch p.Operator {
+	case MetadataOperatorGT:
+		return a > b
+	case MetadataOperatorGTE:
+		return a >= b
+	case MetadataOperatorLT:
+		return a < b
+	case MetadataOperatorLTE:
+		return a <= b
+	default:
+		return false
+	}
+}
+
+func metadataEqual(a, b any) bool {
+	an, aok := metadataNumber(a)
+	bn, bok := metadataNumber(b)
+	if aok || bok {
+		return aok && bok && an == bn
+	}
+
+	switch av := a.(type) {
+	case string:
+		bv, ok := b.(string)
+		return ok && av == bv
+	case bool:
+		bv, ok := b.(bool)
+		return ok && av == bv
+	default:
+		return false
+	}
+}
+
+// metadataNumber приводит числовое значение метаданных к float64
+func metadataNumber(v any) (float64, bool) {
+	switch n := v.(type) {
+	case int64:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case float64:
+		return n, true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,65 @@
+package model
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+)
+
+// PriceVersion - версия цены детали. Действует с EffectiveFrom до начала следующей версии.
+type PriceVersion struct {
+	// Uuid - UUIDv7; из версий с одинаковым EffectiveFrom действует созданная последней
+	Uuid          string
+	Price         float64
+	EffectiveFrom time.Time
+	CreatedAt     time.Time
+}
+
+// PriceChange - запрос на изменение цены детали в будущем
+type PriceChange struct {
+	PartUuid      string
+	Price         float64
+	EffectiveFrom time.Time
+}
+
+// AddPriceVersion добавляет версию в историю цен, сохраняя порядок по началу действия
+func (p *Part) AddPriceVersion(version PriceVersion) {
+	i, _ := slices.BinarySearchFunc(p.PriceHistory, version, comparePriceVersions)
+	p.PriceHistory = slices.Insert(p.PriceHistory, i, version)
+}
+
+// PriceAt возвращает цену, действующую в момент at. Для детали без истории цен
+// и для момента раньше первой версии - Price.
+func (p *Part) PriceAt(at time.Time) float64 {
+	for i := len(p.PriceHistory) - 1; i >= 0; i-- {
+		if !p.PriceHistory[i].EffectiveFrom.After(at) {
+			return p.PriceHistory[i].Price
+		}
+	}
+
+	return p.Price
+}
+
+// ApplyPriceHistory переносит в Price цену, действующую в момент at, а в NextPriceChangeAt -
+// начало следующей версии (nil, если изменений цены больше не запланировано)
+func (p *Part) ApplyPriceHistory(at time.Time) {
+	p.Price = p.PriceAt(at)
+	p.NextPriceChangeAt = nil
+
+	for _, v := range p.PriceHistory {
+		if v.EffectiveFrom.After(at) {
+			p.NextPriceChangeAt = lo.ToPtr(v.EffectiveFrom)
+			break
+		}
+	}
+}
+
+func comparePriceVersions(a, b PriceVersion) int {
+	if c := a.EffectiveFrom.Compare(b.EffectiveFrom); c != 0 {
+		return c
+	}
+
+	return strings.Compare(a.Uuid, b.Uuid)
+}
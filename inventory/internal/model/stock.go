@@ -0,0 +1,107 @@
+package model
+
+import (
+	"time"
+)
+
+// StockMovementReason - причина движения остатка
+type StockMovementReason int32
+
+const (
+	StockMovementReasonUnspecified StockMovementReason = iota
+	StockMovementReasonReceipt
+	StockMovementReasonSale
+	StockMovementReasonReservation
+	StockMovementReasonDamage
+	StockMovementReasonCorrection
+	StockMovementReasonTransfer
+)
+
+// IsKnown сообщает, что причина задана и поддерживается
+func (r StockMovementReason) IsKnown() bool {
+	return r >= StockMovementReasonReceipt && r <= StockMovementReasonTransfer
+}
+
+// AllowsDelta проверяет знак изменения для причины: поступление только увеличивает остаток,
+// продажа и списание - только уменьшают, резерв, корректировка и перемещение допускают оба направления
+func (r StockMovementReason) AllowsDelta(delta int64) bool {
+	switch r {
+	case StockMovementReasonReceipt:
+		return delta > 0
+	case StockMovementReasonSale, StockMovementReasonDamage:
+		return delta < 0
+	default:
+		return delta != 0
+	}
+}
+
+// StockAdjustment - запрос на изменение остатка детали
+type StockAdjustment struct {
+	PartUuid string
+	// WarehouseUuid - склад, на котором изменяется остаток (пусто - основной склад)
+	WarehouseUuid string
+	Delta         int64
+	Reason        StockMovementReason
+	ReferenceID   string
+}
+
+// StockMovement - запись журнала движений остатка
+type StockMovement struct {
+	// Uuid - UUIDv7, поэтому порядок идентификаторов совпадает с порядком движений
+	Uuid          string
+	PartUuid      string
+	WarehouseUuid string
+	Delta         int64
+	// QuantityAfter - общий остаток детали по всем складам после движения
+	QuantityAfter int64
+	Reason        StockMovementReason
+	ReferenceID   string
+	CreatedAt     time.Time
+}
+
+// StockTransfer - запрос на перемещение остатка детали между складами
+type StockTransfer struct {
+	PartUuid          string
+	FromWarehouseUuid string
+	ToWarehouseUuid   string
+	Quantity          int64
+	ReferenceID       string
+}
+
+// StockFulfillment - запрос на списание остатков под отгрузку
+type StockFulfillment struct {
+	ReferenceID string
+	// PreferredWarehouseUuid - склад, с которого списывается в первую очередь (пусто - без предпочтения)
+	PreferredWarehouseUuid string
+	Items                  []FulfillmentItem
+}
+
+// FulfillmentItem - позиция отгрузки
+type FulfillmentItem struct {
+	PartUuid string
+	Quantity int64
+}
+
+// StockMovementsQuery - запрос страницы журнала движений к репозиторию
+type StockMovementsQuery struct {
+	PartUuid string
+	// ReferenceID - только движения с этой ссылкой (пусто - все движения детали)
+	ReferenceID string
+	// Before - UUID движения, после которого (в порядке от новых к старым) начинается выборка
+	Before string
+	// Limit - максимальное количество движений (0 - без ограничения)
+	Limit int
+}
+
+// ListStockMovementsParams - параметры постраничного получения журнала движений
+type ListStockMovementsParams struct {
+	PartUuid  string
+	PageSize  int
+	PageToken string
+}
+
+// StockMovementsPage - страница журнала движений
+type StockMovementsPage struct {
+	Movements     []*StockMovement
+	NextPageToken string
+}
@@ -0,0 +1,25 @@
+package model
+
+import (
+	"time"
+)
+
+// IsLowStock сообщает, что у детали задан порог дозаказа и остаток опустился до него
+func (p *Part) IsLowStock() bool {
+	return p.ReorderThreshold > 0 && p.StockQuantity <= p.ReorderThreshold
+}
+
+// NeedsLowStockAlertUpdate сообщает, что остаток пересёк порог дозаказа после последней проверки:
+// опустился до порога без оповещения или поднялся выше порога после оповещения
+func (p *Part) NeedsLowStockAlertUpdate() bool {
+	return p.IsLowStock() != (p.LowStockAlertedAt != nil)
+}
+
+// LowStockAlert - оповещение о том, что остаток детали опустился до порога дозаказа
+type LowStockAlert struct {
+	PartUuid         string
+	PartName         string
+	StockQuantity    int64
+	ReorderThreshold int64
+	DetectedAt       time.Time
+}
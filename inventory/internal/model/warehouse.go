@@ -0,0 +1,129 @@
+package model
+
+import (
+	"cmp"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// Warehouse - склад, на котором хранятся детали
+type Warehouse struct {
+	Uuid     string
+	Code     string
+	Name     string
+	Location string
+}
+
+// DefaultWarehouse - основной склад. К нему относятся остатки деталей, сохранённых до появления
+// складов, и изменения остатка без указания склада. UUID постоянен.
+var DefaultWarehouse = &Warehouse{
+	Uuid: "3f7c2b9e-4a1d-4e8b-9c01-000000000001",
+	Code: "default",
+	Name: "Основной склад",
+}
+
+var warehouseCodePattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// maxWarehouseCodeLength - наибольшая длина кода склада
+const maxWarehouseCodeLength = 32
+
+// Validate проверяет склад перед сохранением
+func (w *Warehouse) Validate() error {
+	if len(w.Code) > maxWarehouseCodeLength || !warehouseCodePattern.MatchString(w.Code) {
+		return fmt.Errorf("%w: code must be lowercase latin letters and digits separated by dashes, up to %d characters",
+			ErrInvalidWarehouse, maxWarehouseCodeLength)
+	}
+
+	if strings.TrimSpace(w.Name) == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidWarehouse)
+	}
+
+	return nil
+}
+
+// StockLevel - остаток детали на складе
+type StockLevel struct {
+	WarehouseUuid string
+	Quantity      int64
+}
+
+// StockIn возвращает остаток детали на складе
+func (p *Part) StockIn(warehouseUuid string) int64 {
+	for _, l := range p.StockLevels {
+		if l.WarehouseUuid == warehouseUuid {
+			return l.Quantity
+		}
+	}
+	return 0
+}
+
+// NormalizeStockLevels приводит остатки к разбивке по складам: остаток детали без разбивки
+// относится к основному складу, а StockQuantity пересчитывается как сумма остатков складов
+func (p *Part) NormalizeStockLevels() {
+	if len(p.StockLevels) == 0 {
+		if p.StockQuantity != 0 {
+			p.StockLevels = []StockLevel{{WarehouseUuid: DefaultWarehouse.Uuid, Quantity: p.StockQuantity}}
+		}
+		return
+	}
+
+	p.StockQuantity = 0
+	for _, l := range p.StockLevels {
+		p.StockQuantity += l.Quantity
+	}
+}
+
+// ApplyStockDelta изменяет остаток на складе и общий остаток на delta.
+// Возвращает ErrInsufficientStock, если остаток на складе стал бы отрицательным.
+func (p *Part) ApplyStockDelta(warehouseUuid string, delta int64) error {
+	if p.StockIn(warehouseUuid)+delta < 0 {
+		return ErrInsufficientStock
+	}
+
+	i := slices.IndexFunc(p.StockLevels, func(l StockLevel) bool { return l.WarehouseUuid == warehouseUuid })
+	if i < 0 {
+		p.StockLevels = append(p.StockLevels, StockLevel{WarehouseUuid: warehouseUuid})
+		i = len(p.StockLevels) - 1
+	}
+
+	p.StockLevels[i].Quantity += delta
+	p.StockQuantity += delta
+
+	return nil
+}
+
+// AllocateStock распределяет quantity единиц по складам: сначала предпочтительный склад
+// (пустой - без предпочтения), затем остальные от большего остатка к меньшему.
+// Возвращает ErrInsufficientStock, если общего остатка не хватает.
+func (p *Part) AllocateStock(quantity int64, preferredWarehouseUuid string) ([]StockLevel, error) {
+	if quantity > p.StockQuantity {
+		return nil, fmt.Errorf("%w: part %s has %d, requested %d", ErrInsufficientStock, p.Uuid, p.StockQuantity, quantity)
+	}
+
+	levels := slices.Clone(p.StockLevels)
+	slices.SortFunc(levels, func(a, b StockLevel) int {
+		return cmp.Or(cmp.Compare(b.Quantity, a.Quantity), strings.Compare(a.WarehouseUuid, b.WarehouseUuid))
+	})
+	if i := slices.IndexFunc(levels, func(l StockLevel) bool { return l.WarehouseUuid == preferredWarehouseUuid }); i > 0 {
+		preferred := levels[i]
+		copy(levels[1:i+1], levels[:i])
+		levels[0] = preferred
+	}
+
+	var allocation []StockLevel
+	for _, l := range levels {
+		if quantity == 0 {
+			break
+		}
+		if l.Quantity <= 0 {
+			continue
+		}
+		take := min(l.Quantity, quantity)
+		allocation = append(allocation, StockLevel{WarehouseUuid: l.WarehouseUuid, Quantity: take})
+		quantity -= take
+	}
+
+	return allocation, nil
+}
@@ -0,0 +1,29 @@
+package logging
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+	"github.com/bogdanovds/rocket_factory/platform/pkg/logger"
+)
+
+// Notifier записывает оповещения о низком остатке в лог сервиса
+type Notifier struct{}
+
+func NewNotifier() *Notifier {
+	return &Notifier{}
+}
+
+func (n *Notifier) NotifyLowStock(ctx context.Context, alert *model.LowStockAlert) error {
+	logger.Warn(ctx, "⚠️ Part stock is at or below reorder threshold",
+		zap.String("part_uuid", alert.PartUuid),
+		zap.String("part_name", alert.PartName),
+		zap.Int64("stock_quantity", alert.StockQuantity),
+		zap.Int64("reorder_threshold", alert.ReorderThreshold),
+		zap.Time("detected_at", alert.DetectedAt),
+	)
+
+	return nil
+}
@@ -0,0 +1,25 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+// MockLowStockNotifier - мок оповещателя о низком остатке
+type MockLowStockNotifier struct {
+	mock.Mock
+}
+
+// NewMockLowStockNotifier создает новый мок оповещателя
+func NewMockLowStockNotifier() *MockLowStockNotifier {
+	return &MockLowStockNotifier{}
+}
+
+// NotifyLowStock отправляет оповещение о низком остатке
+func (m *MockLowStockNotifier) NotifyLowStock(ctx context.Context, alert *model.LowStockAlert) error {
+	args := m.Called(ctx, alert)
+	return args.Error(0)
+}
@@ -0,0 +1,13 @@
+package notifier
+
+import (
+	"context"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+// LowStockNotifier доставляет оповещения о низком остатке деталей
+type LowStockNotifier interface {
+	// NotifyLowStock отправляет оповещение. Ошибка означает, что оповещение не доставлено.
+	NotifyLowStock(ctx context.Context, alert *model.LowStockAlert) error
+}
@@ -0,0 +1,75 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+// EventLowStock - значение поля event в теле запроса об оповещении о низком остатке
+const EventLowStock = "part.low_stock"
+
+// lowStockPayload - тело POST-запроса, которым доставляется оповещение
+type lowStockPayload struct {
+	Event            string    `json:"event"`
+	PartUuid         string    `json:"part_uuid"`
+	PartName         string    `json:"part_name"`
+	StockQuantity    int64     `json:"stock_quantity"`
+	ReorderThreshold int64     `json:"reorder_threshold"`
+	DetectedAt       time.Time `json:"detected_at"`
+}
+
+// Notifier отправляет оповещения POST-запросом с JSON на заданный URL.
+// Оповещение считается доставленным, если получатель ответил статусом 2xx.
+type Notifier struct {
+	url    string
+	client *http.Client
+}
+
+// NewNotifier создаёт оповещатель; timeout ограничивает время одного запроса
+func NewNotifier(url string, timeout time.Duration) *Notifier {
+	return &Notifier{
+		url:    url,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+func (n *Notifier) NotifyLowStock(ctx context.Context, alert *model.LowStockAlert) error {
+	body, err := json.Marshal(lowStockPayload{
+		Event:            EventLowStock,
+		PartUuid:         alert.PartUuid,
+		PartName:         alert.PartName,
+		StockQuantity:    alert.StockQuantity,
+		ReorderThreshold: alert.ReorderThreshold,
+		DetectedAt:       alert.DetectedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal low stock alert: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send webhook: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	// Дочитываем тело, чтобы соединение вернулось в пул
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+
+	return nil
+}
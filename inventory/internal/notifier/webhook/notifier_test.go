@@ -0,0 +1,79 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+type NotifierTestSuite struct {
+	suite.Suite
+	status   int
+	received []lowStockPayload
+	server   *httptest.Server
+	notifier *Notifier
+}
+
+func (s *NotifierTestSuite) SetupTest() {
+	s.status = http.StatusNoContent
+	s.received = nil
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload lowStockPayload
+		if r.Method != http.MethodPost || r.Header.Get("Content-Type") != "application/json" ||
+			json.NewDecoder(r.Body).Decode(&payload) != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		s.received = append(s.received, payload)
+		w.WriteHeader(s.status)
+	}))
+	s.notifier = NewNotifier(s.server.URL, time.Second)
+}
+
+func (s *NotifierTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *NotifierTestSuite) alert() *model.LowStockAlert {
+	return &model.LowStockAlert{
+		PartUuid:         "6ba7b810-9dad-11d1-80b4-00c04fd430d0",
+		PartName:         "Porthole",
+		StockQuantity:    3,
+		ReorderThreshold: 5,
+		DetectedAt:       time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func (s *NotifierTestSuite) TestNotifyLowStock_PostsAlert() {
+	alert := s.alert()
+
+	s.Require().NoError(s.notifier.NotifyLowStock(context.Background(), alert))
+
+	s.Equal([]lowStockPayload{{
+		Event:            EventLowStock,
+		PartUuid:         alert.PartUuid,
+		PartName:         alert.PartName,
+		StockQuantity:    3,
+		ReorderThreshold: 5,
+		DetectedAt:       alert.DetectedAt,
+	}}, s.received)
+}
+
+func (s *NotifierTestSuite) TestNotifyLowStock_ErrorStatus() {
+	s.status = http.StatusServiceUnavailable
+
+	err := s.notifier.NotifyLowStock(context.Background(), s.alert())
+
+	s.ErrorContains(err, "503")
+}
+
+func TestNotifierTestSuite(t *testing.T) {
+	suite.Run(t, new(NotifierTestSuite))
+}
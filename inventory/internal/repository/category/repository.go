@@ -0,0 +1,96 @@
+package category
+
+import (
+	"context"
+	"sync"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+// Repository - дерево категорий в памяти
+type Repository struct {
+	mu         sync.RWMutex
+	categories map[string]*model.CategoryNode
+}
+
+func NewCategoryRepository() *Repository {
+	return &Repository{
+		categories: make(map[string]*model.CategoryNode),
+	}
+}
+
+func (r *Repository) Get(_ context.Context, uuid string) (*model.CategoryNode, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	n, exists := r.categories[uuid]
+	if !exists {
+		return nil, model.ErrCategoryNotFound
+	}
+
+	return n.Clone(), nil
+}
+
+func (r *Repository) List(_ context.Context) ([]*model.CategoryNode, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]*model.CategoryNode, 0, len(r.categories))
+	for _, n := range r.categories {
+		result = append(result, n.Clone())
+	}
+
+	return result, nil
+}
+
+func (r *Repository) Create(_ context.Context, category *model.CategoryNode) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.categories[category.Uuid]; exists || r.slugTaken(category) {
+		return model.ErrCategoryAlreadyExists
+	}
+
+	r.categories[category.Uuid] = category.Clone()
+
+	return nil
+}
+
+func (r *Repository) Update(_ context.Context, category *model.CategoryNode) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.categories[category.Uuid]; !exists {
+		return model.ErrCategoryNotFound
+	}
+	if r.slugTaken(category) {
+		return model.ErrCategoryAlreadyExists
+	}
+
+	r.categories[category.Uuid] = category.Clone()
+
+	return nil
+}
+
+func (r *Repository) Delete(_ context.Context, uuid string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.categories[uuid]; !exists {
+		return model.ErrCategoryNotFound
+	}
+
+	delete(r.categories, uuid)
+
+	return nil
+}
+
+// slugTaken сообщает, что slug занят другой категорией (как уникальный индекс slug в MongoDB)
+func (r *Repository) slugTaken(category *model.CategoryNode) bool {
+	for uuid, n := range r.categories {
+		if uuid != category.Uuid && n.Slug == category.Slug {
+			return true
+		}
+	}
+	return false
+}
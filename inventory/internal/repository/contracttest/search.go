@@ -0,0 +1,160 @@
+// Package contracttest содержит общие наборы тестов, проверяющие, что все реализации
+// repository.PartRepository ведут себя одинаково
+package contracttest
+
+import (
+	"context"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+	"github.com/bogdanovds/rocket_factory/inventory/internal/repository"
+)
+
+// SearchSuite - контрактный набор тестов полнотекстового поиска деталей.
+// NewRepository вызывается перед каждым тестом и должен возвращать пустое хранилище.
+type SearchSuite struct {
+	suite.Suite
+	NewRepository func() repository.PartRepository
+
+	ctx  context.Context
+	repo repository.PartRepository
+}
+
+const (
+	tankUuid     = "6ba7b810-9dad-11d1-80b4-00c04fd430c1"
+	shieldUuid   = "6ba7b810-9dad-11d1-80b4-00c04fd430c2"
+	fuelTankUuid = "6ba7b810-9dad-11d1-80b4-00c04fd430c3"
+	portholeUuid = "6ba7b810-9dad-11d1-80b4-00c04fd430c4"
+)
+
+// SetupTest выполняется перед каждым тестом
+func (s *SearchSuite) SetupTest() {
+	s.ctx = context.Background()
+	s.repo = s.NewRepository()
+
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	parts := []*model.Part{
+		{
+			Uuid: tankUuid, Name: "Hydrogen Tank", Description: "Cryogenic storage for liquid hydrogen",
+			Price: 100, Category: model.CategoryFuel, Tags: []string{"cryogenic"},
+		},
+		{
+			Uuid: shieldUuid, Name: "Thermal Shield", Description: "Protects the hull from hydrogen fire",
+			Price: 200, Category: model.CategoryWing, Tags: []string{"heat shield"},
+		},
+		{
+			Uuid: fuelTankUuid, Name: "Топливный бак", Description: "Бак для жидкого водорода",
+			Price: 300, Category: model.CategoryFuel, Tags: []string{"топливо"},
+		},
+		{Uuid: portholeUuid, Name: "Porthole", Price: 400, Category: model.CategoryPorthole},
+	}
+	for _, p := range parts {
+		p.LifecycleState = model.LifecycleStateActive
+		p.CreatedAt = &now
+		p.UpdatedAt = &now
+		s.Require().NoError(s.repo.Create(s.ctx, p))
+	}
+}
+
+func (s *SearchSuite) search(query string) []*model.Part {
+	return s.list(&model.PartsQuery{
+		Filter: &model.PartsFilter{Query: query},
+		Order:  model.PartsOrder{Field: model.PartsOrderFieldRelevance},
+	})
+}
+
+func (s *SearchSuite) list(query *model.PartsQuery) []*model.Part {
+	parts, err := s.repo.List(s.ctx, query)
+	s.Require().NoError(err)
+	return parts
+}
+
+// scores возвращает uuid найденных деталей с их релевантностью в порядке выдачи
+func scores(parts []*model.Part) map[string]float64 {
+	result := make(map[string]float64, len(parts))
+	for _, p := range parts {
+		result[p.Uuid] = p.SearchScore
+	}
+	return result
+}
+
+func uuids(parts []*model.Part) []string {
+	result := make([]string, len(parts))
+	for i, p := range parts {
+		result[i] = p.Uuid
+	}
+	return result
+}
+
+func (s *SearchSuite) TestFullWordMatch() {
+	parts := s.search("hydrogen")
+
+	// Название весит 10, описание 1
+	s.Equal([]string{tankUuid, shieldUuid}, uuids(parts))
+	s.Equal(map[string]float64{tankUuid: 11, shieldUuid: 1}, scores(parts))
+}
+
+func (s *SearchSuite) TestPrefixMatch() {
+	parts := s.search("hydro")
+
+	// Совпадение по префиксу даёт половину веса поля
+	s.Equal([]string{tankUuid, shieldUuid}, uuids(parts))
+	s.Equal(map[string]float64{tankUuid: 5.5, shieldUuid: 0.5}, scores(parts))
+}
+
+func (s *SearchSuite) TestStemming() {
+	parts := s.search("Shields")
+
+	// Название и тег "heat shield"
+	s.Equal([]string{shieldUuid}, uuids(parts))
+	s.Equal(map[string]float64{shieldUuid: 15}, scores(parts))
+}
+
+func (s *SearchSuite) TestSeveralWords() {
+	parts := s.search("cryogenic tank")
+
+	// tank: название; cryogenic: описание и тег
+	s.Equal(map[string]float64{tankUuid: 16}, scores(parts))
+}
+
+func (s *SearchSuite) TestRussian() {
+	s.Equal([]string{fuelTankUuid}, uuids(s.search("водород")))
+	s.Equal([]string{fuelTankUuid}, uuids(s.search("топл")))
+	s.Equal([]string{fuelTankUuid}, uuids(s.search("Баки")))
+}
+
+func (s *SearchSuite) TestNoMatch() {
+	s.Empty(s.search("wing"))
+	s.Empty(s.search("hydrogenic"))
+	// Запрос из одних стоп-слов ничего не находит
+	s.Empty(s.search("the and"))
+}
+
+func (s *SearchSuite) TestCombinedWithFilter() {
+	parts := s.list(&model.PartsQuery{Filter: &model.PartsFilter{
+		Query:      "hydrogen",
+		Categories: []model.Category{model.CategoryWing},
+	}})
+
+	s.Equal([]string{shieldUuid}, uuids(parts))
+}
+
+func (s *SearchSuite) TestCount() {
+	count, err := s.repo.Count(s.ctx, &model.PartsFilter{Query: "hydro"})
+
+	s.Require().NoError(err)
+	s.Equal(2, count)
+}
+
+func (s *SearchSuite) TestRelevanceCursor() {
+	order := model.PartsOrder{Field: model.PartsOrderFieldRelevance}
+	filter := &model.PartsFilter{Query: "hydro"}
+
+	first := s.list(&model.PartsQuery{Filter: filter, Order: order, Limit: 1})
+	s.Require().Equal([]string{tankUuid}, uuids(first))
+
+	rest := s.list(&model.PartsQuery{Filter: filter, Order: order, After: model.NewPartsCursor(first[0]), Limit: 1})
+	s.Equal([]string{shieldUuid}, uuids(rest))
+}
@@ -0,0 +1,106 @@
+package manufacturer
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/samber/lo"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+// Repository - справочник производителей в памяти
+type Repository struct {
+	mu            sync.RWMutex
+	manufacturers map[string]*model.Manufacturer
+}
+
+func NewManufacturerRepository() *Repository {
+	return &Repository{
+		manufacturers: make(map[string]*model.Manufacturer),
+	}
+}
+
+func (r *Repository) Get(_ context.Context, uuid string) (*model.Manufacturer, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	m, exists := r.manufacturers[uuid]
+	if !exists {
+		return nil, model.ErrManufacturerNotFound
+	}
+
+	return lo.ToPtr(*m), nil
+}
+
+func (r *Repository) List(_ context.Context, filter *model.ManufacturersFilter) ([]*model.Manufacturer, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var result []*model.Manufacturer
+	for _, m := range r.manufacturers {
+		if filter.Matches(m) {
+			result = append(result, lo.ToPtr(*m))
+		}
+	}
+
+	slices.SortFunc(result, func(a, b *model.Manufacturer) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return result, nil
+}
+
+func (r *Repository) Create(_ context.Context, manufacturer *model.Manufacturer) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.manufacturers[manufacturer.Uuid]; exists || r.nameTaken(manufacturer) {
+		return model.ErrManufacturerAlreadyExists
+	}
+
+	r.manufacturers[manufacturer.Uuid] = lo.ToPtr(*manufacturer)
+
+	return nil
+}
+
+func (r *Repository) Update(_ context.Context, manufacturer *model.Manufacturer) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.manufacturers[manufacturer.Uuid]; !exists {
+		return model.ErrManufacturerNotFound
+	}
+	if r.nameTaken(manufacturer) {
+		return model.ErrManufacturerAlreadyExists
+	}
+
+	r.manufacturers[manufacturer.Uuid] = lo.ToPtr(*manufacturer)
+
+	return nil
+}
+
+func (r *Repository) Delete(_ context.Context, uuid string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.manufacturers[uuid]; !exists {
+		return model.ErrManufacturerNotFound
+	}
+
+	delete(r.manufacturers, uuid)
+
+	return nil
+}
+
+// nameTaken сообщает, что название занято другим производителем (как уникальный индекс name в MongoDB)
+func (r *Repository) nameTaken(manufacturer *model.Manufacturer) bool {
+	for uuid, m := range r.manufacturers {
+		if uuid != manufacturer.Uuid && m.Name == manufacturer.Name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,55 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+// MockCategoryRepository - мок репозитория дерева категорий
+type MockCategoryRepository struct {
+	mock.Mock
+}
+
+// NewMockCategoryRepository создает новый мок репозитория категорий
+func NewMockCategoryRepository() *MockCategoryRepository {
+	return &MockCategoryRepository{}
+}
+
+// Get возвращает категорию по UUID
+func (m *MockCategoryRepository) Get(ctx context.Context, uuid string) (*model.CategoryNode, error) {
+	args := m.Called(ctx, uuid)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.CategoryNode), args.Error(1)
+}
+
+// List возвращает все категории
+func (m *MockCategoryRepository) List(ctx context.Context) ([]*model.CategoryNode, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.CategoryNode), args.Error(1)
+}
+
+// Create сохраняет новую категорию
+func (m *MockCategoryRepository) Create(ctx context.Context, category *model.CategoryNode) error {
+	args := m.Called(ctx, category)
+	return args.Error(0)
+}
+
+// Update заменяет категорию
+func (m *MockCategoryRepository) Update(ctx context.Context, category *model.CategoryNode) error {
+	args := m.Called(ctx, category)
+	return args.Error(0)
+}
+
+// Delete удаляет категорию
+func (m *MockCategoryRepository) Delete(ctx context.Context, uuid string) error {
+	args := m.Called(ctx, uuid)
+	return args.Error(0)
+}
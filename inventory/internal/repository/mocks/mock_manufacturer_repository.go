@@ -0,0 +1,55 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+// MockManufacturerRepository - мок репозитория производителей
+type MockManufacturerRepository struct {
+	mock.Mock
+}
+
+// NewMockManufacturerRepository создает новый мок репозитория производителей
+func NewMockManufacturerRepository() *MockManufacturerRepository {
+	return &MockManufacturerRepository{}
+}
+
+// Get возвращает производителя по UUID
+func (m *MockManufacturerRepository) Get(ctx context.Context, uuid string) (*model.Manufacturer, error) {
+	args := m.Called(ctx, uuid)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Manufacturer), args.Error(1)
+}
+
+// List возвращает производителей по фильтру
+func (m *MockManufacturerRepository) List(ctx context.Context, filter *model.ManufacturersFilter) ([]*model.Manufacturer, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.Manufacturer), args.Error(1)
+}
+
+// Create сохраняет нового производителя
+func (m *MockManufacturerRepository) Create(ctx context.Context, manufacturer *model.Manufacturer) error {
+	args := m.Called(ctx, manufacturer)
+	return args.Error(0)
+}
+
+// Update заменяет производителя
+func (m *MockManufacturerRepository) Update(ctx context.Context, manufacturer *model.Manufacturer) error {
+	args := m.Called(ctx, manufacturer)
+	return args.Error(0)
+}
+
+// Delete удаляет производителя
+func (m *MockManufacturerRepository) Delete(ctx context.Context, uuid string) error {
+	args := m.Called(ctx, uuid)
+	return args.Error(0)
+}
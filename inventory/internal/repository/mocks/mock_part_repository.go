@@ -2,6 +2,8 @@ package mocks
 
 import (
 	"context"
+	"iter"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 
@@ -27,8 +29,98 @@ func (m *MockPartRepository) Get(ctx context.Context, uuid string) (*model.Part,
 	return args.Get(0).(*model.Part), args.Error(1)
 }
 
-// List возвращает список всех деталей
-func (m *MockPartRepository) List(ctx context.Context) ([]*model.Part, error) {
+// List возвращает страницу деталей по запросу
+func (m *MockPartRepository) List(ctx context.Context, query *model.PartsQuery) ([]*model.Part, error) {
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.Part), args.Error(1)
+}
+
+// Count возвращает количество деталей по фильтру
+func (m *MockPartRepository) Count(ctx context.Context, filter *model.PartsFilter) (int, error) {
+	args := m.Called(ctx, filter)
+	return args.Int(0), args.Error(1)
+}
+
+// Facets возвращает количество деталей по измерениям фильтра
+func (m *MockPartRepository) Facets(ctx context.Context, filter *model.PartsFilter) (*model.PartFacets, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.PartFacets), args.Error(1)
+}
+
+// Create сохраняет новую деталь
+func (m *MockPartRepository) Create(ctx context.Context, part *model.Part) error {
+	args := m.Called(ctx, part)
+	return args.Error(0)
+}
+
+// Update обновляет деталь с проверкой конкурентного изменения
+func (m *MockPartRepository) Update(ctx context.Context, part *model.Part, prevUpdatedAt time.Time) error {
+	args := m.Called(ctx, part, prevUpdatedAt)
+	return args.Error(0)
+}
+
+// Delete удаляет деталь
+func (m *MockPartRepository) Delete(ctx context.Context, uuid string) error {
+	args := m.Called(ctx, uuid)
+	return args.Error(0)
+}
+
+// AdjustStock изменяет остаток детали
+func (m *MockPartRepository) AdjustStock(ctx context.Context, movement *model.StockMovement) error {
+	args := m.Called(ctx, movement)
+	return args.Error(0)
+}
+
+// TransferStock перемещает остаток детали между складами
+func (m *MockPartRepository) TransferStock(ctx context.Context, outgoing, incoming *model.StockMovement) error {
+	args := m.Called(ctx, outgoing, incoming)
+	return args.Error(0)
+}
+
+// ListStockMovements возвращает журнал движений остатка
+func (m *MockPartRepository) ListStockMovements(ctx context.Context, query *model.StockMovementsQuery) ([]*model.StockMovement, error) {
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.StockMovement), args.Error(1)
+}
+
+// Watch возвращает поток изменений деталей
+func (m *MockPartRepository) Watch(ctx context.Context, resumeToken string) iter.Seq2[*model.PartEvent, error] {
+	args := m.Called(ctx, resumeToken)
+	return args.Get(0).(iter.Seq2[*model.PartEvent, error])
+}
+
+// UpdateManufacturer обновляет копию производителя в деталях
+func (m *MockPartRepository) UpdateManufacturer(ctx context.Context, manufacturer *model.Manufacturer, updatedAt time.Time) (int, error) {
+	args := m.Called(ctx, manufacturer, updatedAt)
+	return args.Int(0), args.Error(1)
+}
+
+// ListDuePriceChanges возвращает детали с наступившей запланированной сменой цены
+func (m *MockPartRepository) ListDuePriceChanges(ctx context.Context, at time.Time) ([]*model.Part, error) {
+	args := m.Called(ctx, at)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.Part), args.Error(1)
+}
+
+// SetLowStockAlertedAt заменяет отметку об оповещении о низком остатке
+func (m *MockPartRepository) SetLowStockAlertedAt(ctx context.Context, uuid string, prev, next *time.Time) error {
+	args := m.Called(ctx, uuid, prev, next)
+	return args.Error(0)
+}
+
+// ListLowStockAlertChanges возвращает детали, остаток которых пересёк порог дозаказа
+func (m *MockPartRepository) ListLowStockAlertChanges(ctx context.Context) ([]*model.Part, error) {
 	args := m.Called(ctx)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -0,0 +1,43 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+// MockWarehouseRepository - мок репозитория складов
+type MockWarehouseRepository struct {
+	mock.Mock
+}
+
+// NewMockWarehouseRepository создает новый мок репозитория складов
+func NewMockWarehouseRepository() *MockWarehouseRepository {
+	return &MockWarehouseRepository{}
+}
+
+// Get возвращает склад по UUID
+func (m *MockWarehouseRepository) Get(ctx context.Context, uuid string) (*model.Warehouse, error) {
+	args := m.Called(ctx, uuid)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Warehouse), args.Error(1)
+}
+
+// List возвращает все склады
+func (m *MockWarehouseRepository) List(ctx context.Context) ([]*model.Warehouse, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.Warehouse), args.Error(1)
+}
+
+// Create сохраняет новый склад
+func (m *MockWarehouseRepository) Create(ctx context.Context, warehouse *model.Warehouse) error {
+	args := m.Called(ctx, warehouse)
+	return args.Error(0)
+}
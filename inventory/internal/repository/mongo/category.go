@@ -0,0 +1,139 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+// CategoryRepository реализует интерфейс repository.CategoryRepository для MongoDB
+type CategoryRepository struct {
+	collection *mongo.Collection
+}
+
+// NewCategoryRepository создаёт MongoDB репозиторий дерева категорий
+func NewCategoryRepository(client *mongo.Client, dbName string) *CategoryRepository {
+	return &CategoryRepository{
+		collection: client.Database(dbName).Collection(categoriesCollectionName),
+	}
+}
+
+// EnsureIndexes создаёт уникальные индексы по UUID и slug категории
+func (r *CategoryRepository) EnsureIndexes(ctx context.Context) error {
+	indexes := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "uuid", Value: 1}},
+			Options: options.Index().SetName("uuid_unique").SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "slug", Value: 1}},
+			Options: options.Index().SetName("slug_unique").SetUnique(true),
+		},
+	}
+
+	if _, err := r.collection.Indexes().CreateMany(ctx, indexes); err != nil {
+		return fmt.Errorf("failed to create category indexes: %w", err)
+	}
+
+	return nil
+}
+
+// Get получает категорию по UUID
+func (r *CategoryRepository) Get(ctx context.Context, uuid string) (*model.CategoryNode, error) {
+	var doc CategoryDocument
+	err := r.collection.FindOne(ctx, bson.M{"uuid": uuid}).Decode(&doc)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, model.ErrCategoryNotFound
+		}
+		return nil, err
+	}
+
+	return ToCategoryModel(&doc), nil
+}
+
+// List возвращает все категории
+func (r *CategoryRepository) List(ctx context.Context) ([]*model.CategoryNode, error) {
+	cursor, err := r.collection.Find(ctx, bson.M{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find categories: %w", err)
+	}
+
+	var docs []CategoryDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, fmt.Errorf("failed to decode categories: %w", err)
+	}
+
+	nodes := make([]*model.CategoryNode, len(docs))
+	for i := range docs {
+		nodes[i] = ToCategoryModel(&docs[i])
+	}
+
+	return nodes, nil
+}
+
+// Create сохраняет новую категорию
+func (r *CategoryRepository) Create(ctx context.Context, category *model.CategoryNode) error {
+	_, err := r.collection.InsertOne(ctx, ToCategoryDocument(category))
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return model.ErrCategoryAlreadyExists
+		}
+		return fmt.Errorf("failed to insert category: %w", err)
+	}
+
+	return nil
+}
+
+// Update заменяет категорию
+func (r *CategoryRepository) Update(ctx context.Context, category *model.CategoryNode) error {
+	result, err := r.collection.ReplaceOne(ctx, bson.M{"uuid": category.Uuid}, ToCategoryDocument(category))
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return model.ErrCategoryAlreadyExists
+		}
+		return fmt.Errorf("failed to update category: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return model.ErrCategoryNotFound
+	}
+
+	return nil
+}
+
+// Delete удаляет категорию
+func (r *CategoryRepository) Delete(ctx context.Context, uuid string) error {
+	result, err := r.collection.DeleteOne(ctx, bson.M{"uuid": uuid})
+	if err != nil {
+		return fmt.Errorf("failed to delete category: %w", err)
+	}
+
+	if result.DeletedCount == 0 {
+		return model.ErrCategoryNotFound
+	}
+
+	return nil
+}
+
+// BackfillCategoryUuids связывает детали, сохранённые до появления дерева категорий,
+// со встроенными категориями по значению enum
+func (r *Repository) BackfillCategoryUuids(ctx context.Context) error {
+	for _, node := range model.BuiltinCategories {
+		filter := bson.M{
+			"category":      int32(node.Legacy),
+			"category_uuid": bson.M{"$in": bson.A{nil, ""}},
+		}
+		if _, err := r.collection.UpdateMany(ctx, filter, bson.M{"$set": bson.M{"category_uuid": node.Uuid}}); err != nil {
+			return fmt.Errorf("failed to backfill category uuids: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -1,7 +1,10 @@
 package mongo
 
 import (
+	"slices"
+
 	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+	"github.com/bogdanovds/rocket_factory/inventory/internal/textsearch"
 )
 
 // ToServiceModel конвертирует документ MongoDB в модель сервисного слоя
@@ -11,16 +14,28 @@ func ToServiceModel(doc *PartDocument) *model.Part {
 	}
 
 	part := &model.Part{
-		Uuid:          doc.UUID,
-		Name:          doc.Name,
-		Description:   doc.Description,
-		Price:         doc.Price,
-		StockQuantity: doc.StockQuantity,
-		Category:      model.Category(doc.Category),
-		Tags:          doc.Tags,
-		Metadata:      doc.Metadata,
-		CreatedAt:     &doc.CreatedAt,
-		UpdatedAt:     &doc.UpdatedAt,
+		Uuid:              doc.UUID,
+		Name:              doc.Name,
+		Description:       doc.Description,
+		Price:             doc.Price,
+		StockQuantity:     doc.StockQuantity,
+		Category:          model.Category(doc.Category),
+		CategoryUuid:      doc.CategoryUUID,
+		Tags:              doc.Tags,
+		Metadata:          doc.Metadata,
+		CreatedAt:         &doc.CreatedAt,
+		SearchScore:       doc.Score,
+		NextPriceChangeAt: doc.NextPriceChangeAt,
+		ReorderThreshold:  doc.ReorderThreshold,
+		LowStockAlertedAt: doc.LowStockAlertedAt,
+
+		LifecycleState:      model.LifecycleState(doc.LifecycleState),
+		ReplacementPartUuid: doc.ReplacementPartUUID,
+	}
+
+	// Нулевая дата - документ сохранён без updated_at (см. model.Part.LastModifiedAt)
+	if !doc.UpdatedAt.IsZero() {
+		part.UpdatedAt = &doc.UpdatedAt
 	}
 
 	if doc.Dimensions != nil {
@@ -33,11 +48,24 @@ func ToServiceModel(doc *PartDocument) *model.Part {
 	}
 
 	if doc.Manufacturer != nil {
-		part.Manufacturer = &model.Manufacturer{
-			Name:    doc.Manufacturer.Name,
-			Country: doc.Manufacturer.Country,
-			Website: doc.Manufacturer.Website,
-		}
+		part.Manufacturer = ToManufacturerModel(doc.Manufacturer)
+	}
+
+	for _, c := range doc.Components {
+		part.Components = append(part.Components, model.Component{PartUuid: c.PartUUID, Quantity: c.Quantity})
+	}
+
+	for _, l := range doc.StockLevels {
+		part.StockLevels = append(part.StockLevels, model.StockLevel{WarehouseUuid: l.WarehouseUUID, Quantity: l.Quantity})
+	}
+
+	for _, v := range doc.PriceHistory {
+		part.PriceHistory = append(part.PriceHistory, model.PriceVersion{
+			Uuid:          v.UUID,
+			Price:         v.Price,
+			EffectiveFrom: v.EffectiveFrom,
+			CreatedAt:     v.CreatedAt,
+		})
 	}
 
 	return part
@@ -50,15 +78,23 @@ func ToDocument(part *model.Part) *PartDocument {
 	}
 
 	doc := &PartDocument{
-		UUID:          part.Uuid,
-		Name:          part.Name,
-		Description:   part.Description,
-		Price:         part.Price,
-		StockQuantity: part.StockQuantity,
-		Category:      int32(part.Category),
-		Tags:          part.Tags,
-		Metadata:      part.Metadata,
+		UUID:              part.Uuid,
+		Name:              part.Name,
+		Description:       part.Description,
+		Price:             part.Price,
+		StockQuantity:     part.StockQuantity,
+		Category:          int32(part.Category),
+		CategoryUUID:      part.CategoryUuid,
+		Tags:              part.Tags,
+		Metadata:          part.Metadata,
+		NextPriceChangeAt: part.NextPriceChangeAt,
+		ReorderThreshold:  part.ReorderThreshold,
+		LowStockAlertedAt: part.LowStockAlertedAt,
+
+		LifecycleState:      int32(part.LifecycleState),
+		ReplacementPartUUID: part.ReplacementPartUuid,
 	}
+	doc.SearchTerms, doc.SearchPrefixes = buildSearchTerms(part.Name, part.Description, part.Tags)
 
 	if part.CreatedAt != nil {
 		doc.CreatedAt = *part.CreatedAt
@@ -78,12 +114,176 @@ func ToDocument(part *model.Part) *PartDocument {
 	}
 
 	if part.Manufacturer != nil {
-		doc.Manufacturer = &ManufacturerDocument{
-			Name:    part.Manufacturer.Name,
-			Country: part.Manufacturer.Country,
-			Website: part.Manufacturer.Website,
-		}
+		doc.Manufacturer = ToManufacturerDocument(part.Manufacturer)
+	}
+
+	for _, c := range part.Components {
+		doc.Components = append(doc.Components, ComponentDocument{PartUUID: c.PartUuid, Quantity: c.Quantity})
+	}
+
+	for _, l := range part.StockLevels {
+		doc.StockLevels = append(doc.StockLevels, StockLevelDocument{WarehouseUUID: l.WarehouseUuid, Quantity: l.Quantity})
+	}
+
+	for _, v := range part.PriceHistory {
+		doc.PriceHistory = append(doc.PriceHistory, PriceVersionDocument{
+			UUID:          v.Uuid,
+			Price:         v.Price,
+			EffectiveFrom: v.EffectiveFrom,
+			CreatedAt:     v.CreatedAt,
+		})
 	}
 
 	return doc
 }
+
+// ToMovementDocument конвертирует движение остатка в документ MongoDB
+func ToMovementDocument(m *model.StockMovement) *StockMovementDocument {
+	return &StockMovementDocument{
+		UUID:          m.Uuid,
+		PartUUID:      m.PartUuid,
+		WarehouseUUID: m.WarehouseUuid,
+		Delta:         m.Delta,
+		QuantityAfter: m.QuantityAfter,
+		Reason:        int32(m.Reason),
+		ReferenceID:   m.ReferenceID,
+		CreatedAt:     m.CreatedAt,
+	}
+}
+
+// ToMovementModel конвертирует документ журнала движений в модель сервисного слоя
+func ToMovementModel(doc *StockMovementDocument) *model.StockMovement {
+	return &model.StockMovement{
+		Uuid:          doc.UUID,
+		PartUuid:      doc.PartUUID,
+		WarehouseUuid: doc.WarehouseUUID,
+		Delta:         doc.Delta,
+		QuantityAfter: doc.QuantityAfter,
+		Reason:        model.StockMovementReason(doc.Reason),
+		ReferenceID:   doc.ReferenceID,
+		CreatedAt:     doc.CreatedAt,
+	}
+}
+
+// ToManufacturerModel конвертирует документ производителя в модель сервисного слоя
+func ToManufacturerModel(doc *ManufacturerDocument) *model.Manufacturer {
+	return &model.Manufacturer{
+		Uuid:    doc.UUID,
+		Name:    doc.Name,
+		Country: doc.Country,
+		Website: doc.Website,
+	}
+}
+
+// ToManufacturerDocument конвертирует производителя в документ MongoDB
+func ToManufacturerDocument(m *model.Manufacturer) *ManufacturerDocument {
+	return &ManufacturerDocument{
+		UUID:    m.Uuid,
+		Name:    m.Name,
+		Country: m.Country,
+		Website: m.Website,
+	}
+}
+
+// ToCategoryModel конвертирует документ категории в модель сервисного слоя
+func ToCategoryModel(doc *CategoryDocument) *model.CategoryNode {
+	return &model.CategoryNode{
+		Uuid:        doc.UUID,
+		Slug:        doc.Slug,
+		DisplayName: doc.DisplayName,
+		ParentUuid:  doc.ParentUUID,
+		Legacy:      model.Category(doc.Legacy),
+
+		MetadataSchema: toMetadataSchemaModel(doc.MetadataSchema),
+	}
+}
+
+func toMetadataSchemaModel(docs []MetadataFieldDocument) []model.MetadataField {
+	if len(docs) == 0 {
+		return nil
+	}
+
+	fields := make([]model.MetadataField, len(docs))
+	for i, d := range docs {
+		fields[i] = model.MetadataField{
+			Key:         d.Key,
+			Type:        model.MetadataType(d.Type),
+			Unit:        d.Unit,
+			Description: d.Description,
+			Required:    d.Required,
+		}
+		if d.Min != nil || d.Max != nil {
+			fields[i].Range = &model.FloatRange{Min: d.Min, Max: d.Max}
+		}
+	}
+	return fields
+}
+
+// ToCategoryDocument конвертирует категорию в документ MongoDB
+func ToCategoryDocument(n *model.CategoryNode) *CategoryDocument {
+	return &CategoryDocument{
+		UUID:        n.Uuid,
+		Slug:        n.Slug,
+		DisplayName: n.DisplayName,
+		ParentUUID:  n.ParentUuid,
+		Legacy:      int32(n.Legacy),
+
+		MetadataSchema: toMetadataSchemaDocuments(n.MetadataSchema),
+	}
+}
+
+func toMetadataSchemaDocuments(fields []model.MetadataField) []MetadataFieldDocument {
+	docs := make([]MetadataFieldDocument, len(fields))
+	for i, f := range fields {
+		docs[i] = MetadataFieldDocument{
+			Key:         f.Key,
+			Type:        int32(f.Type),
+			Unit:        f.Unit,
+			Description: f.Description,
+			Required:    f.Required,
+		}
+		if f.Range != nil {
+			docs[i].Min = f.Range.Min
+			docs[i].Max = f.Range.Max
+		}
+	}
+	return docs
+}
+
+// ToWarehouseModel конвертирует документ склада в модель сервисного слоя
+func ToWarehouseModel(doc *WarehouseDocument) *model.Warehouse {
+	return &model.Warehouse{
+		Uuid:     doc.UUID,
+		Code:     doc.Code,
+		Name:     doc.Name,
+		Location: doc.Location,
+	}
+}
+
+// ToWarehouseDocument конвертирует склад в документ MongoDB
+func ToWarehouseDocument(w *model.Warehouse) *WarehouseDocument {
+	return &WarehouseDocument{
+		UUID:     w.Uuid,
+		Code:     w.Code,
+		Name:     w.Name,
+		Location: w.Location,
+	}
+}
+
+// buildSearchTerms разбивает текстовые поля детали на основы слов и собирает их префиксы
+func buildSearchTerms(name, description string, tags []string) (*SearchTermsDocument, []string) {
+	terms := &SearchTermsDocument{
+		Name:        textsearch.Terms(name),
+		Description: textsearch.Terms(description),
+	}
+	for _, tag := range tags {
+		terms.Tags = append(terms.Tags, textsearch.Terms(tag)...)
+	}
+
+	prefixes := textsearch.Prefixes(slices.Concat(terms.Name, terms.Tags, terms.Description))
+	if prefixes == nil {
+		prefixes = []string{}
+	}
+
+	return terms, prefixes
+}
@@ -0,0 +1,82 @@
+package mongo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+type ConverterTestSuite struct {
+	suite.Suite
+}
+
+// roundTrip сохраняет деталь в BSON и читает обратно, как Create и Get
+func (s *ConverterTestSuite) roundTrip(part *model.Part) *model.Part {
+	data, err := bson.Marshal(ToDocument(part))
+	s.Require().NoError(err)
+
+	var doc PartDocument
+	s.Require().NoError(bson.Unmarshal(data, &doc))
+	return ToServiceModel(&doc)
+}
+
+func (s *ConverterTestSuite) TestRoundTrip_LifecycleFields() {
+	updatedAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	part := &model.Part{
+		Uuid:                "6ba7b810-9dad-11d1-80b4-00c04fd430d0",
+		Name:                "Main Engine",
+		Price:               1500,
+		Category:            model.CategoryEngine,
+		CreatedAt:           lo.ToPtr(updatedAt),
+		UpdatedAt:           lo.ToPtr(updatedAt),
+		LifecycleState:      model.LifecycleStateDiscontinued,
+		ReplacementPartUuid: "6ba7b810-9dad-11d1-80b4-00c04fd430d1",
+	}
+
+	got := s.roundTrip(part)
+
+	s.Equal(model.LifecycleStateDiscontinued, got.LifecycleState)
+	s.Equal(part.ReplacementPartUuid, got.ReplacementPartUuid)
+	s.Equal(part.Name, got.Name)
+}
+
+func (s *ConverterTestSuite) TestRoundTrip_WithoutReplacement() {
+	got := s.roundTrip(&model.Part{Uuid: "uuid-1", LifecycleState: model.LifecycleStateDraft})
+
+	s.Equal(model.LifecycleStateDraft, got.LifecycleState)
+	s.Empty(got.ReplacementPartUuid)
+}
+
+func (s *ConverterTestSuite) TestRoundTrip_WithoutUpdatedAt() {
+	createdAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	got := s.roundTrip(&model.Part{Uuid: "uuid-1", CreatedAt: lo.ToPtr(createdAt)})
+
+	s.Nil(got.UpdatedAt)
+	s.Equal(createdAt, got.LastModifiedAt())
+}
+
+func (s *ConverterTestSuite) TestToDocument_SearchTerms() {
+	doc := ToDocument(&model.Part{Name: "Thermal Shields", Description: "for the hull", Tags: []string{"heat shield"}})
+
+	s.Equal(&SearchTermsDocument{
+		Name:        []string{"thermal", "shield"},
+		Tags:        []string{"heat", "shield"},
+		Description: []string{"hull"},
+	}, doc.SearchTerms)
+	s.Contains(doc.SearchPrefixes, "therm")
+	s.Contains(doc.SearchPrefixes, "hull")
+	s.NotContains(doc.SearchPrefixes, "for")
+
+	// Деталь без текста всё равно получает поле, чтобы BackfillSearchTerms её не обрабатывал
+	s.NotNil(ToDocument(&model.Part{}).SearchPrefixes)
+}
+
+func TestConverterTestSuite(t *testing.T) {
+	suite.Run(t, new(ConverterTestSuite))
+}
@@ -0,0 +1,23 @@
+package mongo
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+// Create сохраняет новую деталь в MongoDB
+func (r *Repository) Create(ctx context.Context, part *model.Part) error {
+	_, err := r.collection.InsertOne(ctx, ToDocument(part))
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return model.ErrPartAlreadyExists
+		}
+		return fmt.Errorf("failed to insert part: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,24 @@
+package mongo
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+// Delete удаляет деталь из MongoDB
+func (r *Repository) Delete(ctx context.Context, uuid string) error {
+	result, err := r.collection.DeleteOne(ctx, bson.M{"uuid": uuid})
+	if err != nil {
+		return fmt.Errorf("failed to delete part: %w", err)
+	}
+
+	if result.DeletedCount == 0 {
+		return model.ErrPartNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,131 @@
+package mongo
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+type facetsDocument struct {
+	Categories []struct {
+		ID struct {
+			CategoryUUID string `bson:"category_uuid"`
+			Category     int32  `bson:"category"`
+		} `bson:"_id"`
+		Count int `bson:"count"`
+	} `bson:"categories"`
+	ManufacturerCountries []facetCountDocument `bson:"manufacturer_countries"`
+	Tags                  []facetCountDocument `bson:"tags"`
+}
+
+type facetCountDocument struct {
+	Value string `bson:"_id"`
+	Count int    `bson:"count"`
+}
+
+// Facets считает детали по измерениям одной агрегацией: общие условия фильтра (включая
+// полнотекстовый запрос) отбирают детали в первом $match, а в каждой ветке $facet
+// добавляются условия остальных измерений, кроме собственного
+func (r *Repository) Facets(ctx context.Context, filter *model.PartsFilter) (*model.PartFacets, error) {
+	common := filter.WithoutCategories().WithoutManufacturerCountries().WithoutTags()
+
+	var categories, countries, tags bson.M
+	if filter != nil {
+		categories = buildFilterQuery(&model.PartsFilter{Categories: filter.Categories, CategoryUuids: filter.CategoryUuids})
+		countries = buildFilterQuery(&model.PartsFilter{ManufacturerCountries: filter.ManufacturerCountries})
+		tags = buildFilterQuery(&model.PartsFilter{Tags: filter.Tags})
+	}
+	match := func(queries ...bson.M) bson.D {
+		and := bson.A{}
+		for _, q := range queries {
+			if len(q) > 0 {
+				and = append(and, q)
+			}
+		}
+		if len(and) == 0 {
+			return bson.D{{Key: "$match", Value: bson.M{}}}
+		}
+		return bson.D{{Key: "$match", Value: bson.M{"$and": and}}}
+	}
+	count := bson.M{"$sum": 1}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: buildFilterQuery(common)}},
+		{{Key: "$facet", Value: bson.M{
+			"categories": bson.A{
+				match(countries, tags),
+				bson.M{"$group": bson.M{
+					"_id":   bson.M{"category_uuid": "$category_uuid", "category": "$category"},
+					"count": count,
+				}},
+			},
+			"manufacturer_countries": bson.A{
+				match(categories, tags),
+				bson.M{"$match": bson.M{"manufacturer.country": bson.M{"$nin": bson.A{nil, ""}}}},
+				bson.M{"$group": bson.M{"_id": "$manufacturer.country", "count": count}},
+			},
+			"tags": bson.A{
+				match(categories, countries),
+				bson.M{"$unwind": "$tags"},
+				// Повторы тега в одной детали учитываются один раз
+				bson.M{"$group": bson.M{"_id": bson.M{"part": "$_id", "tag": "$tags"}}},
+				bson.M{"$group": bson.M{"_id": "$_id.tag", "count": count}},
+			},
+		}}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate part facets: %w", err)
+	}
+	defer func() {
+		if cerr := cursor.Close(ctx); cerr != nil {
+			log.Printf("failed to close cursor: %v", cerr)
+		}
+	}()
+
+	var docs []facetsDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, fmt.Errorf("failed to decode part facets: %w", err)
+	}
+
+	facets := &model.PartFacets{
+		Categories:            []model.CategoryFacetCount{},
+		ManufacturerCountries: []model.FacetCount{},
+		Tags:                  []model.FacetCount{},
+	}
+	if len(docs) == 0 {
+		return facets, nil
+	}
+
+	for _, c := range docs[0].Categories {
+		facets.Categories = append(facets.Categories, model.CategoryFacetCount{
+			CategoryUuid: c.ID.CategoryUUID,
+			Category:     model.Category(c.ID.Category),
+			Count:        c.Count,
+		})
+	}
+	facets.ManufacturerCountries = toFacetCounts(docs[0].ManufacturerCountries)
+	facets.Tags = toFacetCounts(docs[0].Tags)
+
+	// Порядок задаётся так же, как в репозитории в памяти, а не $sort: сравнение строк в Go и MongoDB
+	// совпадает, но сравнение составного _id с отсутствующим category_uuid - нет
+	model.SortCategoryFacetCounts(facets.Categories)
+	model.SortFacetCounts(facets.ManufacturerCountries)
+	model.SortFacetCounts(facets.Tags)
+
+	return facets, nil
+}
+
+func toFacetCounts(docs []facetCountDocument) []model.FacetCount {
+	counts := make([]model.FacetCount, len(docs))
+	for i, d := range docs {
+		counts[i] = model.FacetCount{Value: d.Value, Count: d.Count}
+	}
+	return counts
+}
@@ -0,0 +1,133 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// legacyTextIndex - текстовый индекс, через который раньше работал полнотекстовый поиск
+const legacyTextIndex = "text_search"
+
+// Коды ошибок сервера MongoDB, означающие, что удалять нечего
+const (
+	errCodeNamespaceNotFound = 26
+	errCodeIndexNotFound     = 27
+)
+
+// EnsureIndexes создаёт индексы коллекций деталей и журнала движений, если их ещё нет
+func (r *Repository) EnsureIndexes(ctx context.Context) error {
+	indexes := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "uuid", Value: 1}},
+			Options: options.Index().SetName("uuid_unique").SetUnique(true),
+		},
+		// Индексы под фильтры и сортировки ListParts
+		{
+			Keys:    bson.D{{Key: "name", Value: 1}, {Key: "uuid", Value: 1}},
+			Options: options.Index().SetName("name_uuid"),
+		},
+		{
+			Keys:    bson.D{{Key: "price", Value: 1}, {Key: "uuid", Value: 1}},
+			Options: options.Index().SetName("price_uuid"),
+		},
+		{
+			Keys:    bson.D{{Key: "created_at", Value: 1}, {Key: "uuid", Value: 1}},
+			Options: options.Index().SetName("created_at_uuid"),
+		},
+		{
+			Keys:    bson.D{{Key: "stock_quantity", Value: 1}, {Key: "uuid", Value: 1}},
+			Options: options.Index().SetName("stock_quantity_uuid"),
+		},
+		{
+			Keys:    bson.D{{Key: "category", Value: 1}, {Key: "uuid", Value: 1}},
+			Options: options.Index().SetName("category_uuid"),
+		},
+		{
+			Keys:    bson.D{{Key: "manufacturer.country", Value: 1}, {Key: "uuid", Value: 1}},
+			Options: options.Index().SetName("manufacturer_country_uuid"),
+		},
+		{
+			Keys:    bson.D{{Key: "category_uuid", Value: 1}, {Key: "uuid", Value: 1}},
+			Options: options.Index().SetName("category_uuid_uuid"),
+		},
+		{
+			Keys:    bson.D{{Key: "manufacturer.uuid", Value: 1}},
+			Options: options.Index().SetName("manufacturer_uuid"),
+		},
+		{
+			Keys:    bson.D{{Key: "tags", Value: 1}},
+			Options: options.Index().SetName("tags"),
+		},
+		// Детали с заданным порогом дозаказа: фильтр low_stock и проверка низкого остатка
+		{
+			Keys:    bson.D{{Key: "reorder_threshold", Value: 1}},
+			Options: options.Index().SetName("reorder_threshold").SetSparse(true),
+		},
+		// Поиск деталей с наступившей запланированной сменой цены
+		{
+			Keys:    bson.D{{Key: "next_price_change_at", Value: 1}},
+			Options: options.Index().SetName("next_price_change_at").SetSparse(true),
+		},
+		// Поиск сборок, в которые входит деталь (проверка при удалении, фильтр component_uuids)
+		{
+			Keys:    bson.D{{Key: "components.part_uuid", Value: 1}},
+			Options: options.Index().SetName("components_part_uuid"),
+		},
+		// Полнотекстовый поиск по префиксам основ слов
+		{
+			Keys:    bson.D{{Key: "search_prefixes", Value: 1}},
+			Options: options.Index().SetName("search_prefixes"),
+		},
+	}
+
+	// Текстовый индекс прежних версий больше не используется: $text находит только основы слов
+	// целиком, стеммит своими правилами и оценивает textScore иначе, чем in-memory репозиторий,
+	// а объединить $text с поиском по префиксам в одном запросе MongoDB не позволяет. Основы
+	// считает textsearch, поиск идёт по индексу search_prefixes, оценка - только у найденных деталей.
+	if err := r.dropIndex(ctx, legacyTextIndex); err != nil {
+		return err
+	}
+
+	if _, err := r.collection.Indexes().CreateMany(ctx, indexes); err != nil {
+		return fmt.Errorf("failed to create part indexes: %w", err)
+	}
+
+	movementIndexes := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "part_uuid", Value: 1}, {Key: "uuid", Value: -1}},
+			Options: options.Index().SetName("part_uuid_uuid").SetUnique(true),
+		},
+		// Движения отгрузки по ссылке: FulfillStock проверяет, не списан ли уже остаток
+		{
+			Keys:    bson.D{{Key: "part_uuid", Value: 1}, {Key: "reference_id", Value: 1}},
+			Options: options.Index().SetName("part_uuid_reference_id"),
+		},
+	}
+
+	if _, err := r.movements.Indexes().CreateMany(ctx, movementIndexes); err != nil {
+		return fmt.Errorf("failed to create stock movement indexes: %w", err)
+	}
+
+	return nil
+}
+
+// dropIndex удаляет индекс коллекции деталей, если он есть
+func (r *Repository) dropIndex(ctx context.Context, name string) error {
+	_, err := r.collection.Indexes().DropOne(ctx, name)
+	if err == nil {
+		return nil
+	}
+
+	var serverErr mongo.ServerError
+	if errors.As(err, &serverErr) &&
+		(serverErr.HasErrorCode(errCodeIndexNotFound) || serverErr.HasErrorCode(errCodeNamespaceNotFound)) {
+		return nil
+	}
+
+	return fmt.Errorf("failed to drop index %s: %w", name, err)
+}
@@ -0,0 +1,21 @@
+package mongo
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+// BackfillLifecycleStates переводит в ACTIVE детали, сохранённые до появления жизненного цикла
+func (r *Repository) BackfillLifecycleStates(ctx context.Context) error {
+	filter := bson.M{"lifecycle_state": bson.M{"$exists": false}}
+	update := bson.M{"$set": bson.M{"lifecycle_state": int32(model.LifecycleStateActive)}}
+	if _, err := r.collection.UpdateMany(ctx, filter, update); err != nil {
+		return fmt.Errorf("failed to backfill lifecycle states: %w", err)
+	}
+
+	return nil
+}
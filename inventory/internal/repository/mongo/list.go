@@ -4,15 +4,19 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"unicode/utf8"
 
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 
 	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+	"github.com/bogdanovds/rocket_factory/inventory/internal/textsearch"
 )
 
-// List возвращает все детали из MongoDB
-func (r *Repository) List(ctx context.Context) ([]*model.Part, error) {
-	cursor, err := r.collection.Find(ctx, bson.M{})
+// List возвращает страницу деталей из MongoDB по запросу
+func (r *Repository) List(ctx context.Context, query *model.PartsQuery) ([]*model.Part, error) {
+	cursor, err := r.find(ctx, query)
 	if err != nil {
 		return nil, fmt.Errorf("failed to find parts: %w", err)
 	}
@@ -34,3 +38,294 @@ func (r *Repository) List(ctx context.Context) ([]*model.Part, error) {
 
 	return parts, nil
 }
+
+// find выполняет запрос: обычный Find или, при полнотекстовом поиске, агрегацию,
+// в которой релевантность вычисляется для сортировки и курсора
+func (r *Repository) find(ctx context.Context, query *model.PartsQuery) (*mongo.Cursor, error) {
+	filter := buildFilterQuery(query.Filter)
+
+	if !query.Filter.HasQuery() {
+		if query.After != nil {
+			filter = bson.M{"$and": bson.A{filter, buildAfterQuery(query.Order, query.After)}}
+		}
+
+		opts := options.Find().SetSort(buildSort(query.Order))
+		if query.Limit > 0 {
+			opts.SetLimit(int64(query.Limit))
+		}
+
+		return r.collection.Find(ctx, filter, opts)
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: filter}},
+		{{Key: "$addFields", Value: bson.M{"score": buildScoreExpr(textsearch.Terms(query.Filter.Query))}}},
+	}
+	if query.After != nil {
+		pipeline = append(pipeline, bson.D{{Key: "$match", Value: buildAfterQuery(query.Order, query.After)}})
+	}
+	pipeline = append(pipeline, bson.D{{Key: "$sort", Value: buildSort(query.Order)}})
+	if query.Limit > 0 {
+		pipeline = append(pipeline, bson.D{{Key: "$limit", Value: query.Limit}})
+	}
+
+	return r.collection.Aggregate(ctx, pipeline)
+}
+
+// Count возвращает количество деталей, удовлетворяющих фильтру
+func (r *Repository) Count(ctx context.Context, filter *model.PartsFilter) (int, error) {
+	count, err := r.collection.CountDocuments(ctx, buildFilterQuery(filter))
+	if err != nil {
+		return 0, fmt.Errorf("failed to count parts: %w", err)
+	}
+
+	return int(count), nil
+}
+
+// buildFilterQuery строит запрос MongoDB по фильтру: условия объединяются по AND, значения списочного поля - через $in
+func buildFilterQuery(filter *model.PartsFilter) bson.M {
+	query := bson.M{}
+	if filter.IsEmpty() {
+		return query
+	}
+
+	if len(filter.Uuids) > 0 {
+		query["uuid"] = bson.M{"$in": filter.Uuids}
+	}
+
+	if len(filter.Names) > 0 {
+		query["name"] = bson.M{"$in": filter.Names}
+	}
+
+	if len(filter.Categories) > 0 {
+		categories := make([]int32, len(filter.Categories))
+		for i, c := range filter.Categories {
+			categories[i] = int32(c)
+		}
+		query["category"] = bson.M{"$in": categories}
+	}
+
+	if len(filter.ManufacturerCountries) > 0 {
+		query["manufacturer.country"] = bson.M{"$in": filter.ManufacturerCountries}
+	}
+
+	if len(filter.Tags) > 0 {
+		query["tags"] = bson.M{"$in": filter.Tags}
+	}
+
+	if len(filter.CategoryUuids) > 0 {
+		query["category_uuid"] = bson.M{"$in": filter.CategoryUuids}
+	}
+
+	if len(filter.ManufacturerUuids) > 0 {
+		query["manufacturer.uuid"] = bson.M{"$in": filter.ManufacturerUuids}
+	}
+
+	if len(filter.ComponentUuids) > 0 {
+		query["components.part_uuid"] = bson.M{"$in": filter.ComponentUuids}
+	}
+
+	addFloatRange(query, "price", filter.Price)
+	addFloatRange(query, "dimensions.length", filter.Length)
+	addFloatRange(query, "dimensions.width", filter.Width)
+	addFloatRange(query, "dimensions.height", filter.Height)
+	addFloatRange(query, "dimensions.weight", filter.Weight)
+	addTimeRange(query, "created_at", filter.CreatedAt)
+	addTimeRange(query, "updated_at", filter.UpdatedAt)
+
+	if filter.InStockOnly {
+		query["stock_quantity"] = bson.M{"$gt": 0}
+	}
+
+	if len(filter.LifecycleStates) > 0 {
+		states := make([]int32, len(filter.LifecycleStates))
+		for i, s := range filter.LifecycleStates {
+			states[i] = int32(s)
+		}
+		query["lifecycle_state"] = bson.M{"$in": states}
+	}
+
+	if filter.LowStock {
+		// Условие на reorder_threshold отдельно от $expr, чтобы запрос мог использовать индекс
+		query["reorder_threshold"] = bson.M{"$gt": 0}
+		query["$expr"] = lowStockExpr
+	}
+
+	// Несколько условий могут относиться к одному ключу, поэтому объединяем их через $and
+	if len(filter.Metadata) > 0 {
+		predicates := make(bson.A, len(filter.Metadata))
+		for i, p := range filter.Metadata {
+			predicates[i] = buildMetadataQuery(p)
+		}
+		query["$and"] = predicates
+	}
+
+	// Слово запроса совпадает с деталью, если оно - префикс одной из основ её слов (textsearch.Score > 0).
+	// Условие выполняется по multikey-индексу search_prefixes и читает только подходящие детали.
+	// Запрос из одних стоп-слов не находит ничего, как и в in-memory репозитории.
+	if filter.HasQuery() {
+		terms := textsearch.Terms(filter.Query)
+		if terms == nil {
+			terms = []string{}
+		}
+		query["search_prefixes"] = bson.M{"$in": terms}
+	}
+
+	return query
+}
+
+// buildScoreExpr строит выражение релевантности документа, повторяющее textsearch.Score
+// по сохранённым основам слов search_terms
+func buildScoreExpr(queryTerms []string) bson.M {
+	fields := []struct {
+		path   string
+		weight float64
+	}{
+		{"$search_terms.name", textsearch.WeightName},
+		{"$search_terms.tags", textsearch.WeightTags},
+		{"$search_terms.description", textsearch.WeightDescription},
+	}
+
+	sum := bson.A{}
+	for _, f := range fields {
+		// Для каждой основы документа складываются веса совпавших с ней слов запроса
+		matches := bson.A{"$$value"}
+		for _, q := range queryTerms {
+			matches = append(matches, bson.M{"$cond": bson.A{
+				bson.M{"$eq": bson.A{"$$this", q}},
+				f.weight,
+				bson.M{"$cond": bson.A{
+					bson.M{"$eq": bson.A{bson.M{"$substrCP": bson.A{"$$this", 0, utf8.RuneCountInString(q)}}, q}},
+					f.weight * textsearch.PrefixMatchFactor,
+					0,
+				}},
+			}})
+		}
+
+		sum = append(sum, bson.M{"$reduce": bson.M{
+			"input":        bson.M{"$ifNull": bson.A{f.path, bson.A{}}},
+			"initialValue": 0.0,
+			"in":           bson.M{"$add": matches},
+		}})
+	}
+
+	return bson.M{"$add": sum}
+}
+
+func addFloatRange(query bson.M, field string, r *model.FloatRange) {
+	if r.IsEmpty() {
+		return
+	}
+
+	cond := bson.M{}
+	if r.Min != nil {
+		cond["$gte"] = *r.Min
+	}
+	if r.Max != nil {
+		cond["$lte"] = *r.Max
+	}
+	query[field] = cond
+}
+
+func addTimeRange(query bson.M, field string, r *model.TimeRange) {
+	if r.IsEmpty() {
+		return
+	}
+
+	cond := bson.M{}
+	if r.From != nil {
+		cond["$gte"] = *r.From
+	}
+	if r.To != nil {
+		cond["$lt"] = *r.To
+	}
+	query[field] = cond
+}
+
+// buildMetadataQuery строит условие на значение метаданных. Ключ проверен сервисом
+// и не содержит точек и "$", поэтому безопасен как часть пути.
+func buildMetadataQuery(p model.MetadataPredicate) bson.M {
+	field := "metadata." + p.Key
+
+	switch p.Operator {
+	case model.MetadataOperatorExists:
+		return bson.M{field: bson.M{"$exists": true}}
+	case model.MetadataOperatorEQ:
+		return bson.M{field: bson.M{"$eq": p.Value}}
+	case model.MetadataOperatorNE:
+		return bson.M{field: bson.M{"$ne": p.Value}}
+	case model.MetadataOperatorGT:
+		return bson.M{field: bson.M{"$gt": p.Value}}
+	case model.MetadataOperatorGTE:
+		return bson.M{field: bson.M{"$gte": p.Value}}
+	case model.MetadataOperatorLT:
+		return bson.M{field: bson.M{"$lt": p.Value}}
+	case model.MetadataOperatorLTE:
+		return bson.M{field: bson.M{"$lte": p.Value}}
+	default:
+		// Неизвестный оператор отсекается сервисом; на всякий случай ничего не выбираем
+		return bson.M{"_id": bson.M{"$exists": false}}
+	}
+}
+
+// sortKey возвращает поле документа, его естественное направление и значение курсора для поля сортировки
+func sortKey(field model.PartsOrderField, cursor *model.PartsCursor) (key string, direction int, value any) {
+	switch field {
+	case model.PartsOrderFieldPrice:
+		return "price", 1, cursor.Price
+	case model.PartsOrderFieldName:
+		return "name", 1, cursor.Name
+	case model.PartsOrderFieldCreatedAt:
+		return "created_at", 1, cursor.CreatedAt
+	case model.PartsOrderFieldStockQuantity:
+		return "stock_quantity", 1, cursor.StockQuantity
+	case model.PartsOrderFieldRelevance:
+		// Самые релевантные первыми
+		return "score", -1, cursor.Score
+	default:
+		return "", 1, nil
+	}
+}
+
+func buildSort(order model.PartsOrder) bson.D {
+	flip := 1
+	if order.Descending {
+		flip = -1
+	}
+
+	sort := bson.D{}
+	if key, direction, _ := sortKey(order.Field, &model.PartsCursor{}); key != "" {
+		sort = append(sort, bson.E{Key: key, Value: direction * flip})
+	}
+
+	return append(sort, bson.E{Key: "uuid", Value: flip})
+}
+
+// buildAfterQuery строит условие "строго после курсора" для пары (ключ сортировки, uuid)
+func buildAfterQuery(order model.PartsOrder, cursor *model.PartsCursor) bson.M {
+	flip := 1
+	if order.Descending {
+		flip = -1
+	}
+
+	uuidCond := bson.M{"uuid": bson.M{comparisonOp(flip): cursor.Uuid}}
+
+	key, direction, value := sortKey(order.Field, cursor)
+	if key == "" {
+		return uuidCond
+	}
+
+	return bson.M{"$or": bson.A{
+		bson.M{key: bson.M{comparisonOp(direction * flip): value}},
+		bson.M{key: value, "uuid": bson.M{comparisonOp(flip): cursor.Uuid}},
+	}}
+}
+
+// comparisonOp возвращает оператор "следующий в порядке сортировки" для направления
+func comparisonOp(direction int) string {
+	if direction < 0 {
+		return "$lt"
+	}
+
+	return "$gt"
+}
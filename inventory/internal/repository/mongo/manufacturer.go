@@ -0,0 +1,150 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+// ManufacturerRepository реализует интерфейс repository.ManufacturerRepository для MongoDB
+type ManufacturerRepository struct {
+	collection *mongo.Collection
+}
+
+// NewManufacturerRepository создаёт MongoDB репозиторий справочника производителей
+func NewManufacturerRepository(client *mongo.Client, dbName string) *ManufacturerRepository {
+	return &ManufacturerRepository{
+		collection: client.Database(dbName).Collection(manufacturersCollectionName),
+	}
+}
+
+// EnsureIndexes создаёт уникальные индексы по UUID и названию производителя
+func (r *ManufacturerRepository) EnsureIndexes(ctx context.Context) error {
+	indexes := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "uuid", Value: 1}},
+			Options: options.Index().SetName("uuid_unique").SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "name", Value: 1}},
+			Options: options.Index().SetName("name_unique").SetUnique(true),
+		},
+	}
+
+	if _, err := r.collection.Indexes().CreateMany(ctx, indexes); err != nil {
+		return fmt.Errorf("failed to create manufacturer indexes: %w", err)
+	}
+
+	return nil
+}
+
+// Get получает производителя по UUID
+func (r *ManufacturerRepository) Get(ctx context.Context, uuid string) (*model.Manufacturer, error) {
+	var doc ManufacturerDocument
+	err := r.collection.FindOne(ctx, bson.M{"uuid": uuid}).Decode(&doc)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, model.ErrManufacturerNotFound
+		}
+		return nil, err
+	}
+
+	return ToManufacturerModel(&doc), nil
+}
+
+// List возвращает производителей, упорядоченных по названию
+func (r *ManufacturerRepository) List(ctx context.Context, filter *model.ManufacturersFilter) ([]*model.Manufacturer, error) {
+	query := bson.M{}
+	if filter != nil && len(filter.Countries) > 0 {
+		query["country"] = bson.M{"$in": filter.Countries}
+	}
+
+	cursor, err := r.collection.Find(ctx, query, options.Find().SetSort(bson.D{{Key: "name", Value: 1}}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find manufacturers: %w", err)
+	}
+
+	var docs []ManufacturerDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, fmt.Errorf("failed to decode manufacturers: %w", err)
+	}
+
+	manufacturers := make([]*model.Manufacturer, len(docs))
+	for i := range docs {
+		manufacturers[i] = ToManufacturerModel(&docs[i])
+	}
+
+	return manufacturers, nil
+}
+
+// Create сохраняет нового производителя
+func (r *ManufacturerRepository) Create(ctx context.Context, manufacturer *model.Manufacturer) error {
+	_, err := r.collection.InsertOne(ctx, ToManufacturerDocument(manufacturer))
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return model.ErrManufacturerAlreadyExists
+		}
+		return fmt.Errorf("failed to insert manufacturer: %w", err)
+	}
+
+	return nil
+}
+
+// Update заменяет производителя
+func (r *ManufacturerRepository) Update(ctx context.Context, manufacturer *model.Manufacturer) error {
+	result, err := r.collection.ReplaceOne(ctx, bson.M{"uuid": manufacturer.Uuid}, ToManufacturerDocument(manufacturer))
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return model.ErrManufacturerAlreadyExists
+		}
+		return fmt.Errorf("failed to update manufacturer: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return model.ErrManufacturerNotFound
+	}
+
+	return nil
+}
+
+// Delete удаляет производителя
+func (r *ManufacturerRepository) Delete(ctx context.Context, uuid string) error {
+	result, err := r.collection.DeleteOne(ctx, bson.M{"uuid": uuid})
+	if err != nil {
+		return fmt.Errorf("failed to delete manufacturer: %w", err)
+	}
+
+	if result.DeletedCount == 0 {
+		return model.ErrManufacturerNotFound
+	}
+
+	return nil
+}
+
+// UpdateManufacturer обновляет копию производителя в деталях одним запросом. updated_at
+// продвигается хотя бы на миллисекунду, чтобы оптимистичная блокировка Update заметила изменение.
+func (r *Repository) UpdateManufacturer(ctx context.Context, manufacturer *model.Manufacturer, updatedAt time.Time) (int, error) {
+	update := mongo.Pipeline{
+		{{Key: "$set", Value: bson.M{
+			"manufacturer": ToManufacturerDocument(manufacturer),
+			"updated_at": bson.M{"$max": bson.A{
+				updatedAt,
+				bson.M{"$add": bson.A{"$updated_at", 1}},
+			}},
+		}}},
+	}
+
+	result, err := r.collection.UpdateMany(ctx, bson.M{"manufacturer.uuid": manufacturer.Uuid}, update)
+	if err != nil {
+		return 0, fmt.Errorf("failed to update parts manufacturer: %w", err)
+	}
+
+	return int(result.ModifiedCount), nil
+}
@@ -15,12 +15,62 @@ type PartDocument struct {
 	Price         float64                `bson:"price"`
 	StockQuantity int64                  `bson:"stock_quantity"`
 	Category      int32                  `bson:"category"`
+	CategoryUUID  string                 `bson:"category_uuid"`
 	Dimensions    *DimensionsDocument    `bson:"dimensions,omitempty"`
 	Manufacturer  *ManufacturerDocument  `bson:"manufacturer,omitempty"`
 	Tags          []string               `bson:"tags"`
 	Metadata      map[string]interface{} `bson:"metadata,omitempty"`
 	CreatedAt     time.Time              `bson:"created_at"`
 	UpdatedAt     time.Time              `bson:"updated_at"`
+	// SearchTerms - основы слов текстовых полей для оценки релевантности, как в textsearch.Score
+	SearchTerms *SearchTermsDocument `bson:"search_terms,omitempty"`
+	// SearchPrefixes - префиксы основ из SearchTerms, по ним запрос находит детали
+	SearchPrefixes []string `bson:"search_prefixes"`
+	// Score - релевантность текстовому запросу, вычисляется при поиске и не хранится
+	Score float64 `bson:"score,omitempty"`
+	// Components - состав сборки
+	Components []ComponentDocument `bson:"components,omitempty"`
+	// PriceHistory - версии цены в порядке начала действия
+	PriceHistory []PriceVersionDocument `bson:"price_history,omitempty"`
+	// NextPriceChangeAt - начало ближайшей версии цены, ещё не перенесённой в price
+	NextPriceChangeAt *time.Time `bson:"next_price_change_at,omitempty"`
+	// ReorderThreshold - порог дозаказа, не хранится, если не задан
+	ReorderThreshold int64 `bson:"reorder_threshold,omitempty"`
+	// LowStockAlertedAt - время оповещения о текущем падении остатка до порога
+	LowStockAlertedAt *time.Time `bson:"low_stock_alerted_at,omitempty"`
+	// StockLevels - остатки по складам, stock_quantity - их сумма
+	StockLevels []StockLevelDocument `bson:"stock_levels,omitempty"`
+	// LifecycleState - состояние жизненного цикла, у деталей до его появления заполняется BackfillLifecycleStates
+	LifecycleState int32 `bson:"lifecycle_state"`
+	// ReplacementPartUUID - рекомендуемая замена выведенной из продажи детали
+	ReplacementPartUUID string `bson:"replacement_part_uuid,omitempty"`
+}
+
+// SearchTermsDocument - основы слов текстовых полей детали
+type SearchTermsDocument struct {
+	Name        []string `bson:"name"`
+	Tags        []string `bson:"tags"`
+	Description []string `bson:"description"`
+}
+
+// StockLevelDocument - структура остатка детали на складе
+type StockLevelDocument struct {
+	WarehouseUUID string `bson:"warehouse_uuid"`
+	Quantity      int64  `bson:"quantity"`
+}
+
+// PriceVersionDocument - структура версии цены детали
+type PriceVersionDocument struct {
+	UUID          string    `bson:"uuid"`
+	Price         float64   `bson:"price"`
+	EffectiveFrom time.Time `bson:"effective_from"`
+	CreatedAt     time.Time `bson:"created_at"`
+}
+
+// ComponentDocument - структура компонента сборки
+type ComponentDocument struct {
+	PartUUID string `bson:"part_uuid"`
+	Quantity int64  `bson:"quantity"`
 }
 
 // DimensionsDocument - структура размеров
@@ -31,9 +81,54 @@ type DimensionsDocument struct {
 	Weight float64 `bson:"weight"`
 }
 
-// ManufacturerDocument - структура производителя
+// ManufacturerDocument - структура производителя: документ коллекции manufacturers
+// и его копия в документе детали (без uuid, если деталь не ссылается на справочник)
 type ManufacturerDocument struct {
+	UUID    string `bson:"uuid,omitempty"`
 	Name    string `bson:"name"`
 	Country string `bson:"country"`
 	Website string `bson:"website"`
 }
+
+// CategoryDocument - структура документа дерева категорий
+type CategoryDocument struct {
+	UUID        string `bson:"uuid"`
+	Slug        string `bson:"slug"`
+	DisplayName string `bson:"display_name"`
+	ParentUUID  string `bson:"parent_uuid,omitempty"`
+	Legacy      int32  `bson:"legacy,omitempty"`
+
+	MetadataSchema []MetadataFieldDocument `bson:"metadata_schema,omitempty"`
+}
+
+// MetadataFieldDocument - поле схемы метаданных в документе категории
+type MetadataFieldDocument struct {
+	Key         string   `bson:"key"`
+	Type        int32    `bson:"type"`
+	Unit        string   `bson:"unit,omitempty"`
+	Description string   `bson:"description,omitempty"`
+	Required    bool     `bson:"required,omitempty"`
+	Min         *float64 `bson:"min,omitempty"`
+	Max         *float64 `bson:"max,omitempty"`
+}
+
+// WarehouseDocument - структура документа склада
+type WarehouseDocument struct {
+	UUID     string `bson:"uuid"`
+	Code     string `bson:"code"`
+	Name     string `bson:"name"`
+	Location string `bson:"location,omitempty"`
+}
+
+// StockMovementDocument - структура документа журнала движений остатка
+type StockMovementDocument struct {
+	ID            primitive.ObjectID `bson:"_id,omitempty"`
+	UUID          string             `bson:"uuid"`
+	PartUUID      string             `bson:"part_uuid"`
+	WarehouseUUID string             `bson:"warehouse_uuid,omitempty"`
+	Delta         int64              `bson:"delta"`
+	QuantityAfter int64              `bson:"quantity_after"`
+	Reason        int32              `bson:"reason"`
+	ReferenceID   string             `bson:"reference_id,omitempty"`
+	CreatedAt     time.Time          `bson:"created_at"`
+}
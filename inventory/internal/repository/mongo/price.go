@@ -0,0 +1,37 @@
+package mongo
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+// ListDuePriceChanges возвращает детали, у которых запланированная версия цены начала действовать не позже at
+func (r *Repository) ListDuePriceChanges(ctx context.Context, at time.Time) ([]*model.Part, error) {
+	cursor, err := r.collection.Find(ctx, bson.M{"next_price_change_at": bson.M{"$lte": at}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find parts with due price changes: %w", err)
+	}
+	defer func() {
+		if cerr := cursor.Close(ctx); cerr != nil {
+			log.Printf("failed to close cursor: %v", cerr)
+		}
+	}()
+
+	var docs []PartDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, fmt.Errorf("failed to decode parts: %w", err)
+	}
+
+	parts := make([]*model.Part, len(docs))
+	for i := range docs {
+		parts[i] = ToServiceModel(&docs[i])
+	}
+
+	return parts, nil
+}
@@ -10,7 +10,11 @@ import (
 )
 
 const (
-	collectionName = "parts"
+	collectionName              = "parts"
+	movementsCollectionName     = "stock_movements"
+	manufacturersCollectionName = "manufacturers"
+	categoriesCollectionName    = "categories"
+	warehousesCollectionName    = "warehouses"
 )
 
 // Repository реализует интерфейс repository.PartRepository для MongoDB
@@ -18,6 +22,7 @@ type Repository struct {
 	client     *mongo.Client
 	database   *mongo.Database
 	collection *mongo.Collection
+	movements  *mongo.Collection
 }
 
 // NewRepository создаёт новый MongoDB репозиторий
@@ -27,6 +32,7 @@ func NewRepository(client *mongo.Client, dbName string) *Repository {
 		client:     client,
 		database:   db,
 		collection: db.Collection(collectionName),
+		movements:  db.Collection(movementsCollectionName),
 	}
 }
 
@@ -0,0 +1,46 @@
+package mongo
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// BackfillSearchTerms заполняет основы слов и префиксы для полнотекстового поиска у деталей,
+// сохранённых до их появления. Обновление условно: деталь, перезаписанная параллельно, уже их содержит.
+func (r *Repository) BackfillSearchTerms(ctx context.Context) error {
+	filter := bson.M{"search_prefixes": bson.M{"$exists": false}}
+	opts := options.Find().SetProjection(bson.M{"uuid": 1, "name": 1, "description": 1, "tags": 1})
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return fmt.Errorf("failed to find parts without search terms: %w", err)
+	}
+	defer func() {
+		if cerr := cursor.Close(ctx); cerr != nil {
+			log.Printf("failed to close cursor: %v", cerr)
+		}
+	}()
+
+	for cursor.Next(ctx) {
+		var doc PartDocument
+		if err := cursor.Decode(&doc); err != nil {
+			return fmt.Errorf("failed to decode part: %w", err)
+		}
+
+		terms, prefixes := buildSearchTerms(doc.Name, doc.Description, doc.Tags)
+		update := bson.M{"$set": bson.M{"search_terms": terms, "search_prefixes": prefixes}}
+		if _, err := r.collection.UpdateOne(ctx, bson.M{"uuid": doc.UUID, "search_prefixes": bson.M{"$exists": false}}, update); err != nil {
+			return fmt.Errorf("failed to backfill search terms of part %s: %w", doc.UUID, err)
+		}
+	}
+
+	if err := cursor.Err(); err != nil {
+		return fmt.Errorf("failed to iterate parts without search terms: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,186 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+// AdjustStock атомарно изменяет остаток детали на складе и добавляет движение в журнал
+func (r *Repository) AdjustStock(ctx context.Context, movement *model.StockMovement) error {
+	filter := bson.M{"uuid": movement.PartUuid}
+	if movement.Delta < 0 {
+		// Условие на остаток в фильтре делает проверку и изменение одной атомарной операцией
+		filter["stock_levels"] = sufficientStock(movement.WarehouseUuid, -movement.Delta)
+	}
+
+	levels := stockLevelsWithDelta("$stock_levels", movement.WarehouseUuid, movement.Delta)
+
+	doc, err := r.updateStock(ctx, filter, levels, movement.Delta, movement.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	movement.QuantityAfter = doc.StockQuantity
+
+	if _, err := r.movements.InsertOne(ctx, ToMovementDocument(movement)); err != nil {
+		// Без транзакции откатываем изменение остатка, чтобы он не расходился с журналом
+		rerr := r.revertStock(ctx, movement.PartUuid,
+			stockLevelsWithDelta("$stock_levels", movement.WarehouseUuid, -movement.Delta), -movement.Delta)
+		return errors.Join(fmt.Errorf("failed to record stock movement: %w", err), rerr)
+	}
+
+	return nil
+}
+
+// TransferStock атомарно перемещает остаток между складами и добавляет оба движения в журнал
+func (r *Repository) TransferStock(ctx context.Context, outgoing, incoming *model.StockMovement) error {
+	filter := bson.M{
+		"uuid":         outgoing.PartUuid,
+		"stock_levels": sufficientStock(outgoing.WarehouseUuid, -outgoing.Delta),
+	}
+
+	levels := stockLevelsWithDelta(
+		stockLevelsWithDelta("$stock_levels", outgoing.WarehouseUuid, outgoing.Delta),
+		incoming.WarehouseUuid, incoming.Delta,
+	)
+
+	doc, err := r.updateStock(ctx, filter, levels, outgoing.Delta+incoming.Delta, outgoing.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	outgoing.QuantityAfter = doc.StockQuantity
+	incoming.QuantityAfter = doc.StockQuantity
+
+	if _, err := r.movements.InsertMany(ctx, []any{ToMovementDocument(outgoing), ToMovementDocument(incoming)}); err != nil {
+		rerr := r.revertStock(ctx, outgoing.PartUuid, stockLevelsWithDelta(
+			stockLevelsWithDelta("$stock_levels", incoming.WarehouseUuid, -incoming.Delta),
+			outgoing.WarehouseUuid, -outgoing.Delta,
+		), -outgoing.Delta-incoming.Delta)
+		return errors.Join(fmt.Errorf("failed to record stock transfer: %w", err), rerr)
+	}
+
+	return nil
+}
+
+// updateStock заменяет остатки по складам документа, удовлетворяющего filter, и изменяет общий остаток на delta.
+// Если документ не найден, различает отсутствующую деталь и недостаточный остаток.
+func (r *Repository) updateStock(ctx context.Context, filter, levels bson.M, delta int64, at time.Time) (*PartDocument, error) {
+	// updated_at строго растёт, чтобы UpdatePart с устаревшими данными не прошёл проверку конкурентного изменения
+	update := mongo.Pipeline{{{Key: "$set", Value: bson.M{
+		"stock_levels":   levels,
+		"stock_quantity": bson.M{"$add": bson.A{"$stock_quantity", delta}},
+		"updated_at":     bson.M{"$max": bson.A{at, bson.M{"$add": bson.A{"$updated_at", 1}}}},
+	}}}}
+
+	var doc PartDocument
+	err := r.collection.FindOneAndUpdate(ctx, filter, update,
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&doc)
+	if err != nil {
+		if !errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("failed to adjust stock: %w", err)
+		}
+
+		count, err := r.collection.CountDocuments(ctx, bson.M{"uuid": filter["uuid"]})
+		if err != nil {
+			return nil, fmt.Errorf("failed to check part existence: %w", err)
+		}
+		if count == 0 {
+			return nil, model.ErrPartNotFound
+		}
+		return nil, model.ErrInsufficientStock
+	}
+
+	return &doc, nil
+}
+
+// revertStock откатывает изменение остатка, которое не удалось записать в журнал
+func (r *Repository) revertStock(ctx context.Context, partUuid string, levels bson.M, delta int64) error {
+	update := mongo.Pipeline{{{Key: "$set", Value: bson.M{
+		"stock_levels":   levels,
+		"stock_quantity": bson.M{"$add": bson.A{"$stock_quantity", delta}},
+	}}}}
+
+	_, err := r.collection.UpdateOne(ctx, bson.M{"uuid": partUuid}, update)
+	return err
+}
+
+// sufficientStock - условие фильтра: на складе не меньше quantity единиц
+func sufficientStock(warehouseUuid string, quantity int64) bson.M {
+	return bson.M{"$elemMatch": bson.M{"warehouse_uuid": warehouseUuid, "quantity": bson.M{"$gte": quantity}}}
+}
+
+// stockLevelsWithDelta - выражение конвейера обновления: остатки по складам levels, в которых остаток
+// склада изменён на delta. Склада, которого ещё нет у детали, добавляется в конец.
+func stockLevelsWithDelta(levels any, warehouseUuid string, delta int64) bson.M {
+	return bson.M{"$let": bson.M{
+		"vars": bson.M{"levels": bson.M{"$ifNull": bson.A{levels, bson.A{}}}},
+		"in": bson.M{"$cond": bson.A{
+			bson.M{"$in": bson.A{warehouseUuid, "$$levels.warehouse_uuid"}},
+			bson.M{"$map": bson.M{
+				"input": "$$levels",
+				"as":    "level",
+				"in": bson.M{"$cond": bson.A{
+					bson.M{"$eq": bson.A{"$$level.warehouse_uuid", warehouseUuid}},
+					bson.D{
+						{Key: "warehouse_uuid", Value: "$$level.warehouse_uuid"},
+						{Key: "quantity", Value: bson.M{"$add": bson.A{"$$level.quantity", delta}}},
+					},
+					"$$level",
+				}},
+			}},
+			bson.M{"$concatArrays": bson.A{"$$levels", bson.A{bson.D{
+				{Key: "warehouse_uuid", Value: warehouseUuid},
+				{Key: "quantity", Value: delta},
+			}}}},
+		}},
+	}}
+}
+
+// ListStockMovements возвращает движения остатка детали от новых к старым
+func (r *Repository) ListStockMovements(ctx context.Context, query *model.StockMovementsQuery) ([]*model.StockMovement, error) {
+	filter := bson.M{"part_uuid": query.PartUuid}
+	if query.Before != "" {
+		filter["uuid"] = bson.M{"$lt": query.Before}
+	}
+	if query.ReferenceID != "" {
+		filter["reference_id"] = query.ReferenceID
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "uuid", Value: -1}})
+	if query.Limit > 0 {
+		opts.SetLimit(int64(query.Limit))
+	}
+
+	cursor, err := r.movements.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find stock movements: %w", err)
+	}
+	defer func() {
+		if cerr := cursor.Close(ctx); cerr != nil {
+			log.Printf("failed to close cursor: %v", cerr)
+		}
+	}()
+
+	var docs []StockMovementDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, fmt.Errorf("failed to decode stock movements: %w", err)
+	}
+
+	movements := make([]*model.StockMovement, len(docs))
+	for i := range docs {
+		movements[i] = ToMovementModel(&docs[i])
+	}
+
+	return movements, nil
+}
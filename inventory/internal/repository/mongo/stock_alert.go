@@ -0,0 +1,78 @@
+package mongo
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+// lowStockExpr - условие model.Part.IsLowStock для $expr: порог задан и остаток не выше него
+var lowStockExpr = bson.M{"$and": bson.A{
+	bson.M{"$gt": bson.A{"$reorder_threshold", 0}},
+	bson.M{"$lte": bson.A{"$stock_quantity", "$reorder_threshold"}},
+}}
+
+// ListLowStockAlertChanges возвращает детали, остаток которых опустился до порога без оповещения
+// или поднялся выше порога после оповещения
+func (r *Repository) ListLowStockAlertChanges(ctx context.Context) ([]*model.Part, error) {
+	filter := bson.M{"$or": bson.A{
+		bson.M{"low_stock_alerted_at": nil, "reorder_threshold": bson.M{"$gt": 0}, "$expr": lowStockExpr},
+		bson.M{"low_stock_alerted_at": bson.M{"$ne": nil}, "$expr": bson.M{"$not": bson.A{lowStockExpr}}},
+	}}
+
+	cursor, err := r.collection.Find(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find parts crossing reorder threshold: %w", err)
+	}
+	defer func() {
+		if cerr := cursor.Close(ctx); cerr != nil {
+			log.Printf("failed to close cursor: %v", cerr)
+		}
+	}()
+
+	var docs []PartDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, fmt.Errorf("failed to decode parts: %w", err)
+	}
+
+	parts := make([]*model.Part, len(docs))
+	for i := range docs {
+		parts[i] = ToServiceModel(&docs[i])
+	}
+
+	return parts, nil
+}
+
+// SetLowStockAlertedAt заменяет только поле low_stock_alerted_at, если оно равно prev
+func (r *Repository) SetLowStockAlertedAt(ctx context.Context, uuid string, prev, next *time.Time) error {
+	filter := bson.M{"uuid": uuid, "low_stock_alerted_at": prev}
+	update := bson.M{"$unset": bson.M{"low_stock_alerted_at": ""}}
+	if next != nil {
+		filter["$expr"] = lowStockExpr
+		update = bson.M{"$set": bson.M{"low_stock_alerted_at": *next}}
+	}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return fmt.Errorf("failed to update low stock alert: %w", err)
+	}
+
+	if result.MatchedCount > 0 {
+		return nil
+	}
+
+	count, err := r.collection.CountDocuments(ctx, bson.M{"uuid": uuid})
+	if err != nil {
+		return fmt.Errorf("failed to check part existence: %w", err)
+	}
+	if count == 0 {
+		return model.ErrPartNotFound
+	}
+
+	return model.ErrConcurrentModification
+}
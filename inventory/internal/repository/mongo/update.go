@@ -0,0 +1,51 @@
+package mongo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+// Update заменяет документ детали, если он не менялся с момента чтения (model.Part.LastModifiedAt:
+// у документа без updated_at сравнивается created_at). Поле low_stock_alerted_at сохраняется:
+// им распоряжается SetLowStockAlertedAt.
+func (r *Repository) Update(ctx context.Context, part *model.Part, prevUpdatedAt time.Time) error {
+	filter := bson.M{"uuid": part.Uuid, "$or": bson.A{
+		bson.M{"updated_at": prevUpdatedAt},
+		// Документ без updated_at или с нулевой датой, записанной ToDocument для детали без неё
+		bson.M{"updated_at": bson.M{"$in": bson.A{nil, time.Time{}}}, "created_at": prevUpdatedAt},
+	}}
+
+	doc := ToDocument(part)
+	doc.LowStockAlertedAt = nil
+	// $literal - чтобы строки документа, начинающиеся с "$", не читались как пути полей
+	update := mongo.Pipeline{{{Key: "$replaceWith", Value: bson.M{"$mergeObjects": bson.A{
+		bson.M{"$literal": doc},
+		bson.M{"_id": "$_id", "low_stock_alerted_at": "$low_stock_alerted_at"},
+	}}}}}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return fmt.Errorf("failed to update part: %w", err)
+	}
+
+	if result.MatchedCount > 0 {
+		return nil
+	}
+
+	// Документ не найден по паре uuid + updated_at: либо детали нет, либо её успели изменить
+	count, err := r.collection.CountDocuments(ctx, bson.M{"uuid": part.Uuid})
+	if err != nil {
+		return fmt.Errorf("failed to check part existence: %w", err)
+	}
+	if count == 0 {
+		return model.ErrPartNotFound
+	}
+
+	return model.ErrConcurrentModification
+}
@@ -0,0 +1,109 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+// WarehouseRepository реализует интерфейс repository.WarehouseRepository для MongoDB
+type WarehouseRepository struct {
+	collection *mongo.Collection
+}
+
+// NewWarehouseRepository создаёт MongoDB репозиторий складов
+func NewWarehouseRepository(client *mongo.Client, dbName string) *WarehouseRepository {
+	return &WarehouseRepository{
+		collection: client.Database(dbName).Collection(warehousesCollectionName),
+	}
+}
+
+// EnsureIndexes создаёт уникальные индексы по UUID и коду склада
+func (r *WarehouseRepository) EnsureIndexes(ctx context.Context) error {
+	indexes := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "uuid", Value: 1}},
+			Options: options.Index().SetName("uuid_unique").SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "code", Value: 1}},
+			Options: options.Index().SetName("code_unique").SetUnique(true),
+		},
+	}
+
+	if _, err := r.collection.Indexes().CreateMany(ctx, indexes); err != nil {
+		return fmt.Errorf("failed to create warehouse indexes: %w", err)
+	}
+
+	return nil
+}
+
+// Get получает склад по UUID
+func (r *WarehouseRepository) Get(ctx context.Context, uuid string) (*model.Warehouse, error) {
+	var doc WarehouseDocument
+	err := r.collection.FindOne(ctx, bson.M{"uuid": uuid}).Decode(&doc)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, model.ErrWarehouseNotFound
+		}
+		return nil, err
+	}
+
+	return ToWarehouseModel(&doc), nil
+}
+
+// List возвращает все склады, упорядоченные по коду
+func (r *WarehouseRepository) List(ctx context.Context) ([]*model.Warehouse, error) {
+	cursor, err := r.collection.Find(ctx, bson.M{}, options.Find().SetSort(bson.D{{Key: "code", Value: 1}}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find warehouses: %w", err)
+	}
+
+	var docs []WarehouseDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, fmt.Errorf("failed to decode warehouses: %w", err)
+	}
+
+	warehouses := make([]*model.Warehouse, len(docs))
+	for i := range docs {
+		warehouses[i] = ToWarehouseModel(&docs[i])
+	}
+
+	return warehouses, nil
+}
+
+// Create сохраняет новый склад
+func (r *WarehouseRepository) Create(ctx context.Context, warehouse *model.Warehouse) error {
+	_, err := r.collection.InsertOne(ctx, ToWarehouseDocument(warehouse))
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return model.ErrWarehouseAlreadyExists
+		}
+		return fmt.Errorf("failed to insert warehouse: %w", err)
+	}
+
+	return nil
+}
+
+// BackfillStockLevels относит остаток деталей, сохранённых до появления складов, к основному складу
+func (r *Repository) BackfillStockLevels(ctx context.Context) error {
+	update := mongo.Pipeline{{{Key: "$set", Value: bson.M{
+		"stock_levels": bson.A{bson.D{
+			{Key: "warehouse_uuid", Value: model.DefaultWarehouse.Uuid},
+			{Key: "quantity", Value: "$stock_quantity"},
+		}},
+	}}}}
+
+	filter := bson.M{"stock_levels": bson.M{"$exists": false}, "stock_quantity": bson.M{"$ne": 0}}
+	if _, err := r.collection.UpdateMany(ctx, filter, update); err != nil {
+		return fmt.Errorf("failed to backfill stock levels: %w", err)
+	}
+
+	return nil
+}
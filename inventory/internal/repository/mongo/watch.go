@@ -0,0 +1,191 @@
+package mongo
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"iter"
+	"log"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+// Коды ошибок сервера MongoDB, означающие, что поток нельзя продолжить с переданного токена
+const (
+	errCodeInvalidResumeToken      = 260
+	errCodeChangeStreamFatalError  = 280
+	errCodeChangeStreamHistoryLost = 286
+)
+
+// serviceFields - служебные поля детали, изменение которых не считается изменением детали для Watch
+var serviceFields = bson.A{"low_stock_alerted_at"}
+
+// onlyServiceFieldsChanged - условие $expr: обновление затронуло только serviceFields
+var onlyServiceFieldsChanged = bson.M{"$setIsSubset": bson.A{
+	bson.M{"$concatArrays": bson.A{
+		bson.M{"$map": bson.M{
+			"input": bson.M{"$objectToArray": "$updateDescription.updatedFields"},
+			"in":    "$$this.k",
+		}},
+		"$updateDescription.removedFields",
+	}},
+	serviceFields,
+}}
+
+// changeEventDocument - событие change stream коллекции деталей
+type changeEventDocument struct {
+	ID            bson.Raw      `bson:"_id"`
+	OperationType string        `bson:"operationType"`
+	FullDocument  *PartDocument `bson:"fullDocument"`
+	// FullDocumentBeforeChange заполняется, только если для коллекции включены pre-images
+	FullDocumentBeforeChange *PartDocument `bson:"fullDocumentBeforeChange"`
+}
+
+// EnableChangeStreamPreImages включает сохранение состояния документов до изменения. Без него
+// события удаления не содержат UUID детали, а изменения не фильтруются по прежнему состоянию.
+// Требует MongoDB 6.0+.
+func (r *Repository) EnableChangeStreamPreImages(ctx context.Context) error {
+	err := r.database.RunCommand(ctx, bson.D{
+		{Key: "collMod", Value: collectionName},
+		{Key: "changeStreamPreAndPostImages", Value: bson.M{"enabled": true}},
+	}).Err()
+	if err != nil {
+		return fmt.Errorf("failed to enable change stream pre-images: %w", err)
+	}
+
+	return nil
+}
+
+// Watch читает change stream коллекции деталей. Токен возобновления - закодированный
+// идентификатор события change stream. Требует replica set.
+func (r *Repository) Watch(ctx context.Context, resumeToken string) iter.Seq2[*model.PartEvent, error] {
+	return func(yield func(*model.PartEvent, error) bool) {
+		opts := options.ChangeStream().
+			SetFullDocument(options.UpdateLookup).
+			SetFullDocumentBeforeChange(options.WhenAvailable)
+
+		if resumeToken != "" {
+			token, err := decodeResumeToken(resumeToken)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			opts.SetStartAfter(token)
+		}
+
+		pipeline := mongo.Pipeline{{{Key: "$match", Value: bson.M{
+			"operationType": bson.M{"$in": bson.A{"insert", "update", "replace", "delete"}},
+			"$or": bson.A{
+				bson.M{"operationType": bson.M{"$ne": "update"}},
+				bson.M{"$expr": bson.M{"$not": bson.A{onlyServiceFieldsChanged}}},
+			},
+		}}}}
+
+		stream, err := r.collection.Watch(ctx, pipeline, opts)
+		if err != nil {
+			yield(nil, changeStreamError(err))
+			return
+		}
+		defer func() {
+			// ctx может быть уже отменён, а курсор на сервере всё равно нужно закрыть
+			if cerr := stream.Close(context.WithoutCancel(ctx)); cerr != nil {
+				log.Printf("failed to close change stream: %v", cerr)
+			}
+		}()
+
+		for stream.Next(ctx) {
+			var doc changeEventDocument
+			if err := stream.Decode(&doc); err != nil {
+				yield(nil, fmt.Errorf("failed to decode change event: %w", err))
+				return
+			}
+
+			event := toPartEvent(&doc)
+			if event == nil {
+				continue
+			}
+
+			if !yield(event, nil) {
+				return
+			}
+		}
+
+		if ctx.Err() != nil {
+			return
+		}
+		if err := stream.Err(); err != nil {
+			yield(nil, changeStreamError(err))
+		}
+	}
+}
+
+// toPartEvent преобразует событие change stream. Возвращает nil для событий, о которых
+// нечего сообщить: изменённый документ уже удалён (следом придёт событие удаления)
+// или удалённый документ неизвестен, потому что pre-images выключены.
+func toPartEvent(doc *changeEventDocument) *model.PartEvent {
+	event := &model.PartEvent{
+		ResumeToken: base64.RawURLEncoding.EncodeToString(doc.ID),
+	}
+	if doc.FullDocumentBeforeChange != nil {
+		event.Previous = ToServiceModel(doc.FullDocumentBeforeChange)
+	}
+
+	switch doc.OperationType {
+	case "insert":
+		event.Type = model.PartEventTypeCreated
+	case "update", "replace":
+		event.Type = model.PartEventTypeUpdated
+	case "delete":
+		if event.Previous == nil {
+			log.Printf("skipping delete event without pre-image, enable changeStreamPreAndPostImages for %q", collectionName)
+			return nil
+		}
+		event.Type = model.PartEventTypeDeleted
+		event.PartUuid = event.Previous.Uuid
+		event.Part = event.Previous
+		return event
+	default:
+		return nil
+	}
+
+	if doc.FullDocument == nil {
+		return nil
+	}
+	event.Part = ToServiceModel(doc.FullDocument)
+	event.PartUuid = event.Part.Uuid
+
+	return event
+}
+
+func decodeResumeToken(token string) (bson.Raw, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, model.ErrInvalidResumeToken
+	}
+	if err := bson.Raw(raw).Validate(); err != nil {
+		return nil, model.ErrInvalidResumeToken
+	}
+
+	return raw, nil
+}
+
+// changeStreamError отделяет ошибки токена возобновления от прочих ошибок сервера
+func changeStreamError(err error) error {
+	var serverErr mongo.ServerError
+	if errors.As(err, &serverErr) {
+		switch {
+		case serverErr.HasErrorCode(errCodeInvalidResumeToken):
+			return model.ErrInvalidResumeToken
+		case serverErr.HasErrorCode(errCodeChangeStreamHistoryLost),
+			serverErr.HasErrorCode(errCodeChangeStreamFatalError):
+			return model.ErrResumeTokenExpired
+		}
+	}
+
+	return fmt.Errorf("change stream failed: %w", err)
+}
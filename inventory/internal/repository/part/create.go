@@ -0,0 +1,27 @@
+package part
+
+import (
+	"context"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+func (r *Repository) Create(_ context.Context, part *model.Part) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.parts[part.Uuid]; exists {
+		return model.ErrPartAlreadyExists
+	}
+
+	// Как и BackfillStockLevels в MongoDB: остаток без разбивки относится к основному складу
+	stored := part.Clone()
+	stored.NormalizeStockLevels()
+
+	if err := r.commit(&walRecord{Put: []*model.Part{stored}}); err != nil {
+		return err
+	}
+	r.events.publish(model.PartEventTypeCreated, part.Uuid, stored, nil)
+
+	return nil
+}
@@ -0,0 +1,24 @@
+package part
+
+import (
+	"context"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+func (r *Repository) Delete(_ context.Context, uuid string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	existing, exists := r.parts[uuid]
+	if !exists {
+		return model.ErrPartNotFound
+	}
+
+	if err := r.commit(&walRecord{Delete: []string{uuid}}); err != nil {
+		return err
+	}
+	r.events.publish(model.PartEventTypeDeleted, uuid, existing, existing)
+
+	return nil
+}
@@ -15,5 +15,5 @@ func (r *Repository) Get(_ context.Context, uuid string) (*model.Part, error) {
 		return nil, model.ErrPartNotFound
 	}
 
-	return part, nil
+	return part.Clone(), nil
 }
@@ -2,18 +2,93 @@ package part
 
 import (
 	"context"
+	"iter"
+	"slices"
 
 	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+	"github.com/bogdanovds/rocket_factory/inventory/internal/textsearch"
 )
 
-func (r *Repository) List(_ context.Context) ([]*model.Part, error) {
+func (r *Repository) List(_ context.Context, query *model.PartsQuery) ([]*model.Part, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
 	parts := make([]*model.Part, 0, len(r.parts))
-	for _, part := range r.parts {
-		parts = append(parts, part)
+	for part := range r.matching(query.Filter) {
+		if query.Order.After(part, query.After) {
+			parts = append(parts, part)
+		}
+	}
+
+	// Порядок совпадает с MongoDB-репозиторием: ключ сортировки, затем UUID
+	slices.SortFunc(parts, query.Order.Compare)
+
+	if query.Limit > 0 && len(parts) > query.Limit {
+		parts = parts[:query.Limit]
 	}
 
 	return parts, nil
 }
+
+func (r *Repository) Count(_ context.Context, filter *model.PartsFilter) (int, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	count := 0
+	for range r.matching(filter) {
+		count++
+	}
+
+	return count, nil
+}
+
+// matching перебирает копии деталей, удовлетворяющих фильтру. При полнотекстовом запросе
+// копии содержат SearchScore, а детали без единого совпавшего слова пропускаются.
+// Вызывающий должен удерживать r.mu.
+func (r *Repository) matching(filter *model.PartsFilter) iter.Seq[*model.Part] {
+	var queryTerms []string
+	if filter.HasQuery() {
+		queryTerms = textsearch.Terms(filter.Query)
+	}
+
+	return func(yield func(*model.Part) bool) {
+		for _, part := range r.parts {
+			if !filter.Matches(part) {
+				continue
+			}
+
+			var score float64
+			if filter.HasQuery() {
+				score = part.TextScore(queryTerms)
+				if score == 0 {
+					continue
+				}
+			}
+
+			clone := part.Clone()
+			clone.SearchScore = score
+			if !yield(clone) {
+				return
+			}
+		}
+	}
+}
+
+func (r *Repository) Facets(_ context.Context, filter *model.PartsFilter) (*model.PartFacets, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	// Каждое измерение считается по фильтру без условий на него самого, как в MongoDB-репозитории
+	counter := model.NewFacetCounter()
+	for part := range r.matching(filter.WithoutCategories()) {
+		counter.AddCategory(part)
+	}
+	for part := range r.matching(filter.WithoutManufacturerCountries()) {
+		counter.AddManufacturerCountry(part)
+	}
+	for part := range r.matching(filter.WithoutTags()) {
+		counter.AddTags(part)
+	}
+
+	return counter.Facets(), nil
+}
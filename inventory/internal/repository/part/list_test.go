@@ -0,0 +1,329 @@
+package part
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+// ListTestSuite - тесты фильтрации in-memory репозитория.
+// Семантика фильтра должна совпадать с запросом, который строит MongoDB-репозиторий.
+type ListTestSuite struct {
+	suite.Suite
+	ctx  context.Context
+	repo *Repository
+}
+
+func (s *ListTestSuite) SetupTest() {
+	s.ctx = context.Background()
+	s.repo = NewPartRepository()
+
+	parts := []*model.Part{
+		{Uuid: "uuid-3", Name: "Wing Part", Price: 300, StockQuantity: 1, Category: model.CategoryWing, Tags: []string{"light"}, Manufacturer: &model.Manufacturer{Country: "JP"}},
+		{Uuid: "uuid-1", Name: "Engine Part", Price: 900, StockQuantity: 5, Category: model.CategoryEngine, Tags: []string{"heavy", "hot"}, Manufacturer: &model.Manufacturer{Country: "US"}},
+		{Uuid: "uuid-2", Name: "Fuel Part", Price: 300, StockQuantity: 8, Category: model.CategoryFuel, Tags: []string{"heavy"}, Manufacturer: &model.Manufacturer{Country: "US"}},
+		{Uuid: "uuid-4", Name: "Porthole", Price: 150, StockQuantity: 5, Category: model.CategoryPorthole},
+	}
+	for _, p := range parts {
+		s.Require().NoError(s.repo.Create(s.ctx, p))
+	}
+}
+
+func (s *ListTestSuite) uuids(filter *model.PartsFilter) []string {
+	return s.query(&model.PartsQuery{Filter: filter})
+}
+
+func (s *ListTestSuite) query(query *model.PartsQuery) []string {
+	parts, err := s.repo.List(s.ctx, query)
+	s.Require().NoError(err)
+
+	uuids := make([]string, len(parts))
+	for i, p := range parts {
+		uuids[i] = p.Uuid
+	}
+	return uuids
+}
+
+func (s *ListTestSuite) TestNoFilter_SortedByUUID() {
+	s.Equal([]string{"uuid-1", "uuid-2", "uuid-3", "uuid-4"}, s.uuids(nil))
+	s.Equal([]string{"uuid-1", "uuid-2", "uuid-3", "uuid-4"}, s.uuids(&model.PartsFilter{}))
+}
+
+func (s *ListTestSuite) TestFilterByUUIDs() {
+	s.Equal([]string{"uuid-1", "uuid-3"}, s.uuids(&model.PartsFilter{Uuids: []string{"uuid-3", "uuid-1"}}))
+}
+
+func (s *ListTestSuite) TestFilterByNames() {
+	s.Equal([]string{"uuid-4"}, s.uuids(&model.PartsFilter{Names: []string{"Porthole"}}))
+}
+
+func (s *ListTestSuite) TestFilterByCategory() {
+	s.Equal([]string{"uuid-1"}, s.uuids(&model.PartsFilter{Categories: []model.Category{model.CategoryEngine}}))
+}
+
+func (s *ListTestSuite) TestFilterByCountry_SkipsPartsWithoutManufacturer() {
+	s.Equal([]string{"uuid-1", "uuid-2"}, s.uuids(&model.PartsFilter{ManufacturerCountries: []string{"US"}}))
+}
+
+func (s *ListTestSuite) TestFilterByTags_AnyTagMatches() {
+	s.Equal([]string{"uuid-1", "uuid-2"}, s.uuids(&model.PartsFilter{Tags: []string{"hot", "heavy"}}))
+}
+
+func (s *ListTestSuite) TestFilterByComponentUUIDs() {
+	assembly := &model.Part{Uuid: "uuid-5", Name: "Engine Assembly", Components: []model.Component{{PartUuid: "uuid-1", Quantity: 2}}}
+	s.Require().NoError(s.repo.Create(s.ctx, assembly))
+
+	s.Equal([]string{"uuid-5"}, s.uuids(&model.PartsFilter{ComponentUuids: []string{"uuid-2", "uuid-1"}}))
+	s.Empty(s.uuids(&model.PartsFilter{ComponentUuids: []string{"uuid-3"}}))
+}
+
+func (s *ListTestSuite) TestFilterByLifecycleStates() {
+	discontinued := &model.Part{Uuid: "uuid-5", Name: "Old Engine", LifecycleState: model.LifecycleStateDiscontinued}
+	s.Require().NoError(s.repo.Create(s.ctx, discontinued))
+
+	s.Equal([]string{"uuid-5"}, s.uuids(&model.PartsFilter{
+		LifecycleStates: []model.LifecycleState{model.LifecycleStateDiscontinued, model.LifecycleStateRecalled},
+	}))
+	s.Empty(s.uuids(&model.PartsFilter{LifecycleStates: []model.LifecycleState{model.LifecycleStateDraft}}))
+}
+
+func (s *ListTestSuite) TestFieldsCombinedWithAnd() {
+	s.Equal([]string{"uuid-2"}, s.uuids(&model.PartsFilter{
+		Tags:       []string{"heavy"},
+		Categories: []model.Category{model.CategoryFuel, model.CategoryWing},
+	}))
+}
+
+func (s *ListTestSuite) TestReturnsCopies() {
+	parts, err := s.repo.List(s.ctx, &model.PartsQuery{})
+	s.Require().NoError(err)
+
+	parts[0].Name = "changed"
+
+	stored, err := s.repo.Get(s.ctx, parts[0].Uuid)
+	s.Require().NoError(err)
+	s.Equal("Engine Part", stored.Name)
+}
+
+func (s *ListTestSuite) TestOrderBy_TieBreakOnUUID() {
+	price := model.PartsOrder{Field: model.PartsOrderFieldPrice}
+	s.Equal([]string{"uuid-4", "uuid-2", "uuid-3", "uuid-1"}, s.query(&model.PartsQuery{Order: price}))
+
+	price.Descending = true
+	s.Equal([]string{"uuid-1", "uuid-3", "uuid-2", "uuid-4"}, s.query(&model.PartsQuery{Order: price}))
+
+	stock := model.PartsOrder{Field: model.PartsOrderFieldStockQuantity}
+	s.Equal([]string{"uuid-3", "uuid-1", "uuid-4", "uuid-2"}, s.query(&model.PartsQuery{Order: stock}))
+
+	name := model.PartsOrder{Field: model.PartsOrderFieldName, Descending: true}
+	s.Equal([]string{"uuid-3", "uuid-4", "uuid-2", "uuid-1"}, s.query(&model.PartsQuery{Order: name}))
+}
+
+func (s *ListTestSuite) TestAfterCursorAndLimit() {
+	order := model.PartsOrder{Field: model.PartsOrderFieldPrice}
+	after := &model.PartsCursor{Uuid: "uuid-2", Price: 300}
+
+	s.Equal([]string{"uuid-4", "uuid-2"}, s.query(&model.PartsQuery{Order: order, Limit: 2}))
+	s.Equal([]string{"uuid-3", "uuid-1"}, s.query(&model.PartsQuery{Order: order, After: after, Limit: 2}))
+	s.Equal([]string{"uuid-3"}, s.query(&model.PartsQuery{
+		Filter: &model.PartsFilter{ManufacturerCountries: []string{"JP", "US"}},
+		Order:  order,
+		After:  after,
+		Limit:  1,
+	}))
+}
+
+func (s *ListTestSuite) TestCount() {
+	count, err := s.repo.Count(s.ctx, nil)
+	s.Require().NoError(err)
+	s.Equal(4, count)
+
+	count, err = s.repo.Count(s.ctx, &model.PartsFilter{Tags: []string{"heavy"}})
+	s.Require().NoError(err)
+	s.Equal(2, count)
+}
+
+func (s *ListTestSuite) TestQuery_StemmingAndPrefix() {
+	s.Require().NoError(s.repo.Create(s.ctx, &model.Part{
+		Uuid:        "uuid-5",
+		Name:        "Водородный двигатель",
+		Description: "Thermal protection included",
+		Tags:        []string{"hydrogen"},
+	}))
+
+	s.Equal([]string{"uuid-5"}, s.uuids(&model.PartsFilter{Query: "двигатели"}))
+	s.Equal([]string{"uuid-5"}, s.uuids(&model.PartsFilter{Query: "hydro"}))
+	s.Equal([]string{"uuid-1", "uuid-5"}, s.uuids(&model.PartsFilter{Query: "engines двигатель"}))
+	s.Empty(s.uuids(&model.PartsFilter{Query: "the"}))
+
+	count, err := s.repo.Count(s.ctx, &model.PartsFilter{Query: "engine", Categories: []model.Category{model.CategoryEngine}})
+	s.Require().NoError(err)
+	s.Equal(1, count)
+}
+
+func (s *ListTestSuite) TestQuery_OrderByRelevance() {
+	s.Require().NoError(s.repo.Create(s.ctx, &model.Part{
+		Uuid:        "uuid-5",
+		Name:        "Bracket",
+		Description: "Mounts the fuel pump",
+	}))
+
+	parts, err := s.repo.List(s.ctx, &model.PartsQuery{
+		Filter: &model.PartsFilter{Query: "fuel"},
+		Order:  model.PartsOrder{Field: model.PartsOrderFieldRelevance},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(parts, 2)
+	s.Equal("uuid-2", parts[0].Uuid)
+	s.Equal("uuid-5", parts[1].Uuid)
+	s.Greater(parts[0].SearchScore, parts[1].SearchScore)
+
+	s.Equal([]string{"uuid-5"}, s.query(&model.PartsQuery{
+		Filter: &model.PartsFilter{Query: "fuel"},
+		Order:  model.PartsOrder{Field: model.PartsOrderFieldRelevance},
+		After:  model.NewPartsCursor(parts[0]),
+	}))
+}
+
+func (s *ListTestSuite) TestRangesAndInStock() {
+	createdAt := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	s.Require().NoError(s.repo.Create(s.ctx, &model.Part{
+		Uuid:          "uuid-5",
+		Name:          "Heavy Nozzle",
+		Price:         300,
+		StockQuantity: 0,
+		Dimensions:    &model.Dimensions{Length: 120, Weight: 800},
+		CreatedAt:     &createdAt,
+		UpdatedAt:     &createdAt,
+	}))
+
+	s.Equal([]string{"uuid-2", "uuid-3", "uuid-4", "uuid-5"}, s.uuids(&model.PartsFilter{
+		Price: &model.FloatRange{Max: lo.ToPtr(300.0)},
+	}))
+	s.Equal([]string{"uuid-2", "uuid-3", "uuid-4"}, s.uuids(&model.PartsFilter{
+		Price:       &model.FloatRange{Min: lo.ToPtr(150.0), Max: lo.ToPtr(300.0)},
+		InStockOnly: true,
+	}))
+	s.Equal([]string{"uuid-5"}, s.uuids(&model.PartsFilter{
+		Weight: &model.FloatRange{Min: lo.ToPtr(500.0)},
+		Length: &model.FloatRange{Max: lo.ToPtr(120.0)},
+	}))
+	s.Equal([]string{"uuid-5"}, s.uuids(&model.PartsFilter{
+		CreatedAt: &model.TimeRange{From: &createdAt, To: lo.ToPtr(createdAt.Add(time.Millisecond))},
+	}))
+	s.Empty(s.uuids(&model.PartsFilter{
+		UpdatedAt: &model.TimeRange{To: &createdAt},
+	}))
+}
+
+func (s *ListTestSuite) TestMetadataPredicates() {
+	s.Require().NoError(s.repo.Create(s.ctx, &model.Part{
+		Uuid:     "uuid-5",
+		Name:     "Valve",
+		Metadata: map[string]any{"pressure": int64(200), "material": "titanium", "certified": true},
+	}))
+	s.Require().NoError(s.repo.Create(s.ctx, &model.Part{
+		Uuid:     "uuid-6",
+		Name:     "Valve",
+		Metadata: map[string]any{"pressure": 150.5, "material": "steel"},
+	}))
+
+	cases := []struct {
+		predicates []model.MetadataPredicate
+		expected   []string
+	}{
+		{
+			predicates: []model.MetadataPredicate{{Key: "pressure", Operator: model.MetadataOperatorEQ, Value: 200.0}},
+			expected:   []string{"uuid-5"},
+		},
+		{
+			predicates: []model.MetadataPredicate{{Key: "pressure", Operator: model.MetadataOperatorGT, Value: int64(150)}},
+			expected:   []string{"uuid-5", "uuid-6"},
+		},
+		{
+			predicates: []model.MetadataPredicate{
+				{Key: "pressure", Operator: model.MetadataOperatorGTE, Value: int64(100)},
+				{Key: "pressure", Operator: model.MetadataOperatorLT, Value: 200.0},
+			},
+			expected: []string{"uuid-6"},
+		},
+		{
+			predicates: []model.MetadataPredicate{{Key: "material", Operator: model.MetadataOperatorNE, Value: "steel"}},
+			expected:   []string{"uuid-1", "uuid-2", "uuid-3", "uuid-4", "uuid-5"},
+		},
+		{
+			predicates: []model.MetadataPredicate{{Key: "certified", Operator: model.MetadataOperatorEQ, Value: true}},
+			expected:   []string{"uuid-5"},
+		},
+		{
+			predicates: []model.MetadataPredicate{{Key: "certified", Operator: model.MetadataOperatorExists}},
+			expected:   []string{"uuid-5"},
+		},
+		{
+			predicates: []model.MetadataPredicate{{Key: "material", Operator: model.MetadataOperatorEQ, Value: int64(1)}},
+			expected:   []string{},
+		},
+	}
+
+	for i, c := range cases {
+		s.Equal(c.expected, s.uuids(&model.PartsFilter{Metadata: c.predicates}), "case %d", i)
+	}
+}
+
+func (s *ListTestSuite) TestFacets_NoFilter() {
+	facets, err := s.repo.Facets(s.ctx, nil)
+	s.Require().NoError(err)
+
+	s.Equal([]model.CategoryFacetCount{
+		{Category: model.CategoryEngine, Count: 1},
+		{Category: model.CategoryFuel, Count: 1},
+		{Category: model.CategoryPorthole, Count: 1},
+		{Category: model.CategoryWing, Count: 1},
+	}, facets.Categories)
+	// Деталь без производителя в количестве по странам не учитывается
+	s.Equal([]model.FacetCount{{Value: "US", Count: 2}, {Value: "JP", Count: 1}}, facets.ManufacturerCountries)
+	s.Equal([]model.FacetCount{{Value: "heavy", Count: 2}, {Value: "hot", Count: 1}, {Value: "light", Count: 1}}, facets.Tags)
+}
+
+func (s *ListTestSuite) TestFacets_ExcludeOwnDimension() {
+	facets, err := s.repo.Facets(s.ctx, &model.PartsFilter{
+		Categories:            []model.Category{model.CategoryEngine},
+		ManufacturerCountries: []string{"US"},
+		Tags:                  []string{"heavy"},
+	})
+	s.Require().NoError(err)
+
+	// Категории - по стране и тегу, страны - по категории и тегу, теги - по категории и стране
+	s.Equal([]model.CategoryFacetCount{
+		{Category: model.CategoryEngine, Count: 1},
+		{Category: model.CategoryFuel, Count: 1},
+	}, facets.Categories)
+	s.Equal([]model.FacetCount{{Value: "US", Count: 1}}, facets.ManufacturerCountries)
+	s.Equal([]model.FacetCount{{Value: "heavy", Count: 1}, {Value: "hot", Count: 1}}, facets.Tags)
+}
+
+func (s *ListTestSuite) TestFacets_OtherConditionsApplyToAll() {
+	duplicate := &model.Part{Uuid: "uuid-5", Name: "Spare Wing", Price: 50, Category: model.CategoryWing, Tags: []string{"light", "light"}}
+	s.Require().NoError(s.repo.Create(s.ctx, duplicate))
+
+	facets, err := s.repo.Facets(s.ctx, &model.PartsFilter{Price: &model.FloatRange{Max: lo.ToPtr(300.0)}})
+	s.Require().NoError(err)
+
+	s.Equal([]model.CategoryFacetCount{
+		{Category: model.CategoryWing, Count: 2},
+		{Category: model.CategoryFuel, Count: 1},
+		{Category: model.CategoryPorthole, Count: 1},
+	}, facets.Categories)
+	s.Equal([]model.FacetCount{{Value: "JP", Count: 1}, {Value: "US", Count: 1}}, facets.ManufacturerCountries)
+	// Повтор тега в одной детали учитывается один раз
+	s.Equal([]model.FacetCount{{Value: "light", Count: 2}, {Value: "heavy", Count: 1}}, facets.Tags)
+}
+
+func TestListTestSuite(t *testing.T) {
+	suite.Run(t, new(ListTestSuite))
+}
@@ -0,0 +1,47 @@
+package part
+
+import (
+	"context"
+	"time"
+
+	"github.com/samber/lo"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+func (r *Repository) UpdateManufacturer(_ context.Context, manufacturer *model.Manufacturer, updatedAt time.Time) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// Все детали сохраняются одной записью журнала, чтобы после сбоя не остались частично обновлёнными
+	rec := &walRecord{}
+	previous := make(map[string]*model.Part)
+	for uuid, existing := range r.parts {
+		if !existing.Manufacturer.IsReference() || existing.Manufacturer.Uuid != manufacturer.Uuid {
+			continue
+		}
+
+		part := existing.Clone()
+		part.Manufacturer = lo.ToPtr(*manufacturer)
+		if part.UpdatedAt != nil && !updatedAt.After(*part.UpdatedAt) {
+			part.UpdatedAt = lo.ToPtr(part.UpdatedAt.Add(time.Millisecond))
+		} else {
+			part.UpdatedAt = lo.ToPtr(updatedAt)
+		}
+
+		rec.Put = append(rec.Put, part)
+		previous[uuid] = existing
+	}
+
+	if len(rec.Put) == 0 {
+		return 0, nil
+	}
+	if err := r.commit(rec); err != nil {
+		return 0, err
+	}
+	for _, part := range rec.Put {
+		r.events.publish(model.PartEventTypeUpdated, part.Uuid, part, previous[part.Uuid])
+	}
+
+	return len(rec.Put), nil
+}
@@ -0,0 +1,71 @@
+package part
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+type ManufacturerTestSuite struct {
+	suite.Suite
+	ctx       context.Context
+	repo      *Repository
+	updatedAt time.Time
+}
+
+func (s *ManufacturerTestSuite) SetupTest() {
+	s.ctx = context.Background()
+	s.repo = NewPartRepository()
+	s.updatedAt = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	parts := []*model.Part{
+		{Uuid: "uuid-1", Manufacturer: &model.Manufacturer{Uuid: "m-1", Name: "SpaceTech"}, UpdatedAt: lo.ToPtr(s.updatedAt)},
+		{Uuid: "uuid-2", Manufacturer: &model.Manufacturer{Name: "SpaceTech"}, UpdatedAt: lo.ToPtr(s.updatedAt)},
+		{Uuid: "uuid-3", UpdatedAt: lo.ToPtr(s.updatedAt)},
+	}
+	for _, p := range parts {
+		s.Require().NoError(s.repo.Create(s.ctx, p))
+	}
+}
+
+func (s *ManufacturerTestSuite) TestUpdateManufacturer_OnlyReferencingParts() {
+	renamed := &model.Manufacturer{Uuid: "m-1", Name: "SpaceTech Inc", Country: "USA"}
+
+	updated, err := s.repo.UpdateManufacturer(s.ctx, renamed, s.updatedAt.Add(time.Hour))
+	s.Require().NoError(err)
+	s.Equal(1, updated)
+
+	part, err := s.repo.Get(s.ctx, "uuid-1")
+	s.Require().NoError(err)
+	s.Equal(renamed, part.Manufacturer)
+	s.Equal(s.updatedAt.Add(time.Hour), *part.UpdatedAt)
+
+	legacy, err := s.repo.Get(s.ctx, "uuid-2")
+	s.Require().NoError(err)
+	s.Equal("SpaceTech", legacy.Manufacturer.Name)
+}
+
+func (s *ManufacturerTestSuite) TestUpdateManufacturer_UpdatedAtAlwaysAdvances() {
+	_, err := s.repo.UpdateManufacturer(s.ctx, &model.Manufacturer{Uuid: "m-1", Name: "SpaceTech"}, s.updatedAt)
+	s.Require().NoError(err)
+
+	part, err := s.repo.Get(s.ctx, "uuid-1")
+	s.Require().NoError(err)
+	s.True(part.UpdatedAt.After(s.updatedAt))
+}
+
+func (s *ManufacturerTestSuite) TestFilterByManufacturerUuids() {
+	parts, err := s.repo.List(s.ctx, &model.PartsQuery{Filter: &model.PartsFilter{ManufacturerUuids: []string{"m-1"}}})
+	s.Require().NoError(err)
+	s.Require().Len(parts, 1)
+	s.Equal("uuid-1", parts[0].Uuid)
+}
+
+func TestManufacturerTestSuite(t *testing.T) {
+	suite.Run(t, new(ManufacturerTestSuite))
+}
@@ -0,0 +1,340 @@
+package part
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+const (
+	snapshotFileName = "parts.snapshot"
+	walFileName      = "parts.wal"
+
+	// walFrameHeaderSize - длина и контрольная сумма записи журнала, по 4 байта
+	walFrameHeaderSize = 8
+)
+
+var errStoreClosed = errors.New("parts store is closed")
+
+// walRecord - запись журнала предзаписи: одно изменение репозитория, которое при восстановлении
+// применяется целиком или не применяется вовсе
+type walRecord struct {
+	// Seq - номер записи. Записи с номером не больше snapshotState.LastSeq уже учтены в снимке.
+	Seq       uint64
+	Put       []*model.Part
+	Delete    []string
+	Movements []*model.StockMovement
+}
+
+// snapshotState - содержимое файла снимка
+type snapshotState struct {
+	LastSeq   uint64
+	Parts     map[string]*model.Part
+	Movements map[string][]*model.StockMovement
+}
+
+// store - файлы репозитория на диске: снимок и журнал предзаписи изменений после него.
+// Запись в журнал синхронизируется с диском до того, как изменение станет видно в памяти.
+type store struct {
+	mu  sync.Mutex
+	dir string
+	// wal - журнал предзаписи, nil после Close
+	wal *os.File
+	// size - длина журнала без недописанного хвоста
+	size int64
+	// seq - номер последней записи в журнале или снимке
+	seq uint64
+}
+
+// NewPersistentPartRepository создаёт репозиторий, который сохраняет данные в каталоге dir:
+// восстанавливает их из снимка и журнала предзаписи, а затем записывает в журнал каждое изменение.
+// Журнал событий Watch не сохраняется, поэтому токены возобновления после перезапуска недействительны.
+func NewPersistentPartRepository(dir string) (*Repository, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create parts data directory: %w", err)
+	}
+
+	r := NewPartRepository()
+
+	state, err := readSnapshot(filepath.Join(dir, snapshotFileName))
+	if err != nil {
+		return nil, err
+	}
+	if state.Parts != nil {
+		r.parts = state.Parts
+	}
+	if state.Movements != nil {
+		r.movements = state.Movements
+	}
+
+	wal, err := os.OpenFile(filepath.Join(dir, walFileName), os.O_RDWR|os.O_CREATE|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open parts WAL: %w", err)
+	}
+
+	s := &store{dir: dir, wal: wal, seq: state.LastSeq}
+	if err := s.replay(r.apply); err != nil {
+		_ = wal.Close()
+		return nil, err
+	}
+	r.store = s
+
+	backfillLifecycleStates(r.parts)
+
+	return r, nil
+}
+
+// Snapshot сохраняет детали и журнал движений в файл снимка и очищает журнал предзаписи.
+// Для репозитория без сохранения на диск ничего не делает.
+func (r *Repository) Snapshot() error {
+	if r.store == nil {
+		return nil
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.store.snapshot(r.parts, r.movements)
+}
+
+// Close сохраняет снимок и закрывает журнал предзаписи. Изменения после Close возвращают ошибку.
+func (r *Repository) Close() error {
+	if r.store == nil {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.store.close(r.parts, r.movements)
+}
+
+// commit записывает изменение в журнал предзаписи (если репозиторий сохраняется на диск)
+// и применяет его. Вызывается под r.mu.
+func (r *Repository) commit(rec *walRecord) error {
+	if r.store != nil {
+		if err := r.store.append(rec); err != nil {
+			return err
+		}
+	}
+
+	r.apply(rec)
+	return nil
+}
+
+func (r *Repository) apply(rec *walRecord) {
+	for _, part := range rec.Put {
+		r.parts[part.Uuid] = part
+	}
+	for _, uuid := range rec.Delete {
+		delete(r.parts, uuid)
+	}
+	for _, movement := range rec.Movements {
+		r.movements[movement.PartUuid] = append(r.movements[movement.PartUuid], movement)
+	}
+}
+
+// backfillLifecycleStates переводит в ACTIVE детали, сохранённые до появления жизненного цикла.
+// Изменение не пишется в журнал: при следующем восстановлении оно повторится, пока его не сохранит снимок.
+func backfillLifecycleStates(parts map[string]*model.Part) {
+	for _, part := range parts {
+		if part.LifecycleState == model.LifecycleStateUnspecified {
+			part.LifecycleState = model.LifecycleStateActive
+		}
+	}
+}
+
+func readSnapshot(path string) (*snapshotState, error) {
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return &snapshotState{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open parts snapshot: %w", err)
+	}
+	defer func() { _ = f.Close() }()
+
+	var state snapshotState
+	if err := gob.NewDecoder(bufio.NewReader(f)).Decode(&state); err != nil {
+		return nil, fmt.Errorf("failed to decode parts snapshot: %w", err)
+	}
+
+	return &state, nil
+}
+
+// replay применяет записи журнала, не учтённые в снимке. Недописанный или повреждённый хвост
+// (сбой во время записи) отбрасывается, чтобы следующие записи не оказались за ним.
+func (s *store) replay(apply func(*walRecord)) error {
+	reader := bufio.NewReader(s.wal)
+	header := make([]byte, walFrameHeaderSize)
+	for {
+		if _, err := io.ReadFull(reader, header); err != nil {
+			break
+		}
+		payload := make([]byte, binary.BigEndian.Uint32(header[:4]))
+		if _, err := io.ReadFull(reader, payload); err != nil {
+			break
+		}
+		if crc32.ChecksumIEEE(payload) != binary.BigEndian.Uint32(header[4:]) {
+			break
+		}
+
+		var rec walRecord
+		if err := gob.NewDecoder(bytes.NewReader(payload)).Decode(&rec); err != nil {
+			break
+		}
+		s.size += int64(walFrameHeaderSize + len(payload))
+
+		if rec.Seq <= s.seq {
+			continue
+		}
+		apply(&rec)
+		s.seq = rec.Seq
+	}
+
+	if err := s.wal.Truncate(s.size); err != nil {
+		return fmt.Errorf("failed to truncate parts WAL: %w", err)
+	}
+
+	return nil
+}
+
+func (s *store) append(rec *walRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.wal == nil {
+		return errStoreClosed
+	}
+
+	rec.Seq = s.seq + 1
+
+	var payload bytes.Buffer
+	if err := gob.NewEncoder(&payload).Encode(rec); err != nil {
+		return fmt.Errorf("failed to encode parts WAL record: %w", err)
+	}
+
+	frame := make([]byte, walFrameHeaderSize, walFrameHeaderSize+payload.Len())
+	binary.BigEndian.PutUint32(frame[:4], uint32(payload.Len()))
+	binary.BigEndian.PutUint32(frame[4:], crc32.ChecksumIEEE(payload.Bytes()))
+	frame = append(frame, payload.Bytes()...)
+
+	if _, err := s.wal.Write(frame); err != nil {
+		// Недописанная запись отрезается, иначе восстановление остановится на ней
+		_ = s.wal.Truncate(s.size)
+		return fmt.Errorf("failed to write parts WAL: %w", err)
+	}
+	if err := s.wal.Sync(); err != nil {
+		_ = s.wal.Truncate(s.size)
+		return fmt.Errorf("failed to sync parts WAL: %w", err)
+	}
+
+	s.size += int64(len(frame))
+	s.seq = rec.Seq
+
+	return nil
+}
+
+// snapshot записывает снимок во временный файл и заменяет им прежний, так что при сбое на диске
+// остаётся целый старый или целый новый снимок. Журнал очищается только после замены: если сбой
+// произойдёт раньше, записи журнала, уже учтённые в снимке, пропускаются при восстановлении по номеру.
+func (s *store) snapshot(parts map[string]*model.Part, movements map[string][]*model.StockMovement) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.wal == nil {
+		return errStoreClosed
+	}
+
+	return s.writeSnapshot(&snapshotState{LastSeq: s.seq, Parts: parts, Movements: movements})
+}
+
+func (s *store) close(parts map[string]*model.Part, movements map[string][]*model.StockMovement) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.wal == nil {
+		return nil
+	}
+
+	err := s.writeSnapshot(&snapshotState{LastSeq: s.seq, Parts: parts, Movements: movements})
+	if cerr := s.wal.Close(); cerr != nil {
+		err = errors.Join(err, fmt.Errorf("failed to close parts WAL: %w", cerr))
+	}
+	s.wal = nil
+
+	return err
+}
+
+func (s *store) writeSnapshot(state *snapshotState) error {
+	path := filepath.Join(s.dir, snapshotFileName)
+	tmp := path + ".tmp"
+
+	if err := writeFileSynced(tmp, func(w io.Writer) error {
+		return gob.NewEncoder(w).Encode(state)
+	}); err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("failed to write parts snapshot: %w", err)
+	}
+
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("failed to replace parts snapshot: %w", err)
+	}
+	if err := syncDir(s.dir); err != nil {
+		return fmt.Errorf("failed to sync parts data directory: %w", err)
+	}
+
+	if err := s.wal.Truncate(0); err != nil {
+		return fmt.Errorf("failed to truncate parts WAL: %w", err)
+	}
+	if err := s.wal.Sync(); err != nil {
+		return fmt.Errorf("failed to sync parts WAL: %w", err)
+	}
+	s.size = 0
+
+	return nil
+}
+
+func writeFileSynced(path string, write func(io.Writer) error) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	w := bufio.NewWriter(f)
+	if err := write(w); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := w.Flush(); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+// syncDir сохраняет на диск запись каталога, чтобы переименование файла пережило сбой
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = d.Close() }()
+
+	return d.Sync()
+}
@@ -0,0 +1,249 @@
+package part
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+type PersistenceTestSuite struct {
+	suite.Suite
+	ctx       context.Context
+	dir       string
+	repo      *Repository
+	updatedAt time.Time
+}
+
+func (s *PersistenceTestSuite) SetupTest() {
+	s.ctx = context.Background()
+	s.dir = filepath.Join(s.T().TempDir(), "parts")
+	s.updatedAt = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.repo = s.open()
+}
+
+func (s *PersistenceTestSuite) TearDownTest() {
+	s.NoError(s.repo.Close())
+}
+
+func (s *PersistenceTestSuite) open() *Repository {
+	repo, err := NewPersistentPartRepository(s.dir)
+	s.Require().NoError(err)
+	return repo
+}
+
+// reopen открывает каталог заново, не закрывая прежний репозиторий, как после аварийного завершения
+func (s *PersistenceTestSuite) reopen() {
+	s.repo = s.open()
+}
+
+func (s *PersistenceTestSuite) part(id string) *model.Part {
+	return &model.Part{
+		Uuid:          id,
+		Name:          "Part " + id,
+		Price:         100,
+		StockQuantity: 10,
+		Tags:          []string{"heavy"},
+		Metadata:      map[string]any{"thrust": int64(1000), "reusable": true},
+		CreatedAt:     lo.ToPtr(s.updatedAt),
+		UpdatedAt:     lo.ToPtr(s.updatedAt),
+	}
+}
+
+func (s *PersistenceTestSuite) movement(partUuid string, delta int64) *model.StockMovement {
+	return &model.StockMovement{
+		Uuid:          uuid.Must(uuid.NewV7()).String(),
+		PartUuid:      partUuid,
+		WarehouseUuid: model.DefaultWarehouse.Uuid,
+		Delta:         delta,
+		Reason:        model.StockMovementReasonCorrection,
+		CreatedAt:     s.updatedAt.Add(time.Hour),
+	}
+}
+
+func (s *PersistenceTestSuite) walSize() int64 {
+	info, err := os.Stat(filepath.Join(s.dir, walFileName))
+	s.Require().NoError(err)
+	return info.Size()
+}
+
+func (s *PersistenceTestSuite) movementsOf(partUuid string) []*model.StockMovement {
+	movements, err := s.repo.ListStockMovements(s.ctx, &model.StockMovementsQuery{PartUuid: partUuid})
+	s.Require().NoError(err)
+	return movements
+}
+
+func (s *PersistenceTestSuite) TestReplaysWAL() {
+	s.Require().NoError(s.repo.Create(s.ctx, s.part("uuid-1")))
+	s.Require().NoError(s.repo.Create(s.ctx, s.part("uuid-2")))
+	s.Require().NoError(s.repo.AdjustStock(s.ctx, s.movement("uuid-1", -3)))
+
+	renamed := s.part("uuid-2")
+	renamed.Name = "Renamed"
+	s.Require().NoError(s.repo.Update(s.ctx, renamed, s.updatedAt))
+	s.Require().NoError(s.repo.Delete(s.ctx, "uuid-1"))
+	s.Require().NoError(s.repo.Create(s.ctx, s.part("uuid-3")))
+
+	s.reopen()
+
+	_, err := s.repo.Get(s.ctx, "uuid-1")
+	s.ErrorIs(err, model.ErrPartNotFound)
+
+	part, err := s.repo.Get(s.ctx, "uuid-2")
+	s.Require().NoError(err)
+	s.Equal("Renamed", part.Name)
+	s.Equal(map[string]any{"thrust": int64(1000), "reusable": true}, part.Metadata)
+
+	_, err = s.repo.Get(s.ctx, "uuid-3")
+	s.NoError(err)
+
+	// Журнал движений удалённой детали сохраняется, как и без диска
+	movements := s.movementsOf("uuid-1")
+	s.Require().Len(movements, 1)
+	s.Equal(int64(7), movements[0].QuantityAfter)
+}
+
+func (s *PersistenceTestSuite) TestSnapshotTruncatesWAL() {
+	s.Require().NoError(s.repo.Create(s.ctx, s.part("uuid-1")))
+	s.Require().NoError(s.repo.AdjustStock(s.ctx, s.movement("uuid-1", 5)))
+	s.Positive(s.walSize())
+
+	s.Require().NoError(s.repo.Snapshot())
+	s.Zero(s.walSize())
+
+	s.Require().NoError(s.repo.AdjustStock(s.ctx, s.movement("uuid-1", 1)))
+
+	s.reopen()
+
+	part, err := s.repo.Get(s.ctx, "uuid-1")
+	s.Require().NoError(err)
+	s.Equal(int64(16), part.StockQuantity)
+	s.Len(s.movementsOf("uuid-1"), 2)
+}
+
+func (s *PersistenceTestSuite) TestCloseWritesSnapshot() {
+	s.Require().NoError(s.repo.Create(s.ctx, s.part("uuid-1")))
+	s.Require().NoError(s.repo.Close())
+
+	s.Zero(s.walSize())
+	s.Error(s.repo.Create(s.ctx, s.part("uuid-2")))
+
+	s.reopen()
+
+	_, err := s.repo.Get(s.ctx, "uuid-1")
+	s.NoError(err)
+	_, err = s.repo.Get(s.ctx, "uuid-2")
+	s.ErrorIs(err, model.ErrPartNotFound)
+}
+
+func (s *PersistenceTestSuite) TestUpdateManufacturerIsOneRecord() {
+	manufacturer := &model.Manufacturer{Uuid: "m-1", Name: "Rocketdyne"}
+	for _, id := range []string{"uuid-1", "uuid-2"} {
+		part := s.part(id)
+		part.Manufacturer = lo.ToPtr(*manufacturer)
+		s.Require().NoError(s.repo.Create(s.ctx, part))
+	}
+	before := s.walSize()
+
+	manufacturer.Country = "US"
+	updated, err := s.repo.UpdateManufacturer(s.ctx, manufacturer, s.updatedAt.Add(time.Hour))
+	s.Require().NoError(err)
+	s.Equal(2, updated)
+
+	// Обрезка журнала посередине записи отбрасывает обновление обеих деталей
+	s.Require().NoError(os.Truncate(filepath.Join(s.dir, walFileName), s.walSize()-1))
+	s.reopen()
+
+	for _, id := range []string{"uuid-1", "uuid-2"} {
+		part, err := s.repo.Get(s.ctx, id)
+		s.Require().NoError(err)
+		s.Empty(part.Manufacturer.Country, id)
+	}
+	s.Equal(before, s.walSize())
+}
+
+func (s *PersistenceTestSuite) TestDiscardsTornWALTail() {
+	s.Require().NoError(s.repo.Create(s.ctx, s.part("uuid-1")))
+	size := s.walSize()
+
+	wal, err := os.OpenFile(filepath.Join(s.dir, walFileName), os.O_WRONLY|os.O_APPEND, 0o644)
+	s.Require().NoError(err)
+	_, err = wal.Write([]byte{0, 0, 1, 0, 42, 42})
+	s.Require().NoError(err)
+	s.Require().NoError(wal.Close())
+
+	s.reopen()
+	s.Equal(size, s.walSize())
+
+	// Новые записи продолжают журнал после последней целой и восстанавливаются
+	s.Require().NoError(s.repo.Create(s.ctx, s.part("uuid-2")))
+	s.reopen()
+
+	count, err := s.repo.Count(s.ctx, nil)
+	s.Require().NoError(err)
+	s.Equal(2, count)
+}
+
+func (s *PersistenceTestSuite) TestSkipsWALRecordsAlreadyInSnapshot() {
+	s.Require().NoError(s.repo.Create(s.ctx, s.part("uuid-1")))
+	s.Require().NoError(s.repo.AdjustStock(s.ctx, s.movement("uuid-1", 5)))
+
+	walPath := filepath.Join(s.dir, walFileName)
+	wal, err := os.ReadFile(walPath)
+	s.Require().NoError(err)
+
+	// Сбой после замены снимка, но до очистки журнала: в журнале остались учтённые в снимке записи
+	s.Require().NoError(s.repo.Snapshot())
+	s.Require().NoError(os.WriteFile(walPath, wal, 0o644))
+
+	s.reopen()
+
+	part, err := s.repo.Get(s.ctx, "uuid-1")
+	s.Require().NoError(err)
+	s.Equal(int64(15), part.StockQuantity)
+	s.Len(s.movementsOf("uuid-1"), 1)
+
+	// Нумерация продолжается после учтённых записей, поэтому новые записи не пропускаются
+	s.Require().NoError(s.repo.AdjustStock(s.ctx, s.movement("uuid-1", 1)))
+	s.reopen()
+	s.Len(s.movementsOf("uuid-1"), 2)
+}
+
+func (s *PersistenceTestSuite) TestBackfillsLifecycleState() {
+	legacy := s.part("uuid-1")
+	draft := s.part("uuid-2")
+	draft.LifecycleState = model.LifecycleStateDraft
+	s.Require().NoError(s.repo.Create(s.ctx, legacy))
+	s.Require().NoError(s.repo.Create(s.ctx, draft))
+
+	s.reopen()
+
+	part, err := s.repo.Get(s.ctx, "uuid-1")
+	s.Require().NoError(err)
+	s.Equal(model.LifecycleStateActive, part.LifecycleState)
+
+	part, err = s.repo.Get(s.ctx, "uuid-2")
+	s.Require().NoError(err)
+	s.Equal(model.LifecycleStateDraft, part.LifecycleState)
+}
+
+func (s *PersistenceTestSuite) TestLeavesNoTemporarySnapshot() {
+	s.Require().NoError(s.repo.Create(s.ctx, s.part("uuid-1")))
+	s.Require().NoError(s.repo.Snapshot())
+
+	entries, err := os.ReadDir(s.dir)
+	s.Require().NoError(err)
+	names := lo.Map(entries, func(e os.DirEntry, _ int) string { return e.Name() })
+	s.ElementsMatch([]string{snapshotFileName, walFileName}, names)
+}
+
+func TestPersistenceTestSuite(t *testing.T) {
+	suite.Run(t, new(PersistenceTestSuite))
+}
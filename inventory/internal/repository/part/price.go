@@ -0,0 +1,22 @@
+package part
+
+import (
+	"context"
+	"time"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+func (r *Repository) ListDuePriceChanges(_ context.Context, at time.Time) ([]*model.Part, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var parts []*model.Part
+	for _, part := range r.parts {
+		if part.NextPriceChangeAt != nil && !part.NextPriceChangeAt.After(at) {
+			parts = append(parts, part.Clone())
+		}
+	}
+
+	return parts, nil
+}
@@ -9,10 +9,18 @@ import (
 type Repository struct {
 	mu    sync.RWMutex
 	parts map[string]*model.Part
+	// movements - журнал движений остатка по UUID детали в порядке записи
+	movements map[string][]*model.StockMovement
+	// events - журнал изменений деталей для Watch
+	events *eventLog
+	// store - файлы на диске, nil для репозитория только в памяти (см. NewPersistentPartRepository)
+	store *store
 }
 
 func NewPartRepository() *Repository {
 	return &Repository{
-		parts: make(map[string]*model.Part),
+		parts:     make(map[string]*model.Part),
+		movements: make(map[string][]*model.StockMovement),
+		events:    newEventLog(),
 	}
 }
@@ -0,0 +1,17 @@
+package part
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/repository"
+	"github.com/bogdanovds/rocket_factory/inventory/internal/repository/contracttest"
+)
+
+// TestSearchContract проверяет полнотекстовый поиск in-memory репозитория общим контрактным набором
+func TestSearchContract(t *testing.T) {
+	suite.Run(t, &contracttest.SearchSuite{
+		NewRepository: func() repository.PartRepository { return NewPartRepository() },
+	})
+}
@@ -0,0 +1,96 @@
+package part
+
+import (
+	"context"
+	"time"
+
+	"github.com/samber/lo"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+func (r *Repository) AdjustStock(_ context.Context, movement *model.StockMovement) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	part, exists := r.parts[movement.PartUuid]
+	if !exists {
+		return model.ErrPartNotFound
+	}
+
+	updated := part.Clone()
+	if err := updated.ApplyStockDelta(movement.WarehouseUuid, movement.Delta); err != nil {
+		return err
+	}
+
+	movement.QuantityAfter = updated.StockQuantity
+
+	return r.saveStockChange(updated, part, movement.CreatedAt, movement)
+}
+
+func (r *Repository) TransferStock(_ context.Context, outgoing, incoming *model.StockMovement) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	part, exists := r.parts[outgoing.PartUuid]
+	if !exists {
+		return model.ErrPartNotFound
+	}
+
+	updated := part.Clone()
+	if err := updated.ApplyStockDelta(outgoing.WarehouseUuid, outgoing.Delta); err != nil {
+		return err
+	}
+	if err := updated.ApplyStockDelta(incoming.WarehouseUuid, incoming.Delta); err != nil {
+		return err
+	}
+
+	outgoing.QuantityAfter = updated.StockQuantity
+	incoming.QuantityAfter = updated.StockQuantity
+
+	return r.saveStockChange(updated, part, outgoing.CreatedAt, outgoing, incoming)
+}
+
+// saveStockChange сохраняет деталь с изменённым остатком вместе с движениями. Как и в MongoDB,
+// updated_at строго растёт, чтобы UpdatePart с устаревшими данными не прошёл проверку конкурентного изменения.
+func (r *Repository) saveStockChange(updated, prev *model.Part, at time.Time, movements ...*model.StockMovement) error {
+	updatedAt := at
+	if prev.UpdatedAt != nil && !updatedAt.After(*prev.UpdatedAt) {
+		updatedAt = prev.UpdatedAt.Add(time.Millisecond)
+	}
+	updated.UpdatedAt = lo.ToPtr(updatedAt)
+
+	rec := &walRecord{Put: []*model.Part{updated}}
+	for _, m := range movements {
+		rec.Movements = append(rec.Movements, lo.ToPtr(*m))
+	}
+	if err := r.commit(rec); err != nil {
+		return err
+	}
+	r.events.publish(model.PartEventTypeUpdated, updated.Uuid, updated, prev)
+
+	return nil
+}
+
+func (r *Repository) ListStockMovements(_ context.Context, query *model.StockMovementsQuery) ([]*model.StockMovement, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	journal := r.movements[query.PartUuid]
+
+	movements := make([]*model.StockMovement, 0, len(journal))
+	for i := len(journal) - 1; i >= 0; i-- {
+		if query.Before != "" && journal[i].Uuid >= query.Before {
+			continue
+		}
+		if query.ReferenceID != "" && journal[i].ReferenceID != query.ReferenceID {
+			continue
+		}
+		if query.Limit > 0 && len(movements) == query.Limit {
+			break
+		}
+		movements = append(movements, lo.ToPtr(*journal[i]))
+	}
+
+	return movements, nil
+}
@@ -0,0 +1,54 @@
+package part
+
+import (
+	"context"
+	"time"
+
+	"github.com/samber/lo"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+func (r *Repository) ListLowStockAlertChanges(_ context.Context) ([]*model.Part, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var parts []*model.Part
+	for _, part := range r.parts {
+		if part.NeedsLowStockAlertUpdate() {
+			parts = append(parts, part.Clone())
+		}
+	}
+
+	return parts, nil
+}
+
+func (r *Repository) SetLowStockAlertedAt(_ context.Context, uuid string, prev, next *time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	existing, exists := r.parts[uuid]
+	if !exists {
+		return model.ErrPartNotFound
+	}
+
+	if !sameTime(existing.LowStockAlertedAt, prev) || (next != nil && !existing.IsLowStock()) {
+		return model.ErrConcurrentModification
+	}
+
+	updated := existing.Clone()
+	updated.LowStockAlertedAt = nil
+	if next != nil {
+		updated.LowStockAlertedAt = lo.ToPtr(*next)
+	}
+
+	return r.commit(&walRecord{Put: []*model.Part{updated}})
+}
+
+// sameTime сравнивает необязательные моменты времени: nil равен только nil
+func sameTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
@@ -0,0 +1,183 @@
+package part
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+type StockTestSuite struct {
+	suite.Suite
+	ctx       context.Context
+	repo      *Repository
+	updatedAt time.Time
+}
+
+func (s *StockTestSuite) SetupTest() {
+	s.ctx = context.Background()
+	s.repo = NewPartRepository()
+	s.updatedAt = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	s.Require().NoError(s.repo.Create(s.ctx, &model.Part{
+		Uuid:          "uuid-1",
+		StockQuantity: 10,
+		UpdatedAt:     lo.ToPtr(s.updatedAt),
+	}))
+}
+
+func (s *StockTestSuite) movement(delta int64) *model.StockMovement {
+	return &model.StockMovement{
+		Uuid:          uuid.Must(uuid.NewV7()).String(),
+		PartUuid:      "uuid-1",
+		WarehouseUuid: model.DefaultWarehouse.Uuid,
+		Delta:         delta,
+		Reason:        model.StockMovementReasonCorrection,
+		CreatedAt:     s.updatedAt,
+	}
+}
+
+func (s *StockTestSuite) transfer(to string, quantity int64) (*model.StockMovement, *model.StockMovement) {
+	outgoing := s.movement(-quantity)
+	outgoing.Reason = model.StockMovementReasonTransfer
+
+	incoming := s.movement(quantity)
+	incoming.Reason = model.StockMovementReasonTransfer
+	incoming.WarehouseUuid = to
+
+	return outgoing, incoming
+}
+
+func (s *StockTestSuite) TestAdjustStock_UpdatesQuantityAndJournal() {
+	first := s.movement(5)
+	s.Require().NoError(s.repo.AdjustStock(s.ctx, first))
+	s.Equal(int64(15), first.QuantityAfter)
+
+	second := s.movement(-15)
+	s.Require().NoError(s.repo.AdjustStock(s.ctx, second))
+	s.Equal(int64(0), second.QuantityAfter)
+
+	part, err := s.repo.Get(s.ctx, "uuid-1")
+	s.Require().NoError(err)
+	s.Equal(int64(0), part.StockQuantity)
+	// updated_at строго растёт, даже если время движения не новее
+	s.True(part.UpdatedAt.After(s.updatedAt))
+
+	movements, err := s.repo.ListStockMovements(s.ctx, &model.StockMovementsQuery{PartUuid: "uuid-1"})
+	s.Require().NoError(err)
+	s.Require().Len(movements, 2)
+	s.Equal(second.Uuid, movements[0].Uuid)
+	s.Equal(first.Uuid, movements[1].Uuid)
+
+	movements, err = s.repo.ListStockMovements(s.ctx, &model.StockMovementsQuery{PartUuid: "uuid-1", Before: second.Uuid, Limit: 5})
+	s.Require().NoError(err)
+	s.Require().Len(movements, 1)
+	s.Equal(first.Uuid, movements[0].Uuid)
+}
+
+func (s *StockTestSuite) TestListStockMovements_ByReference() {
+	sale := s.movement(-2)
+	sale.Reason = model.StockMovementReasonSale
+	sale.ReferenceID = "order-1"
+	s.Require().NoError(s.repo.AdjustStock(s.ctx, s.movement(5)))
+	s.Require().NoError(s.repo.AdjustStock(s.ctx, sale))
+
+	movements, err := s.repo.ListStockMovements(s.ctx, &model.StockMovementsQuery{PartUuid: "uuid-1", ReferenceID: "order-1"})
+	s.Require().NoError(err)
+	s.Require().Len(movements, 1)
+	s.Equal(sale.Uuid, movements[0].Uuid)
+}
+
+func (s *StockTestSuite) TestAdjustStock_RejectsNegativeStock() {
+	err := s.repo.AdjustStock(s.ctx, s.movement(-11))
+	s.ErrorIs(err, model.ErrInsufficientStock)
+
+	part, err := s.repo.Get(s.ctx, "uuid-1")
+	s.Require().NoError(err)
+	s.Equal(int64(10), part.StockQuantity)
+
+	movements, err := s.repo.ListStockMovements(s.ctx, &model.StockMovementsQuery{PartUuid: "uuid-1"})
+	s.Require().NoError(err)
+	s.Empty(movements)
+}
+
+func (s *StockTestSuite) TestAdjustStock_PartNotFound() {
+	m := s.movement(1)
+	m.PartUuid = "missing"
+
+	s.ErrorIs(s.repo.AdjustStock(s.ctx, m), model.ErrPartNotFound)
+}
+
+func (s *StockTestSuite) TestAdjustStock_LegacyStockBelongsToDefaultWarehouse() {
+	part, err := s.repo.Get(s.ctx, "uuid-1")
+	s.Require().NoError(err)
+	s.Equal([]model.StockLevel{{WarehouseUuid: model.DefaultWarehouse.Uuid, Quantity: 10}}, part.StockLevels)
+
+	m := s.movement(-1)
+	m.WarehouseUuid = "launch-site"
+	s.ErrorIs(s.repo.AdjustStock(s.ctx, m), model.ErrInsufficientStock)
+}
+
+func (s *StockTestSuite) TestTransferStock_MovesBetweenWarehouses() {
+	outgoing, incoming := s.transfer("launch-site", 4)
+	s.Require().NoError(s.repo.TransferStock(s.ctx, outgoing, incoming))
+	s.Equal(int64(10), outgoing.QuantityAfter)
+	s.Equal(int64(10), incoming.QuantityAfter)
+
+	part, err := s.repo.Get(s.ctx, "uuid-1")
+	s.Require().NoError(err)
+	s.Equal(int64(10), part.StockQuantity)
+	s.Equal([]model.StockLevel{
+		{WarehouseUuid: model.DefaultWarehouse.Uuid, Quantity: 6},
+		{WarehouseUuid: "launch-site", Quantity: 4},
+	}, part.StockLevels)
+
+	movements, err := s.repo.ListStockMovements(s.ctx, &model.StockMovementsQuery{PartUuid: "uuid-1"})
+	s.Require().NoError(err)
+	s.Len(movements, 2)
+}
+
+func (s *StockTestSuite) TestTransferStock_InsufficientStock() {
+	outgoing, incoming := s.transfer("launch-site", 11)
+	s.ErrorIs(s.repo.TransferStock(s.ctx, outgoing, incoming), model.ErrInsufficientStock)
+
+	part, err := s.repo.Get(s.ctx, "uuid-1")
+	s.Require().NoError(err)
+	s.Equal(int64(10), part.StockIn(model.DefaultWarehouse.Uuid))
+	s.Zero(part.StockIn("launch-site"))
+}
+
+func (s *StockTestSuite) TestSetLowStockAlertedAt_OnlyChangesMark() {
+	low := &model.Part{Uuid: "uuid-low", StockQuantity: 3, ReorderThreshold: 5, UpdatedAt: lo.ToPtr(s.updatedAt)}
+	s.Require().NoError(s.repo.Create(s.ctx, low))
+	alertedAt := s.updatedAt.Add(time.Hour)
+
+	s.Require().NoError(s.repo.SetLowStockAlertedAt(s.ctx, low.Uuid, nil, &alertedAt))
+
+	part, err := s.repo.Get(s.ctx, low.Uuid)
+	s.Require().NoError(err)
+	s.Equal(alertedAt, *part.LowStockAlertedAt)
+	// updated_at не меняется: параллельное обновление по прочитанной ранее версии проходит
+	s.Equal(s.updatedAt, *part.UpdatedAt)
+	s.NoError(s.repo.Update(s.ctx, low, s.updatedAt))
+
+	// Отметка снимается только по поставленному значению
+	s.ErrorIs(s.repo.SetLowStockAlertedAt(s.ctx, low.Uuid, nil, nil), model.ErrConcurrentModification)
+}
+
+func (s *StockTestSuite) TestSetLowStockAlertedAt_RequiresLowStock() {
+	alertedAt := s.updatedAt.Add(time.Hour)
+
+	// У uuid-1 не задан порог дозаказа
+	s.ErrorIs(s.repo.SetLowStockAlertedAt(s.ctx, "uuid-1", nil, &alertedAt), model.ErrConcurrentModification)
+	s.ErrorIs(s.repo.SetLowStockAlertedAt(s.ctx, "missing", nil, &alertedAt), model.ErrPartNotFound)
+}
+
+func TestStockTestSuite(t *testing.T) {
+	suite.Run(t, new(StockTestSuite))
+}
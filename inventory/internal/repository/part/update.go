@@ -0,0 +1,33 @@
+package part
+
+import (
+	"context"
+	"time"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+func (r *Repository) Update(_ context.Context, part *model.Part, prevUpdatedAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	existing, exists := r.parts[part.Uuid]
+	if !exists {
+		return model.ErrPartNotFound
+	}
+
+	if !existing.LastModifiedAt().Equal(prevUpdatedAt) {
+		return model.ErrConcurrentModification
+	}
+
+	// Отметкой об оповещении распоряжается SetLowStockAlertedAt
+	stored := part.Clone()
+	stored.LowStockAlertedAt = existing.Clone().LowStockAlertedAt
+
+	if err := r.commit(&walRecord{Put: []*model.Part{stored}}); err != nil {
+		return err
+	}
+	r.events.publish(model.PartEventTypeUpdated, part.Uuid, stored, existing)
+
+	return nil
+}
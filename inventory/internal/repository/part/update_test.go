@@ -0,0 +1,41 @@
+package part
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+type UpdateTestSuite struct {
+	suite.Suite
+	ctx       context.Context
+	repo      *Repository
+	createdAt time.Time
+}
+
+func (s *UpdateTestSuite) SetupTest() {
+	s.ctx = context.Background()
+	s.repo = NewPartRepository()
+	s.createdAt = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+}
+
+func (s *UpdateTestSuite) TestUpdate_WithoutUpdatedAtComparesCreatedAt() {
+	s.Require().NoError(s.repo.Create(s.ctx, &model.Part{Uuid: "uuid-1", Name: "Old", CreatedAt: lo.ToPtr(s.createdAt)}))
+
+	renamed := &model.Part{Uuid: "uuid-1", Name: "New", CreatedAt: lo.ToPtr(s.createdAt), UpdatedAt: lo.ToPtr(s.createdAt.Add(time.Hour))}
+	s.ErrorIs(s.repo.Update(s.ctx, renamed, s.createdAt.Add(time.Minute)), model.ErrConcurrentModification)
+	s.Require().NoError(s.repo.Update(s.ctx, renamed, s.createdAt))
+
+	part, err := s.repo.Get(s.ctx, "uuid-1")
+	s.Require().NoError(err)
+	s.Equal("New", part.Name)
+}
+
+func TestUpdateTestSuite(t *testing.T) {
+	suite.Run(t, new(UpdateTestSuite))
+}
@@ -0,0 +1,117 @@
+package part
+
+import (
+	"context"
+	"iter"
+	"slices"
+	"strconv"
+	"sync"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+// eventHistorySize - сколько последних событий хранится для возобновления потока.
+// Подписчик, отставший сильнее, получает model.ErrResumeTokenExpired.
+const eventHistorySize = 1024
+
+// eventLog - журнал изменений для подписчиков Watch. События нумеруются подряд,
+// номер события служит токеном возобновления.
+type eventLog struct {
+	mu sync.Mutex
+	// last - номер последнего опубликованного события
+	last uint64
+	// history - последние события с номерами от last-len(history)+1 до last.
+	// События общие для всех подписчиков и после публикации не изменяются.
+	history []*model.PartEvent
+	// wake закрывается при каждой публикации, чтобы разбудить ожидающих подписчиков
+	wake chan struct{}
+}
+
+func newEventLog() *eventLog {
+	return &eventLog{wake: make(chan struct{})}
+}
+
+// publish добавляет событие в журнал. Детали копируются, поэтому вызывающий может
+// и дальше использовать переданные значения.
+func (l *eventLog) publish(eventType model.PartEventType, uuid string, part, previous *model.Part) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.last++
+	event := &model.PartEvent{
+		Type:        eventType,
+		PartUuid:    uuid,
+		Part:        part.Clone(),
+		Previous:    previous.Clone(),
+		ResumeToken: strconv.FormatUint(l.last, 10),
+	}
+
+	if len(l.history) == eventHistorySize {
+		l.history = l.history[1:]
+	}
+	l.history = append(l.history, event)
+
+	close(l.wake)
+	l.wake = make(chan struct{})
+}
+
+// position возвращает номер события, после которого нужно продолжить поток
+func (l *eventLog) position(resumeToken string) (uint64, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if resumeToken == "" {
+		return l.last, nil
+	}
+
+	after, err := strconv.ParseUint(resumeToken, 10, 64)
+	if err != nil || after > l.last {
+		return 0, model.ErrInvalidResumeToken
+	}
+
+	return after, nil
+}
+
+// since возвращает события после номера after и канал, который закроется при следующей публикации
+func (l *eventLog) since(after uint64) ([]*model.PartEvent, <-chan struct{}, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	first := l.last - uint64(len(l.history)) + 1
+	if after+1 < first {
+		return nil, nil, model.ErrResumeTokenExpired
+	}
+
+	return slices.Clone(l.history[after+1-first:]), l.wake, nil
+}
+
+func (r *Repository) Watch(ctx context.Context, resumeToken string) iter.Seq2[*model.PartEvent, error] {
+	return func(yield func(*model.PartEvent, error) bool) {
+		after, err := r.events.position(resumeToken)
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+
+		for {
+			events, wake, err := r.events.since(after)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+
+			for _, event := range events {
+				after++
+				if !yield(event, nil) {
+					return
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-wake:
+			}
+		}
+	}
+}
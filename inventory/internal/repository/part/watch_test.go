@@ -0,0 +1,135 @@
+package part
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+type WatchTestSuite struct {
+	suite.Suite
+	ctx  context.Context
+	repo *Repository
+}
+
+func (s *WatchTestSuite) SetupTest() {
+	s.ctx = context.Background()
+	s.repo = NewPartRepository()
+}
+
+// subscribe читает события потока в фоне, чтобы тест не зависал на ожидании новых изменений
+func (s *WatchTestSuite) subscribe(ctx context.Context, resumeToken string) <-chan *model.PartEvent {
+	events := make(chan *model.PartEvent, 16)
+	go func() {
+		defer close(events)
+		for event, err := range s.repo.Watch(ctx, resumeToken) {
+			if err != nil {
+				return
+			}
+			events <- event
+		}
+	}()
+	return events
+}
+
+func (s *WatchTestSuite) next(events <-chan *model.PartEvent) *model.PartEvent {
+	select {
+	case event := <-events:
+		s.Require().NotNil(event, "stream closed")
+		return event
+	case <-time.After(time.Second):
+		s.FailNow("no event")
+		return nil
+	}
+}
+
+func (s *WatchTestSuite) TestWatch_PublishesChanges() {
+	ctx, cancel := context.WithCancel(s.ctx)
+	defer cancel()
+
+	// Поток, продолженный после первого события, не повторяет его
+	s.Require().NoError(s.repo.Create(s.ctx, &model.Part{Uuid: "uuid-0"}))
+
+	events := s.subscribe(ctx, "1")
+
+	updatedAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.Require().NoError(s.repo.Create(s.ctx, &model.Part{Uuid: "uuid-1", Name: "Engine", UpdatedAt: &updatedAt}))
+	s.Require().NoError(s.repo.Update(s.ctx, &model.Part{Uuid: "uuid-1", Name: "Main Engine"}, updatedAt))
+	s.Require().NoError(s.repo.AdjustStock(s.ctx, &model.StockMovement{Uuid: "m-1", PartUuid: "uuid-1", Delta: 3}))
+	s.Require().NoError(s.repo.Delete(s.ctx, "uuid-1"))
+
+	created := s.next(events)
+	s.Equal(model.PartEventTypeCreated, created.Type)
+	s.Equal("uuid-1", created.PartUuid)
+	s.Nil(created.Previous)
+
+	updated := s.next(events)
+	s.Equal(model.PartEventTypeUpdated, updated.Type)
+	s.Equal("Main Engine", updated.Part.Name)
+	s.Equal("Engine", updated.Previous.Name)
+
+	stock := s.next(events)
+	s.Equal(model.PartEventTypeUpdated, stock.Type)
+	s.Equal(int64(3), stock.Part.StockQuantity)
+
+	deleted := s.next(events)
+	s.Equal(model.PartEventTypeDeleted, deleted.Type)
+	s.Equal("uuid-1", deleted.PartUuid)
+	s.Equal("Main Engine", deleted.Part.Name)
+}
+
+func (s *WatchTestSuite) TestWatch_Resume() {
+	ctx, cancel := context.WithCancel(s.ctx)
+	defer cancel()
+
+	for i := range 3 {
+		s.Require().NoError(s.repo.Create(s.ctx, &model.Part{Uuid: "uuid-" + strconv.Itoa(i)}))
+	}
+
+	first := s.next(s.subscribe(ctx, "1"))
+	s.Equal("uuid-1", first.PartUuid)
+	s.Equal("2", first.ResumeToken)
+}
+
+func (s *WatchTestSuite) TestWatch_InvalidResumeToken() {
+	for _, token := range []string{"abc", "-1", "100"} {
+		for _, err := range s.repo.Watch(s.ctx, token) {
+			s.ErrorIs(err, model.ErrInvalidResumeToken, token)
+			break
+		}
+	}
+}
+
+func (s *WatchTestSuite) TestWatch_ResumeTokenExpired() {
+	for i := range eventHistorySize + 1 {
+		s.Require().NoError(s.repo.Create(s.ctx, &model.Part{Uuid: "uuid-" + strconv.Itoa(i)}))
+	}
+
+	for _, err := range s.repo.Watch(s.ctx, "0") {
+		s.ErrorIs(err, model.ErrResumeTokenExpired)
+		break
+	}
+}
+
+func (s *WatchTestSuite) TestWatch_StopsOnContextCancel() {
+	ctx, cancel := context.WithCancel(s.ctx)
+	events := s.subscribe(ctx, "")
+
+	cancel()
+
+	select {
+	case _, ok := <-events:
+		s.False(ok)
+	case <-time.After(time.Second):
+		s.FailNow("stream did not stop")
+	}
+}
+
+func TestWatchTestSuite(t *testing.T) {
+	suite.Run(t, new(WatchTestSuite))
+}
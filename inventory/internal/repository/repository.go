@@ -2,11 +2,96 @@ package repository
 
 import (
 	"context"
+	"iter"
+	"time"
 
 	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
 )
 
 type PartRepository interface {
 	Get(ctx context.Context, uuid string) (*model.Part, error)
-	List(ctx context.Context) ([]*model.Part, error)
+	// List возвращает детали, удовлетворяющие фильтру запроса, в порядке query.Order,
+	// начиная со следующей после query.After и не более query.Limit штук.
+	List(ctx context.Context, query *model.PartsQuery) ([]*model.Part, error)
+	// Count возвращает количество деталей, удовлетворяющих фильтру (nil - все детали).
+	Count(ctx context.Context, filter *model.PartsFilter) (int, error)
+	// Facets возвращает количество деталей по категориям, странам производителей и тегам. Количество
+	// по измерению считается по фильтру без условий на это измерение (nil - по всем деталям).
+	Facets(ctx context.Context, filter *model.PartsFilter) (*model.PartFacets, error)
+	// Create сохраняет новую деталь. Возвращает model.ErrPartAlreadyExists, если UUID занят.
+	Create(ctx context.Context, part *model.Part) error
+	// Update заменяет деталь, если с момента чтения она не менялась (updated_at совпадает с prevUpdatedAt).
+	// Возвращает model.ErrPartNotFound для отсутствующей детали и model.ErrConcurrentModification,
+	// если деталь успели изменить. Отметку об оповещении о низком остатке не меняет (см. SetLowStockAlertedAt).
+	Update(ctx context.Context, part *model.Part, prevUpdatedAt time.Time) error
+	// Delete удаляет деталь. Возвращает model.ErrPartNotFound для отсутствующей детали.
+	Delete(ctx context.Context, uuid string) error
+	// AdjustStock атомарно изменяет остаток детали на складе movement.WarehouseUuid и общий остаток
+	// на movement.Delta, продвигает updated_at и добавляет движение в журнал, заполняя movement.QuantityAfter.
+	// Возвращает model.ErrPartNotFound для отсутствующей детали и model.ErrInsufficientStock,
+	// если остаток на складе стал бы отрицательным.
+	AdjustStock(ctx context.Context, movement *model.StockMovement) error
+	// TransferStock атомарно применяет оба движения перемещения (списание outgoing и поступление incoming
+	// на другом складе), продвигает updated_at и добавляет их в журнал. Ошибки - как у AdjustStock.
+	TransferStock(ctx context.Context, outgoing, incoming *model.StockMovement) error
+	// ListStockMovements возвращает движения остатка детали от новых к старым.
+	ListStockMovements(ctx context.Context, query *model.StockMovementsQuery) ([]*model.StockMovement, error)
+	// Watch возвращает поток изменений деталей, начиная со следующего после resumeToken события
+	// (пустой токен - с момента начала перебора). Ошибка завершает поток; model.ErrInvalidResumeToken
+	// означает нераспознанный токен, model.ErrResumeTokenExpired - что событий после токена уже нет
+	// в истории. Отмена ctx завершает поток без ошибки.
+	Watch(ctx context.Context, resumeToken string) iter.Seq2[*model.PartEvent, error]
+	// UpdateManufacturer заменяет копию производителя во всех деталях, которые ссылаются на него
+	// по manufacturer.Uuid, и продвигает их updated_at не раньше updatedAt. Возвращает количество
+	// изменённых деталей.
+	UpdateManufacturer(ctx context.Context, manufacturer *model.Manufacturer, updatedAt time.Time) (int, error)
+	// ListDuePriceChanges возвращает детали, у которых запланированная версия цены начала действовать
+	// не позже at (NextPriceChangeAt <= at).
+	ListDuePriceChanges(ctx context.Context, at time.Time) ([]*model.Part, error)
+	// ListLowStockAlertChanges возвращает детали, остаток которых пересёк порог дозаказа после
+	// последней проверки (model.Part.NeedsLowStockAlertUpdate).
+	ListLowStockAlertChanges(ctx context.Context) ([]*model.Part, error)
+	// SetLowStockAlertedAt заменяет только отметку об оповещении о низком остатке (nil - снять), не меняя
+	// updated_at и не порождая событие Watch. Отметка заменяется, если она равна prev, а при установке
+	// деталь ещё и должна быть в низком остатке (model.Part.IsLowStock); иначе возвращает
+	// model.ErrConcurrentModification. Возвращает model.ErrPartNotFound, если детали нет.
+	SetLowStockAlertedAt(ctx context.Context, uuid string, prev, next *time.Time) error
+}
+
+type ManufacturerRepository interface {
+	// Get возвращает производителя. Возвращает model.ErrManufacturerNotFound, если его нет.
+	Get(ctx context.Context, uuid string) (*model.Manufacturer, error)
+	// List возвращает производителей, удовлетворяющих фильтру (nil - всех), упорядоченных по названию.
+	List(ctx context.Context, filter *model.ManufacturersFilter) ([]*model.Manufacturer, error)
+	// Create сохраняет нового производителя. Возвращает model.ErrManufacturerAlreadyExists,
+	// если UUID или название заняты.
+	Create(ctx context.Context, manufacturer *model.Manufacturer) error
+	// Update заменяет производителя. Возвращает model.ErrManufacturerNotFound для отсутствующего
+	// производителя и model.ErrManufacturerAlreadyExists, если название занято другим.
+	Update(ctx context.Context, manufacturer *model.Manufacturer) error
+	// Delete удаляет производителя. Возвращает model.ErrManufacturerNotFound, если его нет.
+	Delete(ctx context.Context, uuid string) error
+}
+
+type CategoryRepository interface {
+	// Get возвращает категорию. Возвращает model.ErrCategoryNotFound, если её нет.
+	Get(ctx context.Context, uuid string) (*model.CategoryNode, error)
+	// List возвращает все категории дерева.
+	List(ctx context.Context) ([]*model.CategoryNode, error)
+	// Create сохраняет новую категорию. Возвращает model.ErrCategoryAlreadyExists, если UUID или slug заняты.
+	Create(ctx context.Context, category *model.CategoryNode) error
+	// Update заменяет категорию. Возвращает model.ErrCategoryNotFound для отсутствующей категории
+	// и model.ErrCategoryAlreadyExists, если slug занят другой.
+	Update(ctx context.Context, category *model.CategoryNode) error
+	// Delete удаляет категорию. Возвращает model.ErrCategoryNotFound, если её нет.
+	Delete(ctx context.Context, uuid string) error
+}
+
+type WarehouseRepository interface {
+	// Get возвращает склад. Возвращает model.ErrWarehouseNotFound, если его нет.
+	Get(ctx context.Context, uuid string) (*model.Warehouse, error)
+	// List возвращает все склады, упорядоченные по коду.
+	List(ctx context.Context) ([]*model.Warehouse, error)
+	// Create сохраняет новый склад. Возвращает model.ErrWarehouseAlreadyExists, если UUID или код заняты.
+	Create(ctx context.Context, warehouse *model.Warehouse) error
 }
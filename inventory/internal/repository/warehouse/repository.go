@@ -0,0 +1,71 @@
+package warehouse
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/samber/lo"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+// Repository - склады в памяти
+type Repository struct {
+	mu         sync.RWMutex
+	warehouses map[string]*model.Warehouse
+}
+
+func NewWarehouseRepository() *Repository {
+	return &Repository{
+		warehouses: make(map[string]*model.Warehouse),
+	}
+}
+
+func (r *Repository) Get(_ context.Context, uuid string) (*model.Warehouse, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	w, exists := r.warehouses[uuid]
+	if !exists {
+		return nil, model.ErrWarehouseNotFound
+	}
+
+	return lo.ToPtr(*w), nil
+}
+
+func (r *Repository) List(_ context.Context) ([]*model.Warehouse, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]*model.Warehouse, 0, len(r.warehouses))
+	for _, w := range r.warehouses {
+		result = append(result, lo.ToPtr(*w))
+	}
+
+	slices.SortFunc(result, func(a, b *model.Warehouse) int {
+		return strings.Compare(a.Code, b.Code)
+	})
+
+	return result, nil
+}
+
+func (r *Repository) Create(_ context.Context, warehouse *model.Warehouse) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.warehouses[warehouse.Uuid]; exists {
+		return model.ErrWarehouseAlreadyExists
+	}
+	// Как уникальный индекс code в MongoDB
+	for _, w := range r.warehouses {
+		if w.Code == warehouse.Code {
+			return model.ErrWarehouseAlreadyExists
+		}
+	}
+
+	r.warehouses[warehouse.Uuid] = lo.ToPtr(*warehouse)
+
+	return nil
+}
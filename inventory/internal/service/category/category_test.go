@@ -0,0 +1,177 @@
+package category
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+const (
+	testTurbopumpUuid = "7c1e1a52-3d0f-4d1c-9a6e-000000000001"
+	testImpellerUuid  = "7c1e1a52-3d0f-4d1c-9a6e-000000000002"
+	testAvionicsUuid  = "7c1e1a52-3d0f-4d1c-9a6e-000000000003"
+)
+
+// testTree - встроенные категории, ветка "Двигатели -> Турбонасосы -> Крыльчатки" и корень "Авионика"
+func testTree() []*model.CategoryNode {
+	engine := model.BuiltinCategories[0]
+	return append(append([]*model.CategoryNode{}, model.BuiltinCategories...),
+		&model.CategoryNode{Uuid: testTurbopumpUuid, Slug: "turbopumps", DisplayName: "Турбонасосы", ParentUuid: engine.Uuid, Legacy: model.CategoryEngine},
+		&model.CategoryNode{Uuid: testImpellerUuid, Slug: "impellers", DisplayName: "Крыльчатки", ParentUuid: testTurbopumpUuid, Legacy: model.CategoryEngine},
+		&model.CategoryNode{Uuid: testAvionicsUuid, Slug: "avionics", DisplayName: "Авионика"},
+	)
+}
+
+func nodeByUuid(uuid string) *model.CategoryNode {
+	for _, n := range testTree() {
+		if n.Uuid == uuid {
+			return n
+		}
+	}
+	return nil
+}
+
+func (s *CategoryServiceTestSuite) TestCreateCategory_InheritsLegacyCategory() {
+	ctx := context.Background()
+	engine := model.BuiltinCategories[0]
+
+	s.mockRepo.On("Get", ctx, engine.Uuid).Return(engine, nil)
+	s.mockRepo.On("Create", ctx, mock.AnythingOfType("*model.CategoryNode")).Return(nil)
+
+	category, err := s.service.CreateCategory(ctx, &model.CategoryNode{
+		Slug:        "heat-shields",
+		DisplayName: "Тепловые экраны",
+		ParentUuid:  engine.Uuid,
+		Legacy:      model.CategoryWing,
+	})
+
+	s.NoError(err)
+	s.NotEmpty(category.Uuid)
+	s.Equal(model.CategoryEngine, category.Legacy)
+}
+
+func (s *CategoryServiceTestSuite) TestCreateCategory_InvalidSlug() {
+	category, err := s.service.CreateCategory(context.Background(), &model.CategoryNode{Slug: "Heat Shields", DisplayName: "Экраны"})
+
+	s.Nil(category)
+	s.ErrorIs(err, model.ErrInvalidCategory)
+}
+
+func (s *CategoryServiceTestSuite) TestCreateCategory_ParentNotFound() {
+	ctx := context.Background()
+
+	s.mockRepo.On("Get", ctx, testAvionicsUuid).Return(nil, model.ErrCategoryNotFound)
+
+	category, err := s.service.CreateCategory(ctx, &model.CategoryNode{Slug: "radar", DisplayName: "Радары", ParentUuid: testAvionicsUuid})
+
+	s.Nil(category)
+	s.ErrorIs(err, model.ErrInvalidCategory)
+}
+
+func (s *CategoryServiceTestSuite) TestListCategories_SubtreeParentsFirst() {
+	ctx := context.Background()
+	engine := model.BuiltinCategories[0]
+
+	s.mockRepo.On("List", ctx).Return(testTree(), nil)
+
+	categories, err := s.service.ListCategories(ctx, engine.Uuid)
+
+	s.Require().NoError(err)
+	s.Require().Len(categories, 3)
+	s.Equal(engine.Uuid, categories[0].Uuid)
+	s.Equal(testTurbopumpUuid, categories[1].Uuid)
+	s.Equal(testImpellerUuid, categories[2].Uuid)
+}
+
+func (s *CategoryServiceTestSuite) TestUpdateCategory_MoveIntoOwnSubtree() {
+	ctx := context.Background()
+	moved := nodeByUuid(testTurbopumpUuid)
+	moved.ParentUuid = testImpellerUuid
+
+	s.mockRepo.On("Get", ctx, testTurbopumpUuid).Return(nodeByUuid(testTurbopumpUuid), nil)
+	s.mockRepo.On("Get", ctx, testImpellerUuid).Return(nodeByUuid(testImpellerUuid), nil)
+	s.mockRepo.On("List", ctx).Return(testTree(), nil)
+
+	category, err := s.service.UpdateCategory(ctx, moved)
+
+	s.Nil(category)
+	s.ErrorIs(err, model.ErrInvalidCategory)
+}
+
+func (s *CategoryServiceTestSuite) TestUpdateCategory_MoveChangingLegacyCategory() {
+	ctx := context.Background()
+	moved := nodeByUuid(testTurbopumpUuid)
+	moved.ParentUuid = testAvionicsUuid
+
+	s.mockRepo.On("Get", ctx, testTurbopumpUuid).Return(nodeByUuid(testTurbopumpUuid), nil)
+	s.mockRepo.On("Get", ctx, testAvionicsUuid).Return(nodeByUuid(testAvionicsUuid), nil)
+
+	category, err := s.service.UpdateCategory(ctx, moved)
+
+	s.Nil(category)
+	s.ErrorIs(err, model.ErrInvalidCategory)
+}
+
+func (s *CategoryServiceTestSuite) TestUpdateCategory_Rename() {
+	ctx := context.Background()
+	renamed := nodeByUuid(testImpellerUuid)
+	renamed.DisplayName = "Рабочие колёса"
+	renamed.Legacy = model.CategoryUnspecified
+
+	s.mockRepo.On("Get", ctx, testImpellerUuid).Return(nodeByUuid(testImpellerUuid), nil)
+	s.mockRepo.On("Update", ctx, mock.AnythingOfType("*model.CategoryNode")).Return(nil)
+
+	category, err := s.service.UpdateCategory(ctx, renamed)
+
+	s.NoError(err)
+	s.Equal("Рабочие колёса", category.DisplayName)
+	s.Equal(model.CategoryEngine, category.Legacy)
+}
+
+func (s *CategoryServiceTestSuite) TestDeleteCategory_Builtin() {
+	err := s.service.DeleteCategory(context.Background(), model.BuiltinCategories[1].Uuid)
+
+	s.ErrorIs(err, model.ErrInvalidCategory)
+}
+
+func (s *CategoryServiceTestSuite) TestDeleteCategory_HasSubcategories() {
+	ctx := context.Background()
+
+	s.mockRepo.On("List", ctx).Return(testTree(), nil)
+
+	err := s.service.DeleteCategory(ctx, testTurbopumpUuid)
+
+	s.ErrorIs(err, model.ErrCategoryInUse)
+}
+
+func (s *CategoryServiceTestSuite) TestDeleteCategory_HasParts() {
+	ctx := context.Background()
+
+	s.mockRepo.On("List", ctx).Return(testTree(), nil)
+	s.mockParts.On("Count", ctx, &model.PartsFilter{CategoryUuids: []string{testImpellerUuid}}).Return(4, nil)
+
+	err := s.service.DeleteCategory(ctx, testImpellerUuid)
+
+	s.ErrorIs(err, model.ErrCategoryInUse)
+}
+
+func (s *CategoryServiceTestSuite) TestDeleteCategory_Success() {
+	ctx := context.Background()
+
+	s.mockRepo.On("List", ctx).Return(testTree(), nil)
+	s.mockParts.On("Count", ctx, &model.PartsFilter{CategoryUuids: []string{testAvionicsUuid}}).Return(0, nil)
+	s.mockRepo.On("Delete", ctx, testAvionicsUuid).Return(nil)
+
+	s.NoError(s.service.DeleteCategory(ctx, testAvionicsUuid))
+}
+
+func (s *CategoryServiceTestSuite) TestEnsureBuiltinCategories_IgnoresExisting() {
+	ctx := context.Background()
+
+	s.mockRepo.On("Create", ctx, mock.AnythingOfType("*model.CategoryNode")).Return(model.ErrCategoryAlreadyExists)
+
+	s.NoError(s.service.EnsureBuiltinCategories(ctx))
+	s.mockRepo.AssertNumberOfCalls(s.T(), "Create", len(model.BuiltinCategories))
+}
@@ -0,0 +1,57 @@
+package category
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+func (s *Service) CreateCategory(ctx context.Context, category *model.CategoryNode) (*model.CategoryNode, error) {
+	category = lo.ToPtr(*category)
+	if category.Uuid == "" {
+		category.Uuid = uuid.NewString()
+	} else if _, err := uuid.Parse(category.Uuid); err != nil {
+		return nil, fmt.Errorf("%w: uuid must be a valid UUID", model.ErrInvalidCategory)
+	}
+
+	if err := category.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Встроенные категории создаются только при запуске сервиса, схема метаданных задаётся отдельно
+	category.Legacy = model.CategoryUnspecified
+	category.MetadataSchema = nil
+	if category.ParentUuid != "" {
+		parent, err := s.parent(ctx, category.ParentUuid)
+		if err != nil {
+			return nil, err
+		}
+		category.Legacy = parent.Legacy
+	}
+
+	if err := s.repo.Create(ctx, category); err != nil {
+		if errors.Is(err, model.ErrCategoryAlreadyExists) {
+			return nil, model.ErrCategoryAlreadyExists
+		}
+		return nil, model.ErrRepositoryOperation
+	}
+
+	return category, nil
+}
+
+func (s *Service) parent(ctx context.Context, uuid string) (*model.CategoryNode, error) {
+	parent, err := s.repo.Get(ctx, uuid)
+	if err != nil {
+		if errors.Is(err, model.ErrCategoryNotFound) {
+			return nil, fmt.Errorf("%w: parent category %s not found", model.ErrInvalidCategory, uuid)
+		}
+		return nil, model.ErrRepositoryOperation
+	}
+
+	return parent, nil
+}
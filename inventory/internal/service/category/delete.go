@@ -0,0 +1,40 @@
+package category
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+func (s *Service) DeleteCategory(ctx context.Context, uuid string) error {
+	if (&model.CategoryNode{Uuid: uuid}).IsBuiltin() {
+		return fmt.Errorf("%w: builtin category cannot be deleted", model.ErrInvalidCategory)
+	}
+
+	tree, err := s.tree(ctx)
+	if err != nil {
+		return err
+	}
+	if children := tree.Children(uuid); len(children) > 0 {
+		return fmt.Errorf("%w: %d subcategories", model.ErrCategoryInUse, len(children))
+	}
+
+	parts, err := s.parts.Count(ctx, &model.PartsFilter{CategoryUuids: []string{uuid}})
+	if err != nil {
+		return model.ErrRepositoryOperation
+	}
+	if parts > 0 {
+		return fmt.Errorf("%w: %d parts", model.ErrCategoryInUse, parts)
+	}
+
+	if err := s.repo.Delete(ctx, uuid); err != nil {
+		if errors.Is(err, model.ErrCategoryNotFound) {
+			return model.ErrCategoryNotFound
+		}
+		return model.ErrRepositoryOperation
+	}
+
+	return nil
+}
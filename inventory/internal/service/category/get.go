@@ -0,0 +1,39 @@
+package category
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+func (s *Service) GetCategory(ctx context.Context, uuid string) (*model.CategoryNode, error) {
+	category, err := s.repo.Get(ctx, uuid)
+	if err != nil {
+		if errors.Is(err, model.ErrCategoryNotFound) {
+			return nil, model.ErrCategoryNotFound
+		}
+		return nil, model.ErrRepositoryOperation
+	}
+
+	return category, nil
+}
+
+func (s *Service) ListCategories(ctx context.Context, rootUuid string) ([]*model.CategoryNode, error) {
+	tree, err := s.tree(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := tree.Get(rootUuid); rootUuid != "" && !ok {
+		return nil, model.ErrCategoryNotFound
+	}
+
+	uuids := tree.Subtree(rootUuid)
+	categories := make([]*model.CategoryNode, len(uuids))
+	for i, uuid := range uuids {
+		categories[i], _ = tree.Get(uuid)
+	}
+
+	return categories, nil
+}
@@ -0,0 +1,55 @@
+package category
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+func (s *Service) SetMetadataSchema(ctx context.Context, uuid string, fields []model.MetadataField) (*model.CategoryNode, error) {
+	if err := model.ValidateMetadataSchema(fields); err != nil {
+		return nil, err
+	}
+
+	tree, err := s.tree(ctx)
+	if err != nil {
+		return nil, err
+	}
+	existing, ok := tree.Get(uuid)
+	if !ok {
+		return nil, model.ErrCategoryNotFound
+	}
+	if err := tree.CheckMetadataSchema(uuid, existing.ParentUuid, fields); err != nil {
+		return nil, err
+	}
+
+	updated := existing.Clone()
+	updated.MetadataSchema = nil
+	for _, f := range fields {
+		// Категория поля - сама категория, в собственной схеме она не хранится
+		f.CategoryUuid = ""
+		updated.MetadataSchema = append(updated.MetadataSchema, f)
+	}
+
+	if err := s.repo.Update(ctx, updated); err != nil {
+		if errors.Is(err, model.ErrCategoryNotFound) {
+			return nil, model.ErrCategoryNotFound
+		}
+		return nil, model.ErrRepositoryOperation
+	}
+
+	return updated, nil
+}
+
+func (s *Service) GetMetadataSchema(ctx context.Context, uuid string) ([]model.MetadataField, error) {
+	tree, err := s.tree(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := tree.Get(uuid); !ok {
+		return nil, model.ErrCategoryNotFound
+	}
+
+	return tree.MetadataSchema(uuid), nil
+}
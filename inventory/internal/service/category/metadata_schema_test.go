@@ -0,0 +1,129 @@
+package category
+
+import (
+	"context"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+// schemaTree - testTree, в котором "Двигатели" задают тягу, а "Крыльчатки" - диаметр
+func schemaTree() []*model.CategoryNode {
+	nodes := testTree()
+	// Встроенные узлы общие для всех тестов, поэтому схема задаётся копии
+	nodes[0] = nodes[0].Clone()
+	nodes[0].MetadataSchema = []model.MetadataField{{Key: "thrust", Type: model.MetadataTypeDouble, Unit: "кН", Required: true}}
+	nodes[len(nodes)-2].MetadataSchema = []model.MetadataField{{Key: "diameter", Type: model.MetadataTypeDouble, Unit: "мм"}}
+	return nodes
+}
+
+func (s *CategoryServiceTestSuite) TestSetMetadataSchema_Success() {
+	ctx := context.Background()
+	fields := []model.MetadataField{
+		{Key: "stages", Type: model.MetadataTypeInt64, Required: true, Range: &model.FloatRange{Min: lo.ToPtr(1.0)}},
+		{Key: "material", Type: model.MetadataTypeString, CategoryUuid: "ignored"},
+	}
+
+	s.mockRepo.On("List", ctx).Return(schemaTree(), nil)
+	s.mockRepo.On("Update", ctx, mock.MatchedBy(func(n *model.CategoryNode) bool {
+		return n.Uuid == testTurbopumpUuid && len(n.MetadataSchema) == 2 && n.MetadataSchema[1].CategoryUuid == ""
+	})).Return(nil)
+
+	category, err := s.service.SetMetadataSchema(ctx, testTurbopumpUuid, fields)
+
+	s.Require().NoError(err)
+	s.Equal("stages", category.MetadataSchema[0].Key)
+	s.Equal("turbopumps", category.Slug)
+}
+
+func (s *CategoryServiceTestSuite) TestSetMetadataSchema_Invalid() {
+	cases := map[string][]model.MetadataField{
+		"empty key":         {{Key: "", Type: model.MetadataTypeString}},
+		"dotted key":        {{Key: "size.max", Type: model.MetadataTypeDouble}},
+		"duplicate key":     {{Key: "stages", Type: model.MetadataTypeInt64}, {Key: "stages", Type: model.MetadataTypeString}},
+		"unknown type":      {{Key: "stages"}},
+		"range on string":   {{Key: "material", Type: model.MetadataTypeString, Range: &model.FloatRange{Max: lo.ToPtr(1.0)}}},
+		"min greater":       {{Key: "stages", Type: model.MetadataTypeInt64, Range: &model.FloatRange{Min: lo.ToPtr(5.0), Max: lo.ToPtr(1.0)}}},
+		"key of ancestor":   {{Key: "thrust", Type: model.MetadataTypeDouble}},
+		"key of descendant": {{Key: "diameter", Type: model.MetadataTypeDouble}},
+	}
+
+	ctx := context.Background()
+	s.mockRepo.On("List", ctx).Return(schemaTree(), nil).Maybe()
+
+	for name, fields := range cases {
+		category, err := s.service.SetMetadataSchema(ctx, testTurbopumpUuid, fields)
+
+		s.Nil(category, name)
+		s.ErrorIs(err, model.ErrInvalidCategory, name)
+	}
+}
+
+func (s *CategoryServiceTestSuite) TestSetMetadataSchema_NotFound() {
+	ctx := context.Background()
+
+	s.mockRepo.On("List", ctx).Return(schemaTree(), nil)
+
+	_, err := s.service.SetMetadataSchema(ctx, "7c1e1a52-3d0f-4d1c-9a6e-0000000000ff", nil)
+
+	s.ErrorIs(err, model.ErrCategoryNotFound)
+}
+
+func (s *CategoryServiceTestSuite) TestGetMetadataSchema_IncludesAncestors() {
+	ctx := context.Background()
+	engine := model.BuiltinCategories[0]
+
+	s.mockRepo.On("List", ctx).Return(schemaTree(), nil)
+
+	fields, err := s.service.GetMetadataSchema(ctx, testImpellerUuid)
+
+	s.Require().NoError(err)
+	s.Require().Len(fields, 2)
+	s.Equal("thrust", fields[0].Key)
+	s.Equal(engine.Uuid, fields[0].CategoryUuid)
+	s.Equal("diameter", fields[1].Key)
+	s.Equal(testImpellerUuid, fields[1].CategoryUuid)
+}
+
+func (s *CategoryServiceTestSuite) TestUpdateCategory_KeepsMetadataSchema() {
+	ctx := context.Background()
+	stored := schemaTree()[len(schemaTree())-2]
+	renamed := nodeByUuid(testImpellerUuid)
+	renamed.DisplayName = "Рабочие колёса"
+
+	s.mockRepo.On("Get", ctx, testImpellerUuid).Return(stored, nil)
+	s.mockRepo.On("Update", ctx, mock.AnythingOfType("*model.CategoryNode")).Return(nil)
+
+	category, err := s.service.UpdateCategory(ctx, renamed)
+
+	s.Require().NoError(err)
+	s.Equal(stored.MetadataSchema, category.MetadataSchema)
+}
+
+func (s *CategoryServiceTestSuite) TestUpdateCategory_MoveConflictingMetadataSchema() {
+	ctx := context.Background()
+	const sensorsUuid, radarUuid = "7c1e1a52-3d0f-4d1c-9a6e-000000000004", "7c1e1a52-3d0f-4d1c-9a6e-000000000005"
+	// "Авионика" уже задаёт дальность: радар с таким же полем под неё перенести нельзя
+	avionics := nodeByUuid(testAvionicsUuid)
+	avionics.MetadataSchema = []model.MetadataField{{Key: "range_km", Type: model.MetadataTypeDouble}}
+	radar := &model.CategoryNode{
+		Uuid: radarUuid, Slug: "radars", DisplayName: "Радары", ParentUuid: sensorsUuid,
+		MetadataSchema: []model.MetadataField{{Key: "range_km", Type: model.MetadataTypeDouble}},
+	}
+	tree := append(schemaTree()[:len(testTree())-1], avionics,
+		&model.CategoryNode{Uuid: sensorsUuid, Slug: "sensors", DisplayName: "Датчики"}, radar)
+	moved := radar.Clone()
+	moved.ParentUuid = testAvionicsUuid
+
+	s.mockRepo.On("Get", ctx, radarUuid).Return(radar, nil)
+	s.mockRepo.On("Get", ctx, testAvionicsUuid).Return(avionics, nil)
+	s.mockRepo.On("List", ctx).Return(tree, nil)
+
+	category, err := s.service.UpdateCategory(ctx, moved)
+
+	s.Nil(category)
+	s.ErrorIs(err, model.ErrInvalidCategory)
+	s.Contains(err.Error(), "range_km")
+}
@@ -0,0 +1,44 @@
+package category
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+	"github.com/bogdanovds/rocket_factory/inventory/internal/repository"
+)
+
+type Service struct {
+	repo  repository.CategoryRepository
+	parts repository.PartRepository
+}
+
+// NewCategoryService создаёт сервис дерева категорий. parts нужен, чтобы не удалить категорию с деталями.
+func NewCategoryService(repo repository.CategoryRepository, parts repository.PartRepository) *Service {
+	return &Service{
+		repo:  repo,
+		parts: parts,
+	}
+}
+
+// EnsureBuiltinCategories создаёт встроенные категории, соответствующие значениям enum Category, если их нет
+func (s *Service) EnsureBuiltinCategories(ctx context.Context) error {
+	for _, node := range model.BuiltinCategories {
+		if err := s.repo.Create(ctx, node); err != nil && !errors.Is(err, model.ErrCategoryAlreadyExists) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// tree загружает дерево категорий целиком: категорий немного, и все операции с деревом
+// (поддеревья, проверка циклов) выполняются в памяти
+func (s *Service) tree(ctx context.Context) (*model.CategoryTree, error) {
+	nodes, err := s.repo.List(ctx)
+	if err != nil {
+		return nil, model.ErrRepositoryOperation
+	}
+
+	return model.NewCategoryTree(nodes), nil
+}
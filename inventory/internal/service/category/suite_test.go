@@ -0,0 +1,35 @@
+package category
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/repository/mocks"
+)
+
+// CategoryServiceTestSuite - тестовый набор для сервиса дерева категорий
+type CategoryServiceTestSuite struct {
+	suite.Suite
+	mockRepo  *mocks.MockCategoryRepository
+	mockParts *mocks.MockPartRepository
+	service   *Service
+}
+
+// SetupTest выполняется перед каждым тестом
+func (s *CategoryServiceTestSuite) SetupTest() {
+	s.mockRepo = mocks.NewMockCategoryRepository()
+	s.mockParts = mocks.NewMockPartRepository()
+	s.service = NewCategoryService(s.mockRepo, s.mockParts)
+}
+
+// TearDownTest выполняется после каждого теста
+func (s *CategoryServiceTestSuite) TearDownTest() {
+	s.mockRepo.AssertExpectations(s.T())
+	s.mockParts.AssertExpectations(s.T())
+}
+
+// TestCategoryServiceTestSuite запускает тестовый набор
+func TestCategoryServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(CategoryServiceTestSuite))
+}
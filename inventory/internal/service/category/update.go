@@ -0,0 +1,75 @@
+package category
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/samber/lo"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+func (s *Service) UpdateCategory(ctx context.Context, category *model.CategoryNode) (*model.CategoryNode, error) {
+	existing, err := s.GetCategory(ctx, category.Uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	updated := lo.ToPtr(*category)
+	updated.Legacy = existing.Legacy
+	updated.MetadataSchema = existing.MetadataSchema
+	if err := updated.Validate(); err != nil {
+		return nil, err
+	}
+
+	if updated.ParentUuid != existing.ParentUuid {
+		if err := s.checkMove(ctx, updated); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.repo.Update(ctx, updated); err != nil {
+		switch {
+		case errors.Is(err, model.ErrCategoryNotFound):
+			return nil, model.ErrCategoryNotFound
+		case errors.Is(err, model.ErrCategoryAlreadyExists):
+			return nil, model.ErrCategoryAlreadyExists
+		default:
+			return nil, model.ErrRepositoryOperation
+		}
+	}
+
+	return updated, nil
+}
+
+// checkMove проверяет перенос категории к новому родителю: встроенные категории остаются корнями,
+// значение enum у деталей поддерева не меняется, а дерево не получает цикла
+func (s *Service) checkMove(ctx context.Context, category *model.CategoryNode) error {
+	if category.IsBuiltin() {
+		return fmt.Errorf("%w: builtin category cannot be moved", model.ErrInvalidCategory)
+	}
+
+	legacy := model.CategoryUnspecified
+	if category.ParentUuid != "" {
+		parent, err := s.parent(ctx, category.ParentUuid)
+		if err != nil {
+			return err
+		}
+		legacy = parent.Legacy
+	}
+	if legacy != category.Legacy {
+		return fmt.Errorf("%w: moving the category would change the legacy category of its parts", model.ErrInvalidCategory)
+	}
+
+	tree, err := s.tree(ctx)
+	if err != nil {
+		return err
+	}
+	if tree.IsDescendant(category.ParentUuid, category.Uuid) {
+		return fmt.Errorf("%w: category cannot be moved under its own subcategory", model.ErrInvalidCategory)
+	}
+
+	// Ключи схем метаданных в ветке не повторяются и после переноса
+	return tree.CheckMetadataSchema(category.Uuid, category.ParentUuid, category.MetadataSchema)
+}
@@ -0,0 +1,84 @@
+package lowstock
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+// CheckLowStock оповещает о деталях, остаток которых опустился до порога дозаказа, по одному разу
+// на каждое падение: отметка об оповещении снимается, когда остаток поднимается выше порога.
+// Деталь, отметку или остаток которой изменили во время проверки, пропускает до следующего вызова. Возвращает количество
+// отправленных оповещений и ошибки тех, что отправить не удалось.
+func (s *Service) CheckLowStock(ctx context.Context) (int, error) {
+	parts, err := s.repo.ListLowStockAlertChanges(ctx)
+	if err != nil {
+		return 0, model.ErrRepositoryOperation
+	}
+
+	sent := 0
+	var errs []error
+	for _, part := range parts {
+		notified, err := s.check(ctx, part)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if notified {
+			sent++
+		}
+	}
+
+	return sent, errors.Join(errs...)
+}
+
+// check сохраняет отметку об оповещении и, если остаток опустился до порога, отправляет оповещение.
+// Отметка сохраняется до отправки: если отметку успели изменить или остаток уже поднялся,
+// оповещение не уходит и не может уйти дважды. Сохраняется только отметка, поэтому проверка
+// не мешает параллельным изменениям детали.
+func (s *Service) check(ctx context.Context, part *model.Part) (bool, error) {
+	low := part.IsLowStock()
+	// Точность MongoDB, чтобы снять отметку по тому же значению при ошибке отправки
+	now := time.Now().UTC().Truncate(time.Millisecond)
+
+	var alertedAt *time.Time
+	if low {
+		alertedAt = &now
+	}
+
+	saved, err := s.setAlertedAt(ctx, part.Uuid, part.LowStockAlertedAt, alertedAt)
+	if err != nil || !saved || !low {
+		return false, err
+	}
+
+	err = s.notifier.NotifyLowStock(ctx, &model.LowStockAlert{
+		PartUuid:         part.Uuid,
+		PartName:         part.Name,
+		StockQuantity:    part.StockQuantity,
+		ReorderThreshold: part.ReorderThreshold,
+		DetectedAt:       now,
+	})
+	if err != nil {
+		// Снимаем отметку, чтобы повторить оповещение при следующей проверке
+		_, uerr := s.setAlertedAt(ctx, part.Uuid, alertedAt, nil)
+		return false, errors.Join(err, uerr)
+	}
+
+	return true, nil
+}
+
+// setAlertedAt сохраняет отметку и сообщает, сохранена ли она. Изменённая или удалённая за время
+// проверки деталь не считается ошибкой.
+func (s *Service) setAlertedAt(ctx context.Context, partUuid string, prev, next *time.Time) (bool, error) {
+	err := s.repo.SetLowStockAlertedAt(ctx, partUuid, prev, next)
+	switch {
+	case err == nil:
+		return true, nil
+	case errors.Is(err, model.ErrConcurrentModification), errors.Is(err, model.ErrPartNotFound):
+		return false, nil
+	default:
+		return false, model.ErrRepositoryOperation
+	}
+}
@@ -0,0 +1,104 @@
+package lowstock
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+func lowPart() *model.Part {
+	updatedAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	return &model.Part{
+		Uuid:             "6ba7b810-9dad-11d1-80b4-00c04fd430d0",
+		Name:             "Porthole",
+		Price:            1500,
+		StockQuantity:    3,
+		ReorderThreshold: 5,
+		Category:         model.CategoryPorthole,
+		CreatedAt:        lo.ToPtr(updatedAt),
+		UpdatedAt:        lo.ToPtr(updatedAt),
+	}
+}
+
+func (s *LowStockServiceTestSuite) TestCheckLowStock_MarksAndNotifies() {
+	ctx := context.Background()
+	part := lowPart()
+
+	s.mockRepo.On("ListLowStockAlertChanges", ctx).Return([]*model.Part{part}, nil)
+	s.mockRepo.On("SetLowStockAlertedAt", ctx, part.Uuid, (*time.Time)(nil), mock.AnythingOfType("*time.Time")).Return(nil)
+	s.mockNotifier.On("NotifyLowStock", ctx, mock.MatchedBy(func(a *model.LowStockAlert) bool {
+		return a.PartUuid == part.Uuid && a.StockQuantity == 3 && a.ReorderThreshold == 5
+	})).Return(nil)
+
+	sent, err := s.service.CheckLowStock(ctx)
+
+	s.NoError(err)
+	s.Equal(1, sent)
+}
+
+func (s *LowStockServiceTestSuite) TestCheckLowStock_RearmsAfterRestock() {
+	ctx := context.Background()
+	part := lowPart()
+	part.StockQuantity = 20
+	part.LowStockAlertedAt = lo.ToPtr(*part.UpdatedAt)
+
+	s.mockRepo.On("ListLowStockAlertChanges", ctx).Return([]*model.Part{part}, nil)
+	s.mockRepo.On("SetLowStockAlertedAt", ctx, part.Uuid, part.LowStockAlertedAt, (*time.Time)(nil)).Return(nil)
+
+	sent, err := s.service.CheckLowStock(ctx)
+
+	s.NoError(err)
+	s.Zero(sent)
+}
+
+func (s *LowStockServiceTestSuite) TestCheckLowStock_SkipsConcurrentlyModified() {
+	ctx := context.Background()
+	part := lowPart()
+
+	s.mockRepo.On("ListLowStockAlertChanges", ctx).Return([]*model.Part{part}, nil)
+	s.mockRepo.On("SetLowStockAlertedAt", ctx, part.Uuid, (*time.Time)(nil), mock.AnythingOfType("*time.Time")).
+		Return(model.ErrConcurrentModification)
+
+	sent, err := s.service.CheckLowStock(ctx)
+
+	s.NoError(err)
+	s.Zero(sent)
+	s.mockNotifier.AssertNotCalled(s.T(), "NotifyLowStock", mock.Anything, mock.Anything)
+}
+
+func (s *LowStockServiceTestSuite) TestCheckLowStock_NotifierFailureClearsMark() {
+	ctx := context.Background()
+	part := lowPart()
+	notifyErr := errors.New("webhook responded with status 503")
+
+	s.mockRepo.On("ListLowStockAlertChanges", ctx).Return([]*model.Part{part}, nil)
+	var alertedAt *time.Time
+	s.mockRepo.On("SetLowStockAlertedAt", ctx, part.Uuid, (*time.Time)(nil), mock.AnythingOfType("*time.Time")).
+		Run(func(args mock.Arguments) { alertedAt = args.Get(3).(*time.Time) }).Return(nil).Once()
+	s.mockNotifier.On("NotifyLowStock", ctx, mock.AnythingOfType("*model.LowStockAlert")).Return(notifyErr)
+	// Снимается именно поставленная отметка
+	s.mockRepo.On("SetLowStockAlertedAt", ctx, part.Uuid, mock.MatchedBy(func(prev *time.Time) bool {
+		return prev != nil && prev == alertedAt
+	}), (*time.Time)(nil)).Return(nil).Once()
+
+	sent, err := s.service.CheckLowStock(ctx)
+
+	s.ErrorIs(err, notifyErr)
+	s.Zero(sent)
+}
+
+func (s *LowStockServiceTestSuite) TestCheckLowStock_RepositoryError() {
+	ctx := context.Background()
+
+	s.mockRepo.On("ListLowStockAlertChanges", ctx).Return(nil, errors.New("connection refused"))
+
+	sent, err := s.service.CheckLowStock(ctx)
+
+	s.ErrorIs(err, model.ErrRepositoryOperation)
+	s.Zero(sent)
+}
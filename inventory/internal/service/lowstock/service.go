@@ -0,0 +1,19 @@
+package lowstock
+
+import (
+	"github.com/bogdanovds/rocket_factory/inventory/internal/notifier"
+	"github.com/bogdanovds/rocket_factory/inventory/internal/repository"
+)
+
+type Service struct {
+	repo     repository.PartRepository
+	notifier notifier.LowStockNotifier
+}
+
+// NewLowStockService создаёт сервис оповещений о низком остатке деталей из repo через notifier
+func NewLowStockService(repo repository.PartRepository, notifier notifier.LowStockNotifier) *Service {
+	return &Service{
+		repo:     repo,
+		notifier: notifier,
+	}
+}
@@ -0,0 +1,36 @@
+package lowstock
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	notifierMocks "github.com/bogdanovds/rocket_factory/inventory/internal/notifier/mocks"
+	"github.com/bogdanovds/rocket_factory/inventory/internal/repository/mocks"
+)
+
+// LowStockServiceTestSuite - тестовый набор для сервиса оповещений о низком остатке
+type LowStockServiceTestSuite struct {
+	suite.Suite
+	mockRepo     *mocks.MockPartRepository
+	mockNotifier *notifierMocks.MockLowStockNotifier
+	service      *Service
+}
+
+// SetupTest выполняется перед каждым тестом
+func (s *LowStockServiceTestSuite) SetupTest() {
+	s.mockRepo = mocks.NewMockPartRepository()
+	s.mockNotifier = notifierMocks.NewMockLowStockNotifier()
+	s.service = NewLowStockService(s.mockRepo, s.mockNotifier)
+}
+
+// TearDownTest выполняется после каждого теста
+func (s *LowStockServiceTestSuite) TearDownTest() {
+	s.mockRepo.AssertExpectations(s.T())
+	s.mockNotifier.AssertExpectations(s.T())
+}
+
+// TestLowStockServiceTestSuite запускает тестовый набор
+func TestLowStockServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(LowStockServiceTestSuite))
+}
@@ -0,0 +1,34 @@
+package manufacturer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+func (s *Service) CreateManufacturer(ctx context.Context, manufacturer *model.Manufacturer) (*model.Manufacturer, error) {
+	manufacturer = lo.ToPtr(*manufacturer)
+	if manufacturer.Uuid == "" {
+		manufacturer.Uuid = uuid.NewString()
+	} else if _, err := uuid.Parse(manufacturer.Uuid); err != nil {
+		return nil, fmt.Errorf("%w: uuid must be a valid UUID", model.ErrInvalidManufacturer)
+	}
+
+	if err := manufacturer.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Create(ctx, manufacturer); err != nil {
+		if errors.Is(err, model.ErrManufacturerAlreadyExists) {
+			return nil, model.ErrManufacturerAlreadyExists
+		}
+		return nil, model.ErrRepositoryOperation
+	}
+
+	return manufacturer, nil
+}
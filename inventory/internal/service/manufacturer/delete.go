@@ -0,0 +1,29 @@
+package manufacturer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+func (s *Service) DeleteManufacturer(ctx context.Context, uuid string) error {
+	// Детали хранят копию производителя, но без записи справочника её нельзя было бы обновить
+	usedBy, err := s.parts.Count(ctx, &model.PartsFilter{ManufacturerUuids: []string{uuid}})
+	if err != nil {
+		return model.ErrRepositoryOperation
+	}
+	if usedBy > 0 {
+		return fmt.Errorf("%w: referenced by %d parts", model.ErrManufacturerInUse, usedBy)
+	}
+
+	if err := s.repo.Delete(ctx, uuid); err != nil {
+		if errors.Is(err, model.ErrManufacturerNotFound) {
+			return model.ErrManufacturerNotFound
+		}
+		return model.ErrRepositoryOperation
+	}
+
+	return nil
+}
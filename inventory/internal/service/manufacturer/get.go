@@ -0,0 +1,29 @@
+package manufacturer
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+func (s *Service) GetManufacturer(ctx context.Context, uuid string) (*model.Manufacturer, error) {
+	manufacturer, err := s.repo.Get(ctx, uuid)
+	if err != nil {
+		if errors.Is(err, model.ErrManufacturerNotFound) {
+			return nil, model.ErrManufacturerNotFound
+		}
+		return nil, model.ErrRepositoryOperation
+	}
+
+	return manufacturer, nil
+}
+
+func (s *Service) ListManufacturers(ctx context.Context, filter *model.ManufacturersFilter) ([]*model.Manufacturer, error) {
+	manufacturers, err := s.repo.List(ctx, filter)
+	if err != nil {
+		return nil, model.ErrRepositoryOperation
+	}
+
+	return manufacturers, nil
+}
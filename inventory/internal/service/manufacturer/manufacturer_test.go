@@ -0,0 +1,103 @@
+package manufacturer
+
+import (
+	"context"
+	"errors"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+const testManufacturerUuid = "6ba7b811-9dad-11d1-80b4-00c04fd430c8"
+
+func spaceTech() *model.Manufacturer {
+	return &model.Manufacturer{Uuid: testManufacturerUuid, Name: "SpaceTech", Country: "USA", Website: "spacetech.com"}
+}
+
+func (s *ManufacturerServiceTestSuite) TestCreateManufacturer_GeneratesUuid() {
+	ctx := context.Background()
+
+	s.mockRepo.On("Create", ctx, mock.AnythingOfType("*model.Manufacturer")).Return(nil)
+
+	manufacturer, err := s.service.CreateManufacturer(ctx, &model.Manufacturer{Name: "SpaceTech"})
+
+	s.NoError(err)
+	s.NotEmpty(manufacturer.Uuid)
+}
+
+func (s *ManufacturerServiceTestSuite) TestCreateManufacturer_NameRequired() {
+	manufacturer, err := s.service.CreateManufacturer(context.Background(), &model.Manufacturer{Name: " "})
+
+	s.Nil(manufacturer)
+	s.ErrorIs(err, model.ErrInvalidManufacturer)
+}
+
+func (s *ManufacturerServiceTestSuite) TestCreateManufacturer_AlreadyExists() {
+	ctx := context.Background()
+
+	s.mockRepo.On("Create", ctx, spaceTech()).Return(model.ErrManufacturerAlreadyExists)
+
+	manufacturer, err := s.service.CreateManufacturer(ctx, spaceTech())
+
+	s.Nil(manufacturer)
+	s.ErrorIs(err, model.ErrManufacturerAlreadyExists)
+}
+
+func (s *ManufacturerServiceTestSuite) TestUpdateManufacturer_UpdatesPartCopies() {
+	ctx := context.Background()
+	renamed := spaceTech()
+	renamed.Name = "SpaceTech Inc"
+
+	s.mockRepo.On("Update", ctx, renamed).Return(nil)
+	s.mockParts.On("UpdateManufacturer", ctx, renamed, mock.AnythingOfType("time.Time")).Return(3, nil)
+
+	manufacturer, updatedParts, err := s.service.UpdateManufacturer(ctx, renamed)
+
+	s.NoError(err)
+	s.Equal("SpaceTech Inc", manufacturer.Name)
+	s.Equal(3, updatedParts)
+}
+
+func (s *ManufacturerServiceTestSuite) TestUpdateManufacturer_NotFound() {
+	ctx := context.Background()
+
+	s.mockRepo.On("Update", ctx, spaceTech()).Return(model.ErrManufacturerNotFound)
+
+	manufacturer, _, err := s.service.UpdateManufacturer(ctx, spaceTech())
+
+	s.Nil(manufacturer)
+	s.ErrorIs(err, model.ErrManufacturerNotFound)
+	s.mockParts.AssertNotCalled(s.T(), "UpdateManufacturer", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *ManufacturerServiceTestSuite) TestDeleteManufacturer_Success() {
+	ctx := context.Background()
+
+	s.mockParts.On("Count", ctx, &model.PartsFilter{ManufacturerUuids: []string{testManufacturerUuid}}).Return(0, nil)
+	s.mockRepo.On("Delete", ctx, testManufacturerUuid).Return(nil)
+
+	s.NoError(s.service.DeleteManufacturer(ctx, testManufacturerUuid))
+}
+
+func (s *ManufacturerServiceTestSuite) TestDeleteManufacturer_ReferencedByParts() {
+	ctx := context.Background()
+
+	s.mockParts.On("Count", ctx, &model.PartsFilter{ManufacturerUuids: []string{testManufacturerUuid}}).Return(2, nil)
+
+	err := s.service.DeleteManufacturer(ctx, testManufacturerUuid)
+
+	s.ErrorIs(err, model.ErrManufacturerInUse)
+	s.mockRepo.AssertNotCalled(s.T(), "Delete", ctx, testManufacturerUuid)
+}
+
+func (s *ManufacturerServiceTestSuite) TestGetManufacturer_RepositoryError() {
+	ctx := context.Background()
+
+	s.mockRepo.On("Get", ctx, testManufacturerUuid).Return(nil, errors.New("connection lost"))
+
+	manufacturer, err := s.service.GetManufacturer(ctx, testManufacturerUuid)
+
+	s.Nil(manufacturer)
+	s.ErrorIs(err, model.ErrRepositoryOperation)
+}
@@ -0,0 +1,19 @@
+package manufacturer
+
+import (
+	"github.com/bogdanovds/rocket_factory/inventory/internal/repository"
+)
+
+type Service struct {
+	repo  repository.ManufacturerRepository
+	parts repository.PartRepository
+}
+
+// NewManufacturerService создаёт сервис справочника производителей. parts - репозиторий деталей,
+// в которых хранятся копии производителей.
+func NewManufacturerService(repo repository.ManufacturerRepository, parts repository.PartRepository) *Service {
+	return &Service{
+		repo:  repo,
+		parts: parts,
+	}
+}
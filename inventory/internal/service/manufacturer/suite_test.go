@@ -0,0 +1,35 @@
+package manufacturer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/repository/mocks"
+)
+
+// ManufacturerServiceTestSuite - тестовый набор для сервиса справочника производителей
+type ManufacturerServiceTestSuite struct {
+	suite.Suite
+	mockRepo  *mocks.MockManufacturerRepository
+	mockParts *mocks.MockPartRepository
+	service   *Service
+}
+
+// SetupTest выполняется перед каждым тестом
+func (s *ManufacturerServiceTestSuite) SetupTest() {
+	s.mockRepo = mocks.NewMockManufacturerRepository()
+	s.mockParts = mocks.NewMockPartRepository()
+	s.service = NewManufacturerService(s.mockRepo, s.mockParts)
+}
+
+// TearDownTest выполняется после каждого теста
+func (s *ManufacturerServiceTestSuite) TearDownTest() {
+	s.mockRepo.AssertExpectations(s.T())
+	s.mockParts.AssertExpectations(s.T())
+}
+
+// TestManufacturerServiceTestSuite запускает тестовый набор
+func TestManufacturerServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ManufacturerServiceTestSuite))
+}
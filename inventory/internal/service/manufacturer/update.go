@@ -0,0 +1,35 @@
+package manufacturer
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+// UpdateManufacturer сохраняет производителя, затем обновляет его копию в деталях. Если второй
+// шаг не удался, повторный вызов с теми же данными доводит копии до актуального состояния.
+func (s *Service) UpdateManufacturer(ctx context.Context, manufacturer *model.Manufacturer) (*model.Manufacturer, int, error) {
+	if err := manufacturer.Validate(); err != nil {
+		return nil, 0, err
+	}
+
+	if err := s.repo.Update(ctx, manufacturer); err != nil {
+		switch {
+		case errors.Is(err, model.ErrManufacturerNotFound):
+			return nil, 0, model.ErrManufacturerNotFound
+		case errors.Is(err, model.ErrManufacturerAlreadyExists):
+			return nil, 0, model.ErrManufacturerAlreadyExists
+		default:
+			return nil, 0, model.ErrRepositoryOperation
+		}
+	}
+
+	updatedParts, err := s.parts.UpdateManufacturer(ctx, manufacturer, time.Now().UTC().Truncate(time.Millisecond))
+	if err != nil {
+		return nil, 0, model.ErrRepositoryOperation
+	}
+
+	return manufacturer, updatedParts, nil
+}
@@ -0,0 +1,79 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+// MockCategoryService - мок сервиса дерева категорий
+type MockCategoryService struct {
+	mock.Mock
+}
+
+// NewMockCategoryService создает новый мок сервиса категорий
+func NewMockCategoryService() *MockCategoryService {
+	return &MockCategoryService{}
+}
+
+// GetCategory возвращает категорию по UUID
+func (m *MockCategoryService) GetCategory(ctx context.Context, uuid string) (*model.CategoryNode, error) {
+	args := m.Called(ctx, uuid)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.CategoryNode), args.Error(1)
+}
+
+// ListCategories возвращает поддерево категорий
+func (m *MockCategoryService) ListCategories(ctx context.Context, rootUuid string) ([]*model.CategoryNode, error) {
+	args := m.Called(ctx, rootUuid)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.CategoryNode), args.Error(1)
+}
+
+// CreateCategory добавляет категорию
+func (m *MockCategoryService) CreateCategory(ctx context.Context, category *model.CategoryNode) (*model.CategoryNode, error) {
+	args := m.Called(ctx, category)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.CategoryNode), args.Error(1)
+}
+
+// UpdateCategory изменяет категорию
+func (m *MockCategoryService) UpdateCategory(ctx context.Context, category *model.CategoryNode) (*model.CategoryNode, error) {
+	args := m.Called(ctx, category)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.CategoryNode), args.Error(1)
+}
+
+// DeleteCategory удаляет категорию
+func (m *MockCategoryService) DeleteCategory(ctx context.Context, uuid string) error {
+	args := m.Called(ctx, uuid)
+	return args.Error(0)
+}
+
+// SetMetadataSchema заменяет схему метаданных категории
+func (m *MockCategoryService) SetMetadataSchema(ctx context.Context, uuid string, fields []model.MetadataField) (*model.CategoryNode, error) {
+	args := m.Called(ctx, uuid, fields)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.CategoryNode), args.Error(1)
+}
+
+// GetMetadataSchema возвращает действующую схему метаданных категории
+func (m *MockCategoryService) GetMetadataSchema(ctx context.Context, uuid string) ([]model.MetadataField, error) {
+	args := m.Called(ctx, uuid)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]model.MetadataField), args.Error(1)
+}
@@ -0,0 +1,61 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+// MockManufacturerService - мок сервиса справочника производителей
+type MockManufacturerService struct {
+	mock.Mock
+}
+
+// NewMockManufacturerService создает новый мок сервиса производителей
+func NewMockManufacturerService() *MockManufacturerService {
+	return &MockManufacturerService{}
+}
+
+// GetManufacturer возвращает производителя по UUID
+func (m *MockManufacturerService) GetManufacturer(ctx context.Context, uuid string) (*model.Manufacturer, error) {
+	args := m.Called(ctx, uuid)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Manufacturer), args.Error(1)
+}
+
+// ListManufacturers возвращает производителей по фильтру
+func (m *MockManufacturerService) ListManufacturers(ctx context.Context, filter *model.ManufacturersFilter) ([]*model.Manufacturer, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.Manufacturer), args.Error(1)
+}
+
+// CreateManufacturer добавляет производителя
+func (m *MockManufacturerService) CreateManufacturer(ctx context.Context, manufacturer *model.Manufacturer) (*model.Manufacturer, error) {
+	args := m.Called(ctx, manufacturer)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Manufacturer), args.Error(1)
+}
+
+// UpdateManufacturer изменяет производителя и его копии в деталях
+func (m *MockManufacturerService) UpdateManufacturer(ctx context.Context, manufacturer *model.Manufacturer) (*model.Manufacturer, int, error) {
+	args := m.Called(ctx, manufacturer)
+	if args.Get(0) == nil {
+		return nil, args.Int(1), args.Error(2)
+	}
+	return args.Get(0).(*model.Manufacturer), args.Int(1), args.Error(2)
+}
+
+// DeleteManufacturer удаляет производителя
+func (m *MockManufacturerService) DeleteManufacturer(ctx context.Context, uuid string) error {
+	args := m.Called(ctx, uuid)
+	return args.Error(0)
+}
@@ -2,6 +2,8 @@ package mocks
 
 import (
 	"context"
+	"iter"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 
@@ -18,20 +20,146 @@ func NewMockPartService() *MockPartService {
 	return &MockPartService{}
 }
 
-// GetPart возвращает деталь по UUID
-func (m *MockPartService) GetPart(ctx context.Context, uuid string) (*model.Part, error) {
-	args := m.Called(ctx, uuid)
+// GetPart возвращает деталь по UUID с ценой на момент asOf
+func (m *MockPartService) GetPart(ctx context.Context, uuid string, asOf time.Time) (*model.Part, error) {
+	args := m.Called(ctx, uuid, asOf)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).(*model.Part), args.Error(1)
 }
 
-// ListParts возвращает список деталей с учетом фильтра
-func (m *MockPartService) ListParts(ctx context.Context, filter *model.PartsFilter) ([]*model.Part, error) {
+// BatchGetParts возвращает детали по списку UUID
+func (m *MockPartService) BatchGetParts(ctx context.Context, uuids []string, asOf time.Time) (*model.PartsBatch, error) {
+	args := m.Called(ctx, uuids, asOf)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.PartsBatch), args.Error(1)
+}
+
+// ListParts возвращает страницу деталей с учетом фильтра и сортировки
+func (m *MockPartService) ListParts(ctx context.Context, params *model.ListPartsParams) (*model.PartsPage, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.PartsPage), args.Error(1)
+}
+
+// GetPartFacets возвращает количество деталей по измерениям фильтра
+func (m *MockPartService) GetPartFacets(ctx context.Context, filter *model.PartsFilter) (*model.PartFacets, error) {
 	args := m.Called(ctx, filter)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
-	return args.Get(0).([]*model.Part), args.Error(1)
+	return args.Get(0).(*model.PartFacets), args.Error(1)
+}
+
+// CreatePart создаёт деталь
+func (m *MockPartService) CreatePart(ctx context.Context, part *model.Part) (*model.Part, error) {
+	args := m.Called(ctx, part)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Part), args.Error(1)
+}
+
+// UpdatePart обновляет деталь
+func (m *MockPartService) UpdatePart(ctx context.Context, update *model.PartUpdate) (*model.Part, error) {
+	args := m.Called(ctx, update)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Part), args.Error(1)
+}
+
+// DeletePart удаляет деталь
+func (m *MockPartService) DeletePart(ctx context.Context, uuid string) error {
+	args := m.Called(ctx, uuid)
+	return args.Error(0)
+}
+
+// ChangeLifecycleState переводит деталь в другое состояние жизненного цикла
+func (m *MockPartService) ChangeLifecycleState(ctx context.Context, change *model.LifecycleChange) (*model.Part, error) {
+	args := m.Called(ctx, change)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Part), args.Error(1)
+}
+
+// AdjustStock изменяет остаток детали
+func (m *MockPartService) AdjustStock(ctx context.Context, adjustment *model.StockAdjustment) (*model.StockMovement, error) {
+	args := m.Called(ctx, adjustment)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.StockMovement), args.Error(1)
+}
+
+// ListStockMovements возвращает журнал движений остатка
+func (m *MockPartService) ListStockMovements(ctx context.Context, params *model.ListStockMovementsParams) (*model.StockMovementsPage, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.StockMovementsPage), args.Error(1)
+}
+
+// TransferStock перемещает остаток между складами
+func (m *MockPartService) TransferStock(ctx context.Context, transfer *model.StockTransfer) (*model.StockMovement, *model.StockMovement, error) {
+	args := m.Called(ctx, transfer)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	return args.Get(0).(*model.StockMovement), args.Get(1).(*model.StockMovement), args.Error(2)
+}
+
+// FulfillStock списывает остатки под отгрузку
+func (m *MockPartService) FulfillStock(ctx context.Context, fulfillment *model.StockFulfillment) ([]*model.StockMovement, error) {
+	args := m.Called(ctx, fulfillment)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.StockMovement), args.Error(1)
+}
+
+// WatchParts возвращает поток изменений деталей
+func (m *MockPartService) WatchParts(ctx context.Context, params *model.WatchPartsParams) iter.Seq2[*model.PartEvent, error] {
+	args := m.Called(ctx, params)
+	return args.Get(0).(iter.Seq2[*model.PartEvent, error])
+}
+
+// ExpandBom разузловывает спецификацию сборки
+func (m *MockPartService) ExpandBom(ctx context.Context, uuid string, quantity int64) (*model.Bom, error) {
+	args := m.Called(ctx, uuid, quantity)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Bom), args.Error(1)
+}
+
+// SchedulePriceChange планирует изменение цены детали
+func (m *MockPartService) SchedulePriceChange(ctx context.Context, change *model.PriceChange) (*model.PriceVersion, error) {
+	args := m.Called(ctx, change)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.PriceVersion), args.Error(1)
+}
+
+// GetPriceHistory возвращает историю цен детали
+func (m *MockPartService) GetPriceHistory(ctx context.Context, partUuid string) ([]model.PriceVersion, error) {
+	args := m.Called(ctx, partUuid)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]model.PriceVersion), args.Error(1)
+}
+
+// ApplyScheduledPrices переносит в детали наступившие запланированные цены
+func (m *MockPartService) ApplyScheduledPrices(ctx context.Context) (int, error) {
+	args := m.Called(ctx)
+	return args.Int(0), args.Error(1)
 }
@@ -0,0 +1,59 @@
+package part
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+// maxBatchGetSize - наибольшее количество UUID в BatchGetParts, как размер страницы ListParts
+const maxBatchGetSize = maxPageSize
+
+func (s *Service) BatchGetParts(ctx context.Context, uuids []string, asOf time.Time) (*model.PartsBatch, error) {
+	if len(uuids) > maxBatchGetSize {
+		return nil, fmt.Errorf("%w: at most %d uuids can be requested, got %d", model.ErrInvalidBatchGet, maxBatchGetSize, len(uuids))
+	}
+
+	batch := &model.PartsBatch{Parts: make(map[string]*model.Part, len(uuids))}
+
+	// Повторы учитываются один раз, порядок первого упоминания сохраняется
+	seen := make(map[string]bool, len(uuids))
+	valid := make([]string, 0, len(uuids))
+	for _, id := range uuids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		if _, err := uuid.Parse(id); err != nil {
+			batch.InvalidUuids = append(batch.InvalidUuids, id)
+			continue
+		}
+		valid = append(valid, id)
+	}
+
+	if len(valid) == 0 {
+		return batch, nil
+	}
+
+	parts, err := s.repo.List(ctx, &model.PartsQuery{Filter: &model.PartsFilter{Uuids: valid}})
+	if err != nil {
+		return nil, model.ErrRepositoryOperation
+	}
+	applyPricesAt(parts, asOf)
+
+	for _, part := range parts {
+		batch.Parts[part.Uuid] = part
+	}
+	for _, id := range valid {
+		if _, ok := batch.Parts[id]; !ok {
+			batch.NotFoundUuids = append(batch.NotFoundUuids, id)
+		}
+	}
+
+	return batch, nil
+}
@@ -0,0 +1,116 @@
+package part
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+const (
+	testBatchUuid1 = "550e8400-e29b-41d4-a716-446655440001"
+	testBatchUuid2 = "550e8400-e29b-41d4-a716-446655440002"
+)
+
+func (s *PartServiceTestSuite) TestBatchGetParts_Success() {
+	ctx := context.Background()
+	part1 := &model.Part{Uuid: testBatchUuid1, Name: "Main Engine", Price: 1500}
+	part2 := &model.Part{Uuid: testBatchUuid2, Name: "Wing", Price: 300}
+
+	s.mockRepo.On("List", ctx, &model.PartsQuery{
+		Filter: &model.PartsFilter{Uuids: []string{testBatchUuid2, testBatchUuid1}},
+	}).Return([]*model.Part{part1, part2}, nil)
+
+	batch, err := s.service.BatchGetParts(ctx, []string{testBatchUuid2, testBatchUuid1}, time.Time{})
+
+	s.NoError(err)
+	s.Equal(map[string]*model.Part{testBatchUuid1: part1, testBatchUuid2: part2}, batch.Parts)
+	s.Empty(batch.NotFoundUuids)
+	s.Empty(batch.InvalidUuids)
+}
+
+func (s *PartServiceTestSuite) TestBatchGetParts_DuplicatesRequestedOnce() {
+	ctx := context.Background()
+	part := &model.Part{Uuid: testBatchUuid1, Name: "Main Engine"}
+
+	s.mockRepo.On("List", ctx, &model.PartsQuery{
+		Filter: &model.PartsFilter{Uuids: []string{testBatchUuid1}},
+	}).Return([]*model.Part{part}, nil)
+
+	batch, err := s.service.BatchGetParts(ctx, []string{testBatchUuid1, testBatchUuid1, testBatchUuid1}, time.Time{})
+
+	s.NoError(err)
+	s.Equal(map[string]*model.Part{testBatchUuid1: part}, batch.Parts)
+	s.Empty(batch.NotFoundUuids)
+}
+
+func (s *PartServiceTestSuite) TestBatchGetParts_NotFoundAndInvalid() {
+	ctx := context.Background()
+	part := &model.Part{Uuid: testBatchUuid1, Name: "Main Engine"}
+
+	s.mockRepo.On("List", ctx, &model.PartsQuery{
+		Filter: &model.PartsFilter{Uuids: []string{testBatchUuid1, testBatchUuid2}},
+	}).Return([]*model.Part{part}, nil)
+
+	batch, err := s.service.BatchGetParts(ctx, []string{"engine", testBatchUuid1, testBatchUuid2, "engine", ""}, time.Time{})
+
+	s.NoError(err)
+	s.Equal(map[string]*model.Part{testBatchUuid1: part}, batch.Parts)
+	s.Equal([]string{testBatchUuid2}, batch.NotFoundUuids)
+	s.Equal([]string{"engine", ""}, batch.InvalidUuids)
+}
+
+func (s *PartServiceTestSuite) TestBatchGetParts_OnlyInvalidSkipsRepository() {
+	batch, err := s.service.BatchGetParts(context.Background(), []string{"engine"}, time.Time{})
+
+	s.NoError(err)
+	s.Empty(batch.Parts)
+	s.Empty(batch.NotFoundUuids)
+	s.Equal([]string{"engine"}, batch.InvalidUuids)
+}
+
+func (s *PartServiceTestSuite) TestBatchGetParts_AppliesPriceAsOf() {
+	ctx := context.Background()
+	asOf := time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC)
+	part := &model.Part{
+		Uuid:  testBatchUuid1,
+		Price: 1200,
+		PriceHistory: []model.PriceVersion{
+			{Price: 1000, EffectiveFrom: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)},
+			{Price: 1200, EffectiveFrom: time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)},
+		},
+	}
+
+	s.mockRepo.On("List", ctx, &model.PartsQuery{
+		Filter: &model.PartsFilter{Uuids: []string{testBatchUuid1}},
+	}).Return([]*model.Part{part}, nil)
+
+	batch, err := s.service.BatchGetParts(ctx, []string{testBatchUuid1}, asOf)
+
+	s.NoError(err)
+	s.Equal(1000.0, batch.Parts[testBatchUuid1].Price)
+}
+
+func (s *PartServiceTestSuite) TestBatchGetParts_TooManyUuids() {
+	uuids := make([]string, maxBatchGetSize+1)
+	for i := range uuids {
+		uuids[i] = testBatchUuid1
+	}
+
+	_, err := s.service.BatchGetParts(context.Background(), uuids, time.Time{})
+
+	s.ErrorIs(err, model.ErrInvalidBatchGet)
+}
+
+func (s *PartServiceTestSuite) TestBatchGetParts_RepositoryError() {
+	ctx := context.Background()
+
+	s.mockRepo.On("List", ctx, &model.PartsQuery{
+		Filter: &model.PartsFilter{Uuids: []string{testBatchUuid1}},
+	}).Return(nil, errors.New("connection lost"))
+
+	_, err := s.service.BatchGetParts(ctx, []string{testBatchUuid1}, time.Time{})
+
+	s.ErrorIs(err, model.ErrRepositoryOperation)
+}
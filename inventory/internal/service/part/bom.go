@@ -0,0 +1,280 @@
+package part
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+	"github.com/bogdanovds/rocket_factory/inventory/internal/repository"
+)
+
+// maxBomNodes ограничивает размер дерева спецификации: подсборка, входящая в несколько сборок,
+// повторяется в каждой ветке, и дерево может расти экспоненциально от числа уровней
+const maxBomNodes = 10_000
+
+func (s *Service) ExpandBom(ctx context.Context, uuid string, quantity int64) (*model.Bom, error) {
+	if quantity < 0 {
+		return nil, fmt.Errorf("%w: quantity must not be negative", model.ErrInvalidBomRequest)
+	}
+	if quantity == 0 {
+		quantity = 1
+	}
+
+	root, err := s.repo.Get(ctx, uuid)
+	if err != nil {
+		if errors.Is(err, model.ErrPartNotFound) {
+			return nil, model.ErrPartNotFound
+		}
+		return nil, model.ErrRepositoryOperation
+	}
+
+	graph := newBomGraph(s.repo)
+	graph.parts[root.Uuid] = root
+	if err := graph.loadComponents(ctx, root); err != nil {
+		return nil, err
+	}
+	applyPricesAt(slices.Collect(maps.Values(graph.parts)), time.Time{})
+
+	order, err := graph.topologicalOrder(root)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &bomBuilder{graph: graph}
+	tree, err := b.node(root, quantity, quantity)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.Bom{
+		Root:              tree,
+		BuildableQuantity: graph.buildable(root, order),
+	}, nil
+}
+
+// checkComponents проверяет, что компоненты сборки существуют и не образуют цикл
+// с самой сборкой. Конкурентные обновления всё же могут создать цикл, поэтому ExpandBom
+// проверяет его повторно.
+func (s *Service) checkComponents(ctx context.Context, part *model.Part) error {
+	graph := newBomGraph(s.repo)
+	graph.parts[part.Uuid] = part
+
+	if err := graph.loadComponents(ctx, part); err != nil {
+		if errors.Is(err, model.ErrInvalidBom) {
+			return fmt.Errorf("%w: %w", model.ErrInvalidPart, err)
+		}
+		return err
+	}
+
+	if _, err := graph.topologicalOrder(part); err != nil {
+		return fmt.Errorf("%w: %w", model.ErrInvalidPart, err)
+	}
+
+	return nil
+}
+
+// bomGraph - детали спецификации по UUID, загружаемые из репозитория по уровням
+type bomGraph struct {
+	repo  repository.PartRepository
+	parts map[string]*model.Part
+}
+
+func newBomGraph(repo repository.PartRepository) *bomGraph {
+	return &bomGraph{repo: repo, parts: make(map[string]*model.Part)}
+}
+
+// loadComponents загружает все компоненты сборки на всех уровнях, по одному запросу на уровень
+func (g *bomGraph) loadComponents(ctx context.Context, root *model.Part) error {
+	level := []*model.Part{root}
+	for depth := 0; len(level) > 0; depth++ {
+		if depth > model.MaxBomDepth {
+			return fmt.Errorf("%w: assemblies are nested deeper than %d levels", model.ErrInvalidBom, model.MaxBomDepth)
+		}
+
+		var missing []string
+		for _, part := range level {
+			for _, c := range part.Components {
+				if _, ok := g.parts[c.PartUuid]; !ok && !slices.Contains(missing, c.PartUuid) {
+					missing = append(missing, c.PartUuid)
+				}
+			}
+		}
+		if len(missing) == 0 {
+			return nil
+		}
+
+		loaded, err := g.repo.List(ctx, &model.PartsQuery{Filter: &model.PartsFilter{Uuids: missing}})
+		if err != nil {
+			return model.ErrRepositoryOperation
+		}
+		for _, part := range loaded {
+			g.parts[part.Uuid] = part
+		}
+
+		for _, uuid := range missing {
+			if _, ok := g.parts[uuid]; !ok {
+				return fmt.Errorf("%w: component %s not found", model.ErrInvalidBom, uuid)
+			}
+		}
+
+		level = make([]*model.Part, 0, len(missing))
+		for _, uuid := range missing {
+			level = append(level, g.parts[uuid])
+		}
+	}
+
+	return nil
+}
+
+// topologicalOrder возвращает сборку и все её компоненты так, что каждая деталь идёт раньше
+// своих компонентов. Возвращает model.ErrInvalidBom с путём цикла, если он есть.
+func (g *bomGraph) topologicalOrder(root *model.Part) ([]*model.Part, error) {
+	const (
+		visiting = 1
+		done     = 2
+	)
+	state := make(map[string]int, len(g.parts))
+	var path []string
+	var postorder []*model.Part
+
+	var visit func(part *model.Part) error
+	visit = func(part *model.Part) error {
+		switch state[part.Uuid] {
+		case done:
+			return nil
+		case visiting:
+			cycle := append(slices.Clone(path[slices.Index(path, part.Uuid):]), part.Uuid)
+			return fmt.Errorf("%w: components form a cycle %s", model.ErrInvalidBom, strings.Join(cycle, " -> "))
+		}
+
+		state[part.Uuid] = visiting
+		path = append(path, part.Uuid)
+		for _, c := range part.Components {
+			if err := visit(g.parts[c.PartUuid]); err != nil {
+				return err
+			}
+		}
+		path = path[:len(path)-1]
+		state[part.Uuid] = done
+		postorder = append(postorder, part)
+
+		return nil
+	}
+
+	if err := visit(root); err != nil {
+		return nil, err
+	}
+
+	slices.Reverse(postorder)
+	return postorder, nil
+}
+
+// buildable находит наибольшее количество сборок, которое можно собрать из остатков, двоичным поиском
+func (g *bomGraph) buildable(root *model.Part, order []*model.Part) int64 {
+	// Каждая собранная единица расходует хотя бы одну единицу остатка какого-то компонента
+	var upper int64
+	for _, part := range order[1:] {
+		if part.StockQuantity > 0 {
+			upper = saturatingAdd(upper, part.StockQuantity)
+		}
+	}
+
+	lo, hi := int64(0), upper
+	for lo < hi {
+		mid := lo + (hi-lo+1)/2
+		if g.canBuild(root, order, mid) {
+			lo = mid
+		} else {
+			hi = mid - 1
+		}
+	}
+
+	return lo
+}
+
+// canBuild проверяет, хватит ли остатков на n сборок. Потребность распространяется
+// от сборки к компонентам в топологическом порядке, поэтому компонент, нужный в нескольких
+// ветках, получает суммарную потребность до того, как расходуется его остаток. Остаток
+// готовых подсборок расходуется раньше, чем их компоненты; остаток самого корня не учитывается.
+func (g *bomGraph) canBuild(root *model.Part, order []*model.Part, n int64) bool {
+	demand := map[string]int64{root.Uuid: n}
+
+	for _, part := range order {
+		need := demand[part.Uuid]
+		if part != root {
+			need -= min(need, max(part.StockQuantity, 0))
+		}
+		if need == 0 {
+			continue
+		}
+		if !part.IsAssembly() {
+			return false
+		}
+
+		for _, c := range part.Components {
+			if need > math.MaxInt64/c.Quantity {
+				return false
+			}
+			demand[c.PartUuid] = saturatingAdd(demand[c.PartUuid], need*c.Quantity)
+		}
+	}
+
+	return true
+}
+
+// bomBuilder строит дерево спецификации, считая стоимость и вес снизу вверх
+type bomBuilder struct {
+	graph *bomGraph
+	nodes int
+}
+
+func (b *bomBuilder) node(part *model.Part, quantity, total int64) (*model.BomNode, error) {
+	b.nodes++
+	if b.nodes > maxBomNodes {
+		return nil, fmt.Errorf("%w: expanded tree has more than %d nodes", model.ErrInvalidBom, maxBomNodes)
+	}
+
+	node := &model.BomNode{
+		Part:          part,
+		Quantity:      quantity,
+		TotalQuantity: total,
+	}
+
+	if !part.IsAssembly() {
+		node.RolledUpPrice = part.Price
+		if part.Dimensions != nil {
+			node.RolledUpWeight = part.Dimensions.Weight
+		}
+		return node, nil
+	}
+
+	for _, c := range part.Components {
+		if total > math.MaxInt64/c.Quantity {
+			return nil, fmt.Errorf("%w: total quantity of %s overflows", model.ErrInvalidBom, c.PartUuid)
+		}
+
+		child, err := b.node(b.graph.parts[c.PartUuid], c.Quantity, total*c.Quantity)
+		if err != nil {
+			return nil, err
+		}
+
+		node.RolledUpPrice += child.RolledUpPrice * float64(c.Quantity)
+		node.RolledUpWeight += child.RolledUpWeight * float64(c.Quantity)
+		node.Components = append(node.Components, child)
+	}
+
+	return node, nil
+}
+
+func saturatingAdd(a, b int64) int64 {
+	if a > math.MaxInt64-b {
+		return math.MaxInt64
+	}
+	return a + b
+}
@@ -0,0 +1,128 @@
+package part
+
+import (
+	"context"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+// Двигатель: 2 сопла, насос и клапан. Насос - подсборка из 3 клапанов.
+func bomParts() (engine, nozzle, pump, valve *model.Part) {
+	nozzle = &model.Part{Uuid: "nozzle", Name: "Nozzle", Price: 100, StockQuantity: 10, Dimensions: &model.Dimensions{Weight: 10}}
+	valve = &model.Part{Uuid: "valve", Name: "Valve", Price: 5, StockQuantity: 9, Dimensions: &model.Dimensions{Weight: 1}}
+	pump = &model.Part{
+		Uuid:          "pump",
+		Name:          "Pump",
+		StockQuantity: 1,
+		Components:    []model.Component{{PartUuid: "valve", Quantity: 3}},
+	}
+	engine = &model.Part{
+		Uuid: "engine",
+		Name: "Engine",
+		Components: []model.Component{
+			{PartUuid: "nozzle", Quantity: 2},
+			{PartUuid: "pump", Quantity: 1},
+			{PartUuid: "valve", Quantity: 1},
+		},
+	}
+	return engine, nozzle, pump, valve
+}
+
+func componentsQuery(uuids ...string) *model.PartsQuery {
+	return &model.PartsQuery{Filter: &model.PartsFilter{Uuids: uuids}}
+}
+
+func (s *PartServiceTestSuite) TestExpandBom_Tree() {
+	ctx := context.Background()
+	engine, nozzle, pump, valve := bomParts()
+
+	s.mockRepo.On("Get", ctx, "engine").Return(engine, nil)
+	s.mockRepo.On("List", ctx, componentsQuery("nozzle", "pump", "valve")).Return([]*model.Part{nozzle, pump, valve}, nil)
+
+	bom, err := s.service.ExpandBom(ctx, "engine", 2)
+
+	s.Require().NoError(err)
+	s.Equal(int64(2), bom.Root.TotalQuantity)
+	s.Equal(220.0, bom.Root.RolledUpPrice)
+	s.Equal(24.0, bom.Root.RolledUpWeight)
+	s.Require().Len(bom.Root.Components, 3)
+
+	pumpNode := bom.Root.Components[1]
+	s.Equal("pump", pumpNode.Part.Uuid)
+	s.Equal(15.0, pumpNode.RolledUpPrice)
+	s.Require().Len(pumpNode.Components, 1)
+	s.Equal(int64(3), pumpNode.Components[0].Quantity)
+	s.Equal(int64(6), pumpNode.Components[0].TotalQuantity)
+}
+
+func (s *PartServiceTestSuite) TestExpandBom_BuildableQuantityWithSharedComponent() {
+	ctx := context.Background()
+	engine, nozzle, pump, valve := bomParts()
+
+	s.mockRepo.On("Get", ctx, "engine").Return(engine, nil)
+	s.mockRepo.On("List", ctx, componentsQuery("nozzle", "pump", "valve")).Return([]*model.Part{nozzle, pump, valve}, nil)
+
+	bom, err := s.service.ExpandBom(ctx, "engine", 1)
+
+	// Сопел хватает на 5 двигателей, но клапаны нужны и насосам, и двигателю напрямую:
+	// на 3 двигателя - 3 клапана плюс 2 насоса по 3 клапана сверх насоса на складе
+	s.Require().NoError(err)
+	s.Equal(int64(3), bom.BuildableQuantity)
+}
+
+func (s *PartServiceTestSuite) TestExpandBom_MissingComponent() {
+	ctx := context.Background()
+	engine, nozzle, pump, _ := bomParts()
+
+	s.mockRepo.On("Get", ctx, "engine").Return(engine, nil)
+	s.mockRepo.On("List", ctx, componentsQuery("nozzle", "pump", "valve")).Return([]*model.Part{nozzle, pump}, nil)
+
+	bom, err := s.service.ExpandBom(ctx, "engine", 1)
+
+	s.Nil(bom)
+	s.ErrorIs(err, model.ErrInvalidBom)
+}
+
+func (s *PartServiceTestSuite) TestExpandBom_NegativeQuantity() {
+	bom, err := s.service.ExpandBom(context.Background(), "engine", -1)
+
+	s.Nil(bom)
+	s.ErrorIs(err, model.ErrInvalidBomRequest)
+}
+
+func (s *PartServiceTestSuite) TestExpandBom_NotFound() {
+	ctx := context.Background()
+
+	s.mockRepo.On("Get", ctx, "missing").Return(nil, model.ErrPartNotFound)
+
+	bom, err := s.service.ExpandBom(ctx, "missing", 1)
+
+	s.Nil(bom)
+	s.ErrorIs(err, model.ErrPartNotFound)
+}
+
+func (s *PartServiceTestSuite) TestCreatePart_ComponentCycle() {
+	ctx := context.Background()
+	s.expectCategoryTree(ctx)
+	assembly := validPart()
+	assembly.Components = []model.Component{{PartUuid: "pump", Quantity: 1}}
+	pump := &model.Part{Uuid: "pump", Components: []model.Component{{PartUuid: assembly.Uuid, Quantity: 2}}}
+
+	s.mockRepo.On("List", ctx, componentsQuery("pump")).Return([]*model.Part{pump}, nil)
+
+	part, err := s.service.CreatePart(ctx, assembly)
+
+	s.Nil(part)
+	s.ErrorIs(err, model.ErrInvalidPart)
+	s.ErrorContains(err, "cycle")
+}
+
+func (s *PartServiceTestSuite) TestCreatePart_SelfReference() {
+	assembly := validPart()
+	assembly.Components = []model.Component{{PartUuid: assembly.Uuid, Quantity: 1}}
+
+	part, err := s.service.CreatePart(context.Background(), assembly)
+
+	s.Nil(part)
+	s.ErrorIs(err, model.ErrInvalidPart)
+}
@@ -0,0 +1,70 @@
+package part
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+// resolveCategory согласует значение enum и узел дерева категорий детали: по значению enum
+// выбирается встроенная категория, по узлу - значение enum его ближайшей встроенной категории
+func (s *Service) resolveCategory(ctx context.Context, part *model.Part) error {
+	if part.CategoryUuid == "" {
+		part.CategoryUuid = model.BuiltinCategoryUuid(part.Category)
+		return nil
+	}
+
+	category, err := s.categories.Get(ctx, part.CategoryUuid)
+	if err != nil {
+		if errors.Is(err, model.ErrCategoryNotFound) {
+			return fmt.Errorf("%w: category %s not found", model.ErrInvalidPart, part.CategoryUuid)
+		}
+		return model.ErrRepositoryOperation
+	}
+
+	if part.Category != model.CategoryUnspecified && part.Category != category.Legacy {
+		return fmt.Errorf("%w: category %d does not match category uuid %s", model.ErrInvalidPart, part.Category, part.CategoryUuid)
+	}
+	part.Category = category.Legacy
+
+	return nil
+}
+
+// checkMetadata проверяет метаданные детали по действующей схеме её категории
+func (s *Service) checkMetadata(ctx context.Context, part *model.Part) error {
+	nodes, err := s.categories.List(ctx)
+	if err != nil {
+		return model.ErrRepositoryOperation
+	}
+
+	return model.ValidateMetadata(model.NewCategoryTree(nodes).MetadataSchema(part.CategoryUuid), part.Metadata)
+}
+
+// expandCategories возвращает копию фильтра, в которой категории дополнены всеми потомками
+func (s *Service) expandCategories(ctx context.Context, filter *model.PartsFilter) (*model.PartsFilter, error) {
+	if filter == nil || len(filter.CategoryUuids) == 0 {
+		return filter, nil
+	}
+
+	nodes, err := s.categories.List(ctx)
+	if err != nil {
+		return nil, model.ErrRepositoryOperation
+	}
+	tree := model.NewCategoryTree(nodes)
+
+	seen := make(map[string]bool)
+	expanded := *filter
+	expanded.CategoryUuids = nil
+	for _, uuid := range filter.CategoryUuids {
+		for _, descendant := range tree.Subtree(uuid) {
+			if !seen[descendant] {
+				seen[descendant] = true
+				expanded.CategoryUuids = append(expanded.CategoryUuids, descendant)
+			}
+		}
+	}
+
+	return &expanded, nil
+}
@@ -0,0 +1,92 @@
+package part
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+const (
+	testAvionicsUuid   = "7c1e1a52-3d0f-4d1c-9a6e-000000000001"
+	testNavigationUuid = "7c1e1a52-3d0f-4d1c-9a6e-000000000002"
+)
+
+// testCategories - встроенные категории и ветка "Авионика -> Навигация" без соответствия в enum
+func testCategories() []*model.CategoryNode {
+	return append(append([]*model.CategoryNode{}, model.BuiltinCategories...),
+		&model.CategoryNode{Uuid: testAvionicsUuid, Slug: "avionics", DisplayName: "Авионика"},
+		&model.CategoryNode{Uuid: testNavigationUuid, Slug: "navigation", DisplayName: "Навигация", ParentUuid: testAvionicsUuid},
+	)
+}
+
+func (s *PartServiceTestSuite) TestCreatePart_LegacyCategoryMapsToBuiltinNode() {
+	ctx := context.Background()
+	s.expectCategoryTree(ctx)
+
+	s.mockRepo.On("Create", ctx, mock.AnythingOfType("*model.Part")).Return(nil)
+
+	part, err := s.service.CreatePart(ctx, validPart())
+
+	s.NoError(err)
+	s.Equal(model.BuiltinCategoryUuid(model.CategoryPorthole), part.CategoryUuid)
+}
+
+func (s *PartServiceTestSuite) TestCreatePart_CategoryNodeWithoutLegacyValue() {
+	ctx := context.Background()
+	s.expectCategoryTree(ctx, testCategories()...)
+	input := validPart()
+	input.Category = model.CategoryUnspecified
+	input.CategoryUuid = testNavigationUuid
+
+	s.mockCategories.On("Get", ctx, testNavigationUuid).Return(testCategories()[5], nil)
+	s.mockRepo.On("Create", ctx, mock.AnythingOfType("*model.Part")).Return(nil)
+
+	part, err := s.service.CreatePart(ctx, input)
+
+	s.NoError(err)
+	s.Equal(model.CategoryUnspecified, part.Category)
+	s.Equal(testNavigationUuid, part.CategoryUuid)
+}
+
+func (s *PartServiceTestSuite) TestCreatePart_CategoryMismatch() {
+	ctx := context.Background()
+	input := validPart()
+	input.CategoryUuid = model.BuiltinCategoryUuid(model.CategoryEngine)
+
+	s.mockCategories.On("Get", ctx, input.CategoryUuid).Return(model.BuiltinCategories[0], nil)
+
+	part, err := s.service.CreatePart(ctx, input)
+
+	s.Nil(part)
+	s.ErrorIs(err, model.ErrInvalidPart)
+}
+
+func (s *PartServiceTestSuite) TestCreatePart_CategoryRequired() {
+	input := validPart()
+	input.Category = model.CategoryUnspecified
+
+	part, err := s.service.CreatePart(context.Background(), input)
+
+	s.Nil(part)
+	s.ErrorIs(err, model.ErrInvalidPart)
+}
+
+func (s *PartServiceTestSuite) TestListParts_CategoryFilterIncludesDescendants() {
+	ctx := context.Background()
+	filter := &model.PartsFilter{CategoryUuids: []string{testAvionicsUuid}}
+	expanded := &model.PartsFilter{
+		CategoryUuids:   []string{testAvionicsUuid, testNavigationUuid},
+		LifecycleStates: []model.LifecycleState{model.LifecycleStateActive},
+	}
+
+	s.mockCategories.On("List", ctx).Return(testCategories(), nil)
+	s.mockRepo.On("List", ctx, &model.PartsQuery{Filter: expanded, Limit: defaultPageSize + 1}).Return([]*model.Part{}, nil)
+	s.mockRepo.On("Count", ctx, expanded).Return(0, nil)
+
+	_, err := s.service.ListParts(ctx, &model.ListPartsParams{Filter: filter})
+
+	s.NoError(err)
+	s.Equal([]string{testAvionicsUuid}, filter.CategoryUuids)
+}
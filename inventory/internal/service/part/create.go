@@ -0,0 +1,80 @@
+package part
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+func (s *Service) CreatePart(ctx context.Context, part *model.Part) (*model.Part, error) {
+	part = part.Clone()
+	if part.Uuid == "" {
+		part.Uuid = uuid.NewString()
+	} else if _, err := uuid.Parse(part.Uuid); err != nil {
+		return nil, fmt.Errorf("%w: uuid must be a valid UUID", model.ErrInvalidPart)
+	}
+
+	if err := part.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := initLifecycleState(part); err != nil {
+		return nil, err
+	}
+
+	if err := s.resolveCategory(ctx, part); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkMetadata(ctx, part); err != nil {
+		return nil, err
+	}
+
+	if err := s.resolveManufacturer(ctx, part); err != nil {
+		return nil, err
+	}
+
+	part.NormalizeStockLevels()
+	if err := s.checkStockLevels(ctx, part); err != nil {
+		return nil, err
+	}
+
+	if part.IsAssembly() {
+		if err := s.checkComponents(ctx, part); err != nil {
+			return nil, err
+		}
+	}
+
+	now := timestamp()
+	part.CreatedAt = &now
+	part.UpdatedAt = &now
+
+	// История цен ведётся сервисом и начинается с цены, с которой деталь создана
+	version, err := newPriceVersion(part.Price, now, now)
+	if err != nil {
+		return nil, err
+	}
+	part.PriceHistory = []model.PriceVersion{version}
+	part.NextPriceChangeAt = nil
+	part.LowStockAlertedAt = nil
+
+	if err := s.repo.Create(ctx, part); err != nil {
+		if errors.Is(err, model.ErrPartAlreadyExists) {
+			return nil, model.ErrPartAlreadyExists
+		}
+		return nil, model.ErrRepositoryOperation
+	}
+
+	return part, nil
+}
+
+// timestamp возвращает текущее время с точностью, которую сохраняет MongoDB,
+// чтобы значения created_at/updated_at совпадали во всех реализациях хранилища
+func timestamp() time.Time {
+	return time.Now().UTC().Truncate(time.Millisecond)
+}
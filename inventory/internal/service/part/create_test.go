@@ -0,0 +1,141 @@
+package part
+
+import (
+	"context"
+	"errors"
+	"math"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+func validPart() *model.Part {
+	return &model.Part{
+		Uuid:          "6ba7b810-9dad-11d1-80b4-00c04fd430d0",
+		Name:          "Porthole",
+		Price:         1500.0,
+		StockQuantity: 10,
+		Category:      model.CategoryPorthole,
+		Dimensions:    &model.Dimensions{Length: 50, Width: 50, Height: 10, Weight: 30},
+		Manufacturer:  &model.Manufacturer{Name: "GlassWorks", Country: "Russia"},
+	}
+}
+
+func (s *PartServiceTestSuite) TestCreatePart_Success() {
+	ctx := context.Background()
+	s.expectCategoryTree(ctx)
+	input := validPart()
+
+	s.mockRepo.On("Create", ctx, mock.AnythingOfType("*model.Part")).Return(nil)
+
+	part, err := s.service.CreatePart(ctx, input)
+
+	s.NoError(err)
+	s.Equal(input.Uuid, part.Uuid)
+	s.Require().NotNil(part.CreatedAt)
+	s.Require().NotNil(part.UpdatedAt)
+	s.Equal(*part.CreatedAt, *part.UpdatedAt)
+}
+
+func (s *PartServiceTestSuite) TestCreatePart_GeneratesUUID() {
+	ctx := context.Background()
+	s.expectCategoryTree(ctx)
+	input := validPart()
+	input.Uuid = ""
+
+	s.mockRepo.On("Create", ctx, mock.AnythingOfType("*model.Part")).Return(nil)
+
+	part, err := s.service.CreatePart(ctx, input)
+
+	s.NoError(err)
+	s.NotEmpty(part.Uuid)
+	s.Empty(input.Uuid)
+}
+
+func (s *PartServiceTestSuite) TestCreatePart_Validation() {
+	ctx := context.Background()
+
+	cases := map[string]func(p *model.Part){
+		"empty name":       func(p *model.Part) { p.Name = " " },
+		"zero price":       func(p *model.Part) { p.Price = 0 },
+		"negative stock":   func(p *model.Part) { p.StockQuantity = -1 },
+		"unknown category": func(p *model.Part) { p.Category = model.CategoryUnspecified },
+		"invalid uuid":     func(p *model.Part) { p.Uuid = "not-a-uuid" },
+		"negative weight":  func(p *model.Part) { p.Dimensions.Weight = -5 },
+		"NaN price":        func(p *model.Part) { p.Price = math.NaN() },
+		"infinite price":   func(p *model.Part) { p.Price = math.Inf(1) },
+		"NaN length":       func(p *model.Part) { p.Dimensions.Length = math.NaN() },
+		"infinite height":  func(p *model.Part) { p.Dimensions.Height = math.Inf(1) },
+		"NaN metadata":     func(p *model.Part) { p.Metadata = map[string]any{"thrust": math.NaN()} },
+		"negative level": func(p *model.Part) {
+			p.StockLevels = []model.StockLevel{{WarehouseUuid: model.DefaultWarehouse.Uuid, Quantity: -1}}
+		},
+	}
+
+	for name, mutate := range cases {
+		input := validPart()
+		mutate(input)
+
+		part, err := s.service.CreatePart(ctx, input)
+
+		s.Nil(part, name)
+		s.ErrorIs(err, model.ErrInvalidPart, name)
+	}
+}
+
+func (s *PartServiceTestSuite) TestCreatePart_StockLevels() {
+	ctx := context.Background()
+	s.expectCategoryTree(ctx)
+	input := validPart()
+	input.StockLevels = []model.StockLevel{
+		{WarehouseUuid: model.DefaultWarehouse.Uuid, Quantity: 3},
+		{WarehouseUuid: testSiteWarehouseUuid, Quantity: 4},
+	}
+
+	s.mockWarehouses.On("Get", ctx, testSiteWarehouseUuid).Return(&model.Warehouse{Uuid: testSiteWarehouseUuid}, nil)
+	s.mockRepo.On("Create", ctx, mock.AnythingOfType("*model.Part")).Return(nil)
+
+	part, err := s.service.CreatePart(ctx, input)
+
+	s.Require().NoError(err)
+	s.Equal(int64(7), part.StockQuantity)
+}
+
+func (s *PartServiceTestSuite) TestCreatePart_UnknownWarehouse() {
+	ctx := context.Background()
+	s.expectCategoryTree(ctx)
+	input := validPart()
+	input.StockLevels = []model.StockLevel{{WarehouseUuid: testSiteWarehouseUuid, Quantity: 4}}
+
+	s.mockWarehouses.On("Get", ctx, testSiteWarehouseUuid).Return(nil, model.ErrWarehouseNotFound)
+
+	part, err := s.service.CreatePart(ctx, input)
+
+	s.Nil(part)
+	s.ErrorIs(err, model.ErrInvalidPart)
+}
+
+func (s *PartServiceTestSuite) TestCreatePart_AlreadyExists() {
+	ctx := context.Background()
+	s.expectCategoryTree(ctx)
+
+	s.mockRepo.On("Create", ctx, mock.AnythingOfType("*model.Part")).Return(model.ErrPartAlreadyExists)
+
+	part, err := s.service.CreatePart(ctx, validPart())
+
+	s.Nil(part)
+	s.ErrorIs(err, model.ErrPartAlreadyExists)
+}
+
+func (s *PartServiceTestSuite) TestCreatePart_RepositoryError() {
+	ctx := context.Background()
+	s.expectCategoryTree(ctx)
+
+	s.mockRepo.On("Create", ctx, mock.AnythingOfType("*model.Part")).Return(errors.New("connection lost"))
+
+	part, err := s.service.CreatePart(ctx, validPart())
+
+	s.Nil(part)
+	s.ErrorIs(err, model.ErrRepositoryOperation)
+}
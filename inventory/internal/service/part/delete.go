@@ -0,0 +1,29 @@
+package part
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+func (s *Service) DeletePart(ctx context.Context, uuid string) error {
+	// Удаление компонента сломало бы спецификации сборок, в которые он входит
+	usedIn, err := s.repo.Count(ctx, &model.PartsFilter{ComponentUuids: []string{uuid}})
+	if err != nil {
+		return model.ErrRepositoryOperation
+	}
+	if usedIn > 0 {
+		return fmt.Errorf("%w: used in %d assemblies", model.ErrPartInUse, usedIn)
+	}
+
+	if err := s.repo.Delete(ctx, uuid); err != nil {
+		if errors.Is(err, model.ErrPartNotFound) {
+			return model.ErrPartNotFound
+		}
+		return model.ErrRepositoryOperation
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package part
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+func (s *PartServiceTestSuite) TestDeletePart_Success() {
+	ctx := context.Background()
+
+	s.mockRepo.On("Count", ctx, &model.PartsFilter{ComponentUuids: []string{"uuid-1"}}).Return(0, nil)
+	s.mockRepo.On("Delete", ctx, "uuid-1").Return(nil)
+
+	err := s.service.DeletePart(ctx, "uuid-1")
+
+	s.NoError(err)
+}
+
+func (s *PartServiceTestSuite) TestDeletePart_NotFound() {
+	ctx := context.Background()
+
+	s.mockRepo.On("Count", ctx, &model.PartsFilter{ComponentUuids: []string{"missing"}}).Return(0, nil)
+	s.mockRepo.On("Delete", ctx, "missing").Return(model.ErrPartNotFound)
+
+	err := s.service.DeletePart(ctx, "missing")
+
+	s.ErrorIs(err, model.ErrPartNotFound)
+}
+
+func (s *PartServiceTestSuite) TestDeletePart_RepositoryError() {
+	ctx := context.Background()
+
+	s.mockRepo.On("Count", ctx, &model.PartsFilter{ComponentUuids: []string{"uuid-1"}}).Return(0, nil)
+	s.mockRepo.On("Delete", ctx, "uuid-1").Return(errors.New("connection lost"))
+
+	err := s.service.DeletePart(ctx, "uuid-1")
+
+	s.ErrorIs(err, model.ErrRepositoryOperation)
+}
+
+func (s *PartServiceTestSuite) TestDeletePart_UsedInAssembly() {
+	ctx := context.Background()
+
+	s.mockRepo.On("Count", ctx, &model.PartsFilter{ComponentUuids: []string{"uuid-1"}}).Return(2, nil)
+
+	err := s.service.DeletePart(ctx, "uuid-1")
+
+	s.ErrorIs(err, model.ErrPartInUse)
+	s.mockRepo.AssertNotCalled(s.T(), "Delete", ctx, "uuid-1")
+}
@@ -0,0 +1,25 @@
+package part
+
+import (
+	"context"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+func (s *Service) GetPartFacets(ctx context.Context, filter *model.PartsFilter) (*model.PartFacets, error) {
+	if err := validateFilter(filter); err != nil {
+		return nil, err
+	}
+
+	filter, err := s.expandCategories(ctx, filter.WithDefaultLifecycleStates())
+	if err != nil {
+		return nil, err
+	}
+
+	facets, err := s.repo.Facets(ctx, filter)
+	if err != nil {
+		return nil, model.ErrRepositoryOperation
+	}
+
+	return facets, nil
+}
@@ -0,0 +1,62 @@
+package part
+
+import (
+	"context"
+	"errors"
+
+	"github.com/samber/lo"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+func (s *PartServiceTestSuite) TestGetPartFacets_Success() {
+	ctx := context.Background()
+	filter := &model.PartsFilter{Tags: []string{"heavy"}, LifecycleStates: []model.LifecycleState{model.LifecycleStateRecalled}}
+	expected := &model.PartFacets{
+		Categories:            []model.CategoryFacetCount{{Category: model.CategoryEngine, Count: 2}},
+		ManufacturerCountries: []model.FacetCount{{Value: "US", Count: 2}},
+		Tags:                  []model.FacetCount{{Value: "heavy", Count: 2}, {Value: "orbital", Count: 1}},
+	}
+
+	s.mockRepo.On("Facets", ctx, filter).Return(expected, nil)
+
+	facets, err := s.service.GetPartFacets(ctx, filter)
+
+	s.NoError(err)
+	s.Equal(expected, facets)
+}
+
+func (s *PartServiceTestSuite) TestGetPartFacets_CategoryFilterIncludesDescendants() {
+	ctx := context.Background()
+	filter := &model.PartsFilter{CategoryUuids: []string{testAvionicsUuid}}
+	expanded := &model.PartsFilter{
+		CategoryUuids:   []string{testAvionicsUuid, testNavigationUuid},
+		LifecycleStates: []model.LifecycleState{model.LifecycleStateActive},
+	}
+
+	s.mockCategories.On("List", ctx).Return(testCategories(), nil)
+	s.mockRepo.On("Facets", ctx, expanded).Return(&model.PartFacets{}, nil)
+
+	_, err := s.service.GetPartFacets(ctx, filter)
+
+	s.NoError(err)
+}
+
+func (s *PartServiceTestSuite) TestGetPartFacets_InvalidFilter() {
+	ctx := context.Background()
+	filter := &model.PartsFilter{Price: &model.FloatRange{Min: lo.ToPtr(10.0), Max: lo.ToPtr(1.0)}}
+
+	_, err := s.service.GetPartFacets(ctx, filter)
+
+	s.ErrorIs(err, model.ErrInvalidListQuery)
+}
+
+func (s *PartServiceTestSuite) TestGetPartFacets_RepositoryError() {
+	ctx := context.Background()
+
+	s.mockRepo.On("Facets", ctx, activeOnly()).Return(nil, errors.New("connection lost"))
+
+	_, err := s.service.GetPartFacets(ctx, nil)
+
+	s.ErrorIs(err, model.ErrRepositoryOperation)
+}
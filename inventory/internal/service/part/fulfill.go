@@ -0,0 +1,140 @@
+package part
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+// FulfillStock списывает остатки под отгрузку движениями SALE, распределяя каждую позицию
+// по складам (model.Part.AllocateStock). Все позиции распределяются до первого списания, поэтому
+// нехватка остатка обнаруживается без изменений. Если остаток успели изменить и списание не прошло,
+// уже записанные движения компенсируются движениями CORRECTION: отгрузка не выполняется частично.
+// Повторный вызов с той же ссылкой ничего не списывает и возвращает движения первой отгрузки, поэтому
+// заказ можно отгружать повторно после сбоя или таймаута.
+func (s *Service) FulfillStock(ctx context.Context, fulfillment *model.StockFulfillment) ([]*model.StockMovement, error) {
+	if len(fulfillment.Items) == 0 {
+		return nil, fmt.Errorf("%w: items are required", model.ErrInvalidFulfillment)
+	}
+
+	// Повторяющиеся детали объединяются в одну позицию
+	var partUuids []string
+	quantities := make(map[string]int64, len(fulfillment.Items))
+	for _, item := range fulfillment.Items {
+		switch {
+		case item.PartUuid == "":
+			return nil, fmt.Errorf("%w: part uuid is required", model.ErrInvalidFulfillment)
+		case item.Quantity <= 0:
+			return nil, fmt.Errorf("%w: quantity of part %s must be positive", model.ErrInvalidFulfillment, item.PartUuid)
+		}
+		if _, seen := quantities[item.PartUuid]; !seen {
+			partUuids = append(partUuids, item.PartUuid)
+		}
+		quantities[item.PartUuid] += item.Quantity
+	}
+
+	if fulfillment.PreferredWarehouseUuid != "" {
+		if err := s.checkWarehouse(ctx, fulfillment.PreferredWarehouseUuid); err != nil {
+			return nil, err
+		}
+	}
+
+	s.fulfillMu.Lock()
+	defer s.fulfillMu.Unlock()
+
+	if fulfillment.ReferenceID != "" {
+		existing, err := s.fulfilledMovements(ctx, fulfillment.ReferenceID, partUuids)
+		if err != nil {
+			return nil, err
+		}
+		if len(existing) > 0 {
+			return existing, nil
+		}
+	}
+
+	var movements []*model.StockMovement
+	for _, partUuid := range partUuids {
+		part, err := s.repo.Get(ctx, partUuid)
+		if err != nil {
+			if errors.Is(err, model.ErrPartNotFound) {
+				return nil, fmt.Errorf("%w: %s", model.ErrPartNotFound, partUuid)
+			}
+			return nil, model.ErrRepositoryOperation
+		}
+
+		allocation, err := part.AllocateStock(quantities[partUuid], fulfillment.PreferredWarehouseUuid)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, l := range allocation {
+			movement, err := newStockMovement(partUuid, l.WarehouseUuid, -l.Quantity,
+				model.StockMovementReasonSale, fulfillment.ReferenceID)
+			if err != nil {
+				return nil, err
+			}
+			movements = append(movements, movement)
+		}
+	}
+
+	for i, movement := range movements {
+		if err := s.repo.AdjustStock(ctx, movement); err != nil {
+			return nil, errors.Join(stockError(err), s.compensate(ctx, movements[:i]))
+		}
+	}
+
+	return movements, nil
+}
+
+// fulfilledMovements возвращает действующие движения SALE отгрузки referenceID: записанные
+// и не отменённые компенсацией (CORRECTION с той же ссылкой и обратным изменением)
+func (s *Service) fulfilledMovements(ctx context.Context, referenceID string, partUuids []string) ([]*model.StockMovement, error) {
+	var result []*model.StockMovement
+	for _, partUuid := range partUuids {
+		journal, err := s.repo.ListStockMovements(ctx, &model.StockMovementsQuery{PartUuid: partUuid, ReferenceID: referenceID})
+		if err != nil {
+			return nil, model.ErrRepositoryOperation
+		}
+
+		// Журнал - от новых к старым, компенсация следует за отменяемым движением
+		var sales []*model.StockMovement
+		for j := len(journal) - 1; j >= 0; j-- {
+			m := journal[j]
+			switch m.Reason {
+			case model.StockMovementReasonSale:
+				sales = append(sales, m)
+			case model.StockMovementReasonCorrection:
+				i := slices.IndexFunc(sales, func(sale *model.StockMovement) bool {
+					return sale.WarehouseUuid == m.WarehouseUuid && sale.Delta == -m.Delta
+				})
+				if i >= 0 {
+					sales = slices.Delete(sales, i, i+1)
+				}
+			}
+		}
+		result = append(result, sales...)
+	}
+
+	return result, nil
+}
+
+// compensate возвращает остатки, списанные движениями applied, в обратном порядке
+func (s *Service) compensate(ctx context.Context, applied []*model.StockMovement) error {
+	var errs []error
+	for i := len(applied) - 1; i >= 0; i-- {
+		m := applied[i]
+
+		correction, err := newStockMovement(m.PartUuid, m.WarehouseUuid, -m.Delta, model.StockMovementReasonCorrection, m.ReferenceID)
+		if err == nil {
+			err = s.repo.AdjustStock(ctx, correction)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to compensate stock movement %s: %w", m.Uuid, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
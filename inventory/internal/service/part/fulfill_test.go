@@ -0,0 +1,151 @@
+package part
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+const (
+	testSiteWarehouseUuid = "3f7c2b9e-4a1d-4e8b-9c01-0000000000aa"
+	testOrderReference    = "order-1"
+)
+
+func stockedPart(uuid string, levels ...model.StockLevel) *model.Part {
+	part := &model.Part{Uuid: uuid, StockLevels: levels}
+	part.NormalizeStockLevels()
+	return part
+}
+
+func saleMovement(partUuid, warehouseUuid string, delta int64) interface{} {
+	return mock.MatchedBy(func(m *model.StockMovement) bool {
+		return m.PartUuid == partUuid && m.WarehouseUuid == warehouseUuid && m.Delta == delta &&
+			m.Reason == model.StockMovementReasonSale && m.ReferenceID == testOrderReference
+	})
+}
+
+// expectJournal ожидает проверку движений отгрузки testOrderReference по детали
+func (s *PartServiceTestSuite) expectJournal(ctx context.Context, partUuid string, movements ...*model.StockMovement) {
+	s.mockRepo.On("ListStockMovements", ctx, &model.StockMovementsQuery{PartUuid: partUuid, ReferenceID: testOrderReference}).
+		Return(movements, nil)
+}
+
+func (s *PartServiceTestSuite) TestFulfillStock_PrefersWarehouseAndFallsBack() {
+	ctx := context.Background()
+	site := &model.Warehouse{Uuid: testSiteWarehouseUuid, Code: "site", Name: "Стартовая площадка"}
+
+	s.mockWarehouses.On("Get", ctx, site.Uuid).Return(site, nil)
+	s.expectJournal(ctx, "uuid-1")
+	s.mockRepo.On("Get", ctx, "uuid-1").Return(stockedPart("uuid-1",
+		model.StockLevel{WarehouseUuid: model.DefaultWarehouse.Uuid, Quantity: 10},
+		model.StockLevel{WarehouseUuid: site.Uuid, Quantity: 2},
+	), nil)
+	s.mockRepo.On("AdjustStock", ctx, saleMovement("uuid-1", site.Uuid, -2)).Return(nil).Once()
+	s.mockRepo.On("AdjustStock", ctx, saleMovement("uuid-1", model.DefaultWarehouse.Uuid, -3)).Return(nil).Once()
+
+	movements, err := s.service.FulfillStock(ctx, &model.StockFulfillment{
+		ReferenceID:            testOrderReference,
+		PreferredWarehouseUuid: site.Uuid,
+		// Повторяющаяся деталь списывается одной позицией
+		Items: []model.FulfillmentItem{{PartUuid: "uuid-1", Quantity: 3}, {PartUuid: "uuid-1", Quantity: 2}},
+	})
+
+	s.Require().NoError(err)
+	s.Len(movements, 2)
+}
+
+func (s *PartServiceTestSuite) TestFulfillStock_InsufficientStockWritesNothing() {
+	ctx := context.Background()
+	s.expectJournal(ctx, "uuid-1")
+	s.expectJournal(ctx, "uuid-2")
+
+	s.mockRepo.On("Get", ctx, "uuid-1").Return(stockedPart("uuid-1",
+		model.StockLevel{WarehouseUuid: model.DefaultWarehouse.Uuid, Quantity: 5},
+	), nil)
+	s.mockRepo.On("Get", ctx, "uuid-2").Return(stockedPart("uuid-2",
+		model.StockLevel{WarehouseUuid: model.DefaultWarehouse.Uuid, Quantity: 1},
+	), nil)
+
+	movements, err := s.service.FulfillStock(ctx, &model.StockFulfillment{
+		ReferenceID: testOrderReference,
+		Items:       []model.FulfillmentItem{{PartUuid: "uuid-1", Quantity: 1}, {PartUuid: "uuid-2", Quantity: 2}},
+	})
+
+	s.Nil(movements)
+	s.ErrorIs(err, model.ErrInsufficientStock)
+	s.mockRepo.AssertNotCalled(s.T(), "AdjustStock", mock.Anything, mock.Anything)
+}
+
+func (s *PartServiceTestSuite) TestFulfillStock_CompensatesAfterConcurrentChange() {
+	ctx := context.Background()
+	s.expectJournal(ctx, "uuid-1")
+	s.expectJournal(ctx, "uuid-2")
+
+	s.mockRepo.On("Get", ctx, "uuid-1").Return(stockedPart("uuid-1",
+		model.StockLevel{WarehouseUuid: model.DefaultWarehouse.Uuid, Quantity: 5},
+	), nil)
+	s.mockRepo.On("Get", ctx, "uuid-2").Return(stockedPart("uuid-2",
+		model.StockLevel{WarehouseUuid: model.DefaultWarehouse.Uuid, Quantity: 5},
+	), nil)
+	s.mockRepo.On("AdjustStock", ctx, saleMovement("uuid-1", model.DefaultWarehouse.Uuid, -1)).Return(nil).Once()
+	// Остаток второй детали успели списать между чтением и списанием
+	s.mockRepo.On("AdjustStock", ctx, saleMovement("uuid-2", model.DefaultWarehouse.Uuid, -2)).
+		Return(model.ErrInsufficientStock).Once()
+	s.mockRepo.On("AdjustStock", ctx, mock.MatchedBy(func(m *model.StockMovement) bool {
+		return m.PartUuid == "uuid-1" && m.Delta == 1 && m.Reason == model.StockMovementReasonCorrection
+	})).Return(nil).Once()
+
+	movements, err := s.service.FulfillStock(ctx, &model.StockFulfillment{
+		ReferenceID: testOrderReference,
+		Items:       []model.FulfillmentItem{{PartUuid: "uuid-1", Quantity: 1}, {PartUuid: "uuid-2", Quantity: 2}},
+	})
+
+	s.Nil(movements)
+	s.ErrorIs(err, model.ErrInsufficientStock)
+}
+
+func (s *PartServiceTestSuite) TestFulfillStock_RepeatReturnsExistingMovements() {
+	ctx := context.Background()
+	sale := &model.StockMovement{
+		Uuid: "m-2", PartUuid: "uuid-1", WarehouseUuid: model.DefaultWarehouse.Uuid, Delta: -1,
+		Reason: model.StockMovementReasonSale, ReferenceID: testOrderReference,
+	}
+	// Первая попытка была компенсирована, вторая прошла
+	s.expectJournal(ctx, "uuid-1", sale,
+		&model.StockMovement{
+			Uuid: "m-1", PartUuid: "uuid-1", WarehouseUuid: model.DefaultWarehouse.Uuid, Delta: 1,
+			Reason: model.StockMovementReasonCorrection, ReferenceID: testOrderReference,
+		},
+		&model.StockMovement{
+			Uuid: "m-0", PartUuid: "uuid-1", WarehouseUuid: model.DefaultWarehouse.Uuid, Delta: -1,
+			Reason: model.StockMovementReasonSale, ReferenceID: testOrderReference,
+		},
+	)
+	s.expectJournal(ctx, "uuid-2")
+
+	movements, err := s.service.FulfillStock(ctx, &model.StockFulfillment{
+		ReferenceID: testOrderReference,
+		Items:       []model.FulfillmentItem{{PartUuid: "uuid-1", Quantity: 1}, {PartUuid: "uuid-2", Quantity: 2}},
+	})
+
+	s.Require().NoError(err)
+	s.Equal([]*model.StockMovement{sale}, movements)
+	s.mockRepo.AssertNotCalled(s.T(), "AdjustStock", mock.Anything, mock.Anything)
+}
+
+func (s *PartServiceTestSuite) TestFulfillStock_InvalidRequest() {
+	cases := map[string]*model.StockFulfillment{
+		"no items":      {ReferenceID: testOrderReference},
+		"zero quantity": {Items: []model.FulfillmentItem{{PartUuid: "uuid-1"}}},
+		"no part uuid":  {Items: []model.FulfillmentItem{{Quantity: 1}}},
+	}
+
+	for name, fulfillment := range cases {
+		movements, err := s.service.FulfillStock(context.Background(), fulfillment)
+
+		s.Nil(movements, name)
+		s.ErrorIs(err, model.ErrInvalidFulfillment, name)
+	}
+}
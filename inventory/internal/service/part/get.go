@@ -3,14 +3,12 @@ package part
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
 )
 
-func (s *Service) GetPart(ctx context.Context, uuid string) (*model.Part, error) {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
-
+func (s *Service) GetPart(ctx context.Context, uuid string, asOf time.Time) (*model.Part, error) {
 	part, err := s.repo.Get(ctx, uuid)
 	if err != nil {
 		if errors.Is(err, model.ErrPartNotFound) {
@@ -19,5 +17,7 @@ func (s *Service) GetPart(ctx context.Context, uuid string) (*model.Part, error)
 		return nil, model.ErrRepositoryOperation
 	}
 
+	applyPricesAt([]*model.Part{part}, asOf)
+
 	return part, nil
 }
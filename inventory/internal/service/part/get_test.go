@@ -3,6 +3,7 @@ package part
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
 )
@@ -16,7 +17,7 @@ func (s *PartServiceTestSuite) TestGetPart_Success() {
 
 	s.mockRepo.On("Get", ctx, "test-uuid-123").Return(expectedPart, nil)
 
-	part, err := s.service.GetPart(ctx, "test-uuid-123")
+	part, err := s.service.GetPart(ctx, "test-uuid-123", time.Time{})
 
 	s.NoError(err)
 	s.NotNil(part)
@@ -29,7 +30,7 @@ func (s *PartServiceTestSuite) TestGetPart_NotFound() {
 
 	s.mockRepo.On("Get", ctx, "non-existent-uuid").Return(nil, model.ErrPartNotFound)
 
-	part, err := s.service.GetPart(ctx, "non-existent-uuid")
+	part, err := s.service.GetPart(ctx, "non-existent-uuid", time.Time{})
 
 	s.Nil(part)
 	s.ErrorIs(err, model.ErrPartNotFound)
@@ -41,7 +42,7 @@ func (s *PartServiceTestSuite) TestGetPart_RepositoryError() {
 
 	s.mockRepo.On("Get", ctx, "test-uuid").Return(nil, repoErr)
 
-	part, err := s.service.GetPart(ctx, "test-uuid")
+	part, err := s.service.GetPart(ctx, "test-uuid", time.Time{})
 
 	s.Nil(part)
 	s.ErrorIs(err, model.ErrRepositoryOperation)
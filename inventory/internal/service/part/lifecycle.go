@@ -0,0 +1,96 @@
+package part
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+func (s *Service) ChangeLifecycleState(ctx context.Context, change *model.LifecycleChange) (*model.Part, error) {
+	if !change.State.IsKnown() {
+		return nil, fmt.Errorf("%w: unknown lifecycle state", model.ErrInvalidLifecycleChange)
+	}
+	if change.ReplacementPartUuid != "" {
+		if !change.State.IsWithdrawn() {
+			return nil, fmt.Errorf("%w: replacement is allowed only for discontinued or recalled parts", model.ErrInvalidLifecycleChange)
+		}
+		if change.ReplacementPartUuid == change.PartUuid {
+			return nil, fmt.Errorf("%w: part cannot replace itself", model.ErrInvalidLifecycleChange)
+		}
+	}
+
+	existing, err := s.repo.Get(ctx, change.PartUuid)
+	if err != nil {
+		if errors.Is(err, model.ErrPartNotFound) {
+			return nil, model.ErrPartNotFound
+		}
+		return nil, model.ErrRepositoryOperation
+	}
+
+	if !existing.LifecycleState.CanTransitionTo(change.State) {
+		return nil, fmt.Errorf("%w: from %s to %s", model.ErrLifecycleTransitionNotAllowed, existing.LifecycleState, change.State)
+	}
+
+	if change.ReplacementPartUuid != "" {
+		if err := s.checkReplacement(ctx, change.ReplacementPartUuid); err != nil {
+			return nil, err
+		}
+	}
+
+	prevUpdatedAt := existing.LastModifiedAt()
+	now := nextUpdatedAt(prevUpdatedAt)
+
+	updated := existing.Clone()
+	updated.LifecycleState = change.State
+	updated.ReplacementPartUuid = change.ReplacementPartUuid
+	updated.UpdatedAt = &now
+
+	if err := s.repo.Update(ctx, updated, prevUpdatedAt); err != nil {
+		switch {
+		case errors.Is(err, model.ErrPartNotFound):
+			return nil, model.ErrPartNotFound
+		case errors.Is(err, model.ErrConcurrentModification):
+			return nil, model.ErrConcurrentModification
+		default:
+			return nil, model.ErrRepositoryOperation
+		}
+	}
+
+	return updated, nil
+}
+
+// checkReplacement проверяет, что замену можно заказать вместо выведенной из продажи детали
+func (s *Service) checkReplacement(ctx context.Context, uuid string) error {
+	replacement, err := s.repo.Get(ctx, uuid)
+	if err != nil {
+		if errors.Is(err, model.ErrPartNotFound) {
+			return fmt.Errorf("%w: replacement part %s not found", model.ErrInvalidLifecycleChange, uuid)
+		}
+		return model.ErrRepositoryOperation
+	}
+
+	if replacement.LifecycleState != model.LifecycleStateActive {
+		return fmt.Errorf("%w: replacement part %s is %s", model.ErrInvalidLifecycleChange, uuid, replacement.LifecycleState)
+	}
+
+	return nil
+}
+
+// initLifecycleState задаёт состояние новой детали: черновик или действующая (по умолчанию)
+func initLifecycleState(part *model.Part) error {
+	switch part.LifecycleState {
+	case model.LifecycleStateUnspecified:
+		part.LifecycleState = model.LifecycleStateActive
+	case model.LifecycleStateDraft, model.LifecycleStateActive:
+	default:
+		return fmt.Errorf("%w: part can be created only as draft or active", model.ErrInvalidPart)
+	}
+
+	if part.ReplacementPartUuid != "" {
+		return fmt.Errorf("%w: replacement_part_uuid is set by ChangePartLifecycleState", model.ErrInvalidPart)
+	}
+
+	return nil
+}
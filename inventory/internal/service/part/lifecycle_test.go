@@ -0,0 +1,205 @@
+package part
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+const testReplacementUuid = "6ba7b810-9dad-11d1-80b4-00c04fd430d1"
+
+// activeOnly - фильтр, который ListParts и GetPartFacets подставляют вместо пустого
+func activeOnly() *model.PartsFilter {
+	return &model.PartsFilter{LifecycleStates: []model.LifecycleState{model.LifecycleStateActive}}
+}
+
+func storedPartIn(state model.LifecycleState) *model.Part {
+	part := storedPart()
+	part.LifecycleState = state
+	return part
+}
+
+func (s *PartServiceTestSuite) TestChangeLifecycleState_DiscontinueWithReplacement() {
+	ctx := context.Background()
+	existing := storedPartIn(model.LifecycleStateActive)
+	replacement := storedPartIn(model.LifecycleStateActive)
+	replacement.Uuid = testReplacementUuid
+
+	s.mockRepo.On("Get", ctx, existing.Uuid).Return(existing, nil)
+	s.mockRepo.On("Get", ctx, testReplacementUuid).Return(replacement, nil)
+	s.mockRepo.On("Update", ctx, mock.AnythingOfType("*model.Part"), *existing.UpdatedAt).Return(nil)
+
+	part, err := s.service.ChangeLifecycleState(ctx, &model.LifecycleChange{
+		PartUuid:            existing.Uuid,
+		State:               model.LifecycleStateDiscontinued,
+		ReplacementPartUuid: testReplacementUuid,
+	})
+
+	s.Require().NoError(err)
+	s.Equal(model.LifecycleStateDiscontinued, part.LifecycleState)
+	s.Equal(testReplacementUuid, part.ReplacementPartUuid)
+	s.True(part.UpdatedAt.After(*existing.UpdatedAt))
+	s.Equal(model.LifecycleStateActive, existing.LifecycleState)
+}
+
+func (s *PartServiceTestSuite) TestChangeLifecycleState_ReactivationClearsReplacement() {
+	ctx := context.Background()
+	existing := storedPartIn(model.LifecycleStateRecalled)
+	existing.ReplacementPartUuid = testReplacementUuid
+
+	s.mockRepo.On("Get", ctx, existing.Uuid).Return(existing, nil)
+	s.mockRepo.On("Update", ctx, mock.AnythingOfType("*model.Part"), *existing.UpdatedAt).Return(nil)
+
+	part, err := s.service.ChangeLifecycleState(ctx, &model.LifecycleChange{
+		PartUuid: existing.Uuid,
+		State:    model.LifecycleStateActive,
+	})
+
+	s.Require().NoError(err)
+	s.Equal(model.LifecycleStateActive, part.LifecycleState)
+	s.Empty(part.ReplacementPartUuid)
+}
+
+func (s *PartServiceTestSuite) TestChangeLifecycleState_WithoutUpdatedAt() {
+	ctx := context.Background()
+	existing := storedPartIn(model.LifecycleStateActive)
+	existing.UpdatedAt = nil
+
+	s.mockRepo.On("Get", ctx, existing.Uuid).Return(existing, nil)
+	s.mockRepo.On("Update", ctx, mock.AnythingOfType("*model.Part"), *existing.CreatedAt).Return(nil)
+
+	part, err := s.service.ChangeLifecycleState(ctx, &model.LifecycleChange{
+		PartUuid: existing.Uuid,
+		State:    model.LifecycleStateDiscontinued,
+	})
+
+	s.Require().NoError(err)
+	s.Equal(model.LifecycleStateDiscontinued, part.LifecycleState)
+}
+
+func (s *PartServiceTestSuite) TestChangeLifecycleState_TransitionNotAllowed() {
+	ctx := context.Background()
+
+	cases := map[string]struct {
+		from, to model.LifecycleState
+	}{
+		"draft to discontinued": {model.LifecycleStateDraft, model.LifecycleStateDiscontinued},
+		"active to draft":       {model.LifecycleStateActive, model.LifecycleStateDraft},
+		"active to active":      {model.LifecycleStateActive, model.LifecycleStateActive},
+		"recalled to draft":     {model.LifecycleStateRecalled, model.LifecycleStateDraft},
+	}
+
+	for name, tc := range cases {
+		existing := storedPartIn(tc.from)
+		s.mockRepo.On("Get", ctx, existing.Uuid).Return(existing, nil).Once()
+
+		part, err := s.service.ChangeLifecycleState(ctx, &model.LifecycleChange{PartUuid: existing.Uuid, State: tc.to})
+
+		s.Nil(part, name)
+		s.ErrorIs(err, model.ErrLifecycleTransitionNotAllowed, name)
+	}
+}
+
+func (s *PartServiceTestSuite) TestChangeLifecycleState_InvalidChange() {
+	ctx := context.Background()
+	partUuid := storedPart().Uuid
+
+	cases := map[string]*model.LifecycleChange{
+		"unspecified state":       {PartUuid: partUuid},
+		"unknown state":           {PartUuid: partUuid, State: 42},
+		"replacement when active": {PartUuid: partUuid, State: model.LifecycleStateActive, ReplacementPartUuid: testReplacementUuid},
+		"replaces itself":         {PartUuid: partUuid, State: model.LifecycleStateRecalled, ReplacementPartUuid: partUuid},
+	}
+
+	for name, change := range cases {
+		part, err := s.service.ChangeLifecycleState(ctx, change)
+
+		s.Nil(part, name)
+		s.ErrorIs(err, model.ErrInvalidLifecycleChange, name)
+	}
+}
+
+func (s *PartServiceTestSuite) TestChangeLifecycleState_ReplacementMustBeActive() {
+	ctx := context.Background()
+	existing := storedPartIn(model.LifecycleStateActive)
+	replacement := storedPartIn(model.LifecycleStateDraft)
+	replacement.Uuid = testReplacementUuid
+
+	s.mockRepo.On("Get", ctx, existing.Uuid).Return(existing, nil).Twice()
+	s.mockRepo.On("Get", ctx, testReplacementUuid).Return(replacement, nil).Once()
+	s.mockRepo.On("Get", ctx, testReplacementUuid).Return(nil, model.ErrPartNotFound).Once()
+
+	change := &model.LifecycleChange{
+		PartUuid:            existing.Uuid,
+		State:               model.LifecycleStateRecalled,
+		ReplacementPartUuid: testReplacementUuid,
+	}
+
+	_, err := s.service.ChangeLifecycleState(ctx, change)
+	s.ErrorIs(err, model.ErrInvalidLifecycleChange)
+
+	_, err = s.service.ChangeLifecycleState(ctx, change)
+	s.ErrorIs(err, model.ErrInvalidLifecycleChange)
+}
+
+func (s *PartServiceTestSuite) TestChangeLifecycleState_NotFound() {
+	ctx := context.Background()
+
+	s.mockRepo.On("Get", ctx, "missing").Return(nil, model.ErrPartNotFound)
+
+	part, err := s.service.ChangeLifecycleState(ctx, &model.LifecycleChange{PartUuid: "missing", State: model.LifecycleStateActive})
+
+	s.Nil(part)
+	s.ErrorIs(err, model.ErrPartNotFound)
+}
+
+func (s *PartServiceTestSuite) TestChangeLifecycleState_ConcurrentModification() {
+	ctx := context.Background()
+	existing := storedPartIn(model.LifecycleStateDraft)
+
+	s.mockRepo.On("Get", ctx, existing.Uuid).Return(existing, nil)
+	s.mockRepo.On("Update", ctx, mock.AnythingOfType("*model.Part"), *existing.UpdatedAt).Return(model.ErrConcurrentModification)
+
+	part, err := s.service.ChangeLifecycleState(ctx, &model.LifecycleChange{PartUuid: existing.Uuid, State: model.LifecycleStateActive})
+
+	s.Nil(part)
+	s.ErrorIs(err, model.ErrConcurrentModification)
+}
+
+func (s *PartServiceTestSuite) TestCreatePart_LifecycleState() {
+	ctx := context.Background()
+	s.expectCategoryTree(ctx)
+	s.mockRepo.On("Create", ctx, mock.AnythingOfType("*model.Part")).Return(nil)
+
+	part, err := s.service.CreatePart(ctx, validPart())
+	s.Require().NoError(err)
+	s.Equal(model.LifecycleStateActive, part.LifecycleState)
+
+	draft := validPart()
+	draft.LifecycleState = model.LifecycleStateDraft
+	part, err = s.service.CreatePart(ctx, draft)
+	s.Require().NoError(err)
+	s.Equal(model.LifecycleStateDraft, part.LifecycleState)
+}
+
+func (s *PartServiceTestSuite) TestCreatePart_WithdrawnStateRejected() {
+	ctx := context.Background()
+
+	cases := map[string]func(p *model.Part){
+		"discontinued": func(p *model.Part) { p.LifecycleState = model.LifecycleStateDiscontinued },
+		"unknown":      func(p *model.Part) { p.LifecycleState = 42 },
+		"replacement":  func(p *model.Part) { p.ReplacementPartUuid = testReplacementUuid },
+	}
+
+	for name, mutate := range cases {
+		input := validPart()
+		mutate(input)
+
+		part, err := s.service.CreatePart(ctx, input)
+
+		s.Nil(part, name)
+		s.ErrorIs(err, model.ErrInvalidPart, name)
+	}
+}
@@ -2,83 +2,99 @@ package part
 
 import (
 	"context"
-	"slices"
+	"errors"
+	"fmt"
 
 	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
 )
 
-func (s *Service) ListParts(ctx context.Context, filter *model.PartsFilter) ([]*model.Part, error) {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
+const (
+	defaultPageSize = 50
+	maxPageSize     = 1000
+)
 
-	parts, err := s.repo.List(ctx)
+func (s *Service) ListParts(ctx context.Context, params *model.ListPartsParams) (*model.PartsPage, error) {
+	pageSize, err := normalizePageSize(params.PageSize)
 	if err != nil {
-		return nil, model.ErrRepositoryOperation
+		return nil, err
 	}
 
-	if isEmptyFilter(filter) {
-		return parts, nil
+	if err := validateFilter(params.Filter); err != nil {
+		return nil, err
 	}
-
-	var filteredParts []*model.Part
-	for _, part := range parts {
-		if matchesFilter(part, filter) {
-			filteredParts = append(filteredParts, part)
-		}
+	if !params.Order.Field.IsKnown() {
+		return nil, fmt.Errorf("%w: unknown order_by field", model.ErrInvalidListQuery)
 	}
-
-	return filteredParts, nil
-}
-
-func isEmptyFilter(filter *model.PartsFilter) bool {
-	return filter == nil ||
-		(len(filter.Uuids) == 0 &&
-			len(filter.Names) == 0 &&
-			len(filter.Categories) == 0 &&
-			len(filter.ManufacturerCountries) == 0 &&
-			len(filter.Tags) == 0)
-}
-
-func matchesFilter(part *model.Part, filter *model.PartsFilter) bool {
-	if len(filter.Uuids) > 0 && !slices.Contains(filter.Uuids, part.Uuid) {
-		return false
+	if params.Order.Field == model.PartsOrderFieldRelevance && !params.Filter.HasQuery() {
+		return nil, fmt.Errorf("%w: ordering by relevance requires filter.query", model.ErrInvalidListQuery)
+	}
+	// Фильтр и сортировка по цене работают с текущей ценой, сохранённой в детали
+	if !params.AsOf.IsZero() &&
+		((params.Filter != nil && !params.Filter.Price.IsEmpty()) || params.Order.Field == model.PartsOrderFieldPrice) {
+		return nil, fmt.Errorf("%w: as_of cannot be combined with price filter or ordering by price", model.ErrInvalidListQuery)
 	}
 
-	if len(filter.Names) > 0 && !slices.Contains(filter.Names, part.Name) {
-		return false
+	var after *model.PartsCursor
+	if params.PageToken != "" {
+		cursor, err := s.pageTokens.decode(params.PageToken, params.Filter, params.Order)
+		if err != nil {
+			return nil, err
+		}
+		after = cursor
 	}
 
-	if len(filter.Categories) > 0 && !containsCategory(filter.Categories, part.Category) {
-		return false
+	// Без условия на состояние в каталоге видны только действующие детали
+	filter, err := s.expandCategories(ctx, params.Filter.WithDefaultLifecycleStates())
+	if err != nil {
+		return nil, err
 	}
 
-	if len(filter.ManufacturerCountries) > 0 && !slices.Contains(filter.ManufacturerCountries, part.Manufacturer.Country) {
-		return false
+	// Запрашиваем на одну деталь больше, чтобы узнать, есть ли следующая страница
+	parts, err := s.repo.List(ctx, &model.PartsQuery{
+		Filter: filter,
+		Order:  params.Order,
+		After:  after,
+		Limit:  pageSize + 1,
+	})
+	if err != nil {
+		return nil, model.ErrRepositoryOperation
 	}
 
-	if len(filter.Tags) > 0 && !hasAnyTag(part.Tags, filter.Tags) {
-		return false
+	total, err := s.repo.Count(ctx, filter)
+	if err != nil {
+		return nil, model.ErrRepositoryOperation
 	}
 
-	return true
-}
+	page := &model.PartsPage{Parts: parts, TotalSize: total}
+	if len(parts) > pageSize {
+		page.Parts = parts[:pageSize]
 
-func containsCategory(categories []model.Category, category model.Category) bool {
-	for _, c := range categories {
-		if c == category {
-			return true
+		token, err := s.pageTokens.encode(params.Filter, params.Order, model.NewPartsCursor(page.Parts[pageSize-1]))
+		if errors.Is(err, model.ErrInvalidListQuery) {
+			return nil, err
+		}
+		if err != nil {
+			return nil, errors.Join(model.ErrRepositoryOperation, err)
 		}
+		page.NextPageToken = token
 	}
-	return false
+
+	// Курсор строится по сохранённой цене, поэтому цены на момент as_of подставляются после него
+	applyPricesAt(page.Parts, params.AsOf)
+
+	return page, nil
 }
 
-func hasAnyTag(partTags, filterTags []string) bool {
-	for _, ft := range filterTags {
-		for _, pt := range partTags {
-			if pt == ft {
-				return true
-			}
-		}
+// normalizePageSize применяет размер страницы по умолчанию и ограничивает максимальный
+func normalizePageSize(pageSize int) (int, error) {
+	switch {
+	case pageSize < 0:
+		return 0, fmt.Errorf("%w: page_size must not be negative", model.ErrInvalidListQuery)
+	case pageSize == 0:
+		return defaultPageSize, nil
+	case pageSize > maxPageSize:
+		return maxPageSize, nil
+	default:
+		return pageSize, nil
 	}
-	return false
 }
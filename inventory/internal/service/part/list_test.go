@@ -3,6 +3,11 @@ package part
 import (
 	"context"
 	"errors"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
 
 	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
 )
@@ -14,118 +19,187 @@ func (s *PartServiceTestSuite) TestListParts_Success_NoFilter() {
 		{Uuid: "uuid-2", Name: "Part 2", Manufacturer: &model.Manufacturer{Country: "DE"}},
 	}
 
-	s.mockRepo.On("List", ctx).Return(expectedParts, nil)
+	s.mockRepo.On("List", ctx, &model.PartsQuery{Filter: activeOnly(), Limit: defaultPageSize + 1}).Return(expectedParts, nil)
+	s.mockRepo.On("Count", ctx, activeOnly()).Return(2, nil)
 
-	parts, err := s.service.ListParts(ctx, nil)
+	page, err := s.service.ListParts(ctx, &model.ListPartsParams{})
 
 	s.NoError(err)
-	s.Len(parts, 2)
+	s.Len(page.Parts, 2)
+	s.Equal(2, page.TotalSize)
+	s.Empty(page.NextPageToken)
 }
 
-func (s *PartServiceTestSuite) TestListParts_Success_EmptyFilter() {
+func (s *PartServiceTestSuite) TestListParts_PassesFilterAndOrderToRepository() {
 	ctx := context.Background()
+	filter := &model.PartsFilter{
+		Categories:            []model.Category{model.CategoryEngine},
+		ManufacturerCountries: []string{"US"},
+		Tags:                  []string{"heavy"},
+		LifecycleStates:       []model.LifecycleState{model.LifecycleStateActive, model.LifecycleStateDiscontinued},
+	}
+	order := model.PartsOrder{Field: model.PartsOrderFieldPrice, Descending: true}
 	expectedParts := []*model.Part{
-		{Uuid: "uuid-1", Name: "Part 1", Manufacturer: &model.Manufacturer{Country: "US"}},
+		{Uuid: "uuid-1", Name: "Engine Part", Category: model.CategoryEngine, Tags: []string{"heavy"}},
 	}
 
-	s.mockRepo.On("List", ctx).Return(expectedParts, nil)
+	s.mockRepo.On("List", ctx, &model.PartsQuery{Filter: filter, Order: order, Limit: 11}).Return(expectedParts, nil)
+	s.mockRepo.On("Count", ctx, filter).Return(1, nil)
 
-	parts, err := s.service.ListParts(ctx, &model.PartsFilter{})
+	page, err := s.service.ListParts(ctx, &model.ListPartsParams{Filter: filter, Order: order, PageSize: 10})
 
 	s.NoError(err)
-	s.Len(parts, 1)
+	s.Equal(expectedParts, page.Parts)
 }
 
-func (s *PartServiceTestSuite) TestListParts_Success_FilterByUUIDs() {
+func (s *PartServiceTestSuite) TestListParts_PageSizeCapped() {
 	ctx := context.Background()
-	allParts := []*model.Part{
-		{Uuid: "uuid-1", Name: "Part 1", Manufacturer: &model.Manufacturer{Country: "US"}},
-		{Uuid: "uuid-2", Name: "Part 2", Manufacturer: &model.Manufacturer{Country: "DE"}},
-		{Uuid: "uuid-3", Name: "Part 3", Manufacturer: &model.Manufacturer{Country: "JP"}},
-	}
 
-	s.mockRepo.On("List", ctx).Return(allParts, nil)
+	s.mockRepo.On("List", ctx, &model.PartsQuery{Filter: activeOnly(), Limit: maxPageSize + 1}).Return([]*model.Part{}, nil)
+	s.mockRepo.On("Count", ctx, activeOnly()).Return(0, nil)
 
-	filter := &model.PartsFilter{Uuids: []string{"uuid-1", "uuid-3"}}
-	parts, err := s.service.ListParts(ctx, filter)
+	_, err := s.service.ListParts(ctx, &model.ListPartsParams{PageSize: 100000})
 
 	s.NoError(err)
-	s.Len(parts, 2)
-	s.Equal("uuid-1", parts[0].Uuid)
-	s.Equal("uuid-3", parts[1].Uuid)
 }
 
-func (s *PartServiceTestSuite) TestListParts_Success_FilterByCategory() {
+func (s *PartServiceTestSuite) TestListParts_NextPageToken() {
 	ctx := context.Background()
-	allParts := []*model.Part{
-		{Uuid: "uuid-1", Name: "Engine Part", Category: model.CategoryEngine, Manufacturer: &model.Manufacturer{Country: "US"}},
-		{Uuid: "uuid-2", Name: "Fuel Part", Category: model.CategoryFuel, Manufacturer: &model.Manufacturer{Country: "DE"}},
-		{Uuid: "uuid-3", Name: "Wing Part", Category: model.CategoryWing, Manufacturer: &model.Manufacturer{Country: "JP"}},
+	filter := &model.PartsFilter{Tags: []string{"heavy"}}
+	queried := &model.PartsFilter{Tags: []string{"heavy"}, LifecycleStates: activeOnly().LifecycleStates}
+	order := model.PartsOrder{Field: model.PartsOrderFieldName}
+	firstPage := []*model.Part{
+		{Uuid: "uuid-1", Name: "A"},
+		{Uuid: "uuid-2", Name: "B"},
+		{Uuid: "uuid-3", Name: "C"},
 	}
 
-	s.mockRepo.On("List", ctx).Return(allParts, nil)
+	s.mockRepo.On("List", ctx, &model.PartsQuery{Filter: queried, Order: order, Limit: 3}).Return(firstPage, nil).Once()
+	s.mockRepo.On("Count", ctx, queried).Return(3, nil)
 
-	filter := &model.PartsFilter{Categories: []model.Category{model.CategoryEngine}}
-	parts, err := s.service.ListParts(ctx, filter)
+	page, err := s.service.ListParts(ctx, &model.ListPartsParams{Filter: filter, Order: order, PageSize: 2})
 
-	s.NoError(err)
-	s.Len(parts, 1)
-	s.Equal("Engine Part", parts[0].Name)
+	s.Require().NoError(err)
+	s.Len(page.Parts, 2)
+	s.Equal(3, page.TotalSize)
+	s.Require().NotEmpty(page.NextPageToken)
+
+	after := &model.PartsCursor{Uuid: "uuid-2", Name: "B"}
+	s.mockRepo.On("List", ctx, &model.PartsQuery{Filter: queried, Order: order, After: after, Limit: 3}).
+		Return(firstPage[2:], nil).Once()
+
+	page, err = s.service.ListParts(ctx, &model.ListPartsParams{
+		Filter: filter, Order: order, PageSize: 2, PageToken: page.NextPageToken,
+	})
+
+	s.Require().NoError(err)
+	s.Equal(firstPage[2:], page.Parts)
+	s.Empty(page.NextPageToken)
 }
 
-func (s *PartServiceTestSuite) TestListParts_Success_FilterByCountry() {
+func (s *PartServiceTestSuite) TestListParts_InvalidPageToken() {
 	ctx := context.Background()
-	allParts := []*model.Part{
-		{Uuid: "uuid-1", Name: "Part 1", Manufacturer: &model.Manufacturer{Country: "US"}},
-		{Uuid: "uuid-2", Name: "Part 2", Manufacturer: &model.Manufacturer{Country: "DE"}},
-		{Uuid: "uuid-3", Name: "Part 3", Manufacturer: &model.Manufacturer{Country: "US"}},
+	order := model.PartsOrder{Field: model.PartsOrderFieldPrice}
+
+	token, err := s.service.pageTokens.encode(nil, order, &model.PartsCursor{Uuid: "uuid-1", Price: 10})
+	s.Require().NoError(err)
+
+	payload, mac, _ := strings.Cut(token, ".")
+	forged, err := pageTokenCodec{secret: []byte("other-secret")}.encode(nil, order, &model.PartsCursor{Uuid: "uuid-9"})
+	s.Require().NoError(err)
+	forgedPayload, _, _ := strings.Cut(forged, ".")
+
+	cases := map[string]*model.ListPartsParams{
+		"garbage":         {Order: order, PageToken: "garbage"},
+		"bad mac":         {Order: order, PageToken: payload + ".AAAA"},
+		"swapped payload": {Order: order, PageToken: forgedPayload + "." + mac},
+		"foreign secret":  {Order: order, PageToken: forged},
+		"other order":     {Order: model.PartsOrder{Field: model.PartsOrderFieldName}, PageToken: token},
+		"other filter":    {Order: order, Filter: &model.PartsFilter{Tags: []string{"x"}}, PageToken: token},
 	}
 
-	s.mockRepo.On("List", ctx).Return(allParts, nil)
+	for name, params := range cases {
+		page, err := s.service.ListParts(ctx, params)
 
-	filter := &model.PartsFilter{ManufacturerCountries: []string{"US"}}
-	parts, err := s.service.ListParts(ctx, filter)
+		s.Nil(page, name)
+		s.ErrorIs(err, model.ErrInvalidPageToken, name)
+	}
+}
 
-	s.NoError(err)
-	s.Len(parts, 2)
+func (s *PartServiceTestSuite) TestListParts_InvalidQuery() {
+	ctx := context.Background()
+
+	page, err := s.service.ListParts(ctx, &model.ListPartsParams{PageSize: -1})
+	s.Nil(page)
+	s.ErrorIs(err, model.ErrInvalidListQuery)
+
+	page, err = s.service.ListParts(ctx, &model.ListPartsParams{Order: model.PartsOrder{Field: 42}})
+	s.Nil(page)
+	s.ErrorIs(err, model.ErrInvalidListQuery)
+
+	page, err = s.service.ListParts(ctx, &model.ListPartsParams{Order: model.PartsOrder{Field: model.PartsOrderFieldRelevance}})
+	s.Nil(page)
+	s.ErrorIs(err, model.ErrInvalidListQuery)
 }
 
-func (s *PartServiceTestSuite) TestListParts_Success_FilterByTags() {
+func (s *PartServiceTestSuite) TestListParts_InvalidFilter() {
 	ctx := context.Background()
-	allParts := []*model.Part{
-		{Uuid: "uuid-1", Name: "Part 1", Tags: []string{"heavy", "hot"}, Manufacturer: &model.Manufacturer{Country: "US"}},
-		{Uuid: "uuid-2", Name: "Part 2", Tags: []string{"light"}, Manufacturer: &model.Manufacturer{Country: "DE"}},
-		{Uuid: "uuid-3", Name: "Part 3", Tags: []string{"heavy"}, Manufacturer: &model.Manufacturer{Country: "JP"}},
+	now := time.Now()
+
+	cases := map[string]*model.PartsFilter{
+		"price min > max":      {Price: &model.FloatRange{Min: lo.ToPtr(10.0), Max: lo.ToPtr(1.0)}},
+		"weight NaN":           {Weight: &model.FloatRange{Min: lo.ToPtr(math.NaN())}},
+		"price +Inf":           {Price: &model.FloatRange{Max: lo.ToPtr(math.Inf(1))}},
+		"time from > to":       {CreatedAt: &model.TimeRange{From: lo.ToPtr(now), To: lo.ToPtr(now.Add(-time.Hour))}},
+		"empty key":            {Metadata: []model.MetadataPredicate{{Operator: model.MetadataOperatorExists}}},
+		"dotted key":           {Metadata: []model.MetadataPredicate{{Key: "a.b", Operator: model.MetadataOperatorExists}}},
+		"operator key":         {Metadata: []model.MetadataPredicate{{Key: "$where", Operator: model.MetadataOperatorExists}}},
+		"unknown operator":     {Metadata: []model.MetadataPredicate{{Key: "a", Value: "x"}}},
+		"eq without value":     {Metadata: []model.MetadataPredicate{{Key: "a", Operator: model.MetadataOperatorEQ}}},
+		"gt with string value": {Metadata: []model.MetadataPredicate{{Key: "a", Operator: model.MetadataOperatorGT, Value: "x"}}},
+		"lt with -Inf value":   {Metadata: []model.MetadataPredicate{{Key: "a", Operator: model.MetadataOperatorLT, Value: math.Inf(-1)}}},
+		"eq with NaN value":    {Metadata: []model.MetadataPredicate{{Key: "a", Operator: model.MetadataOperatorEQ, Value: math.NaN()}}},
+		"eq with map value":    {Metadata: []model.MetadataPredicate{{Key: "a", Operator: model.MetadataOperatorEQ, Value: map[string]any{}}}},
+		"unspecified state":    {LifecycleStates: []model.LifecycleState{model.LifecycleStateUnspecified}},
 	}
 
-	s.mockRepo.On("List", ctx).Return(allParts, nil)
+	for name, filter := range cases {
+		page, err := s.service.ListParts(ctx, &model.ListPartsParams{Filter: filter})
 
-	filter := &model.PartsFilter{Tags: []string{"heavy"}}
-	parts, err := s.service.ListParts(ctx, filter)
+		s.Nil(page, name)
+		s.ErrorIs(err, model.ErrInvalidListQuery, name)
+	}
+}
 
-	s.NoError(err)
-	s.Len(parts, 2)
+func (s *PartServiceTestSuite) TestFilterHash_UnsupportedValue() {
+	hash, err := filterHash(&model.PartsFilter{Metadata: []model.MetadataPredicate{
+		{Key: "a", Operator: model.MetadataOperatorEQ, Value: math.Inf(1)},
+	}})
+
+	s.Empty(hash)
+	s.ErrorIs(err, model.ErrInvalidListQuery)
 }
 
 func (s *PartServiceTestSuite) TestListParts_RepositoryError() {
 	ctx := context.Background()
 	repoErr := errors.New("database error")
 
-	s.mockRepo.On("List", ctx).Return(nil, repoErr)
+	s.mockRepo.On("List", ctx, &model.PartsQuery{Filter: activeOnly(), Limit: defaultPageSize + 1}).Return(nil, repoErr)
 
-	parts, err := s.service.ListParts(ctx, nil)
+	page, err := s.service.ListParts(ctx, &model.ListPartsParams{})
 
-	s.Nil(parts)
+	s.Nil(page)
 	s.ErrorIs(err, model.ErrRepositoryOperation)
 }
 
-func (s *PartServiceTestSuite) TestListParts_EmptyResult() {
+func (s *PartServiceTestSuite) TestListParts_CountError() {
 	ctx := context.Background()
 
-	s.mockRepo.On("List", ctx).Return([]*model.Part{}, nil)
+	s.mockRepo.On("List", ctx, &model.PartsQuery{Filter: activeOnly(), Limit: defaultPageSize + 1}).Return([]*model.Part{}, nil)
+	s.mockRepo.On("Count", ctx, activeOnly()).Return(0, errors.New("database error"))
 
-	parts, err := s.service.ListParts(ctx, nil)
+	page, err := s.service.ListParts(ctx, &model.ListPartsParams{})
 
-	s.NoError(err)
-	s.Empty(parts)
+	s.Nil(page)
+	s.ErrorIs(err, model.ErrRepositoryOperation)
 }
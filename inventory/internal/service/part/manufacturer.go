@@ -0,0 +1,28 @@
+package part
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+// resolveManufacturer заменяет производителя детали, ссылающегося на справочник, копией записи
+// справочника: название, страна и сайт из запроса не сохраняются
+func (s *Service) resolveManufacturer(ctx context.Context, part *model.Part) error {
+	if !part.Manufacturer.IsReference() {
+		return nil
+	}
+
+	manufacturer, err := s.manufacturers.Get(ctx, part.Manufacturer.Uuid)
+	if err != nil {
+		if errors.Is(err, model.ErrManufacturerNotFound) {
+			return fmt.Errorf("%w: manufacturer %s not found", model.ErrInvalidPart, part.Manufacturer.Uuid)
+		}
+		return model.ErrRepositoryOperation
+	}
+
+	part.Manufacturer = manufacturer
+	return nil
+}
@@ -0,0 +1,60 @@
+package part
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+const testManufacturerUuid = "6ba7b811-9dad-11d1-80b4-00c04fd430c8"
+
+func (s *PartServiceTestSuite) TestCreatePart_ResolvesManufacturerReference() {
+	ctx := context.Background()
+	s.expectCategoryTree(ctx)
+	input := validPart()
+	input.Manufacturer = &model.Manufacturer{Uuid: testManufacturerUuid, Name: "ignored"}
+	catalogEntry := &model.Manufacturer{Uuid: testManufacturerUuid, Name: "SpaceTech", Country: "USA"}
+
+	s.mockManufacturers.On("Get", ctx, testManufacturerUuid).Return(catalogEntry, nil)
+	s.mockRepo.On("Create", ctx, mock.AnythingOfType("*model.Part")).Return(nil)
+
+	part, err := s.service.CreatePart(ctx, input)
+
+	s.NoError(err)
+	s.Equal(catalogEntry, part.Manufacturer)
+}
+
+func (s *PartServiceTestSuite) TestCreatePart_UnknownManufacturer() {
+	ctx := context.Background()
+	s.expectCategoryTree(ctx)
+	input := validPart()
+	input.Manufacturer = &model.Manufacturer{Uuid: testManufacturerUuid}
+
+	s.mockManufacturers.On("Get", ctx, testManufacturerUuid).Return(nil, model.ErrManufacturerNotFound)
+
+	part, err := s.service.CreatePart(ctx, input)
+
+	s.Nil(part)
+	s.ErrorIs(err, model.ErrInvalidPart)
+}
+
+func (s *PartServiceTestSuite) TestUpdatePart_LinkManufacturerByUuid() {
+	ctx := context.Background()
+	existing := storedPart()
+	catalogEntry := &model.Manufacturer{Uuid: testManufacturerUuid, Name: "GlassWorks", Country: "Russia", Website: "glass.ru"}
+
+	s.mockRepo.On("Get", ctx, existing.Uuid).Return(existing, nil)
+	s.mockManufacturers.On("Get", ctx, testManufacturerUuid).Return(catalogEntry, nil)
+	s.mockRepo.On("Update", ctx, mock.AnythingOfType("*model.Part"), *existing.UpdatedAt).Return(nil)
+
+	part, err := s.service.UpdatePart(ctx, &model.PartUpdate{
+		Part:  &model.Part{Uuid: existing.Uuid, Manufacturer: &model.Manufacturer{Uuid: testManufacturerUuid}},
+		Paths: []string{model.PartFieldManufacturerUuid},
+	})
+
+	s.NoError(err)
+	s.Equal(catalogEntry, part.Manufacturer)
+	s.True(part.UpdatedAt.After(*existing.UpdatedAt))
+}
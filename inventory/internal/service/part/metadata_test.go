@@ -0,0 +1,104 @@
+package part
+
+import (
+	"context"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+// schemaCategories - дерево testCategories, в котором у "Авионики" есть схема метаданных,
+// а "Навигация" добавляет к ней своё поле
+func schemaCategories() []*model.CategoryNode {
+	nodes := testCategories()
+	nodes[4].MetadataSchema = []model.MetadataField{
+		{Key: "voltage", Type: model.MetadataTypeDouble, Unit: "В", Required: true, Range: &model.FloatRange{Min: lo.ToPtr(0.0), Max: lo.ToPtr(48.0)}},
+	}
+	nodes[5].MetadataSchema = []model.MetadataField{
+		{Key: "channels", Type: model.MetadataTypeInt64},
+	}
+	return nodes
+}
+
+func navigationPart(metadata map[string]any) *model.Part {
+	part := validPart()
+	part.Category = model.CategoryUnspecified
+	part.CategoryUuid = testNavigationUuid
+	part.Metadata = metadata
+	return part
+}
+
+func (s *PartServiceTestSuite) TestCreatePart_MetadataMatchesInheritedSchema() {
+	ctx := context.Background()
+	s.expectCategoryTree(ctx, schemaCategories()...)
+
+	s.mockCategories.On("Get", ctx, testNavigationUuid).Return(schemaCategories()[5], nil)
+	s.mockRepo.On("Create", ctx, mock.AnythingOfType("*model.Part")).Return(nil)
+
+	// Целое значение допустимо для поля с плавающей точкой, ключи вне схемы не проверяются
+	_, err := s.service.CreatePart(ctx, navigationPart(map[string]any{"voltage": int64(28), "channels": int64(12), "vendor_code": "N-1"}))
+
+	s.NoError(err)
+}
+
+func (s *PartServiceTestSuite) TestCreatePart_MetadataViolatesSchema() {
+	ctx := context.Background()
+	s.expectCategoryTree(ctx, schemaCategories()...)
+	s.mockCategories.On("Get", ctx, testNavigationUuid).Return(schemaCategories()[5], nil)
+
+	cases := map[string]map[string]any{
+		"required field of ancestor missing": {"channels": int64(12)},
+		"wrong type":                         {"voltage": 28.0, "channels": 12.5},
+		"out of range":                       {"voltage": 120.0},
+		"string instead of number":           {"voltage": "28 V"},
+	}
+
+	for name, metadata := range cases {
+		part, err := s.service.CreatePart(ctx, navigationPart(metadata))
+
+		s.Nil(part, name)
+		s.ErrorIs(err, model.ErrInvalidPart, name)
+	}
+}
+
+func (s *PartServiceTestSuite) TestUpdatePart_MetadataCheckedWhenChanged() {
+	ctx := context.Background()
+	s.expectCategoryTree(ctx, schemaCategories()...)
+	existing := storedPart()
+	existing.Category = model.CategoryUnspecified
+	existing.CategoryUuid = testNavigationUuid
+	existing.Metadata = map[string]any{"voltage": 28.0}
+
+	s.mockRepo.On("Get", ctx, existing.Uuid).Return(existing, nil)
+	s.mockCategories.On("Get", ctx, testNavigationUuid).Return(schemaCategories()[5], nil)
+
+	part, err := s.service.UpdatePart(ctx, &model.PartUpdate{
+		Part:  &model.Part{Uuid: existing.Uuid, Metadata: map[string]any{"channels": int64(4)}},
+		Paths: []string{model.PartFieldMetadata},
+	})
+
+	s.Nil(part)
+	s.ErrorIs(err, model.ErrInvalidPart)
+}
+
+func (s *PartServiceTestSuite) TestUpdatePart_OtherFieldsSkipMetadataCheck() {
+	ctx := context.Background()
+	// Деталь сохранена до появления обязательного поля в схеме
+	existing := storedPart()
+	existing.Category = model.CategoryUnspecified
+	existing.CategoryUuid = testNavigationUuid
+
+	s.mockRepo.On("Get", ctx, existing.Uuid).Return(existing, nil)
+	s.mockCategories.On("Get", ctx, testNavigationUuid).Return(schemaCategories()[5], nil)
+	s.mockRepo.On("Update", ctx, mock.AnythingOfType("*model.Part"), *existing.UpdatedAt).Return(nil)
+
+	part, err := s.service.UpdatePart(ctx, &model.PartUpdate{
+		Part:  &model.Part{Uuid: existing.Uuid, Name: "Navigation Unit"},
+		Paths: []string{model.PartFieldName},
+	})
+
+	s.NoError(err)
+	s.Equal("Navigation Unit", part.Name)
+}
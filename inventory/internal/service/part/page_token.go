@@ -0,0 +1,164 @@
+package part
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+// pageTokenPayload - содержимое токена страницы. Токен привязан к фильтру и порядку сортировки,
+// с которыми был выдан, и подписан HMAC-SHA256, поэтому подделанный или чужой токен отклоняется.
+type pageTokenPayload struct {
+	FilterHash    string                `json:"h,omitempty"`
+	OrderField    model.PartsOrderField `json:"f,omitempty"`
+	Descending    bool                  `json:"d,omitempty"`
+	Uuid          string                `json:"u"`
+	Price         float64               `json:"p,omitempty"`
+	Name          string                `json:"n,omitempty"`
+	CreatedAt     time.Time             `json:"c,omitzero"`
+	StockQuantity int64                 `json:"s,omitempty"`
+	Score         float64               `json:"r,omitempty"`
+}
+
+// Виды токенов: подпись учитывает вид, поэтому токен одного списка нельзя подставить в другой
+const (
+	pageTokenKindParts          = "parts"
+	pageTokenKindStockMovements = "stock_movements"
+)
+
+type pageTokenCodec struct {
+	secret []byte
+}
+
+// seal сериализует содержимое токена и подписывает его вместе с видом токена
+func (c pageTokenCodec) seal(kind string, v any) (string, error) {
+	payload, err := json.Marshal(v)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal page token: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(payload) + "." +
+		base64.RawURLEncoding.EncodeToString(c.sign(kind, payload)), nil
+}
+
+// open проверяет подпись токена и десериализует его содержимое в v
+func (c pageTokenCodec) open(kind, token string, v any) error {
+	encodedPayload, encodedMAC, ok := strings.Cut(token, ".")
+	if !ok {
+		return model.ErrInvalidPageToken
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return model.ErrInvalidPageToken
+	}
+
+	mac, err := base64.RawURLEncoding.DecodeString(encodedMAC)
+	if err != nil || !hmac.Equal(mac, c.sign(kind, payload)) {
+		return model.ErrInvalidPageToken
+	}
+
+	if err := json.Unmarshal(payload, v); err != nil {
+		return model.ErrInvalidPageToken
+	}
+
+	return nil
+}
+
+func (c pageTokenCodec) sign(kind string, payload []byte) []byte {
+	h := hmac.New(sha256.New, c.secret)
+	h.Write([]byte(kind))
+	h.Write([]byte{0})
+	h.Write(payload)
+	return h.Sum(nil)
+}
+
+func (c pageTokenCodec) encode(filter *model.PartsFilter, order model.PartsOrder, cursor *model.PartsCursor) (string, error) {
+	hash, err := filterHash(filter)
+	if err != nil {
+		return "", err
+	}
+
+	return c.seal(pageTokenKindParts, pageTokenPayload{
+		FilterHash:    hash,
+		OrderField:    order.Field,
+		Descending:    order.Descending,
+		Uuid:          cursor.Uuid,
+		Price:         cursor.Price,
+		Name:          cursor.Name,
+		CreatedAt:     cursor.CreatedAt,
+		StockQuantity: cursor.StockQuantity,
+		Score:         cursor.Score,
+	})
+}
+
+func (c pageTokenCodec) decode(token string, filter *model.PartsFilter, order model.PartsOrder) (*model.PartsCursor, error) {
+	var p pageTokenPayload
+	if err := c.open(pageTokenKindParts, token, &p); err != nil {
+		return nil, err
+	}
+
+	hash, err := filterHash(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.FilterHash != hash || p.OrderField != order.Field || p.Descending != order.Descending {
+		return nil, fmt.Errorf("%w: filter or order_by differs from the previous page", model.ErrInvalidPageToken)
+	}
+
+	return &model.PartsCursor{
+		Uuid:          p.Uuid,
+		Price:         p.Price,
+		Name:          p.Name,
+		CreatedAt:     p.CreatedAt,
+		StockQuantity: p.StockQuantity,
+		Score:         p.Score,
+	}, nil
+}
+
+// movementsTokenPayload - содержимое токена страницы журнала движений
+type movementsTokenPayload struct {
+	PartUuid string `json:"p"`
+	Before   string `json:"b"`
+}
+
+func (c pageTokenCodec) encodeMovements(partUuid, before string) (string, error) {
+	return c.seal(pageTokenKindStockMovements, movementsTokenPayload{PartUuid: partUuid, Before: before})
+}
+
+func (c pageTokenCodec) decodeMovements(token, partUuid string) (string, error) {
+	var p movementsTokenPayload
+	if err := c.open(pageTokenKindStockMovements, token, &p); err != nil {
+		return "", err
+	}
+
+	if p.PartUuid != partUuid {
+		return "", fmt.Errorf("%w: part_uuid differs from the previous page", model.ErrInvalidPageToken)
+	}
+
+	return p.Before, nil
+}
+
+// filterHash возвращает отпечаток фильтра; пустой фильтр и его отсутствие эквивалентны.
+// validateFilter отсекает значения, которые нельзя сериализовать (NaN, ±Inf, значения метаданных
+// неподдерживаемых типов), но на непроверенный фильтр возвращается model.ErrInvalidListQuery.
+func filterHash(filter *model.PartsFilter) (string, error) {
+	if filter.IsEmpty() {
+		return "", nil
+	}
+
+	data, err := json.Marshal(filter)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", model.ErrInvalidListQuery, err)
+	}
+	sum := sha256.Sum256(data)
+
+	return base64.RawURLEncoding.EncodeToString(sum[:16]), nil
+}
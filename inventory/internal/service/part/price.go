@@ -0,0 +1,136 @@
+package part
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+func (s *Service) SchedulePriceChange(ctx context.Context, change *model.PriceChange) (*model.PriceVersion, error) {
+	if change.Price <= 0 || !model.IsFinite(change.Price) {
+		return nil, fmt.Errorf("%w: price must be a positive finite number", model.ErrInvalidPriceChange)
+	}
+	// Прошлое истории цен не переписывается, а немедленное изменение - это UpdatePart
+	if !change.EffectiveFrom.After(time.Now()) {
+		return nil, fmt.Errorf("%w: effective_from must be in the future", model.ErrInvalidPriceChange)
+	}
+
+	existing, err := s.repo.Get(ctx, change.PartUuid)
+	if err != nil {
+		if errors.Is(err, model.ErrPartNotFound) {
+			return nil, model.ErrPartNotFound
+		}
+		return nil, model.ErrRepositoryOperation
+	}
+
+	prevUpdatedAt := existing.LastModifiedAt()
+	now := nextUpdatedAt(prevUpdatedAt)
+
+	version, err := newPriceVersion(change.Price, change.EffectiveFrom.UTC().Truncate(time.Millisecond), now)
+	if err != nil {
+		return nil, err
+	}
+
+	updated := existing.Clone()
+	updated.AddPriceVersion(version)
+	updated.ApplyPriceHistory(now)
+	updated.UpdatedAt = &now
+
+	if err := s.repo.Update(ctx, updated, prevUpdatedAt); err != nil {
+		switch {
+		case errors.Is(err, model.ErrPartNotFound):
+			return nil, model.ErrPartNotFound
+		case errors.Is(err, model.ErrConcurrentModification):
+			return nil, model.ErrConcurrentModification
+		default:
+			return nil, model.ErrRepositoryOperation
+		}
+	}
+
+	return &version, nil
+}
+
+func (s *Service) GetPriceHistory(ctx context.Context, partUuid string) ([]model.PriceVersion, error) {
+	part, err := s.repo.Get(ctx, partUuid)
+	if err != nil {
+		if errors.Is(err, model.ErrPartNotFound) {
+			return nil, model.ErrPartNotFound
+		}
+		return nil, model.ErrRepositoryOperation
+	}
+
+	return part.PriceHistory, nil
+}
+
+// ApplyScheduledPrices переносит в детали цены запланированных версий, которые уже начали действовать,
+// чтобы фильтр и сортировка ListParts по цене видели текущую цену. Деталь, изменённую одновременно
+// с переносом, пропускает до следующего вызова. Возвращает количество обновлённых деталей.
+func (s *Service) ApplyScheduledPrices(ctx context.Context) (int, error) {
+	parts, err := s.repo.ListDuePriceChanges(ctx, timestamp())
+	if err != nil {
+		return 0, model.ErrRepositoryOperation
+	}
+
+	applied := 0
+	for _, part := range parts {
+		prevUpdatedAt := part.LastModifiedAt()
+		now := nextUpdatedAt(prevUpdatedAt)
+
+		part.ApplyPriceHistory(now)
+		part.UpdatedAt = &now
+
+		err := s.repo.Update(ctx, part, prevUpdatedAt)
+		switch {
+		case err == nil:
+			applied++
+		case errors.Is(err, model.ErrPartNotFound), errors.Is(err, model.ErrConcurrentModification):
+		default:
+			return applied, model.ErrRepositoryOperation
+		}
+	}
+
+	return applied, nil
+}
+
+// applyPricesAt заменяет цены деталей ценами, действующими в момент asOf (нулевой - текущий)
+func applyPricesAt(parts []*model.Part, asOf time.Time) {
+	if asOf.IsZero() {
+		asOf = timestamp()
+	}
+
+	for _, part := range parts {
+		part.Price = part.PriceAt(asOf)
+	}
+}
+
+// newPriceVersion создаёт версию цены с UUIDv7, чтобы из версий с одинаковым началом действия
+// побеждала созданная последней
+func newPriceVersion(price float64, effectiveFrom, createdAt time.Time) (model.PriceVersion, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return model.PriceVersion{}, errors.Join(model.ErrRepositoryOperation, err)
+	}
+
+	return model.PriceVersion{
+		Uuid:          id.String(),
+		Price:         price,
+		EffectiveFrom: effectiveFrom,
+		CreatedAt:     createdAt,
+	}, nil
+}
+
+// nextUpdatedAt возвращает текущее время, но строго позже prev: иначе два обновления в пределах
+// одной миллисекунды были бы неразличимы для проверки конкурентного изменения
+func nextUpdatedAt(prev time.Time) time.Time {
+	now := timestamp()
+	if !now.After(prev) {
+		now = prev.Add(time.Millisecond)
+	}
+
+	return now
+}
@@ -0,0 +1,201 @@
+package part
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+// pricedPart - деталь, созданная по цене 1500 с запланированным повышением до 1800
+func pricedPart() *model.Part {
+	part := storedPart()
+	part.PriceHistory = []model.PriceVersion{
+		{Uuid: "0190a000-0000-7000-8000-000000000001", Price: 1500, EffectiveFrom: *part.CreatedAt, CreatedAt: *part.CreatedAt},
+		{Uuid: "0190a000-0000-7000-8000-000000000002", Price: 1800, EffectiveFrom: time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC), CreatedAt: *part.CreatedAt},
+	}
+	part.NextPriceChangeAt = lo.ToPtr(part.PriceHistory[1].EffectiveFrom)
+	return part
+}
+
+func (s *PartServiceTestSuite) TestCreatePart_StartsPriceHistory() {
+	ctx := context.Background()
+	s.expectCategoryTree(ctx)
+	input := validPart()
+	input.PriceHistory = []model.PriceVersion{{Price: 1}}
+
+	s.mockRepo.On("Create", ctx, mock.AnythingOfType("*model.Part")).Return(nil)
+
+	part, err := s.service.CreatePart(ctx, input)
+
+	s.Require().NoError(err)
+	s.Require().Len(part.PriceHistory, 1)
+	s.Equal(input.Price, part.PriceHistory[0].Price)
+	s.Equal(*part.CreatedAt, part.PriceHistory[0].EffectiveFrom)
+	s.Nil(part.NextPriceChangeAt)
+}
+
+func (s *PartServiceTestSuite) TestUpdatePart_PriceChangeAddsVersion() {
+	ctx := context.Background()
+	existing := pricedPart()
+
+	s.mockRepo.On("Get", ctx, existing.Uuid).Return(existing, nil)
+	s.mockRepo.On("Update", ctx, mock.AnythingOfType("*model.Part"), *existing.UpdatedAt).Return(nil)
+
+	part, err := s.service.UpdatePart(ctx, &model.PartUpdate{
+		Part:  &model.Part{Uuid: existing.Uuid, Price: 1600},
+		Paths: []string{model.PartFieldPrice},
+	})
+
+	s.Require().NoError(err)
+	s.Equal(1600.0, part.Price)
+	s.Require().Len(part.PriceHistory, 3)
+	s.Equal(1600.0, part.PriceHistory[2].Price)
+	s.Equal(*part.UpdatedAt, part.PriceHistory[2].EffectiveFrom)
+}
+
+func (s *PartServiceTestSuite) TestUpdatePart_DueScheduledPriceIsNotNewVersion() {
+	ctx := context.Background()
+	existing := pricedPart()
+
+	s.mockRepo.On("Get", ctx, existing.Uuid).Return(existing, nil)
+	s.mockRepo.On("Update", ctx, mock.AnythingOfType("*model.Part"), *existing.UpdatedAt).Return(nil)
+
+	// Клиент прочитал деталь по уже действующей цене 1800, которая ещё не перенесена в деталь
+	part, err := s.service.UpdatePart(ctx, &model.PartUpdate{
+		Part:  &model.Part{Uuid: existing.Uuid, Name: "Porthole M", Price: 1800},
+		Paths: []string{model.PartFieldName, model.PartFieldPrice},
+	})
+
+	s.Require().NoError(err)
+	s.Equal(1800.0, part.Price)
+	s.Len(part.PriceHistory, 2)
+	s.Nil(part.NextPriceChangeAt)
+}
+
+func (s *PartServiceTestSuite) TestSchedulePriceChange_Success() {
+	ctx := context.Background()
+	existing := storedPart()
+	effectiveFrom := time.Now().Add(24 * time.Hour).UTC().Truncate(time.Millisecond)
+
+	s.mockRepo.On("Get", ctx, existing.Uuid).Return(existing, nil)
+	s.mockRepo.On("Update", ctx, mock.MatchedBy(func(p *model.Part) bool {
+		return p.Price == existing.Price && p.NextPriceChangeAt != nil && p.NextPriceChangeAt.Equal(effectiveFrom)
+	}), *existing.UpdatedAt).Return(nil)
+
+	version, err := s.service.SchedulePriceChange(ctx, &model.PriceChange{
+		PartUuid:      existing.Uuid,
+		Price:         2000,
+		EffectiveFrom: effectiveFrom,
+	})
+
+	s.Require().NoError(err)
+	s.NotEmpty(version.Uuid)
+	s.Equal(2000.0, version.Price)
+	s.Equal(effectiveFrom, version.EffectiveFrom)
+}
+
+func (s *PartServiceTestSuite) TestSchedulePriceChange_Validation() {
+	ctx := context.Background()
+
+	cases := map[string]*model.PriceChange{
+		"zero price": {PartUuid: "test-uuid", EffectiveFrom: time.Now().Add(time.Hour)},
+		"past":       {PartUuid: "test-uuid", Price: 10, EffectiveFrom: time.Now().Add(-time.Hour)},
+		"missing":    {PartUuid: "test-uuid", Price: 10},
+		"NaN price":  {PartUuid: "test-uuid", Price: math.NaN(), EffectiveFrom: time.Now().Add(time.Hour)},
+		"+Inf price": {PartUuid: "test-uuid", Price: math.Inf(1), EffectiveFrom: time.Now().Add(time.Hour)},
+	}
+
+	for name, change := range cases {
+		version, err := s.service.SchedulePriceChange(ctx, change)
+
+		s.Nil(version, name)
+		s.ErrorIs(err, model.ErrInvalidPriceChange, name)
+	}
+}
+
+func (s *PartServiceTestSuite) TestSchedulePriceChange_ConcurrentModification() {
+	ctx := context.Background()
+	existing := storedPart()
+
+	s.mockRepo.On("Get", ctx, existing.Uuid).Return(existing, nil)
+	s.mockRepo.On("Update", ctx, mock.AnythingOfType("*model.Part"), *existing.UpdatedAt).Return(model.ErrConcurrentModification)
+
+	version, err := s.service.SchedulePriceChange(ctx, &model.PriceChange{
+		PartUuid:      existing.Uuid,
+		Price:         2000,
+		EffectiveFrom: time.Now().Add(time.Hour),
+	})
+
+	s.Nil(version)
+	s.ErrorIs(err, model.ErrConcurrentModification)
+}
+
+func (s *PartServiceTestSuite) TestGetPart_PriceAsOf() {
+	ctx := context.Background()
+	existing := pricedPart()
+
+	s.mockRepo.On("Get", ctx, existing.Uuid).Return(existing.Clone(), nil).Twice()
+
+	before, err := s.service.GetPart(ctx, existing.Uuid, time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC))
+	s.Require().NoError(err)
+	s.Equal(1500.0, before.Price)
+
+	current, err := s.service.GetPart(ctx, existing.Uuid, time.Time{})
+	s.Require().NoError(err)
+	s.Equal(1800.0, current.Price)
+}
+
+func (s *PartServiceTestSuite) TestListParts_PricesAsOf() {
+	ctx := context.Background()
+	existing := pricedPart()
+
+	s.mockRepo.On("List", ctx, &model.PartsQuery{Filter: activeOnly(), Limit: defaultPageSize + 1}).Return([]*model.Part{existing}, nil)
+	s.mockRepo.On("Count", ctx, activeOnly()).Return(1, nil)
+
+	page, err := s.service.ListParts(ctx, &model.ListPartsParams{AsOf: time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)})
+
+	s.Require().NoError(err)
+	s.Equal(1500.0, page.Parts[0].Price)
+}
+
+func (s *PartServiceTestSuite) TestListParts_AsOfWithPriceQuery() {
+	ctx := context.Background()
+	asOf := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	_, err := s.service.ListParts(ctx, &model.ListPartsParams{
+		AsOf:  asOf,
+		Order: model.PartsOrder{Field: model.PartsOrderFieldPrice},
+	})
+	s.ErrorIs(err, model.ErrInvalidListQuery)
+
+	_, err = s.service.ListParts(ctx, &model.ListPartsParams{
+		AsOf:   asOf,
+		Filter: &model.PartsFilter{Price: &model.FloatRange{Min: lo.ToPtr(100.0)}},
+	})
+	s.ErrorIs(err, model.ErrInvalidListQuery)
+}
+
+func (s *PartServiceTestSuite) TestApplyScheduledPrices() {
+	ctx := context.Background()
+	due := pricedPart()
+	changed := pricedPart()
+	changed.Uuid = "6ba7b810-9dad-11d1-80b4-00c04fd430d1"
+
+	s.mockRepo.On("ListDuePriceChanges", ctx, mock.AnythingOfType("time.Time")).Return([]*model.Part{due, changed}, nil)
+	s.mockRepo.On("Update", ctx, mock.MatchedBy(func(p *model.Part) bool {
+		return p.Uuid == due.Uuid && p.Price == 1800 && p.NextPriceChangeAt == nil
+	}), *due.UpdatedAt).Return(nil)
+	s.mockRepo.On("Update", ctx, mock.MatchedBy(func(p *model.Part) bool {
+		return p.Uuid == changed.Uuid
+	}), *changed.UpdatedAt).Return(model.ErrConcurrentModification)
+
+	applied, err := s.service.ApplyScheduledPrices(ctx)
+
+	s.NoError(err)
+	s.Equal(1, applied)
+}
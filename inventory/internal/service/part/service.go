@@ -7,12 +7,32 @@ import (
 )
 
 type Service struct {
-	repo repository.PartRepository
-	mu   sync.RWMutex
+	repo          repository.PartRepository
+	manufacturers repository.ManufacturerRepository
+	categories    repository.CategoryRepository
+	warehouses    repository.WarehouseRepository
+	pageTokens    pageTokenCodec
+
+	// fulfillMu упорядочивает FulfillStock: проверка уже выполненной отгрузки и списание
+	// по одной ссылке не должны пересекаться
+	fulfillMu sync.Mutex
 }
 
-func NewPartService(repo repository.PartRepository) *Service {
+// NewPartService создаёт сервис деталей. manufacturers - справочник, из которого берутся
+// производители деталей; categories - дерево категорий; warehouses - склады, по которым
+// учитываются остатки; pageTokenSecret - ключ подписи токенов страниц ListParts.
+func NewPartService(
+	repo repository.PartRepository,
+	manufacturers repository.ManufacturerRepository,
+	categories repository.CategoryRepository,
+	warehouses repository.WarehouseRepository,
+	pageTokenSecret []byte,
+) *Service {
 	return &Service{
-		repo: repo,
+		repo:          repo,
+		manufacturers: manufacturers,
+		categories:    categories,
+		warehouses:    warehouses,
+		pageTokens:    pageTokenCodec{secret: pageTokenSecret},
 	}
 }
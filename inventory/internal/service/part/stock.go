@@ -0,0 +1,152 @@
+package part
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+func (s *Service) AdjustStock(ctx context.Context, adjustment *model.StockAdjustment) (*model.StockMovement, error) {
+	if !adjustment.Reason.IsKnown() {
+		return nil, fmt.Errorf("%w: unknown reason", model.ErrInvalidStockAdjustment)
+	}
+	if adjustment.Reason == model.StockMovementReasonTransfer {
+		return nil, fmt.Errorf("%w: transfers are recorded by TransferStock", model.ErrInvalidStockAdjustment)
+	}
+	if !adjustment.Reason.AllowsDelta(adjustment.Delta) {
+		return nil, fmt.Errorf("%w: delta %d is not allowed for this reason", model.ErrInvalidStockAdjustment, adjustment.Delta)
+	}
+
+	warehouseUuid := adjustment.WarehouseUuid
+	if warehouseUuid == "" {
+		warehouseUuid = model.DefaultWarehouse.Uuid
+	}
+	if err := s.checkWarehouse(ctx, warehouseUuid); err != nil {
+		return nil, err
+	}
+
+	movement, err := newStockMovement(adjustment.PartUuid, warehouseUuid, adjustment.Delta, adjustment.Reason, adjustment.ReferenceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.AdjustStock(ctx, movement); err != nil {
+		return nil, stockError(err)
+	}
+
+	return movement, nil
+}
+
+func (s *Service) TransferStock(ctx context.Context, transfer *model.StockTransfer) (*model.StockMovement, *model.StockMovement, error) {
+	switch {
+	case transfer.Quantity <= 0:
+		return nil, nil, fmt.Errorf("%w: quantity must be positive", model.ErrInvalidStockTransfer)
+	case transfer.FromWarehouseUuid == "" || transfer.ToWarehouseUuid == "":
+		return nil, nil, fmt.Errorf("%w: source and destination warehouses are required", model.ErrInvalidStockTransfer)
+	case transfer.FromWarehouseUuid == transfer.ToWarehouseUuid:
+		return nil, nil, fmt.Errorf("%w: source and destination warehouses must differ", model.ErrInvalidStockTransfer)
+	}
+
+	for _, warehouseUuid := range []string{transfer.FromWarehouseUuid, transfer.ToWarehouseUuid} {
+		if err := s.checkWarehouse(ctx, warehouseUuid); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	outgoing, err := newStockMovement(transfer.PartUuid, transfer.FromWarehouseUuid, -transfer.Quantity,
+		model.StockMovementReasonTransfer, transfer.ReferenceID)
+	if err != nil {
+		return nil, nil, err
+	}
+	incoming, err := newStockMovement(transfer.PartUuid, transfer.ToWarehouseUuid, transfer.Quantity,
+		model.StockMovementReasonTransfer, transfer.ReferenceID)
+	if err != nil {
+		return nil, nil, err
+	}
+	// Оба движения - одна операция, поэтому у них одно время
+	incoming.CreatedAt = outgoing.CreatedAt
+
+	if err := s.repo.TransferStock(ctx, outgoing, incoming); err != nil {
+		return nil, nil, stockError(err)
+	}
+
+	return outgoing, incoming, nil
+}
+
+// newStockMovement создаёт движение остатка с UUIDv7, упорядоченным по времени движения
+func newStockMovement(partUuid, warehouseUuid string, delta int64, reason model.StockMovementReason, referenceID string) (*model.StockMovement, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, errors.Join(model.ErrRepositoryOperation, err)
+	}
+
+	return &model.StockMovement{
+		Uuid:          id.String(),
+		PartUuid:      partUuid,
+		WarehouseUuid: warehouseUuid,
+		Delta:         delta,
+		Reason:        reason,
+		ReferenceID:   referenceID,
+		CreatedAt:     timestamp(),
+	}, nil
+}
+
+// stockError приводит ошибку изменения остатка в репозитории к ошибке сервиса
+func stockError(err error) error {
+	switch {
+	case errors.Is(err, model.ErrPartNotFound):
+		return model.ErrPartNotFound
+	case errors.Is(err, model.ErrInsufficientStock):
+		return model.ErrInsufficientStock
+	default:
+		return model.ErrRepositoryOperation
+	}
+}
+
+func (s *Service) ListStockMovements(ctx context.Context, params *model.ListStockMovementsParams) (*model.StockMovementsPage, error) {
+	pageSize, err := normalizePageSize(params.PageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.repo.Get(ctx, params.PartUuid); err != nil {
+		if errors.Is(err, model.ErrPartNotFound) {
+			return nil, model.ErrPartNotFound
+		}
+		return nil, model.ErrRepositoryOperation
+	}
+
+	var before string
+	if params.PageToken != "" {
+		before, err = s.pageTokens.decodeMovements(params.PageToken, params.PartUuid)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	movements, err := s.repo.ListStockMovements(ctx, &model.StockMovementsQuery{
+		PartUuid: params.PartUuid,
+		Before:   before,
+		Limit:    pageSize + 1,
+	})
+	if err != nil {
+		return nil, model.ErrRepositoryOperation
+	}
+
+	page := &model.StockMovementsPage{Movements: movements}
+	if len(movements) > pageSize {
+		page.Movements = movements[:pageSize]
+
+		token, err := s.pageTokens.encodeMovements(params.PartUuid, page.Movements[pageSize-1].Uuid)
+		if err != nil {
+			return nil, errors.Join(model.ErrRepositoryOperation, err)
+		}
+		page.NextPageToken = token
+	}
+
+	return page, nil
+}
@@ -0,0 +1,203 @@
+package part
+
+import (
+	"context"
+	"errors"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+func (s *PartServiceTestSuite) TestAdjustStock_Success() {
+	ctx := context.Background()
+
+	s.mockRepo.On("AdjustStock", ctx, mock.AnythingOfType("*model.StockMovement")).
+		Run(func(args mock.Arguments) {
+			args.Get(1).(*model.StockMovement).QuantityAfter = 15
+		}).
+		Return(nil)
+
+	movement, err := s.service.AdjustStock(ctx, &model.StockAdjustment{
+		PartUuid:    "uuid-1",
+		Delta:       5,
+		Reason:      model.StockMovementReasonReceipt,
+		ReferenceID: "invoice-42",
+	})
+
+	s.Require().NoError(err)
+	s.NotEmpty(movement.Uuid)
+	s.Equal("uuid-1", movement.PartUuid)
+	s.Equal(int64(5), movement.Delta)
+	s.Equal(int64(15), movement.QuantityAfter)
+	s.Equal("invoice-42", movement.ReferenceID)
+	s.False(movement.CreatedAt.IsZero())
+}
+
+func (s *PartServiceTestSuite) TestAdjustStock_InvalidAdjustment() {
+	ctx := context.Background()
+
+	cases := map[string]*model.StockAdjustment{
+		"unknown reason":      {PartUuid: "uuid-1", Delta: 1},
+		"zero delta":          {PartUuid: "uuid-1", Delta: 0, Reason: model.StockMovementReasonCorrection},
+		"negative receipt":    {PartUuid: "uuid-1", Delta: -1, Reason: model.StockMovementReasonReceipt},
+		"positive sale":       {PartUuid: "uuid-1", Delta: 1, Reason: model.StockMovementReasonSale},
+		"positive damage":     {PartUuid: "uuid-1", Delta: 1, Reason: model.StockMovementReasonDamage},
+		"out of range reason": {PartUuid: "uuid-1", Delta: 1, Reason: 42},
+	}
+
+	for name, adjustment := range cases {
+		movement, err := s.service.AdjustStock(ctx, adjustment)
+
+		s.Nil(movement, name)
+		s.ErrorIs(err, model.ErrInvalidStockAdjustment, name)
+	}
+}
+
+func (s *PartServiceTestSuite) TestAdjustStock_RepositoryErrors() {
+	ctx := context.Background()
+
+	cases := map[error]error{
+		model.ErrPartNotFound:      model.ErrPartNotFound,
+		model.ErrInsufficientStock: model.ErrInsufficientStock,
+		errors.New("lost"):         model.ErrRepositoryOperation,
+	}
+
+	for repoErr, expected := range cases {
+		s.SetupTest()
+		s.mockRepo.On("AdjustStock", ctx, mock.AnythingOfType("*model.StockMovement")).Return(repoErr)
+
+		movement, err := s.service.AdjustStock(ctx, &model.StockAdjustment{
+			PartUuid: "uuid-1",
+			Delta:    -10,
+			Reason:   model.StockMovementReasonSale,
+		})
+
+		s.Nil(movement)
+		s.ErrorIs(err, expected)
+	}
+}
+
+func (s *PartServiceTestSuite) TestListStockMovements_Pagination() {
+	ctx := context.Background()
+	movements := []*model.StockMovement{{Uuid: "m-3"}, {Uuid: "m-2"}, {Uuid: "m-1"}}
+
+	s.mockRepo.On("Get", ctx, "uuid-1").Return(&model.Part{Uuid: "uuid-1"}, nil)
+	s.mockRepo.On("ListStockMovements", ctx, &model.StockMovementsQuery{PartUuid: "uuid-1", Limit: 3}).
+		Return(movements, nil).Once()
+
+	page, err := s.service.ListStockMovements(ctx, &model.ListStockMovementsParams{PartUuid: "uuid-1", PageSize: 2})
+
+	s.Require().NoError(err)
+	s.Equal(movements[:2], page.Movements)
+	s.Require().NotEmpty(page.NextPageToken)
+
+	s.mockRepo.On("ListStockMovements", ctx, &model.StockMovementsQuery{PartUuid: "uuid-1", Before: "m-2", Limit: 3}).
+		Return(movements[2:], nil).Once()
+
+	page, err = s.service.ListStockMovements(ctx, &model.ListStockMovementsParams{
+		PartUuid: "uuid-1", PageSize: 2, PageToken: page.NextPageToken,
+	})
+
+	s.Require().NoError(err)
+	s.Equal(movements[2:], page.Movements)
+	s.Empty(page.NextPageToken)
+}
+
+func (s *PartServiceTestSuite) TestListStockMovements_TokenBoundToPart() {
+	ctx := context.Background()
+
+	token, err := s.service.pageTokens.encodeMovements("uuid-1", "m-2")
+	s.Require().NoError(err)
+
+	partsToken, err := s.service.pageTokens.encode(nil, model.PartsOrder{}, &model.PartsCursor{Uuid: "uuid-1"})
+	s.Require().NoError(err)
+
+	s.mockRepo.On("Get", ctx, "uuid-2").Return(&model.Part{Uuid: "uuid-2"}, nil)
+
+	for _, t := range []string{token, partsToken} {
+		page, err := s.service.ListStockMovements(ctx, &model.ListStockMovementsParams{PartUuid: "uuid-2", PageToken: t})
+
+		s.Nil(page)
+		s.ErrorIs(err, model.ErrInvalidPageToken)
+	}
+}
+
+func (s *PartServiceTestSuite) TestListStockMovements_PartNotFound() {
+	ctx := context.Background()
+
+	s.mockRepo.On("Get", ctx, "missing").Return(nil, model.ErrPartNotFound)
+
+	page, err := s.service.ListStockMovements(ctx, &model.ListStockMovementsParams{PartUuid: "missing"})
+
+	s.Nil(page)
+	s.ErrorIs(err, model.ErrPartNotFound)
+}
+
+func (s *PartServiceTestSuite) TestAdjustStock_UnknownWarehouse() {
+	ctx := context.Background()
+	s.mockWarehouses.On("Get", ctx, "warehouse-x").Return(nil, model.ErrWarehouseNotFound)
+
+	movement, err := s.service.AdjustStock(ctx, &model.StockAdjustment{
+		PartUuid:      "uuid-1",
+		WarehouseUuid: "warehouse-x",
+		Delta:         5,
+		Reason:        model.StockMovementReasonReceipt,
+	})
+
+	s.Nil(movement)
+	s.ErrorIs(err, model.ErrWarehouseNotFound)
+}
+
+func (s *PartServiceTestSuite) TestAdjustStock_RejectsTransferReason() {
+	movement, err := s.service.AdjustStock(context.Background(), &model.StockAdjustment{
+		PartUuid: "uuid-1",
+		Delta:    5,
+		Reason:   model.StockMovementReasonTransfer,
+	})
+
+	s.Nil(movement)
+	s.ErrorIs(err, model.ErrInvalidStockAdjustment)
+}
+
+func (s *PartServiceTestSuite) TestTransferStock_Success() {
+	ctx := context.Background()
+	site := &model.Warehouse{Uuid: "warehouse-site", Code: "site", Name: "Стартовая площадка"}
+	s.mockWarehouses.On("Get", ctx, site.Uuid).Return(site, nil)
+	s.mockRepo.On("TransferStock", ctx,
+		mock.MatchedBy(func(m *model.StockMovement) bool {
+			return m.WarehouseUuid == model.DefaultWarehouse.Uuid && m.Delta == -4
+		}),
+		mock.MatchedBy(func(m *model.StockMovement) bool {
+			return m.WarehouseUuid == site.Uuid && m.Delta == 4
+		}),
+	).Return(nil)
+
+	outgoing, incoming, err := s.service.TransferStock(ctx, &model.StockTransfer{
+		PartUuid:          "uuid-1",
+		FromWarehouseUuid: model.DefaultWarehouse.Uuid,
+		ToWarehouseUuid:   site.Uuid,
+		Quantity:          4,
+		ReferenceID:       "waybill-7",
+	})
+
+	s.Require().NoError(err)
+	s.Equal(model.StockMovementReasonTransfer, outgoing.Reason)
+	s.Equal(model.StockMovementReasonTransfer, incoming.Reason)
+	s.Equal("waybill-7", incoming.ReferenceID)
+	s.Equal(outgoing.CreatedAt, incoming.CreatedAt)
+}
+
+func (s *PartServiceTestSuite) TestTransferStock_InvalidTransfer() {
+	cases := map[string]*model.StockTransfer{
+		"zero quantity":     {PartUuid: "uuid-1", FromWarehouseUuid: "a", ToWarehouseUuid: "b"},
+		"same warehouse":    {PartUuid: "uuid-1", FromWarehouseUuid: "a", ToWarehouseUuid: "a", Quantity: 1},
+		"missing warehouse": {PartUuid: "uuid-1", FromWarehouseUuid: "a", Quantity: 1},
+	}
+
+	for name, transfer := range cases {
+		_, _, err := s.service.TransferStock(context.Background(), transfer)
+
+		s.ErrorIs(err, model.ErrInvalidStockTransfer, name)
+	}
+}
@@ -1,29 +1,51 @@
 package part
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
 
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
 	"github.com/bogdanovds/rocket_factory/inventory/internal/repository/mocks"
 )
 
+const testPageTokenSecret = "test-page-token-secret"
+
 // PartServiceTestSuite - тестовый набор для сервиса деталей
 type PartServiceTestSuite struct {
 	suite.Suite
-	mockRepo *mocks.MockPartRepository
-	service  *Service
+	mockRepo          *mocks.MockPartRepository
+	mockManufacturers *mocks.MockManufacturerRepository
+	mockCategories    *mocks.MockCategoryRepository
+	mockWarehouses    *mocks.MockWarehouseRepository
+	service           *Service
 }
 
 // SetupTest выполняется перед каждым тестом
 func (s *PartServiceTestSuite) SetupTest() {
 	s.mockRepo = mocks.NewMockPartRepository()
-	s.service = NewPartService(s.mockRepo)
+	s.mockManufacturers = mocks.NewMockManufacturerRepository()
+	s.mockCategories = mocks.NewMockCategoryRepository()
+	s.mockWarehouses = mocks.NewMockWarehouseRepository()
+	s.service = NewPartService(s.mockRepo, s.mockManufacturers, s.mockCategories, s.mockWarehouses, []byte(testPageTokenSecret))
 }
 
 // TearDownTest выполняется после каждого теста
 func (s *PartServiceTestSuite) TearDownTest() {
 	s.mockRepo.AssertExpectations(s.T())
+	s.mockManufacturers.AssertExpectations(s.T())
+	s.mockCategories.AssertExpectations(s.T())
+	s.mockWarehouses.AssertExpectations(s.T())
+}
+
+// expectCategoryTree ожидает загрузку дерева категорий, по которому проверяются метаданные детали.
+// Без узлов дерево состоит из встроенных категорий без схем метаданных.
+func (s *PartServiceTestSuite) expectCategoryTree(ctx context.Context, nodes ...*model.CategoryNode) {
+	if len(nodes) == 0 {
+		nodes = model.BuiltinCategories
+	}
+	s.mockCategories.On("List", ctx).Return(nodes, nil)
 }
 
 // TestPartServiceTestSuite запускает тестовый набор
@@ -0,0 +1,156 @@
+package part
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+func (s *Service) UpdatePart(ctx context.Context, update *model.PartUpdate) (*model.Part, error) {
+	existing, err := s.repo.Get(ctx, update.Part.Uuid)
+	if err != nil {
+		if errors.Is(err, model.ErrPartNotFound) {
+			return nil, model.ErrPartNotFound
+		}
+		return nil, model.ErrRepositoryOperation
+	}
+
+	paths := update.Paths
+	if len(paths) == 0 {
+		paths = model.PartUpdatableFields
+	}
+
+	prevUpdatedAt := existing.LastModifiedAt()
+	now := nextUpdatedAt(prevUpdatedAt)
+
+	updated := existing.Clone()
+	// Наступившая запланированная цена может быть ещё не перенесена в деталь
+	updated.ApplyPriceHistory(now)
+	currentPrice := updated.Price
+
+	for _, path := range paths {
+		if err := applyField(updated, update.Part, path); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := updated.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.resolveCategory(ctx, updated); err != nil {
+		return nil, err
+	}
+
+	// Схема могла измениться после сохранения детали: метаданные проверяются, только когда меняются
+	// они сами или категория, чтобы не запрещать остальные изменения
+	if slices.ContainsFunc(paths, affectsMetadataSchema) {
+		if err := s.checkMetadata(ctx, updated); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.resolveManufacturer(ctx, updated); err != nil {
+		return nil, err
+	}
+
+	if updated.IsAssembly() {
+		if err := s.checkComponents(ctx, updated); err != nil {
+			return nil, err
+		}
+	}
+
+	// Новая цена действует сразу; запланированные версии остаются в силе
+	if updated.Price != currentPrice {
+		version, err := newPriceVersion(updated.Price, now, now)
+		if err != nil {
+			return nil, err
+		}
+		updated.AddPriceVersion(version)
+		updated.ApplyPriceHistory(now)
+	}
+
+	updated.UpdatedAt = &now
+
+	if err := s.repo.Update(ctx, updated, prevUpdatedAt); err != nil {
+		switch {
+		case errors.Is(err, model.ErrPartNotFound):
+			return nil, model.ErrPartNotFound
+		case errors.Is(err, model.ErrConcurrentModification):
+			return nil, model.ErrConcurrentModification
+		default:
+			return nil, model.ErrRepositoryOperation
+		}
+	}
+
+	return updated, nil
+}
+
+func affectsMetadataSchema(path string) bool {
+	return path == model.PartFieldMetadata || path == model.PartFieldCategory || path == model.PartFieldCategoryUuid
+}
+
+// applyField копирует значение поля path из src в dst
+func applyField(dst, src *model.Part, path string) error {
+	switch path {
+	case model.PartFieldName:
+		dst.Name = src.Name
+	case model.PartFieldDescription:
+		dst.Description = src.Description
+	case model.PartFieldPrice:
+		dst.Price = src.Price
+	case model.PartFieldCategory, model.PartFieldCategoryUuid:
+		// Значение enum и узел дерева описывают одну категорию, поэтому обновляются вместе
+		dst.Category = src.Category
+		dst.CategoryUuid = src.CategoryUuid
+	case model.PartFieldDimensions:
+		dst.Dimensions = src.Clone().Dimensions
+	case model.PartFieldDimensionsLength:
+		dimensions(dst).Length = dimensions(src).Length
+	case model.PartFieldDimensionsWidth:
+		dimensions(dst).Width = dimensions(src).Width
+	case model.PartFieldDimensionsHeight:
+		dimensions(dst).Height = dimensions(src).Height
+	case model.PartFieldDimensionsWeight:
+		dimensions(dst).Weight = dimensions(src).Weight
+	case model.PartFieldManufacturer:
+		dst.Manufacturer = src.Clone().Manufacturer
+	case model.PartFieldManufacturerName:
+		manufacturer(dst).Name = manufacturer(src).Name
+	case model.PartFieldManufacturerCountry:
+		manufacturer(dst).Country = manufacturer(src).Country
+	case model.PartFieldManufacturerWebsite:
+		manufacturer(dst).Website = manufacturer(src).Website
+	case model.PartFieldManufacturerUuid:
+		manufacturer(dst).Uuid = manufacturer(src).Uuid
+	case model.PartFieldTags:
+		dst.Tags = src.Clone().Tags
+	case model.PartFieldMetadata:
+		dst.Metadata = src.Clone().Metadata
+	case model.PartFieldComponents:
+		dst.Components = src.Clone().Components
+	case model.PartFieldReorderThreshold:
+		dst.ReorderThreshold = src.ReorderThreshold
+	default:
+		return fmt.Errorf("%w: field %q cannot be updated", model.ErrInvalidPart, path)
+	}
+
+	return nil
+}
+
+func dimensions(p *model.Part) *model.Dimensions {
+	if p.Dimensions == nil {
+		p.Dimensions = &model.Dimensions{}
+	}
+	return p.Dimensions
+}
+
+func manufacturer(p *model.Part) *model.Manufacturer {
+	if p.Manufacturer == nil {
+		p.Manufacturer = &model.Manufacturer{}
+	}
+	return p.Manufacturer
+}
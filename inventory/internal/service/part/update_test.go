@@ -0,0 +1,151 @@
+package part
+
+import (
+	"context"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+func storedPart() *model.Part {
+	part := validPart()
+	createdAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	part.CreatedAt = lo.ToPtr(createdAt)
+	part.UpdatedAt = lo.ToPtr(createdAt)
+	part.Tags = []string{"glass"}
+	return part
+}
+
+func (s *PartServiceTestSuite) TestUpdatePart_PartialMask() {
+	ctx := context.Background()
+	existing := storedPart()
+
+	s.mockRepo.On("Get", ctx, existing.Uuid).Return(existing, nil)
+	s.mockRepo.On("Update", ctx, mock.AnythingOfType("*model.Part"), *existing.UpdatedAt).Return(nil)
+
+	part, err := s.service.UpdatePart(ctx, &model.PartUpdate{
+		Part:  &model.Part{Uuid: existing.Uuid, Price: 1750.0, Name: "ignored", Dimensions: &model.Dimensions{Weight: 35}},
+		Paths: []string{model.PartFieldPrice, model.PartFieldDimensionsWeight},
+	})
+
+	s.NoError(err)
+	s.Equal(1750.0, part.Price)
+	s.Equal(35.0, part.Dimensions.Weight)
+	s.Equal(50.0, part.Dimensions.Length)
+	s.Equal("Porthole", part.Name)
+	s.Equal([]string{"glass"}, part.Tags)
+	s.Equal(*existing.CreatedAt, *part.CreatedAt)
+	s.True(part.UpdatedAt.After(*existing.UpdatedAt))
+}
+
+func (s *PartServiceTestSuite) TestUpdatePart_EmptyMaskReplacesAllFields() {
+	ctx := context.Background()
+	s.expectCategoryTree(ctx)
+	existing := storedPart()
+	replacement := validPart()
+	replacement.Name = "Porthole XL"
+	replacement.Tags = nil
+	replacement.StockQuantity = 999
+
+	s.mockRepo.On("Get", ctx, existing.Uuid).Return(existing, nil)
+	s.mockRepo.On("Update", ctx, mock.AnythingOfType("*model.Part"), *existing.UpdatedAt).Return(nil)
+
+	part, err := s.service.UpdatePart(ctx, &model.PartUpdate{Part: replacement})
+
+	s.NoError(err)
+	s.Equal("Porthole XL", part.Name)
+	s.Nil(part.Tags)
+	s.Equal(existing.StockQuantity, part.StockQuantity)
+}
+
+func (s *PartServiceTestSuite) TestUpdatePart_ImmutableField() {
+	ctx := context.Background()
+	existing := storedPart()
+
+	s.mockRepo.On("Get", ctx, existing.Uuid).Return(existing, nil)
+
+	part, err := s.service.UpdatePart(ctx, &model.PartUpdate{
+		Part:  &model.Part{Uuid: existing.Uuid},
+		Paths: []string{"created_at"},
+	})
+
+	s.Nil(part)
+	s.ErrorIs(err, model.ErrInvalidPart)
+}
+
+func (s *PartServiceTestSuite) TestUpdatePart_ValidationAfterMerge() {
+	ctx := context.Background()
+	existing := storedPart()
+
+	s.mockRepo.On("Get", ctx, existing.Uuid).Return(existing, nil)
+
+	part, err := s.service.UpdatePart(ctx, &model.PartUpdate{
+		Part:  &model.Part{Uuid: existing.Uuid, Price: -3},
+		Paths: []string{model.PartFieldPrice},
+	})
+
+	s.Nil(part)
+	s.ErrorIs(err, model.ErrInvalidPart)
+}
+
+func (s *PartServiceTestSuite) TestUpdatePart_StockQuantityOnlyViaAdjustStock() {
+	ctx := context.Background()
+	existing := storedPart()
+
+	s.mockRepo.On("Get", ctx, existing.Uuid).Return(existing, nil)
+
+	part, err := s.service.UpdatePart(ctx, &model.PartUpdate{
+		Part:  &model.Part{Uuid: existing.Uuid, StockQuantity: 100},
+		Paths: []string{"stock_quantity"},
+	})
+
+	s.Nil(part)
+	s.ErrorIs(err, model.ErrInvalidPart)
+}
+
+func (s *PartServiceTestSuite) TestUpdatePart_WithoutUpdatedAt() {
+	ctx := context.Background()
+	existing := storedPart()
+	existing.UpdatedAt = nil
+
+	s.mockRepo.On("Get", ctx, existing.Uuid).Return(existing, nil)
+	s.mockRepo.On("Update", ctx, mock.AnythingOfType("*model.Part"), *existing.CreatedAt).Return(nil)
+
+	part, err := s.service.UpdatePart(ctx, &model.PartUpdate{
+		Part:  &model.Part{Uuid: existing.Uuid, Price: 10},
+		Paths: []string{model.PartFieldPrice},
+	})
+
+	s.Require().NoError(err)
+	s.True(part.UpdatedAt.After(*existing.CreatedAt))
+}
+
+func (s *PartServiceTestSuite) TestUpdatePart_NotFound() {
+	ctx := context.Background()
+
+	s.mockRepo.On("Get", ctx, "missing").Return(nil, model.ErrPartNotFound)
+
+	part, err := s.service.UpdatePart(ctx, &model.PartUpdate{Part: &model.Part{Uuid: "missing"}})
+
+	s.Nil(part)
+	s.ErrorIs(err, model.ErrPartNotFound)
+}
+
+func (s *PartServiceTestSuite) TestUpdatePart_ConcurrentModification() {
+	ctx := context.Background()
+	existing := storedPart()
+
+	s.mockRepo.On("Get", ctx, existing.Uuid).Return(existing, nil)
+	s.mockRepo.On("Update", ctx, mock.AnythingOfType("*model.Part"), *existing.UpdatedAt).Return(model.ErrConcurrentModification)
+
+	part, err := s.service.UpdatePart(ctx, &model.PartUpdate{
+		Part:  &model.Part{Uuid: existing.Uuid, Price: 10},
+		Paths: []string{model.PartFieldPrice},
+	})
+
+	s.Nil(part)
+	s.ErrorIs(err, model.ErrConcurrentModification)
+}
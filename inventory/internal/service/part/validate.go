@@ -0,0 +1,89 @@
+package part
+
+import (
+	"fmt"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+// validateFilter проверяет корректность диапазонов и условий на метаданные фильтра ListParts и WatchParts
+func validateFilter(filter *model.PartsFilter) error {
+	if filter == nil {
+		return nil
+	}
+
+	ranges := map[string]*model.FloatRange{
+		"price":  filter.Price,
+		"length": filter.Length,
+		"width":  filter.Width,
+		"height": filter.Height,
+		"weight": filter.Weight,
+	}
+	for name, r := range ranges {
+		if r == nil {
+			continue
+		}
+		for _, bound := range []*float64{r.Min, r.Max} {
+			if bound != nil && !model.IsFinite(*bound) {
+				return fmt.Errorf("%w: %s range bound is not a finite number", model.ErrInvalidListQuery, name)
+			}
+		}
+		if r.Min != nil && r.Max != nil && *r.Min > *r.Max {
+			return fmt.Errorf("%w: %s range min is greater than max", model.ErrInvalidListQuery, name)
+		}
+	}
+
+	for name, r := range map[string]*model.TimeRange{"created_at": filter.CreatedAt, "updated_at": filter.UpdatedAt} {
+		if r != nil && r.From != nil && r.To != nil && r.From.After(*r.To) {
+			return fmt.Errorf("%w: %s range from is after to", model.ErrInvalidListQuery, name)
+		}
+	}
+
+	for _, p := range filter.Metadata {
+		if err := validateMetadataPredicate(p); err != nil {
+			return err
+		}
+	}
+
+	for _, state := range filter.LifecycleStates {
+		if !state.IsKnown() {
+			return fmt.Errorf("%w: unknown lifecycle state", model.ErrInvalidListQuery)
+		}
+	}
+
+	return nil
+}
+
+func validateMetadataPredicate(p model.MetadataPredicate) error {
+	if !model.IsValidMetadataKey(p.Key) {
+		return fmt.Errorf("%w: invalid metadata key %q", model.ErrInvalidListQuery, p.Key)
+	}
+
+	switch {
+	case p.Operator == model.MetadataOperatorExists:
+		return nil
+	case p.Operator == model.MetadataOperatorEQ, p.Operator == model.MetadataOperatorNE:
+		switch v := p.Value.(type) {
+		case string, bool, int64:
+			return nil
+		case float64:
+			if model.IsFinite(v) {
+				return nil
+			}
+		}
+		return fmt.Errorf("%w: metadata predicate %q requires a string, boolean or finite number value",
+			model.ErrInvalidListQuery, p.Key)
+	case p.Operator.IsComparison():
+		switch v := p.Value.(type) {
+		case int64:
+			return nil
+		case float64:
+			if model.IsFinite(v) {
+				return nil
+			}
+		}
+		return fmt.Errorf("%w: metadata predicate %q requires a finite numeric value", model.ErrInvalidListQuery, p.Key)
+	default:
+		return fmt.Errorf("%w: unknown operator for metadata predicate %q", model.ErrInvalidListQuery, p.Key)
+	}
+}
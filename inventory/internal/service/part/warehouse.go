@@ -0,0 +1,39 @@
+package part
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+// checkWarehouse проверяет, что склад существует. Основной склад встроенный и не проверяется.
+func (s *Service) checkWarehouse(ctx context.Context, warehouseUuid string) error {
+	if warehouseUuid == model.DefaultWarehouse.Uuid {
+		return nil
+	}
+
+	if _, err := s.warehouses.Get(ctx, warehouseUuid); err != nil {
+		if errors.Is(err, model.ErrWarehouseNotFound) {
+			return model.ErrWarehouseNotFound
+		}
+		return model.ErrRepositoryOperation
+	}
+
+	return nil
+}
+
+// checkStockLevels проверяет, что склады остатков детали существуют
+func (s *Service) checkStockLevels(ctx context.Context, part *model.Part) error {
+	for _, l := range part.StockLevels {
+		if err := s.checkWarehouse(ctx, l.WarehouseUuid); err != nil {
+			if errors.Is(err, model.ErrWarehouseNotFound) {
+				return fmt.Errorf("%w: warehouse %s not found", model.ErrInvalidPart, l.WarehouseUuid)
+			}
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package part
+
+import (
+	"context"
+	"errors"
+	"iter"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+	"github.com/bogdanovds/rocket_factory/inventory/internal/textsearch"
+)
+
+func (s *Service) WatchParts(ctx context.Context, params *model.WatchPartsParams) iter.Seq2[*model.PartEvent, error] {
+	return func(yield func(*model.PartEvent, error) bool) {
+		if err := validateFilter(params.Filter); err != nil {
+			yield(nil, err)
+			return
+		}
+
+		// Подкатегории, созданные после подписки, в фильтр не попадают
+		filter, err := s.expandCategories(ctx, params.Filter)
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+
+		var queryTerms []string
+		if filter.HasQuery() {
+			queryTerms = textsearch.Terms(filter.Query)
+		}
+
+		for event, err := range s.repo.Watch(ctx, params.ResumeToken) {
+			if err != nil {
+				if !errors.Is(err, model.ErrInvalidResumeToken) && !errors.Is(err, model.ErrResumeTokenExpired) {
+					err = errors.Join(model.ErrRepositoryOperation, err)
+				}
+				yield(nil, err)
+				return
+			}
+
+			// Деталь, вышедшая из выборки, тоже интересна подписчику, поэтому проверяем оба состояния
+			if !matchesFilter(filter, queryTerms, event.Part) &&
+				!matchesFilter(filter, queryTerms, event.Previous) {
+				continue
+			}
+
+			if !yield(event, nil) {
+				return
+			}
+		}
+	}
+}
+
+func matchesFilter(filter *model.PartsFilter, queryTerms []string, part *model.Part) bool {
+	if part == nil || !filter.Matches(part) {
+		return false
+	}
+
+	return !filter.HasQuery() || part.TextScore(queryTerms) > 0
+}
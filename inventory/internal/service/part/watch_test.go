@@ -0,0 +1,105 @@
+package part
+
+import (
+	"context"
+	"errors"
+	"iter"
+
+	"github.com/samber/lo"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+// eventStream возвращает поток из заданных событий, завершающийся ошибкой err, если она задана
+func eventStream(err error, events ...*model.PartEvent) iter.Seq2[*model.PartEvent, error] {
+	return func(yield func(*model.PartEvent, error) bool) {
+		for _, event := range events {
+			if !yield(event, nil) {
+				return
+			}
+		}
+		if err != nil {
+			yield(nil, err)
+		}
+	}
+}
+
+func collectEvents(stream iter.Seq2[*model.PartEvent, error]) ([]*model.PartEvent, error) {
+	var events []*model.PartEvent
+	for event, err := range stream {
+		if err != nil {
+			return events, err
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+func (s *PartServiceTestSuite) TestWatchParts_FiltersEvents() {
+	ctx := context.Background()
+	engine := &model.Part{Uuid: "uuid-1", Name: "Main Engine", Category: model.CategoryEngine}
+	wing := &model.Part{Uuid: "uuid-2", Name: "Left Wing", Category: model.CategoryWing}
+	movedToWing := &model.Part{Uuid: "uuid-1", Name: "Main Engine", Category: model.CategoryWing}
+
+	created := &model.PartEvent{Type: model.PartEventTypeCreated, PartUuid: "uuid-1", Part: engine, ResumeToken: "1"}
+	other := &model.PartEvent{Type: model.PartEventTypeCreated, PartUuid: "uuid-2", Part: wing, ResumeToken: "2"}
+	leftFilter := &model.PartEvent{Type: model.PartEventTypeUpdated, PartUuid: "uuid-1", Part: movedToWing, Previous: engine, ResumeToken: "3"}
+	deleted := &model.PartEvent{Type: model.PartEventTypeDeleted, PartUuid: "uuid-1", Part: movedToWing, Previous: movedToWing, ResumeToken: "4"}
+
+	s.mockRepo.On("Watch", ctx, "token").Return(eventStream(nil, created, other, leftFilter, deleted))
+
+	events, err := collectEvents(s.service.WatchParts(ctx, &model.WatchPartsParams{
+		Filter:      &model.PartsFilter{Categories: []model.Category{model.CategoryEngine}},
+		ResumeToken: "token",
+	}))
+
+	s.NoError(err)
+	s.Equal([]*model.PartEvent{created, leftFilter}, events)
+}
+
+func (s *PartServiceTestSuite) TestWatchParts_FiltersByQuery() {
+	ctx := context.Background()
+	engine := &model.PartEvent{Type: model.PartEventTypeCreated, PartUuid: "uuid-1", Part: &model.Part{Uuid: "uuid-1", Name: "Main Engine"}}
+	wing := &model.PartEvent{Type: model.PartEventTypeCreated, PartUuid: "uuid-2", Part: &model.Part{Uuid: "uuid-2", Name: "Left Wing"}}
+
+	s.mockRepo.On("Watch", ctx, "").Return(eventStream(nil, engine, wing))
+
+	events, err := collectEvents(s.service.WatchParts(ctx, &model.WatchPartsParams{
+		Filter: &model.PartsFilter{Query: "engines"},
+	}))
+
+	s.NoError(err)
+	s.Equal([]*model.PartEvent{engine}, events)
+}
+
+func (s *PartServiceTestSuite) TestWatchParts_InvalidFilter() {
+	ctx := context.Background()
+
+	events, err := collectEvents(s.service.WatchParts(ctx, &model.WatchPartsParams{
+		Filter: &model.PartsFilter{Price: &model.FloatRange{Min: lo.ToPtr(10.0), Max: lo.ToPtr(1.0)}},
+	}))
+
+	s.Empty(events)
+	s.ErrorIs(err, model.ErrInvalidListQuery)
+}
+
+func (s *PartServiceTestSuite) TestWatchParts_RepositoryErrors() {
+	ctx := context.Background()
+
+	cases := map[error]error{
+		model.ErrInvalidResumeToken: model.ErrInvalidResumeToken,
+		model.ErrResumeTokenExpired: model.ErrResumeTokenExpired,
+		errors.New("lost"):          model.ErrRepositoryOperation,
+	}
+
+	for repoErr, expected := range cases {
+		s.SetupTest()
+		event := &model.PartEvent{Type: model.PartEventTypeCreated, PartUuid: "uuid-1", Part: &model.Part{Uuid: "uuid-1"}}
+		s.mockRepo.On("Watch", ctx, "").Return(eventStream(repoErr, event))
+
+		events, err := collectEvents(s.service.WatchParts(ctx, &model.WatchPartsParams{}))
+
+		s.Equal([]*model.PartEvent{event}, events)
+		s.ErrorIs(err, expected)
+	}
+}
@@ -2,11 +2,79 @@ package service
 
 import (
 	"context"
+	"iter"
+	"time"
 
 	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
 )
 
 type PartService interface {
-	GetPart(ctx context.Context, uuid string) (*model.Part, error)
-	ListParts(ctx context.Context, filter *model.PartsFilter) ([]*model.Part, error)
+	// GetPart возвращает деталь с ценой, действующей в момент asOf (нулевой - текущий)
+	GetPart(ctx context.Context, uuid string, asOf time.Time) (*model.Part, error)
+	// BatchGetParts возвращает детали по списку UUID (повторы учитываются один раз) с ценой на момент asOf
+	// и перечисляет ненайденные и некорректные UUID
+	BatchGetParts(ctx context.Context, uuids []string, asOf time.Time) (*model.PartsBatch, error)
+	ListParts(ctx context.Context, params *model.ListPartsParams) (*model.PartsPage, error)
+	// GetPartFacets возвращает количество деталей по категориям, странам производителей и тегам,
+	// не применяя к каждому измерению условия фильтра на него самого
+	GetPartFacets(ctx context.Context, filter *model.PartsFilter) (*model.PartFacets, error)
+	CreatePart(ctx context.Context, part *model.Part) (*model.Part, error)
+	UpdatePart(ctx context.Context, update *model.PartUpdate) (*model.Part, error)
+	DeletePart(ctx context.Context, uuid string) error
+	// ChangeLifecycleState переводит деталь в другое состояние жизненного цикла (model.LifecycleState.CanTransitionTo)
+	// и задаёт замену выведенной из продажи детали
+	ChangeLifecycleState(ctx context.Context, change *model.LifecycleChange) (*model.Part, error)
+	AdjustStock(ctx context.Context, adjustment *model.StockAdjustment) (*model.StockMovement, error)
+	ListStockMovements(ctx context.Context, params *model.ListStockMovementsParams) (*model.StockMovementsPage, error)
+	// TransferStock перемещает остаток между складами. Возвращает движения списания и поступления.
+	TransferStock(ctx context.Context, transfer *model.StockTransfer) (*model.StockMovement, *model.StockMovement, error)
+	// FulfillStock списывает остатки под отгрузку, начиная с предпочтительного склада.
+	// Возвращает записанные движения; при нехватке остатка ничего не списывается.
+	FulfillStock(ctx context.Context, fulfillment *model.StockFulfillment) ([]*model.StockMovement, error)
+	SchedulePriceChange(ctx context.Context, change *model.PriceChange) (*model.PriceVersion, error)
+	GetPriceHistory(ctx context.Context, partUuid string) ([]model.PriceVersion, error)
+	// ApplyScheduledPrices переносит в детали наступившие запланированные цены.
+	// Возвращает количество обновлённых деталей.
+	ApplyScheduledPrices(ctx context.Context) (int, error)
+	// WatchParts возвращает поток изменений деталей, удовлетворяющих фильтру до или после изменения.
+	// Ошибка завершает поток.
+	WatchParts(ctx context.Context, params *model.WatchPartsParams) iter.Seq2[*model.PartEvent, error]
+	// ExpandBom разузловывает спецификацию сборки на quantity единиц (0 - одна единица)
+	ExpandBom(ctx context.Context, uuid string, quantity int64) (*model.Bom, error)
+}
+
+type LowStockService interface {
+	// CheckLowStock оповещает о деталях, остаток которых опустился до порога дозаказа, по одному разу
+	// на каждое падение. Возвращает количество отправленных оповещений.
+	CheckLowStock(ctx context.Context) (int, error)
+}
+
+type ManufacturerService interface {
+	GetManufacturer(ctx context.Context, uuid string) (*model.Manufacturer, error)
+	ListManufacturers(ctx context.Context, filter *model.ManufacturersFilter) ([]*model.Manufacturer, error)
+	CreateManufacturer(ctx context.Context, manufacturer *model.Manufacturer) (*model.Manufacturer, error)
+	// UpdateManufacturer изменяет производителя и его копию в ссылающихся деталях.
+	// Возвращает производителя и количество обновлённых деталей.
+	UpdateManufacturer(ctx context.Context, manufacturer *model.Manufacturer) (*model.Manufacturer, int, error)
+	DeleteManufacturer(ctx context.Context, uuid string) error
+}
+
+type WarehouseService interface {
+	GetWarehouse(ctx context.Context, uuid string) (*model.Warehouse, error)
+	ListWarehouses(ctx context.Context) ([]*model.Warehouse, error)
+	CreateWarehouse(ctx context.Context, warehouse *model.Warehouse) (*model.Warehouse, error)
+}
+
+type CategoryService interface {
+	GetCategory(ctx context.Context, uuid string) (*model.CategoryNode, error)
+	// ListCategories возвращает категорию rootUuid и её потомков (пустой rootUuid - всё дерево),
+	// родителей раньше потомков
+	ListCategories(ctx context.Context, rootUuid string) ([]*model.CategoryNode, error)
+	CreateCategory(ctx context.Context, category *model.CategoryNode) (*model.CategoryNode, error)
+	UpdateCategory(ctx context.Context, category *model.CategoryNode) (*model.CategoryNode, error)
+	DeleteCategory(ctx context.Context, uuid string) error
+	// SetMetadataSchema заменяет собственную схему метаданных категории
+	SetMetadataSchema(ctx context.Context, uuid string, fields []model.MetadataField) (*model.CategoryNode, error)
+	// GetMetadataSchema возвращает действующую схему метаданных категории вместе со схемами предков
+	GetMetadataSchema(ctx context.Context, uuid string) ([]model.MetadataField, error)
 }
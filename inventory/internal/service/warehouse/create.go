@@ -0,0 +1,34 @@
+package warehouse
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+func (s *Service) CreateWarehouse(ctx context.Context, warehouse *model.Warehouse) (*model.Warehouse, error) {
+	warehouse = lo.ToPtr(*warehouse)
+	if warehouse.Uuid == "" {
+		warehouse.Uuid = uuid.NewString()
+	} else if _, err := uuid.Parse(warehouse.Uuid); err != nil {
+		return nil, fmt.Errorf("%w: uuid must be a valid UUID", model.ErrInvalidWarehouse)
+	}
+
+	if err := warehouse.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Create(ctx, warehouse); err != nil {
+		if errors.Is(err, model.ErrWarehouseAlreadyExists) {
+			return nil, model.ErrWarehouseAlreadyExists
+		}
+		return nil, model.ErrRepositoryOperation
+	}
+
+	return warehouse, nil
+}
@@ -0,0 +1,29 @@
+package warehouse
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+func (s *Service) GetWarehouse(ctx context.Context, uuid string) (*model.Warehouse, error) {
+	warehouse, err := s.repo.Get(ctx, uuid)
+	if err != nil {
+		if errors.Is(err, model.ErrWarehouseNotFound) {
+			return nil, model.ErrWarehouseNotFound
+		}
+		return nil, model.ErrRepositoryOperation
+	}
+
+	return warehouse, nil
+}
+
+func (s *Service) ListWarehouses(ctx context.Context) ([]*model.Warehouse, error) {
+	warehouses, err := s.repo.List(ctx)
+	if err != nil {
+		return nil, model.ErrRepositoryOperation
+	}
+
+	return warehouses, nil
+}
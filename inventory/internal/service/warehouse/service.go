@@ -0,0 +1,27 @@
+package warehouse
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+	"github.com/bogdanovds/rocket_factory/inventory/internal/repository"
+)
+
+type Service struct {
+	repo repository.WarehouseRepository
+}
+
+// NewWarehouseService создаёт сервис складов
+func NewWarehouseService(repo repository.WarehouseRepository) *Service {
+	return &Service{repo: repo}
+}
+
+// EnsureDefaultWarehouse создаёт основной склад, если его нет
+func (s *Service) EnsureDefaultWarehouse(ctx context.Context) error {
+	if err := s.repo.Create(ctx, model.DefaultWarehouse); err != nil && !errors.Is(err, model.ErrWarehouseAlreadyExists) {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package warehouse
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/repository/mocks"
+)
+
+// WarehouseServiceTestSuite - тестовый набор для сервиса складов
+type WarehouseServiceTestSuite struct {
+	suite.Suite
+	mockRepo *mocks.MockWarehouseRepository
+	service  *Service
+}
+
+// SetupTest выполняется перед каждым тестом
+func (s *WarehouseServiceTestSuite) SetupTest() {
+	s.mockRepo = mocks.NewMockWarehouseRepository()
+	s.service = NewWarehouseService(s.mockRepo)
+}
+
+// TearDownTest выполняется после каждого теста
+func (s *WarehouseServiceTestSuite) TearDownTest() {
+	s.mockRepo.AssertExpectations(s.T())
+}
+
+// TestWarehouseServiceTestSuite запускает тестовый набор
+func TestWarehouseServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(WarehouseServiceTestSuite))
+}
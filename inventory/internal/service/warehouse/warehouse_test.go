@@ -0,0 +1,78 @@
+package warehouse
+
+import (
+	"context"
+	"errors"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/model"
+)
+
+const testWarehouseUuid = "3f7c2b9e-4a1d-4e8b-9c01-0000000000aa"
+
+func baikonur() *model.Warehouse {
+	return &model.Warehouse{Uuid: testWarehouseUuid, Code: "baikonur", Name: "Байконур", Location: "Site 31"}
+}
+
+func (s *WarehouseServiceTestSuite) TestCreateWarehouse_GeneratesUuid() {
+	ctx := context.Background()
+	s.mockRepo.On("Create", ctx, mock.AnythingOfType("*model.Warehouse")).Return(nil)
+
+	warehouse, err := s.service.CreateWarehouse(ctx, &model.Warehouse{Code: "vostochny", Name: "Восточный"})
+
+	s.NoError(err)
+	s.NotEmpty(warehouse.Uuid)
+}
+
+func (s *WarehouseServiceTestSuite) TestCreateWarehouse_Invalid() {
+	cases := map[string]*model.Warehouse{
+		"empty code":     {Name: "Байконур"},
+		"uppercase code": {Code: "Baikonur", Name: "Байконур"},
+		"empty name":     {Code: "baikonur", Name: " "},
+		"invalid uuid":   {Uuid: "not-a-uuid", Code: "baikonur", Name: "Байконур"},
+	}
+
+	for name, w := range cases {
+		warehouse, err := s.service.CreateWarehouse(context.Background(), w)
+
+		s.Nil(warehouse, name)
+		s.ErrorIs(err, model.ErrInvalidWarehouse, name)
+	}
+}
+
+func (s *WarehouseServiceTestSuite) TestCreateWarehouse_CodeTaken() {
+	ctx := context.Background()
+	s.mockRepo.On("Create", ctx, baikonur()).Return(model.ErrWarehouseAlreadyExists)
+
+	warehouse, err := s.service.CreateWarehouse(ctx, baikonur())
+
+	s.Nil(warehouse)
+	s.ErrorIs(err, model.ErrWarehouseAlreadyExists)
+}
+
+func (s *WarehouseServiceTestSuite) TestEnsureDefaultWarehouse_AlreadyExists() {
+	ctx := context.Background()
+	s.mockRepo.On("Create", ctx, model.DefaultWarehouse).Return(model.ErrWarehouseAlreadyExists)
+
+	s.NoError(s.service.EnsureDefaultWarehouse(ctx))
+}
+
+func (s *WarehouseServiceTestSuite) TestGetWarehouse_Errors() {
+	ctx := context.Background()
+
+	cases := map[error]error{
+		model.ErrWarehouseNotFound: model.ErrWarehouseNotFound,
+		errors.New("lost"):         model.ErrRepositoryOperation,
+	}
+
+	for repoErr, expected := range cases {
+		s.SetupTest()
+		s.mockRepo.On("Get", ctx, testWarehouseUuid).Return(nil, repoErr)
+
+		warehouse, err := s.service.GetWarehouse(ctx, testWarehouseUuid)
+
+		s.Nil(warehouse)
+		s.ErrorIs(err, expected)
+	}
+}
@@ -0,0 +1,77 @@
+package textsearch
+
+import "strings"
+
+// englishSuffixes - словообразовательные суффиксы и их замены, проверяются по порядку
+var englishSuffixes = []struct {
+	suffix      string
+	replacement string
+}{
+	{"ational", "ate"},
+	{"ization", "ize"},
+	{"fulness", "ful"},
+	{"iveness", "ive"},
+	{"ousness", "ous"},
+	{"tional", "tion"},
+	{"ness", ""},
+	{"ment", ""},
+	{"ally", "al"},
+	{"ly", ""},
+}
+
+// stemEnglish - упрощённый стеммер Портера: снимает окончания множественного числа,
+// -ing/-ed, распространённые словообразовательные суффиксы и конечную "e"
+func stemEnglish(w string) string {
+	if len(w) <= 3 || !isLatinWord(w) {
+		return w
+	}
+
+	w = strings.TrimSuffix(w, "'s")
+
+	switch {
+	case strings.HasSuffix(w, "sses"):
+		w = w[:len(w)-2]
+	case strings.HasSuffix(w, "ies") && len(w) > 4:
+		w = w[:len(w)-3] + "y"
+	case strings.HasSuffix(w, "ss"), strings.HasSuffix(w, "us"), strings.HasSuffix(w, "is"):
+	case strings.HasSuffix(w, "s"):
+		w = w[:len(w)-1]
+	}
+
+	for _, suffix := range []string{"ing", "ed"} {
+		stem, ok := strings.CutSuffix(w, suffix)
+		if !ok || len(stem) < 3 || !strings.ContainsAny(stem, "aeiouy") {
+			continue
+		}
+
+		// hopping -> hopp -> hop, но cooling -> cool
+		if n := len(stem); stem[n-1] == stem[n-2] && !strings.ContainsRune("aeiouylsz", rune(stem[n-1])) {
+			stem = stem[:n-1]
+		}
+		w = stem
+		break
+	}
+
+	for _, s := range englishSuffixes {
+		if stem, ok := strings.CutSuffix(w, s.suffix); ok && len(stem) >= 3 {
+			w = stem + s.replacement
+			break
+		}
+	}
+
+	if len(w) > 4 && strings.HasSuffix(w, "e") {
+		w = w[:len(w)-1]
+	}
+
+	return w
+}
+
+func isLatinWord(w string) bool {
+	for i := 0; i < len(w); i++ {
+		if c := w[i]; (c < 'a' || c > 'z') && c != '\'' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,102 @@
+package textsearch
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Группы окончаний русского стеммера Snowball. Окончания первой группы снимаются,
+// только если перед ними стоит "а" или "я".
+var (
+	ruPerfectiveGerund1 = []string{"в", "вши", "вшись"}
+	ruPerfectiveGerund2 = []string{"ив", "ивши", "ившись", "ыв", "ывши", "ывшись"}
+	ruReflexive         = []string{"ся", "сь"}
+	ruAdjective         = []string{
+		"ее", "ие", "ые", "ое", "ими", "ыми", "ей", "ий", "ый", "ой", "ем", "им", "ым", "ом",
+		"его", "ого", "ему", "ому", "их", "ых", "ую", "юю", "ая", "яя", "ою", "ею",
+	}
+	ruParticiple1 = []string{"ем", "нн", "вш", "ющ", "щ"}
+	ruParticiple2 = []string{"ивш", "ывш", "ующ"}
+	ruVerb1       = []string{"ла", "на", "ете", "йте", "ли", "й", "л", "ем", "н", "ло", "но", "ет", "ют", "ны", "ть", "ешь", "нно"}
+	ruVerb2       = []string{
+		"ила", "ыла", "ена", "ейте", "уйте", "ите", "или", "ыли", "ей", "уй", "ил", "ыл", "им", "ым", "ен",
+		"ило", "ыло", "ено", "ят", "ует", "уют", "ит", "ыт", "ены", "ить", "ыть", "ишь", "ую", "ю",
+	}
+	ruNoun = []string{
+		"а", "ев", "ов", "ие", "ье", "е", "иями", "ями", "ами", "еи", "ии", "и", "ией", "ей", "ой", "ий", "й",
+		"иям", "ям", "ием", "ем", "ам", "ом", "о", "у", "ах", "иях", "ях", "ы", "ь", "ию", "ью", "ю", "ия", "ья", "я",
+	}
+	ruSuperlative  = []string{"ейш", "ейше"}
+	ruDerivational = []string{"ост", "ость"}
+)
+
+const ruVowels = "аеиоуыэюя"
+
+// stemRussian - упрощённая реализация русского стеммера Snowball (окончания снимаются в области RV)
+func stemRussian(word string) string {
+	word = strings.ReplaceAll(word, "ё", "е")
+
+	idx := strings.IndexAny(word, ruVowels)
+	if idx < 0 {
+		return word
+	}
+	_, size := utf8.DecodeRuneInString(word[idx:])
+	prefix, rv := word[:idx+size], word[idx+size:]
+
+	// Шаг 1: деепричастие совершенного вида, иначе возвратная частица и прилагательное/глагол/существительное
+	if s, ok := trimEnding(rv, ruPerfectiveGerund1, ruPerfectiveGerund2); ok {
+		rv = s
+	} else {
+		rv, _ = trimEnding(rv, nil, ruReflexive)
+		if s, ok := trimEnding(rv, nil, ruAdjective); ok {
+			rv, _ = trimEnding(s, ruParticiple1, ruParticiple2)
+		} else if s, ok := trimEnding(rv, ruVerb1, ruVerb2); ok {
+			rv = s
+		} else {
+			rv, _ = trimEnding(rv, nil, ruNoun)
+		}
+	}
+
+	// Шаг 2: конечная "и"
+	rv = strings.TrimSuffix(rv, "и")
+
+	// Шаг 3: словообразовательное окончание, если основа остаётся достаточно длинной
+	if s, ok := trimEnding(rv, nil, ruDerivational); ok && len([]rune(s)) >= 2 {
+		rv = s
+	}
+
+	// Шаг 4: превосходная степень, удвоенная "н", мягкий знак
+	rv, _ = trimEnding(rv, nil, ruSuperlative)
+	if s, ok := strings.CutSuffix(rv, "нн"); ok {
+		rv = s + "н"
+	} else {
+		rv = strings.TrimSuffix(rv, "ь")
+	}
+
+	return prefix + rv
+}
+
+// trimEnding снимает самое длинное окончание из групп. Окончания group1 снимаются,
+// только если им предшествует "а" или "я" (сама буква остаётся в основе).
+func trimEnding(s string, group1, group2 []string) (string, bool) {
+	best := ""
+	for _, e := range group2 {
+		if len(e) > len(best) && strings.HasSuffix(s, e) {
+			best = e
+		}
+	}
+	for _, e := range group1 {
+		if len(e) > len(best) && strings.HasSuffix(s, e) {
+			stem := s[:len(s)-len(e)]
+			if strings.HasSuffix(stem, "а") || strings.HasSuffix(stem, "я") {
+				best = e
+			}
+		}
+	}
+
+	if best == "" {
+		return s, false
+	}
+
+	return s[:len(s)-len(best)], true
+}
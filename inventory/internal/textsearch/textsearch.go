@@ -0,0 +1,140 @@
+// Package textsearch реализует полнотекстовый поиск по каталогу без внешнего движка:
+// разбиение на слова, стемминг (английский и русский) и оценку релевантности.
+// Используется обоими репозиториями: in-memory считает Score по деталям, MongoDB хранит
+// основы слов и их префиксы в документе и считает ту же оценку в агрегации.
+package textsearch
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Language - язык текста, определяющий стеммер
+type Language string
+
+const (
+	LanguageEnglish Language = "english"
+	LanguageRussian Language = "russian"
+)
+
+// Веса полей детали в оценке релевантности
+const (
+	WeightName        = 10
+	WeightTags        = 5
+	WeightDescription = 1
+)
+
+// PrefixMatchFactor - доля веса, которую даёт совпадение слова документа по префиксу
+const PrefixMatchFactor = 0.5
+
+var stopWords = map[string]struct{}{
+	"a": {}, "an": {}, "and": {}, "for": {}, "in": {}, "of": {}, "on": {}, "or": {}, "the": {}, "to": {}, "with": {},
+	"в": {}, "для": {}, "и": {}, "из": {}, "к": {}, "на": {}, "о": {}, "по": {}, "с": {},
+}
+
+// Field - поле документа с весом
+type Field struct {
+	Text   string
+	Weight float64
+}
+
+// DetectLanguage определяет язык текста по преобладающей письменности: кириллица - русский, иначе английский
+func DetectLanguage(text string) Language {
+	var cyrillic, latin int
+	for _, r := range text {
+		switch {
+		case unicode.Is(unicode.Cyrillic, r):
+			cyrillic++
+		case unicode.Is(unicode.Latin, r):
+			latin++
+		}
+	}
+
+	if cyrillic > latin {
+		return LanguageRussian
+	}
+
+	return LanguageEnglish
+}
+
+// Terms разбивает текст на слова, отбрасывает стоп-слова и приводит слова к основе.
+// Язык определяется для каждого слова отдельно, поэтому смешанный текст обрабатывается корректно.
+func Terms(text string) []string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	terms := make([]string, 0, len(words))
+	for _, w := range words {
+		if _, stop := stopWords[w]; stop {
+			continue
+		}
+		// Пустая основа совпала бы по префиксу с любым словом
+		if stem := Stem(w); stem != "" {
+			terms = append(terms, stem)
+		}
+	}
+
+	return terms
+}
+
+// Prefixes возвращает все непустые префиксы слов, включая сами слова, без повторов. Слово запроса
+// совпадает с документом в Score, только если оно есть среди префиксов слов документа, поэтому
+// хранилище без префиксного поиска находит документы по точному совпадению с префиксами.
+func Prefixes(terms []string) []string {
+	seen := make(map[string]struct{})
+	var prefixes []string
+	for _, term := range terms {
+		for i := range term {
+			if i == 0 {
+				continue
+			}
+			prefixes = addPrefix(prefixes, seen, term[:i])
+		}
+		prefixes = addPrefix(prefixes, seen, term)
+	}
+
+	return prefixes
+}
+
+func addPrefix(prefixes []string, seen map[string]struct{}, prefix string) []string {
+	if _, ok := seen[prefix]; ok {
+		return prefixes
+	}
+	seen[prefix] = struct{}{}
+	return append(prefixes, prefix)
+}
+
+// Stem приводит слово в нижнем регистре к основе
+func Stem(word string) string {
+	if DetectLanguage(word) == LanguageRussian {
+		return stemRussian(word)
+	}
+
+	return stemEnglish(word)
+}
+
+// Score оценивает релевантность документа запросу. Слово запроса совпадает со словом документа
+// целиком или как его префикс ("hydro" находит "hydrogen"); полное совпадение весит больше.
+// Документ подходит, если совпало хотя бы одно слово запроса (score > 0).
+func Score(queryTerms []string, fields ...Field) float64 {
+	if len(queryTerms) == 0 {
+		return 0
+	}
+
+	var score float64
+	for _, f := range fields {
+		for _, docTerm := range Terms(f.Text) {
+			for _, q := range queryTerms {
+				switch {
+				case docTerm == q:
+					score += f.Weight
+				case strings.HasPrefix(docTerm, q):
+					score += f.Weight * PrefixMatchFactor
+				}
+			}
+		}
+	}
+
+	return score
+}
@@ -0,0 +1,95 @@
+package textsearch
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type TextSearchTestSuite struct {
+	suite.Suite
+}
+
+func (s *TextSearchTestSuite) TestStem_English() {
+	cases := map[string]string{
+		"engines":    "engin",
+		"engine":     "engin",
+		"cooling":    "cool",
+		"cooled":     "cool",
+		"hopping":    "hop",
+		"assemblies": "assemb",
+		"assembly":   "assemb",
+		"thermal":    "thermal",
+		"stainless":  "stainless",
+		"gas":        "gas",
+	}
+
+	for word, stem := range cases {
+		s.Equal(stem, Stem(word), word)
+	}
+}
+
+func (s *TextSearchTestSuite) TestStem_Russian() {
+	cases := map[string]string{
+		"двигатель":    "двигател",
+		"двигатели":    "двигател",
+		"двигателя":    "двигател",
+		"топливный":    "топливн",
+		"топливного":   "топливн",
+		"иллюминаторы": "иллюминатор",
+		"крыло":        "крыл",
+		"крылья":       "крыл",
+		"ёмкость":      "емк",
+	}
+
+	for word, stem := range cases {
+		s.Equal(stem, Stem(word), word)
+	}
+}
+
+func (s *TextSearchTestSuite) TestTerms_SkipsStopWordsAndPunctuation() {
+	s.Equal([]string{"engin", "hydrogen", "двигател"}, Terms("The engine, for HYDROGEN и двигатели!"))
+}
+
+func (s *TextSearchTestSuite) TestDetectLanguage() {
+	s.Equal(LanguageRussian, DetectLanguage("Основной двигатель RD-180"))
+	s.Equal(LanguageEnglish, DetectLanguage("Main engine"))
+	s.Equal(LanguageEnglish, DetectLanguage(""))
+}
+
+func (s *TextSearchTestSuite) TestScore() {
+	name := Field{Text: "Hydrogen thermal shield", Weight: WeightName}
+	description := Field{Text: "Protects engines", Weight: WeightDescription}
+
+	s.Equal(float64(WeightName), Score(Terms("thermal"), name, description))
+	s.Equal(float64(WeightName)*PrefixMatchFactor, Score(Terms("hydro"), name, description))
+	s.Equal(float64(WeightName+WeightDescription), Score(Terms("shield engine"), name, description))
+	s.Zero(Score(Terms("wing"), name, description))
+	s.Zero(Score(nil, name, description))
+}
+
+func (s *TextSearchTestSuite) TestPrefixes() {
+	s.Equal([]string{"h", "hy", "hyd", "hydr", "hydro", "hydrog", "hydroge", "hydrogen", "ho", "hot"},
+		Prefixes([]string{"hydrogen", "hot", "hydro"}))
+	s.Equal([]string{"т", "тя", "тяг"}, Prefixes([]string{"тяг"}))
+	s.Empty(Prefixes(nil))
+}
+
+// TestPrefixes_MatchLikeScore проверяет, что документ находится по префиксам тогда же, когда Score > 0
+func (s *TextSearchTestSuite) TestPrefixes_MatchLikeScore() {
+	docTerms := Terms("Hydrogen thermal shield для двигателей")
+	prefixes := Prefixes(docTerms)
+
+	for _, query := range []string{"hydro", "therm", "shields", "двигатель", "двиг", "wing", "hydrogenic", "h"} {
+		found := false
+		for _, q := range Terms(query) {
+			found = found || slices.Contains(prefixes, q)
+		}
+		s.Equal(Score(Terms(query), Field{Text: "Hydrogen thermal shield для двигателей", Weight: 1}) > 0, found, query)
+	}
+}
+
+func TestTextSearchTestSuite(t *testing.T) {
+	suite.Run(t, new(TextSearchTestSuite))
+}
@@ -0,0 +1,41 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/bogdanovds/rocket_factory/inventory/internal/repository"
+	"github.com/bogdanovds/rocket_factory/inventory/internal/repository/contracttest"
+	"github.com/bogdanovds/rocket_factory/inventory/internal/repository/mongo"
+	tcmongo "github.com/bogdanovds/rocket_factory/platform/pkg/testcontainers/mongo"
+)
+
+const searchContractDatabase = "inventory_search_contract_test"
+
+// TestSearchContract проверяет полнотекстовый поиск MongoDB-репозитория общим контрактным набором
+func TestSearchContract(t *testing.T) {
+	ctx := context.Background()
+
+	container, err := tcmongo.NewContainer(ctx, tcmongo.WithDatabase(searchContractDatabase))
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, container.Terminate(ctx))
+	}()
+
+	suite.Run(t, &contracttest.SearchSuite{
+		NewRepository: func() repository.PartRepository {
+			// Каждый тест начинает с пустой базы
+			require.NoError(t, container.Client().Database(searchContractDatabase).Drop(ctx))
+
+			repo := mongo.NewRepository(container.Client(), searchContractDatabase)
+			require.NoError(t, repo.EnsureIndexes(ctx))
+
+			return repo
+		},
+	})
+}
@@ -0,0 +1,49 @@
+// Package auth проверяет, что заголовки пользователя выставлены доверенным API-шлюзом
+package auth
+
+import (
+	"crypto/subtle"
+	"net/http"
+
+	orderV1 "github.com/bogdanovds/rocket_factory/shared/pkg/openapi/order/v1"
+)
+
+// Заголовки, через которые API-шлюз передаёт аутентифицированного пользователя
+const (
+	HeaderGatewaySecret = "X-Gateway-Secret"
+	HeaderUserUUID      = "X-User-UUID"
+	HeaderUserRole      = "X-User-Role"
+)
+
+// TrustedGateway пропускает заголовки пользователя (X-User-UUID, X-User-Role) только от API-шлюза,
+// который подтверждает их заголовком X-Gateway-Secret с общим секретом. Запрос с заголовками
+// пользователя без верного секрета отклоняется с 401. Без секрета доверенного шлюза нет:
+// отклоняется любой запрос с заголовками пользователя, и действия согласующего и администратора недоступны.
+func TrustedGateway(secret string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			trusted := secret != "" &&
+				subtle.ConstantTimeCompare([]byte(r.Header.Get(HeaderGatewaySecret)), []byte(secret)) == 1
+			if !trusted && (r.Header.Get(HeaderUserUUID) != "" || r.Header.Get(HeaderUserRole) != "") {
+				unauthorized(w, "user headers are accepted only from the trusted gateway")
+				return
+			}
+
+			// Секрет не должен уйти дальше, например в логи обработчиков
+			r.Header.Del(HeaderGatewaySecret)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func unauthorized(w http.ResponseWriter, msg string) {
+	body, err := (&orderV1.UnauthorizedError{Code: http.StatusUnauthorized, Message: msg}).MarshalJSON()
+	if err != nil {
+		http.Error(w, msg, http.StatusUnauthorized)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusUnauthorized)
+	_, _ = w.Write(body)
+}
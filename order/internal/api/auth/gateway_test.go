@@ -0,0 +1,80 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type GatewayTestSuite struct {
+	suite.Suite
+}
+
+// serve выполняет запрос с заголовками через TrustedGateway и возвращает код ответа и заголовки,
+// которые дошли до обработчика
+func (s *GatewayTestSuite) serve(secret string, headers map[string]string) (int, http.Header) {
+	var received http.Header
+	handler := TrustedGateway(secret)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.Header.Clone()
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	req := httptest.NewRequest(http.MethodPost, "/orders/1/approve", nil)
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	return rec.Code, received
+}
+
+func (s *GatewayTestSuite) TestTrustedGateway_AcceptsHeadersWithSecret() {
+	code, received := s.serve("s3cret", map[string]string{
+		HeaderGatewaySecret: "s3cret",
+		HeaderUserRole:      "approver",
+	})
+
+	s.Equal(http.StatusOK, code)
+	s.Equal("approver", received.Get(HeaderUserRole))
+	s.Empty(received.Get(HeaderGatewaySecret))
+}
+
+func (s *GatewayTestSuite) TestTrustedGateway_RejectsForgedHeaders() {
+	for name, headers := range map[string]map[string]string{
+		"no secret":    {HeaderUserRole: "admin"},
+		"wrong secret": {HeaderGatewaySecret: "guess", HeaderUserUUID: "3fa85f64-5717-4562-b3fc-2c963f66afa6"},
+	} {
+		code, received := s.serve("s3cret", headers)
+
+		s.Equal(http.StatusUnauthorized, code, name)
+		s.Nil(received, name)
+	}
+}
+
+func (s *GatewayTestSuite) TestTrustedGateway_AnonymousRequest() {
+	code, _ := s.serve("s3cret", nil)
+
+	s.Equal(http.StatusOK, code)
+}
+
+func (s *GatewayTestSuite) TestTrustedGateway_RejectsUserHeadersWithoutSecret() {
+	for name, headers := range map[string]map[string]string{
+		"no gateway secret":    {HeaderUserRole: "admin"},
+		"empty gateway secret": {HeaderGatewaySecret: "", HeaderUserRole: "approver"},
+	} {
+		code, received := s.serve("", headers)
+
+		s.Equal(http.StatusUnauthorized, code, name)
+		s.Nil(received, name)
+	}
+
+	code, _ := s.serve("", nil)
+	s.Equal(http.StatusOK, code)
+}
+
+func TestGatewayTestSuite(t *testing.T) {
+	suite.Run(t, new(GatewayTestSuite))
+}
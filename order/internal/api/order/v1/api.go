@@ -35,3 +35,17 @@ func conflict(msg string) *orderV1.ConflictError {
 		Message: msg,
 	}
 }
+
+func badGateway(msg string) *orderV1.BadGatewayError {
+	return &orderV1.BadGatewayError{
+		Code:    http.StatusBadGateway,
+		Message: msg,
+	}
+}
+
+func forbidden(msg string) *orderV1.ForbiddenError {
+	return &orderV1.ForbiddenError{
+		Code:    http.StatusForbidden,
+		Message: msg,
+	}
+}
@@ -0,0 +1,39 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/bogdanovds/rocket_factory/order/internal/converter"
+	"github.com/bogdanovds/rocket_factory/order/internal/model"
+	orderV1 "github.com/bogdanovds/rocket_factory/shared/pkg/openapi/order/v1"
+)
+
+func (h *Handler) ApproveOrder(ctx context.Context, params orderV1.ApproveOrderParams) (orderV1.ApproveOrderRes, error) {
+	orderID, err := uuid.Parse(params.OrderUUID.String())
+	if err != nil {
+		return badRequest("invalid order UUID format"), nil
+	}
+
+	// Заголовки пользователя выставляет API-шлюз; auth.TrustedGateway отклоняет их от других клиентов
+	actor := model.Actor{UserID: params.XUserUUID, Role: model.Role(params.XUserRole)}
+
+	order, err := h.service.ApproveOrder(ctx, orderID, actor)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrApproverRoleRequired):
+			return forbidden(err.Error()), nil
+		case errors.Is(err, model.ErrOrderNotFound):
+			return notFound(fmt.Sprintf("Order with UUID %s not found", params.OrderUUID)), nil
+		case errors.Is(err, model.ErrOrderNotAwaitingApproval), errors.Is(err, model.ErrOrderStatusChanged):
+			return conflict(err.Error()), nil
+		default:
+			return nil, fmt.Errorf("approve order error: %w", err)
+		}
+	}
+
+	return converter.ConvertOrderToDTO(order), nil
+}
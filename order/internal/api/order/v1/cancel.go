@@ -22,7 +22,8 @@ func (h *Handler) CancelOrder(ctx context.Context, params orderV1.CancelOrderPar
 		switch {
 		case errors.Is(err, model.ErrOrderNotFound):
 			return notFound(fmt.Sprintf("Order with UUID %s not found", params.OrderUUID)), nil
-		case errors.Is(err, model.ErrOrderAlreadyPaid), errors.Is(err, model.ErrOrderCancelled), errors.Is(err, model.ErrOrderFulfilled):
+		case errors.Is(err, model.ErrOrderAlreadyPaid), errors.Is(err, model.ErrOrderCancelled), errors.Is(err, model.ErrOrderFulfilled),
+			errors.Is(err, model.ErrOrderRejected), errors.Is(err, model.ErrOrderStatusChanged):
 			return conflict(err.Error()), nil
 		default:
 			return nil, fmt.Errorf("cancel order error: %w", err)
@@ -4,9 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 
 	"github.com/google/uuid"
 
+	"github.com/bogdanovds/rocket_factory/order/internal/converter"
 	"github.com/bogdanovds/rocket_factory/order/internal/model"
 	orderV1 "github.com/bogdanovds/rocket_factory/shared/pkg/openapi/order/v1"
 )
@@ -22,20 +24,63 @@ func (h *Handler) CreateOrder(ctx context.Context, req *orderV1.CreateOrderReque
 		partIDs[i] = uuid.MustParse(partUUID.String())
 	}
 
-	order, err := h.service.CreateOrder(ctx, userID, partIDs)
+	// Без предпочтительного склада inventory списывает детали со складов с наибольшим остатком
+	order, err := h.service.CreateOrder(ctx, userID, partIDs, req.PreferredWarehouseUUID.Or(uuid.Nil))
 	if err != nil {
 		switch {
 		case errors.Is(err, model.ErrPartsNotSpecified):
 			return badRequest(err.Error()), nil
-		case errors.Is(err, model.ErrPartsNotFound):
-			return notFound(err.Error()), nil
+		case errors.Is(err, model.ErrPartsNotFound), errors.Is(err, model.ErrWarehouseNotFound):
+			return partsNotFound(err), nil
+		case errors.Is(err, model.ErrPartNotOrderable):
+			return partNotOrderable(err), nil
 		default:
 			return nil, fmt.Errorf("service error: %w", err)
 		}
 	}
 
 	return &orderV1.CreateOrderResponse{
-		OrderUUID:  order.ID,
-		TotalPrice: float32(order.TotalPrice),
+		OrderUUID:        order.ID,
+		OrderNumber:      order.Number,
+		SubtotalPrice:    order.SubtotalPrice,
+		PriceAdjustments: converter.ConvertPriceAdjustmentsToDTO(order.PriceAdjustments),
+		TotalPrice:       float32(order.TotalPrice),
 	}, nil
 }
+
+// partsNotFound перечисляет в ответе детали, которых нет в inventory, чтобы клиент мог убрать их из заказа
+func partsNotFound(err error) *orderV1.PartsNotFoundError {
+	resp := &orderV1.PartsNotFoundError{
+		Code:    http.StatusNotFound,
+		Message: err.Error(),
+	}
+
+	var notFoundErr *model.PartsNotFoundError
+	if errors.As(err, &notFoundErr) {
+		resp.MissingPartUuids = notFoundErr.PartIDs
+	}
+
+	return resp
+}
+
+// partNotOrderable сообщает, какую деталь нельзя заказать и чем её заменить
+func partNotOrderable(err error) *orderV1.PartNotOrderableError {
+	resp := &orderV1.PartNotOrderableError{
+		Code:    http.StatusConflict,
+		Message: err.Error(),
+	}
+
+	var notOrderableErr *model.PartNotOrderableError
+	if errors.As(err, &notOrderableErr) {
+		resp.PartUUID = notOrderableErr.PartID
+		resp.LifecycleState = orderV1.PartNotOrderableErrorLifecycleState(notOrderableErr.State)
+		if notOrderableErr.ReplacementID != uuid.Nil {
+			resp.ReplacementPartUUID = orderV1.NewOptUUID(notOrderableErr.ReplacementID)
+		}
+		if notOrderableErr.ReplacementName != "" {
+			resp.ReplacementPartName = orderV1.NewOptString(notOrderableErr.ReplacementName)
+		}
+	}
+
+	return resp
+}
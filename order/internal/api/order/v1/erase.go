@@ -0,0 +1,33 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/bogdanovds/rocket_factory/order/internal/model"
+	orderV1 "github.com/bogdanovds/rocket_factory/shared/pkg/openapi/order/v1"
+)
+
+func (h *Handler) EraseUserData(ctx context.Context, params orderV1.EraseUserDataParams) (orderV1.EraseUserDataRes, error) {
+	// Роль admin выставляет API-шлюз; auth.TrustedGateway отклоняет заголовки от других клиентов
+	actor := model.Actor{UserID: params.XUserUUID, Role: model.Role(params.XUserRole)}
+
+	result, err := h.service.EraseUserData(ctx, actor, params.UserUUID)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrAdminRoleRequired):
+			return forbidden(err.Error()), nil
+		case errors.Is(err, model.ErrUserHasActiveOrders):
+			return conflict(err.Error()), nil
+		default:
+			return nil, fmt.Errorf("erase user data error: %w", err)
+		}
+	}
+
+	return &orderV1.EraseUserDataResponse{
+		UserUUID:         result.UserID,
+		AnonymizedOrders: result.AnonymizedOrders,
+		AuditEntryUUID:   result.AuditEntryID,
+	}, nil
+}
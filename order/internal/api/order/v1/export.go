@@ -0,0 +1,135 @@
+package v1
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/bogdanovds/rocket_factory/order/internal/converter"
+	"github.com/bogdanovds/rocket_factory/order/internal/model"
+	orderV1 "github.com/bogdanovds/rocket_factory/shared/pkg/openapi/order/v1"
+)
+
+// exportCSVHeader - колонки CSV-выгрузки заказов
+var exportCSVHeader = []string{
+	"order_uuid", "order_number", "user_uuid", "part_uuids", "subtotal_price", "price_adjustments",
+	"total_price", "status", "payment_method", "transaction_uuid", "reviewed_by", "rejection_reason",
+}
+
+func (h *Handler) ExportUserOrders(ctx context.Context, params orderV1.ExportUserOrdersParams) (orderV1.ExportUserOrdersRes, error) {
+	// Роль admin выставляет API-шлюз; auth.TrustedGateway отклоняет заголовки от других клиентов
+	actor := model.Actor{UserID: params.XUserUUID, Role: model.Role(params.XUserRole)}
+	format := model.ExportFormat(params.Format.Or(orderV1.ExportFormatJsonl))
+
+	orders, err := h.service.ExportUserOrders(ctx, actor, params.UserUUID, format)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrAdminRoleRequired):
+			return forbidden(err.Error()), nil
+		case errors.Is(err, model.ErrInvalidExportFormat):
+			return badRequest(err.Error()), nil
+		default:
+			return nil, fmt.Errorf("export user orders error: %w", err)
+		}
+	}
+
+	// Заказы пишутся в pipe по мере чтения из хранилища, поэтому выгрузка не накапливается в памяти.
+	// Ошибка посреди выгрузки обрывает ответ, чтобы клиент не получил неполные данные как успешные.
+	pr, pw := io.Pipe()
+	go func() {
+		if format == model.ExportFormatCSV {
+			pw.CloseWithError(writeOrdersCSV(pw, orders))
+			return
+		}
+		pw.CloseWithError(writeOrdersJSONLines(pw, orders))
+	}()
+
+	if format == model.ExportFormatCSV {
+		return &orderV1.ExportUserOrdersOKTextCsv{Data: pr}, nil
+	}
+	return &orderV1.ExportUserOrdersOKApplicationXNdjson{Data: pr}, nil
+}
+
+func writeOrdersJSONLines(w io.Writer, orders iter.Seq2[*model.Order, error]) error {
+	for order, err := range orders {
+		if err != nil {
+			return err
+		}
+
+		line, err := converter.ConvertOrderToDTO(order).MarshalJSON()
+		if err != nil {
+			return fmt.Errorf("failed to marshal order: %w", err)
+		}
+
+		if _, err := w.Write(append(line, '\n')); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func writeOrdersCSV(w io.Writer, orders iter.Seq2[*model.Order, error]) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(exportCSVHeader); err != nil {
+		return err
+	}
+
+	for order, err := range orders {
+		if err != nil {
+			return err
+		}
+
+		partIDs := make([]string, len(order.PartIDs))
+		for i, id := range order.PartIDs {
+			partIDs[i] = id.String()
+		}
+
+		adjustments, err := json.Marshal(converter.ConvertPriceAdjustmentsToDTO(order.PriceAdjustments))
+		if err != nil {
+			return fmt.Errorf("failed to marshal price adjustments: %w", err)
+		}
+
+		record := []string{
+			order.ID.String(),
+			order.Number,
+			order.UserID.String(),
+			strings.Join(partIDs, ";"),
+			strconv.FormatFloat(order.SubtotalPrice, 'f', 2, 64),
+			string(adjustments),
+			strconv.FormatFloat(order.TotalPrice, 'f', 2, 64),
+			string(order.Status),
+			order.PaymentMethod,
+			optionalUUID(order.TransactionID),
+			optionalUUID(order.ReviewedBy),
+			order.RejectionReason,
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+
+		// Сбрасываем буфер после каждой строки, чтобы клиент получал данные потоково
+		cw.Flush()
+		if err := cw.Error(); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+func optionalUUID(id uuid.UUID) string {
+	if id == uuid.Nil {
+		return ""
+	}
+	return id.String()
+}
@@ -0,0 +1,36 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/bogdanovds/rocket_factory/order/internal/converter"
+	"github.com/bogdanovds/rocket_factory/order/internal/model"
+	orderV1 "github.com/bogdanovds/rocket_factory/shared/pkg/openapi/order/v1"
+)
+
+func (h *Handler) FulfillOrder(ctx context.Context, params orderV1.FulfillOrderParams) (orderV1.FulfillOrderRes, error) {
+	orderID, err := uuid.Parse(params.OrderUUID.String())
+	if err != nil {
+		return badRequest("invalid order UUID format"), nil
+	}
+
+	order, err := h.service.FulfillOrder(ctx, orderID)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrOrderNotFound):
+			return notFound(fmt.Sprintf("Order with UUID %s not found", params.OrderUUID)), nil
+		case errors.Is(err, model.ErrOrderNotPaid), errors.Is(err, model.ErrOrderFulfilled), errors.Is(err, model.ErrOrderStatusChanged):
+			return conflict(err.Error()), nil
+		case errors.Is(err, model.ErrFulfillmentFailed):
+			return badGateway(err.Error()), nil
+		default:
+			return nil, fmt.Errorf("fulfill order error: %w", err)
+		}
+	}
+
+	return converter.ConvertOrderToDTO(order), nil
+}
@@ -0,0 +1,27 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/bogdanovds/rocket_factory/order/internal/converter"
+	"github.com/bogdanovds/rocket_factory/order/internal/model"
+	orderV1 "github.com/bogdanovds/rocket_factory/shared/pkg/openapi/order/v1"
+)
+
+func (h *Handler) GetOrderByNumber(ctx context.Context, params orderV1.GetOrderByNumberParams) (orderV1.GetOrderByNumberRes, error) {
+	order, err := h.service.GetOrderByNumber(ctx, params.OrderNumber)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrInvalidOrderNumber):
+			return badRequest(fmt.Sprintf("invalid order number format, expected %s-YYYY-NNNNNN", model.OrderNumberPrefix)), nil
+		case errors.Is(err, model.ErrOrderNotFound):
+			return notFound(fmt.Sprintf("Order with number %s not found", params.OrderNumber)), nil
+		default:
+			return nil, fmt.Errorf("get order by number error: %w", err)
+		}
+	}
+
+	return converter.ConvertOrderToDTO(order), nil
+}
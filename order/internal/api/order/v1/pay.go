@@ -7,6 +7,7 @@ import (
 
 	"github.com/google/uuid"
 
+	"github.com/bogdanovds/rocket_factory/order/internal/converter"
 	"github.com/bogdanovds/rocket_factory/order/internal/model"
 	orderV1 "github.com/bogdanovds/rocket_factory/shared/pkg/openapi/order/v1"
 )
@@ -22,7 +23,8 @@ func (h *Handler) PayOrder(ctx context.Context, req *orderV1.PayOrderRequest, pa
 		switch {
 		case errors.Is(err, model.ErrOrderNotFound):
 			return notFound(fmt.Sprintf("Order with UUID %s not found", params.OrderUUID)), nil
-		case errors.Is(err, model.ErrOrderAlreadyPaid), errors.Is(err, model.ErrOrderCancelled), errors.Is(err, model.ErrOrderFulfilled):
+		case errors.Is(err, model.ErrOrderAlreadyPaid), errors.Is(err, model.ErrOrderCancelled), errors.Is(err, model.ErrOrderFulfilled),
+			errors.Is(err, model.ErrOrderAwaitingApproval), errors.Is(err, model.ErrOrderRejected), errors.Is(err, model.ErrOrderStatusChanged):
 			return conflict(err.Error()), nil
 		case errors.Is(err, model.ErrPaymentRequired):
 			return badRequest(err.Error()), nil
@@ -33,5 +35,6 @@ func (h *Handler) PayOrder(ctx context.Context, req *orderV1.PayOrderRequest, pa
 
 	return &orderV1.PayOrderResponse{
 		TransactionUUID: order.TransactionID,
+		Status:          converter.ConvertStatusToDTO(order.Status),
 	}, nil
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
 
+	"github.com/bogdanovds/rocket_factory/order/internal/api/auth"
 	"github.com/bogdanovds/rocket_factory/order/internal/config"
 	"github.com/bogdanovds/rocket_factory/platform/pkg/closer"
 	"github.com/bogdanovds/rocket_factory/platform/pkg/logger"
@@ -90,6 +91,13 @@ func (a *App) initHTTPServer(ctx context.Context) error {
 	r.Use(middleware.Recoverer)
 	r.Use(middleware.Timeout(10 * time.Second))
 
+	// Роль и UUID пользователя приходят заголовками от API-шлюза, сервис их не аутентифицирует
+	gatewaySecret := config.AppConfig().HTTP.GatewaySecret()
+	if gatewaySecret == "" {
+		logger.Warn(ctx, "HTTP_GATEWAY_SECRET is not set, requests with X-User-* headers will be rejected")
+	}
+	r.Use(auth.TrustedGateway(gatewaySecret))
+
 	r.Route("/api/v1", func(r chi.Router) {
 		r.With(func(next http.Handler) http.Handler {
 			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -14,8 +14,13 @@ import (
 	inventoryClient "github.com/bogdanovds/rocket_factory/order/internal/client/grpc/inventory/v1"
 	paymentClient "github.com/bogdanovds/rocket_factory/order/internal/client/grpc/payment/v1"
 	"github.com/bogdanovds/rocket_factory/order/internal/config"
+	"github.com/bogdanovds/rocket_factory/order/internal/config/env"
 	"github.com/bogdanovds/rocket_factory/order/internal/migrator"
+	"github.com/bogdanovds/rocket_factory/order/internal/pricing"
+	"github.com/bogdanovds/rocket_factory/order/internal/pricing/rules"
 	"github.com/bogdanovds/rocket_factory/order/internal/repository"
+	auditMemory "github.com/bogdanovds/rocket_factory/order/internal/repository/audit"
+	memory "github.com/bogdanovds/rocket_factory/order/internal/repository/order"
 	"github.com/bogdanovds/rocket_factory/order/internal/repository/postgres"
 	"github.com/bogdanovds/rocket_factory/order/internal/service"
 	orderService "github.com/bogdanovds/rocket_factory/order/internal/service/order"
@@ -29,6 +34,9 @@ type diContainer struct {
 	orderService service.Service
 
 	orderRepository repository.Repository
+	auditRepository repository.AuditRepository
+
+	pricingEngine pricing.Engine
 
 	inventoryClient client.InventoryClient
 	paymentClient   client.PaymentClient
@@ -57,8 +65,11 @@ func (d *diContainer) OrderService(ctx context.Context) service.Service {
 	if d.orderService == nil {
 		d.orderService = orderService.NewService(
 			d.OrderRepository(ctx),
+			d.AuditRepository(ctx),
 			d.InventoryClient(ctx),
 			d.PaymentClient(ctx),
+			d.PricingEngine(ctx),
+			config.AppConfig().Approval.Threshold(),
 		)
 	}
 
@@ -68,12 +79,45 @@ func (d *diContainer) OrderService(ctx context.Context) service.Service {
 // OrderRepository возвращает репозиторий заказов
 func (d *diContainer) OrderRepository(ctx context.Context) repository.Repository {
 	if d.orderRepository == nil {
-		d.orderRepository = postgres.NewRepository(d.DB(ctx))
+		switch config.AppConfig().Repository.Backend() {
+		case env.RepositoryBackendMemory:
+			d.orderRepository = memory.NewRepository()
+		default:
+			d.orderRepository = postgres.NewRepository(d.DB(ctx))
+		}
 	}
 
 	return d.orderRepository
 }
 
+// AuditRepository возвращает журнал аудита
+func (d *diContainer) AuditRepository(ctx context.Context) repository.AuditRepository {
+	if d.auditRepository == nil {
+		switch config.AppConfig().Repository.Backend() {
+		case env.RepositoryBackendMemory:
+			d.auditRepository = auditMemory.NewRepository()
+		default:
+			d.auditRepository = postgres.NewAuditRepository(d.DB(ctx))
+		}
+	}
+
+	return d.auditRepository
+}
+
+// PricingEngine возвращает движок ценообразования
+func (d *diContainer) PricingEngine(_ context.Context) pricing.Engine {
+	if d.pricingEngine == nil {
+		engine, err := rules.Load(config.AppConfig().Pricing.RulesPath())
+		if err != nil {
+			panic(fmt.Sprintf("failed to load pricing rules: %v", err))
+		}
+
+		d.pricingEngine = engine
+	}
+
+	return d.pricingEngine
+}
+
 // InventoryClient возвращает клиент Inventory
 func (d *diContainer) InventoryClient(ctx context.Context) client.InventoryClient {
 	if d.inventoryClient == nil {
@@ -10,7 +10,13 @@ import (
 
 // InventoryClient - интерфейс клиента inventory
 type InventoryClient interface {
-	ListParts(ctx context.Context, partIDs []uuid.UUID) ([]*model.Part, error)
+	// BatchGetParts возвращает детали по ID. Повторы ID запрашиваются один раз.
+	BatchGetParts(ctx context.Context, partIDs []uuid.UUID) (*model.PartsBatch, error)
+	// WarehouseExists проверяет, что склад заведён в inventory
+	WarehouseExists(ctx context.Context, warehouseID uuid.UUID) (bool, error)
+	// FulfillOrder списывает детали заказа со складов: сначала с предпочтительного
+	// (uuid.Nil - без предпочтения), недостающее - с остальных. Повторная деталь списывается повторно.
+	FulfillOrder(ctx context.Context, orderID, preferredWarehouseID uuid.UUID, partIDs []uuid.UUID) error
 }
 
 // PaymentClient - интерфейс клиента payment
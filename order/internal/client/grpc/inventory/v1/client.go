@@ -3,14 +3,21 @@ package inventory
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/google/uuid"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/bogdanovds/rocket_factory/order/internal/model"
 	inventoryV1 "github.com/bogdanovds/rocket_factory/shared/pkg/proto/inventory/v1"
 )
 
+// batchGetPartsLimit - наибольшее количество UUID в одном запросе BatchGetParts, поддерживаемое inventory
+const batchGetPartsLimit = 1000
+
 type Client struct {
 	client inventoryV1.InventoryServiceClient
 }
@@ -21,27 +28,82 @@ func New(conn *grpc.ClientConn) *Client {
 	}
 }
 
-func (c *Client) ListParts(ctx context.Context, partIDs []uuid.UUID) ([]*model.Part, error) {
-	partUUIDs := make([]string, len(partIDs))
-	for i, id := range partIDs {
-		partUUIDs[i] = id.String()
+func (c *Client) BatchGetParts(ctx context.Context, partIDs []uuid.UUID) (*model.PartsBatch, error) {
+	batch := &model.PartsBatch{Parts: make(map[uuid.UUID]*model.Part, len(partIDs))}
+
+	// Повторы деталей в заказе запрашиваются один раз, уникальные ID - частями по лимиту inventory
+	seen := make(map[uuid.UUID]bool, len(partIDs))
+	partUUIDs := make([]string, 0, len(partIDs))
+	for _, id := range partIDs {
+		if !seen[id] {
+			seen[id] = true
+			partUUIDs = append(partUUIDs, id.String())
+		}
+	}
+
+	for chunk := range slices.Chunk(partUUIDs, batchGetPartsLimit) {
+		resp, err := c.client.BatchGetParts(ctx, &inventoryV1.BatchGetPartsRequest{Uuids: chunk})
+		if err != nil {
+			return nil, fmt.Errorf("gRPC inventory error: %w", err)
+		}
+		if invalid := resp.GetInvalidUuids(); len(invalid) > 0 {
+			return nil, fmt.Errorf("inventory rejected part UUIDs: %s", strings.Join(invalid, ", "))
+		}
+
+		for _, p := range resp.GetParts() {
+			part := convertProtoToPart(p)
+			batch.Parts[part.ID] = part
+		}
+		for _, id := range resp.GetNotFoundUuids() {
+			notFound, err := uuid.Parse(id)
+			if err != nil {
+				return nil, fmt.Errorf("inventory returned invalid part UUID %q: %w", id, err)
+			}
+			batch.NotFound = append(batch.NotFound, notFound)
+		}
 	}
 
-	resp, err := c.client.ListParts(ctx, &inventoryV1.ListPartsRequest{
-		Filter: &inventoryV1.PartsFilter{
-			Uuids: partUUIDs,
-		},
-	})
+	return batch, nil
+}
+
+func (c *Client) WarehouseExists(ctx context.Context, warehouseID uuid.UUID) (bool, error) {
+	_, err := c.client.GetWarehouse(ctx, &inventoryV1.GetWarehouseRequest{Uuid: warehouseID.String()})
 	if err != nil {
-		return nil, fmt.Errorf("gRPC inventory error: %w", err)
+		if status.Code(err) == codes.NotFound {
+			return false, nil
+		}
+		return false, fmt.Errorf("gRPC inventory error: %w", err)
 	}
 
-	parts := make([]*model.Part, len(resp.Parts))
-	for i, p := range resp.Parts {
-		parts[i] = convertProtoToPart(p)
+	return true, nil
+}
+
+func (c *Client) FulfillOrder(ctx context.Context, orderID, preferredWarehouseID uuid.UUID, partIDs []uuid.UUID) error {
+	req := &inventoryV1.FulfillStockRequest{
+		// Номер заказа в журнале движений связывает списания с заказом
+		ReferenceId: orderID.String(),
+	}
+	if preferredWarehouseID != uuid.Nil {
+		req.PreferredWarehouseUuid = preferredWarehouseID.String()
 	}
 
-	return parts, nil
+	// Одна позиция на деталь: количество - сколько раз деталь указана в заказе
+	items := make(map[uuid.UUID]*inventoryV1.FulfillmentItem, len(partIDs))
+	for _, id := range partIDs {
+		if item, ok := items[id]; ok {
+			item.Quantity++
+			continue
+		}
+		item := &inventoryV1.FulfillmentItem{PartUuid: id.String(), Quantity: 1}
+		items[id] = item
+		req.Items = append(req.Items, item)
+	}
+
+	if _, err := c.client.FulfillStock(ctx, req); err != nil {
+		return fmt.Errorf("gRPC inventory error: %w", err)
+	}
+
+	return nil
 }
 
 func convertProtoToPart(part *inventoryV1.Part) *model.Part {
@@ -51,10 +113,27 @@ func convertProtoToPart(part *inventoryV1.Part) *model.Part {
 		id = uuid.Nil
 	}
 
+	// Замена без корректного UUID не указывается, деталь всё равно отклоняется по состоянию
+	replacementID, err := uuid.Parse(part.GetReplacementPartUuid())
+	if err != nil {
+		replacementID = uuid.Nil
+	}
+
 	return &model.Part{
 		ID:       id,
 		Name:     part.Name,
 		Price:    float64(part.Price),
-		Category: string(part.Category),
+		Category: strings.TrimPrefix(part.GetCategory().String(), "CATEGORY_"),
+
+		LifecycleState: convertProtoToLifecycleState(part.GetLifecycleState()),
+		ReplacementID:  replacementID,
+	}
+}
+
+// convertProtoToLifecycleState возвращает состояние без префикса, для неуказанного - пустую строку
+func convertProtoToLifecycleState(state inventoryV1.PartLifecycleState) string {
+	if state == inventoryV1.PartLifecycleState_PART_LIFECYCLE_STATE_UNSPECIFIED {
+		return ""
 	}
+	return strings.TrimPrefix(state.String(), "PART_LIFECYCLE_STATE_")
 }
@@ -19,11 +19,23 @@ func NewMockInventoryClient() *MockInventoryClient {
 	return &MockInventoryClient{}
 }
 
-// ListParts возвращает список деталей по ID
-func (m *MockInventoryClient) ListParts(ctx context.Context, partIDs []uuid.UUID) ([]*model.Part, error) {
+// BatchGetParts возвращает детали по ID
+func (m *MockInventoryClient) BatchGetParts(ctx context.Context, partIDs []uuid.UUID) (*model.PartsBatch, error) {
 	args := m.Called(ctx, partIDs)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
-	return args.Get(0).([]*model.Part), args.Error(1)
+	return args.Get(0).(*model.PartsBatch), args.Error(1)
+}
+
+// WarehouseExists проверяет наличие склада
+func (m *MockInventoryClient) WarehouseExists(ctx context.Context, warehouseID uuid.UUID) (bool, error) {
+	args := m.Called(ctx, warehouseID)
+	return args.Bool(0), args.Error(1)
+}
+
+// FulfillOrder списывает детали заказа со складов
+func (m *MockInventoryClient) FulfillOrder(ctx context.Context, orderID, preferredWarehouseID uuid.UUID, partIDs []uuid.UUID) error {
+	args := m.Called(ctx, orderID, preferredWarehouseID, partIDs)
+	return args.Error(0)
 }
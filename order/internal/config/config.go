@@ -14,8 +14,11 @@ type config struct {
 	Logger          LoggerConfig
 	HTTP            HTTPConfig
 	Postgres        PostgresConfig
+	Repository      RepositoryConfig
 	InventoryClient GRPCClientConfig
 	PaymentClient   GRPCClientConfig
+	Pricing         PricingConfig
+	Approval        ApprovalConfig
 }
 
 // Load загружает конфигурацию из .env файла
@@ -40,6 +43,11 @@ func Load(path ...string) error {
 		return err
 	}
 
+	repositoryCfg, err := env.NewRepositoryConfig()
+	if err != nil {
+		return err
+	}
+
 	inventoryClientCfg, err := env.NewInventoryClientConfig()
 	if err != nil {
 		return err
@@ -50,12 +58,25 @@ func Load(path ...string) error {
 		return err
 	}
 
+	pricingCfg, err := env.NewPricingConfig()
+	if err != nil {
+		return err
+	}
+
+	approvalCfg, err := env.NewApprovalConfig()
+	if err != nil {
+		return err
+	}
+
 	appConfig = &config{
 		Logger:          loggerCfg,
 		HTTP:            httpCfg,
 		Postgres:        postgresCfg,
+		Repository:      repositoryCfg,
 		InventoryClient: inventoryClientCfg,
 		PaymentClient:   paymentClientCfg,
+		Pricing:         pricingCfg,
+		Approval:        approvalCfg,
 	}
 
 	return nil
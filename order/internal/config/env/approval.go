@@ -0,0 +1,27 @@
+package env
+
+import (
+	"github.com/caarlos0/env/v11"
+)
+
+type approvalEnvConfig struct {
+	Threshold float64 `env:"APPROVAL_THRESHOLD" envDefault:"5000000"`
+}
+
+type approvalConfig struct {
+	raw approvalEnvConfig
+}
+
+// NewApprovalConfig создаёт конфигурацию согласования заказов из переменных окружения
+func NewApprovalConfig() (*approvalConfig, error) {
+	var raw approvalEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &approvalConfig{raw: raw}, nil
+}
+
+func (cfg *approvalConfig) Threshold() float64 {
+	return cfg.raw.Threshold
+}
@@ -10,6 +10,8 @@ type httpEnvConfig struct {
 	Host        string `env:"HTTP_HOST" envDefault:"0.0.0.0"`
 	Port        string `env:"HTTP_PORT" envDefault:"8081"`
 	ReadTimeout string `env:"HTTP_READ_TIMEOUT" envDefault:"5s"`
+	// GatewaySecret - общий секрет API-шлюза, подтверждающий заголовки X-User-*
+	GatewaySecret string `env:"HTTP_GATEWAY_SECRET"`
 }
 
 type httpConfig struct {
@@ -33,3 +35,7 @@ func (cfg *httpConfig) Address() string {
 func (cfg *httpConfig) ReadTimeout() string {
 	return cfg.raw.ReadTimeout
 }
+
+func (cfg *httpConfig) GatewaySecret() string {
+	return cfg.raw.GatewaySecret
+}
@@ -0,0 +1,27 @@
+package env
+
+import (
+	"github.com/caarlos0/env/v11"
+)
+
+type pricingEnvConfig struct {
+	RulesPath string `env:"PRICING_RULES_PATH" envDefault:""`
+}
+
+type pricingConfig struct {
+	raw pricingEnvConfig
+}
+
+// NewPricingConfig создаёт конфигурацию ценообразования из переменных окружения
+func NewPricingConfig() (*pricingConfig, error) {
+	var raw pricingEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &pricingConfig{raw: raw}, nil
+}
+
+func (cfg *pricingConfig) RulesPath() string {
+	return cfg.raw.RulesPath
+}
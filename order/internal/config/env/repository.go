@@ -0,0 +1,42 @@
+package env
+
+import (
+	"fmt"
+
+	"github.com/caarlos0/env/v11"
+)
+
+const (
+	// RepositoryBackendPostgres - заказы хранятся в PostgreSQL
+	RepositoryBackendPostgres = "postgres"
+	// RepositoryBackendMemory - заказы хранятся в памяти процесса (локальная разработка, тесты)
+	RepositoryBackendMemory = "memory"
+)
+
+type repositoryEnvConfig struct {
+	Backend string `env:"REPOSITORY_BACKEND" envDefault:"postgres"`
+}
+
+type repositoryConfig struct {
+	raw repositoryEnvConfig
+}
+
+// NewRepositoryConfig создаёт конфигурацию хранилища заказов из переменных окружения
+func NewRepositoryConfig() (*repositoryConfig, error) {
+	var raw repositoryEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	switch raw.Backend {
+	case RepositoryBackendPostgres, RepositoryBackendMemory:
+	default:
+		return nil, fmt.Errorf("unknown repository backend %q", raw.Backend)
+	}
+
+	return &repositoryConfig{raw: raw}, nil
+}
+
+func (cfg *repositoryConfig) Backend() string {
+	return cfg.raw.Backend
+}
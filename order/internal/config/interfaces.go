@@ -10,6 +10,8 @@ type LoggerConfig interface {
 type HTTPConfig interface {
 	Address() string
 	ReadTimeout() string
+	// GatewaySecret - секрет, с которым API-шлюз передаёт заголовки пользователя (пусто - заголовки отклоняются)
+	GatewaySecret() string
 }
 
 // PostgresConfig интерфейс для настроек PostgreSQL
@@ -23,7 +25,24 @@ type PostgresConfig interface {
 	SSLMode() string
 }
 
+// RepositoryConfig интерфейс для выбора хранилища заказов
+type RepositoryConfig interface {
+	// Backend - postgres или memory
+	Backend() string
+}
+
 // GRPCClientConfig интерфейс для настроек gRPC клиента
 type GRPCClientConfig interface {
 	Address() string
 }
+
+// PricingConfig интерфейс для настроек ценообразования
+type PricingConfig interface {
+	RulesPath() string
+}
+
+// ApprovalConfig интерфейс для настроек согласования крупных заказов
+type ApprovalConfig interface {
+	// Threshold - сумма заказа, выше которой требуется согласование. 0 отключает согласование.
+	Threshold() float64
+}
@@ -9,11 +9,14 @@ import (
 
 func ConvertOrderToDTO(order *model.Order) *orderV1.OrderDto {
 	return &orderV1.OrderDto{
-		OrderUUID:  order.ID,
-		UserUUID:   order.UserID,
-		PartUuids:  order.PartIDs,
-		TotalPrice: float32(order.TotalPrice),
-		Status:     convertStatusToDTO(order.Status),
+		OrderUUID:        order.ID,
+		OrderNumber:      order.Number,
+		UserUUID:         order.UserID,
+		PartUuids:        order.PartIDs,
+		SubtotalPrice:    order.SubtotalPrice,
+		PriceAdjustments: ConvertPriceAdjustmentsToDTO(order.PriceAdjustments),
+		TotalPrice:       float32(order.TotalPrice),
+		Status:           ConvertStatusToDTO(order.Status),
 		PaymentMethod: orderV1.OptPaymentMethod{
 			Value: orderV1.PaymentMethod(order.PaymentMethod),
 			Set:   order.PaymentMethod != "",
@@ -23,17 +26,52 @@ func ConvertOrderToDTO(order *model.Order) *orderV1.OrderDto {
 			Set:   order.TransactionID != uuid.Nil,
 			Null:  order.TransactionID == uuid.Nil,
 		},
+		ReviewedBy: orderV1.OptUUID{
+			Value: order.ReviewedBy,
+			Set:   order.ReviewedBy != uuid.Nil,
+		},
+		RejectionReason: orderV1.OptString{
+			Value: order.RejectionReason,
+			Set:   order.RejectionReason != "",
+		},
+		PreferredWarehouseUUID: orderV1.OptUUID{
+			Value: order.PreferredWarehouseID,
+			Set:   order.PreferredWarehouseID != uuid.Nil,
+		},
+	}
+}
+
+func ConvertPriceAdjustmentsToDTO(adjustments []model.PriceAdjustment) []orderV1.PriceAdjustment {
+	result := make([]orderV1.PriceAdjustment, 0, len(adjustments))
+	for _, a := range adjustments {
+		result = append(result, orderV1.PriceAdjustment{
+			RuleID:      a.RuleID,
+			Description: a.Description,
+			PartUUID: orderV1.OptUUID{
+				Value: a.PartID,
+				Set:   a.PartID != uuid.Nil,
+			},
+			Amount: a.Amount,
+		})
 	}
+	return result
 }
 
-func convertStatusToDTO(status model.OrderStatus) orderV1.OrderStatus {
+// ConvertStatusToDTO конвертирует статус заказа в DTO
+func ConvertStatusToDTO(status model.OrderStatus) orderV1.OrderStatus {
 	switch status {
 	case model.OrderStatusPending:
 		return orderV1.OrderStatusPENDINGPAYMENT
+	case model.OrderStatusAwaitingApproval:
+		return orderV1.OrderStatusAWAITINGAPPROVAL
+	case model.OrderStatusRejected:
+		return orderV1.OrderStatusREJECTED
 	case model.OrderStatusPaid:
 		return orderV1.OrderStatusPAID
 	case model.OrderStatusCancelled:
 		return orderV1.OrderStatusCANCELLED
+	case model.OrderStatusFulfilled:
+		return orderV1.OrderStatusFULFILLED
 	default:
 		return orderV1.OrderStatusPENDINGPAYMENT
 	}
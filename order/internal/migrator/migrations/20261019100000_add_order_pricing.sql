@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE orders
+    ADD COLUMN IF NOT EXISTS subtotal_price DECIMAL(15, 2) NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS price_adjustments JSONB NOT NULL DEFAULT '[]'::jsonb;
+
+UPDATE orders SET subtotal_price = total_price;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS price_adjustments,
+    DROP COLUMN IF EXISTS subtotal_price;
+-- +goose StatementEnd
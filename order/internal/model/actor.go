@@ -0,0 +1,19 @@
+package model
+
+import "github.com/google/uuid"
+
+// Role - роль пользователя, выполняющего действие
+type Role string
+
+const (
+	// RoleApprover - сотрудник финансового отдела, согласующий крупные заказы
+	RoleApprover Role = "approver"
+	// RoleAdmin - администратор, обрабатывающий запросы пользователей на выгрузку и удаление данных
+	RoleAdmin Role = "admin"
+)
+
+// Actor - пользователь, выполняющий действие над заказом
+type Actor struct {
+	UserID uuid.UUID
+	Role   Role
+}
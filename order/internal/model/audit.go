@@ -0,0 +1,40 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AuditAction - действие с персональными данными пользователя, фиксируемое в журнале аудита
+type AuditAction string
+
+const (
+	// AuditActionUserDataExport - выгрузка заказов пользователя
+	AuditActionUserDataExport AuditAction = "USER_DATA_EXPORT"
+	// AuditActionUserDataErasure - обезличивание заказов пользователя
+	AuditActionUserDataErasure AuditAction = "USER_DATA_ERASURE"
+)
+
+// Ключ и значения Details["status"]: запись создаётся до действия со статусом STARTED и после
+// него завершается статусом COMPLETED или FAILED, поэтому действие не остаётся без записи
+const (
+	AuditDetailStatus = "status"
+
+	AuditStatusStarted   = "STARTED"
+	AuditStatusCompleted = "COMPLETED"
+	AuditStatusFailed    = "FAILED"
+)
+
+// AuditEntry - запись журнала аудита
+type AuditEntry struct {
+	ID     uuid.UUID
+	Action AuditAction
+	// ActorID - администратор, выполнивший действие
+	ActorID uuid.UUID
+	// SubjectUserID - пользователь, чьи данные выгружены или обезличены
+	SubjectUserID uuid.UUID
+	// Details - параметры действия (формат выгрузки, количество обезличенных заказов и т.п.)
+	Details   map[string]string
+	CreatedAt time.Time
+}
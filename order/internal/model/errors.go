@@ -1,6 +1,12 @@
 package model
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
 
 var (
 	ErrOrderNotFound     = errors.New("order not found")
@@ -8,7 +14,76 @@ var (
 	ErrOrderAlreadyPaid  = errors.New("order already paid")
 	ErrOrderCancelled    = errors.New("order cancelled")
 	ErrOrderFulfilled    = errors.New("order fulfilled")
+	ErrOrderNotPaid      = errors.New("order is not paid")
 	ErrPaymentRequired   = errors.New("payment method required")
 	ErrPartsNotSpecified = errors.New("at least one part must be specified")
 	ErrPartsNotFound     = errors.New("some parts not found")
+	ErrPartNotOrderable  = errors.New("part is not orderable")
+
+	ErrWarehouseNotFound = errors.New("warehouse not found")
+	ErrFulfillmentFailed = errors.New("order fulfillment failed")
+
+	// ErrOrderStatusChanged - статус заказа изменили параллельно, пока он обрабатывался
+	ErrOrderStatusChanged = errors.New("order status changed concurrently")
+
+	ErrOrderAwaitingApproval    = errors.New("order is awaiting approval")
+	ErrOrderRejected            = errors.New("order rejected")
+	ErrOrderNotAwaitingApproval = errors.New("order is not awaiting approval")
+	ErrApproverRoleRequired     = errors.New("approver role required")
+	ErrRejectionReasonRequired  = errors.New("rejection reason required")
+
+	ErrInvalidOrderNumber = errors.New("invalid order number")
+	ErrOrderAlreadyExists = errors.New("order already exists")
+
+	ErrAuditEntryNotFound = errors.New("audit entry not found")
+
+	ErrAdminRoleRequired   = errors.New("admin role required")
+	ErrUserHasActiveOrders = errors.New("user has active orders")
+	ErrInvalidExportFormat = errors.New("invalid export format")
 )
+
+// PartsNotFoundError - детали заказа, которых нет в inventory. Соответствует ErrPartsNotFound в errors.Is.
+type PartsNotFoundError struct {
+	PartIDs []uuid.UUID
+}
+
+func (e *PartsNotFoundError) Error() string {
+	ids := make([]string, len(e.PartIDs))
+	for i, id := range e.PartIDs {
+		ids[i] = id.String()
+	}
+	return fmt.Sprintf("%s: %s", ErrPartsNotFound, strings.Join(ids, ", "))
+}
+
+func (e *PartsNotFoundError) Unwrap() error {
+	return ErrPartsNotFound
+}
+
+// PartNotOrderableError - деталь заказа в состоянии, в котором её нельзя заказать.
+// Соответствует ErrPartNotOrderable в errors.Is.
+type PartNotOrderableError struct {
+	PartID uuid.UUID
+	Name   string
+	// State - состояние детали: DRAFT, DISCONTINUED или RECALLED
+	State string
+	// ReplacementID - рекомендуемая замена, uuid.Nil если её нет
+	ReplacementID uuid.UUID
+	// ReplacementName - название замены, пустое если её нет или inventory её не нашёл
+	ReplacementName string
+}
+
+func (e *PartNotOrderableError) Error() string {
+	msg := fmt.Sprintf("%s: part %s (%s) is %s", ErrPartNotOrderable, e.PartID, e.Name, strings.ToLower(e.State))
+	if e.ReplacementID != uuid.Nil {
+		replacement := e.ReplacementID.String()
+		if e.ReplacementName != "" {
+			replacement += fmt.Sprintf(" (%s)", e.ReplacementName)
+		}
+		msg += fmt.Sprintf(", order replacement part %s instead", replacement)
+	}
+	return msg
+}
+
+func (e *PartNotOrderableError) Unwrap() error {
+	return ErrPartNotOrderable
+}
@@ -5,18 +5,31 @@ import "github.com/google/uuid"
 type OrderStatus string
 
 const (
-	OrderStatusPending   OrderStatus = "PENDING"
-	OrderStatusPaid      OrderStatus = "PAID"
-	OrderStatusCancelled OrderStatus = "CANCELLED"
-	OrderStatusFulfilled OrderStatus = "FULFILLED"
+	OrderStatusPending          OrderStatus = "PENDING"
+	OrderStatusAwaitingApproval OrderStatus = "AWAITING_APPROVAL"
+	OrderStatusRejected         OrderStatus = "REJECTED"
+	OrderStatusPaid             OrderStatus = "PAID"
+	OrderStatusCancelled        OrderStatus = "CANCELLED"
+	OrderStatusFulfilled        OrderStatus = "FULFILLED"
 )
 
+// ActiveOrderStatuses - статусы незавершённых заказов, которые ещё ссылаются на пользователя
+// при оплате и согласовании: пока они есть, данные пользователя не обезличиваются
+var ActiveOrderStatuses = []OrderStatus{OrderStatusPending, OrderStatusAwaitingApproval}
+
 type Order struct {
-	ID            uuid.UUID
-	UserID        uuid.UUID
-	PartIDs       []uuid.UUID
-	TotalPrice    float64
-	Status        OrderStatus
-	PaymentMethod string
-	TransactionID uuid.UUID
+	ID               uuid.UUID
+	Number           string
+	UserID           uuid.UUID
+	PartIDs          []uuid.UUID
+	SubtotalPrice    float64
+	PriceAdjustments []PriceAdjustment
+	TotalPrice       float64
+	Status           OrderStatus
+	PaymentMethod    string
+	TransactionID    uuid.UUID
+	ReviewedBy       uuid.UUID
+	RejectionReason  string
+	// PreferredWarehouseID - склад, с которого детали списываются в первую очередь. uuid.Nil - без предпочтения.
+	PreferredWarehouseID uuid.UUID
 }
@@ -0,0 +1,22 @@
+package model
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// OrderNumberPrefix - префикс человекочитаемого номера заказа
+const OrderNumberPrefix = "RF"
+
+// orderNumberPattern - формат номера заказа: RF-<год>-<порядковый номер в году, не менее 6 цифр>
+var orderNumberPattern = regexp.MustCompile(`^RF-\d{4}-\d{6,}$`)
+
+// FormatOrderNumber формирует номер заказа по году и порядковому номеру заказа в этом году
+func FormatOrderNumber(year int, seq int64) string {
+	return fmt.Sprintf("%s-%04d-%06d", OrderNumberPrefix, year, seq)
+}
+
+// IsValidOrderNumber проверяет, что строка соответствует формату номера заказа
+func IsValidOrderNumber(number string) bool {
+	return orderNumberPattern.MatchString(number)
+}
@@ -3,8 +3,38 @@ package model
 import "github.com/google/uuid"
 
 type Part struct {
-	ID       uuid.UUID
-	Name     string
-	Price    float64
+	ID    uuid.UUID
+	Name  string
+	Price float64
+	// Category - категория детали без префикса: ENGINE, FUEL, PORTHOLE, WING
 	Category string
+	// LifecycleState - состояние детали в жизненном цикле без префикса: DRAFT, ACTIVE, DISCONTINUED, RECALLED.
+	// Пусто, если inventory не сообщает состояние.
+	LifecycleState string
+	// ReplacementID - рекомендуемая замена снятой с производства или отозванной детали, uuid.Nil если её нет
+	ReplacementID uuid.UUID
+}
+
+const (
+	PartLifecycleStateDraft        = "DRAFT"
+	PartLifecycleStateActive       = "ACTIVE"
+	PartLifecycleStateDiscontinued = "DISCONTINUED"
+	PartLifecycleStateRecalled     = "RECALLED"
+)
+
+// IsOrderable сообщает, что деталь можно заказать. Деталь без состояния (inventory до появления
+// жизненного цикла) считается действующей.
+func (p *Part) IsOrderable() bool {
+	switch p.LifecycleState {
+	case PartLifecycleStateDraft, PartLifecycleStateDiscontinued, PartLifecycleStateRecalled:
+		return false
+	default:
+		return true
+	}
+}
+
+// PartsBatch - детали, найденные в inventory по списку ID, и ID, которых там нет
+type PartsBatch struct {
+	Parts    map[uuid.UUID]*Part
+	NotFound []uuid.UUID
 }
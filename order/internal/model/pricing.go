@@ -0,0 +1,20 @@
+package model
+
+import "github.com/google/uuid"
+
+// PriceAdjustment - корректировка цены, применённая правилом ценообразования
+type PriceAdjustment struct {
+	RuleID      string
+	Description string
+	// PartID - деталь, к которой относится корректировка. uuid.Nil, если корректировка относится ко всему заказу.
+	PartID uuid.UUID
+	// Amount - сумма корректировки. Отрицательное значение означает скидку.
+	Amount float64
+}
+
+// Pricing - результат расчёта стоимости заказа
+type Pricing struct {
+	Subtotal    float64
+	Adjustments []PriceAdjustment
+	Total       float64
+}
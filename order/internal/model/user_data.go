@@ -0,0 +1,23 @@
+package model
+
+import "github.com/google/uuid"
+
+// AnonymizedUserID - идентификатор, которым заменяется user_id в заказах после удаления данных пользователя
+var AnonymizedUserID = uuid.Nil
+
+// ExportFormat - формат выгрузки заказов пользователя
+type ExportFormat string
+
+const (
+	// ExportFormatJSONLines - по одному JSON-объекту заказа на строку
+	ExportFormatJSONLines ExportFormat = "jsonl"
+	// ExportFormatCSV - CSV с заголовком
+	ExportFormatCSV ExportFormat = "csv"
+)
+
+// ErasureResult - результат обезличивания заказов пользователя
+type ErasureResult struct {
+	UserID           uuid.UUID
+	AnonymizedOrders int
+	AuditEntryID     uuid.UUID
+}
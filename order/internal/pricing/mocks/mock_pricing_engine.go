@@ -0,0 +1,29 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/bogdanovds/rocket_factory/order/internal/model"
+)
+
+// MockPricingEngine - мок движка ценообразования
+type MockPricingEngine struct {
+	mock.Mock
+}
+
+// NewMockPricingEngine создает новый мок движка ценообразования
+func NewMockPricingEngine() *MockPricingEngine {
+	return &MockPricingEngine{}
+}
+
+// Calculate рассчитывает стоимость заказа
+func (m *MockPricingEngine) Calculate(ctx context.Context, userID uuid.UUID, parts []*model.Part) (*model.Pricing, error) {
+	args := m.Called(ctx, userID, parts)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Pricing), args.Error(1)
+}
@@ -0,0 +1,15 @@
+package pricing
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/bogdanovds/rocket_factory/order/internal/model"
+)
+
+// Engine - интерфейс движка ценообразования.
+// parts содержит по одной записи на каждую единицу товара в заказе (повторяющиеся детали передаются несколько раз).
+type Engine interface {
+	Calculate(ctx context.Context, userID uuid.UUID, parts []*model.Part) (*model.Pricing, error)
+}
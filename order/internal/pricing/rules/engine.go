@@ -0,0 +1,182 @@
+package rules
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"math"
+	"slices"
+
+	"github.com/google/uuid"
+
+	"github.com/bogdanovds/rocket_factory/order/internal/model"
+)
+
+// Engine - движок ценообразования на основе правил из YAML-файла
+type Engine struct {
+	rules []Rule
+}
+
+// line - позиция заказа (одна единица детали) с текущей ценой после применённых правил
+type line struct {
+	partID   uuid.UUID
+	category string
+	price    float64
+}
+
+// New создаёт движок с заданным набором правил.
+// Правила упорядочиваются по приоритету, а при равном приоритете - по ID, чтобы результат не зависел от порядка в файле.
+func New(rules []Rule) (*Engine, error) {
+	sorted := make([]Rule, len(rules))
+	copy(sorted, rules)
+
+	seen := make(map[string]struct{}, len(sorted))
+	for i := range sorted {
+		if err := sorted[i].validate(); err != nil {
+			return nil, err
+		}
+		if _, ok := seen[sorted[i].ID]; ok {
+			return nil, fmt.Errorf("duplicate rule id %q", sorted[i].ID)
+		}
+		seen[sorted[i].ID] = struct{}{}
+
+		sorted[i].normalize()
+	}
+
+	slices.SortStableFunc(sorted, func(a, b Rule) int {
+		if c := cmp.Compare(a.Priority, b.Priority); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	return &Engine{rules: sorted}, nil
+}
+
+// Calculate рассчитывает стоимость заказа, последовательно применяя правила
+func (e *Engine) Calculate(_ context.Context, userID uuid.UUID, parts []*model.Part) (*model.Pricing, error) {
+	lines := make([]*line, len(parts))
+	subtotal := 0.0
+	for i, p := range parts {
+		lines[i] = &line{partID: p.ID, category: p.Category, price: p.Price}
+		subtotal += p.Price
+	}
+
+	pricing := &model.Pricing{
+		Subtotal:    roundMoney(subtotal),
+		Adjustments: []model.PriceAdjustment{},
+	}
+
+	for i := range e.rules {
+		rule := &e.rules[i]
+		if !rule.appliesToUser(userID) {
+			continue
+		}
+
+		switch rule.Type {
+		case RuleTypeCustomerPrice:
+			pricing.Adjustments = append(pricing.Adjustments, applyCustomerPrice(rule, lines)...)
+		case RuleTypeVolume:
+			if adj, ok := applyVolume(rule, lines); ok {
+				pricing.Adjustments = append(pricing.Adjustments, adj)
+			}
+		}
+	}
+
+	total := pricing.Subtotal
+	for _, adj := range pricing.Adjustments {
+		total += adj.Amount
+	}
+	pricing.Total = roundMoney(math.Max(total, 0))
+
+	return pricing, nil
+}
+
+// applyCustomerPrice заменяет цену деталей ценой из прайс-листа покупателя.
+// Корректировки формируются по одной на деталь в порядке первого появления детали в заказе.
+func applyCustomerPrice(rule *Rule, lines []*line) []model.PriceAdjustment {
+	var (
+		order   []uuid.UUID
+		amounts = make(map[uuid.UUID]float64)
+		counts  = make(map[uuid.UUID]int)
+	)
+
+	for _, l := range lines {
+		price, ok := rule.Prices[l.partID]
+		if !ok || !rule.appliesToLine(l) {
+			continue
+		}
+
+		if _, seen := amounts[l.partID]; !seen {
+			order = append(order, l.partID)
+		}
+		amounts[l.partID] += price - l.price
+		counts[l.partID]++
+		l.price = price
+	}
+
+	adjustments := make([]model.PriceAdjustment, 0, len(order))
+	for _, partID := range order {
+		amount := roundMoney(amounts[partID])
+		if amount == 0 {
+			continue
+		}
+
+		adjustments = append(adjustments, model.PriceAdjustment{
+			RuleID:      rule.ID,
+			Description: describe(rule, fmt.Sprintf("customer price %.2f per unit, %d unit(s)", rule.Prices[partID], counts[partID])),
+			PartID:      partID,
+			Amount:      amount,
+		})
+	}
+
+	return adjustments
+}
+
+// applyVolume применяет скидку наибольшего достигнутого количественного порога к подходящим позициям
+func applyVolume(rule *Rule, lines []*line) (model.PriceAdjustment, bool) {
+	var matched []*line
+	for _, l := range lines {
+		if rule.appliesToLine(l) {
+			matched = append(matched, l)
+		}
+	}
+
+	tier, ok := rule.tierFor(len(matched))
+	if !ok {
+		return model.PriceAdjustment{}, false
+	}
+
+	discount := 0.0
+	for _, l := range matched {
+		d := l.price * tier.DiscountPercent / 100
+		discount += d
+		l.price -= d
+	}
+
+	amount := roundMoney(-discount)
+	if amount == 0 {
+		return model.PriceAdjustment{}, false
+	}
+
+	return model.PriceAdjustment{
+		RuleID: rule.ID,
+		Description: describe(rule, fmt.Sprintf(
+			"%g%% volume discount for %d unit(s), threshold %d",
+			tier.DiscountPercent, len(matched), tier.MinQuantity,
+		)),
+		Amount: amount,
+	}, true
+}
+
+func describe(rule *Rule, details string) string {
+	if rule.Description == "" {
+		return details
+	}
+
+	return fmt.Sprintf("%s: %s", rule.Description, details)
+}
+
+func roundMoney(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,154 @@
+package rules
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/bogdanovds/rocket_factory/order/internal/model"
+)
+
+var (
+	portholeID = uuid.MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430d1")
+	engineID   = uuid.MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c9")
+	vipUserID  = uuid.MustParse("11111111-1111-1111-1111-111111111111")
+)
+
+const testRules = `
+rules:
+  - id: porthole-volume
+    description: Porthole volume discount
+    type: volume
+    priority: 20
+    categories: [PORTHOLE]
+    tiers:
+      - min_quantity: 10
+        discount_percent: 8
+      - min_quantity: 20
+        discount_percent: 12
+  - id: vip-price-list
+    description: VIP price list
+    type: customer_price
+    priority: 10
+    user_uuids: [11111111-1111-1111-1111-111111111111]
+    prices:
+      6ba7b810-9dad-11d1-80b4-00c04fd430d1: 90
+`
+
+// EngineTestSuite - тестовый набор для движка ценообразования
+type EngineTestSuite struct {
+	suite.Suite
+	engine *Engine
+}
+
+// SetupTest выполняется перед каждым тестом
+func (s *EngineTestSuite) SetupTest() {
+	engine, err := Parse([]byte(testRules))
+	s.Require().NoError(err)
+	s.engine = engine
+}
+
+func portholes(n int) []*model.Part {
+	parts := make([]*model.Part, n)
+	for i := range parts {
+		parts[i] = &model.Part{ID: portholeID, Name: "Porthole", Price: 100, Category: "PORTHOLE"}
+	}
+	return parts
+}
+
+func (s *EngineTestSuite) TestCalculate_NoRulesMatched() {
+	parts := append(portholes(2), &model.Part{ID: engineID, Price: 2500000.99, Category: "ENGINE"})
+
+	pricing, err := s.engine.Calculate(context.Background(), uuid.New(), parts)
+
+	s.NoError(err)
+	s.Equal(2500200.99, pricing.Subtotal)
+	s.Empty(pricing.Adjustments)
+	s.Equal(2500200.99, pricing.Total)
+}
+
+func (s *EngineTestSuite) TestCalculate_VolumeTier() {
+	pricing, err := s.engine.Calculate(context.Background(), uuid.New(), portholes(10))
+
+	s.NoError(err)
+	s.Equal(1000.0, pricing.Subtotal)
+	s.Require().Len(pricing.Adjustments, 1)
+	s.Equal("porthole-volume", pricing.Adjustments[0].RuleID)
+	s.Equal(uuid.Nil, pricing.Adjustments[0].PartID)
+	s.Equal(-80.0, pricing.Adjustments[0].Amount)
+	s.Contains(pricing.Adjustments[0].Description, "8% volume discount for 10 unit(s)")
+	s.Equal(920.0, pricing.Total)
+}
+
+func (s *EngineTestSuite) TestCalculate_HighestReachedTier() {
+	pricing, err := s.engine.Calculate(context.Background(), uuid.New(), portholes(20))
+
+	s.NoError(err)
+	s.Require().Len(pricing.Adjustments, 1)
+	s.Equal(-240.0, pricing.Adjustments[0].Amount)
+	s.Equal(1760.0, pricing.Total)
+}
+
+func (s *EngineTestSuite) TestCalculate_CustomerPriceAppliedBeforeVolume() {
+	pricing, err := s.engine.Calculate(context.Background(), vipUserID, portholes(10))
+
+	s.NoError(err)
+	s.Require().Len(pricing.Adjustments, 2)
+
+	s.Equal("vip-price-list", pricing.Adjustments[0].RuleID)
+	s.Equal(portholeID, pricing.Adjustments[0].PartID)
+	s.Equal(-100.0, pricing.Adjustments[0].Amount)
+
+	// Скидка за объём считается от цены прайс-листа: 10 * 90 * 8%
+	s.Equal("porthole-volume", pricing.Adjustments[1].RuleID)
+	s.Equal(-72.0, pricing.Adjustments[1].Amount)
+
+	s.Equal(828.0, pricing.Total)
+}
+
+func (s *EngineTestSuite) TestCalculate_DeterministicOrder() {
+	reordered, err := New([]Rule{s.engine.rules[1], s.engine.rules[0]})
+	s.Require().NoError(err)
+
+	expected, err := s.engine.Calculate(context.Background(), vipUserID, portholes(12))
+	s.Require().NoError(err)
+
+	actual, err := reordered.Calculate(context.Background(), vipUserID, portholes(12))
+	s.Require().NoError(err)
+
+	s.Equal(expected, actual)
+}
+
+func (s *EngineTestSuite) TestLoad_EmptyPath() {
+	engine, err := Load("")
+	s.Require().NoError(err)
+
+	pricing, err := engine.Calculate(context.Background(), uuid.New(), portholes(3))
+
+	s.NoError(err)
+	s.Empty(pricing.Adjustments)
+	s.Equal(300.0, pricing.Total)
+}
+
+func (s *EngineTestSuite) TestParse_InvalidRules() {
+	cases := map[string]string{
+		"unknown type":   "rules:\n  - id: a\n    type: magic\n",
+		"missing id":     "rules:\n  - type: volume\n    tiers: [{min_quantity: 1, discount_percent: 5}]\n",
+		"bad percent":    "rules:\n  - id: a\n    type: volume\n    tiers: [{min_quantity: 1, discount_percent: 150}]\n",
+		"duplicate id":   "rules:\n  - id: a\n    type: volume\n    tiers: [{min_quantity: 1, discount_percent: 5}]\n  - id: a\n    type: volume\n    tiers: [{min_quantity: 2, discount_percent: 5}]\n",
+		"unknown field":  "rules:\n  - id: a\n    type: volume\n    percent: 5\n",
+		"no price users": "rules:\n  - id: a\n    type: customer_price\n    prices: {6ba7b810-9dad-11d1-80b4-00c04fd430d1: 1}\n",
+	}
+
+	for name, data := range cases {
+		_, err := Parse([]byte(data))
+		s.Error(err, name)
+	}
+}
+
+// TestEngineTestSuite запускает тестовый набор
+func TestEngineTestSuite(t *testing.T) {
+	suite.Run(t, new(EngineTestSuite))
+}
@@ -0,0 +1,49 @@
+package rules
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// file - структура YAML-файла с правилами ценообразования
+type file struct {
+	Rules []Rule `yaml:"rules"`
+}
+
+// Load читает правила из YAML-файла и создаёт движок.
+// Если путь не задан, возвращается движок без правил: стоимость заказа равна сумме цен деталей.
+func Load(path string) (*Engine, error) {
+	if path == "" {
+		return New(nil)
+	}
+
+	data, err := os.ReadFile(path) // #nosec G304 -- путь задаётся конфигурацией сервиса
+	if err != nil {
+		return nil, fmt.Errorf("failed to read pricing rules: %w", err)
+	}
+
+	return Parse(data)
+}
+
+// Parse разбирает правила ценообразования из YAML и создаёт движок
+func Parse(data []byte) (*Engine, error) {
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+
+	var f file
+	if err := dec.Decode(&f); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("failed to parse pricing rules: %w", err)
+	}
+
+	engine, err := New(f.Rules)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pricing rules: %w", err)
+	}
+
+	return engine, nil
+}
@@ -0,0 +1,123 @@
+package rules
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// RuleType - тип правила ценообразования
+type RuleType string
+
+const (
+	// RuleTypeCustomerPrice - индивидуальный прайс-лист покупателя
+	RuleTypeCustomerPrice RuleType = "customer_price"
+	// RuleTypeVolume - скидка за объём (количественные пороги)
+	RuleTypeVolume RuleType = "volume"
+)
+
+// Rule - правило ценообразования из файла конфигурации
+type Rule struct {
+	ID          string   `yaml:"id"`
+	Description string   `yaml:"description"`
+	Type        RuleType `yaml:"type"`
+	// Priority - порядок применения: правила применяются по возрастанию приоритета, при равенстве - по ID
+	Priority int `yaml:"priority"`
+
+	// UserUUIDs - покупатели, для которых действует правило. Пустой список - для всех.
+	UserUUIDs []uuid.UUID `yaml:"user_uuids"`
+	// PartUUIDs - детали, на которые действует правило. Пустой список - без ограничения по деталям.
+	PartUUIDs []uuid.UUID `yaml:"part_uuids"`
+	// Categories - категории деталей (ENGINE, FUEL, PORTHOLE, WING). Пустой список - без ограничения по категории.
+	Categories []string `yaml:"categories"`
+
+	// Tiers - количественные пороги для правил типа volume
+	Tiers []Tier `yaml:"tiers"`
+	// Prices - цены за единицу по UUID детали для правил типа customer_price
+	Prices map[uuid.UUID]float64 `yaml:"prices"`
+}
+
+// Tier - количественный порог скидки
+type Tier struct {
+	MinQuantity     int     `yaml:"min_quantity"`
+	DiscountPercent float64 `yaml:"discount_percent"`
+}
+
+func (r *Rule) validate() error {
+	if r.ID == "" {
+		return fmt.Errorf("rule id is required")
+	}
+
+	switch r.Type {
+	case RuleTypeVolume:
+		if len(r.Tiers) == 0 {
+			return fmt.Errorf("rule %q: at least one tier is required", r.ID)
+		}
+		for _, t := range r.Tiers {
+			if t.MinQuantity <= 0 {
+				return fmt.Errorf("rule %q: tier min_quantity must be positive", r.ID)
+			}
+			if t.DiscountPercent <= 0 || t.DiscountPercent > 100 {
+				return fmt.Errorf("rule %q: tier discount_percent must be in (0, 100]", r.ID)
+			}
+		}
+	case RuleTypeCustomerPrice:
+		if len(r.UserUUIDs) == 0 {
+			return fmt.Errorf("rule %q: user_uuids are required for customer price list", r.ID)
+		}
+		if len(r.Prices) == 0 {
+			return fmt.Errorf("rule %q: prices are required", r.ID)
+		}
+		for partID, price := range r.Prices {
+			if price < 0 {
+				return fmt.Errorf("rule %q: negative price for part %s", r.ID, partID)
+			}
+		}
+	default:
+		return fmt.Errorf("rule %q: unknown type %q", r.ID, r.Type)
+	}
+
+	return nil
+}
+
+func (r *Rule) normalize() {
+	r.Categories = slices.Clone(r.Categories)
+	r.Tiers = slices.Clone(r.Tiers)
+
+	for i, c := range r.Categories {
+		r.Categories[i] = strings.TrimPrefix(strings.ToUpper(c), "CATEGORY_")
+	}
+
+	// Пороги упорядочиваем по убыванию, чтобы первым находился самый выгодный из достигнутых
+	slices.SortFunc(r.Tiers, func(a, b Tier) int {
+		return b.MinQuantity - a.MinQuantity
+	})
+}
+
+func (r *Rule) appliesToUser(userID uuid.UUID) bool {
+	return len(r.UserUUIDs) == 0 || slices.Contains(r.UserUUIDs, userID)
+}
+
+func (r *Rule) appliesToLine(l *line) bool {
+	if len(r.PartUUIDs) > 0 && !slices.Contains(r.PartUUIDs, l.partID) {
+		return false
+	}
+
+	if len(r.Categories) > 0 && !slices.Contains(r.Categories, l.category) {
+		return false
+	}
+
+	return true
+}
+
+func (r *Rule) tierFor(quantity int) (Tier, bool) {
+	for _, t := range r.Tiers {
+		if quantity >= t.MinQuantity {
+			return t, true
+		}
+	}
+
+	return Tier{}, false
+}
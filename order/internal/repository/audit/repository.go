@@ -0,0 +1,52 @@
+package audit
+
+import (
+	"context"
+	"maps"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bogdanovds/rocket_factory/order/internal/model"
+)
+
+// Repository - журнал аудита в памяти
+type Repository struct {
+	mu      sync.RWMutex
+	entries []model.AuditEntry
+}
+
+// NewRepository создаёт журнал аудита в памяти
+func NewRepository() *Repository {
+	return &Repository{}
+}
+
+// Create добавляет запись в журнал аудита
+func (r *Repository) Create(_ context.Context, entry *model.AuditEntry) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entry.CreatedAt = time.Now()
+
+	stored := *entry
+	stored.Details = maps.Clone(entry.Details)
+	r.entries = append(r.entries, stored)
+
+	return nil
+}
+
+// UpdateDetails заменяет параметры записи журнала аудита
+func (r *Repository) UpdateDetails(_ context.Context, id uuid.UUID, details map[string]string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i := range r.entries {
+		if r.entries[i].ID == id {
+			r.entries[i].Details = maps.Clone(details)
+			return nil
+		}
+	}
+
+	return model.ErrAuditEntryNotFound
+}
@@ -0,0 +1,376 @@
+// Package contracttest содержит общий набор тестов, проверяющий, что все реализации
+// repository.Repository ведут себя одинаково
+package contracttest
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/bogdanovds/rocket_factory/order/internal/model"
+	"github.com/bogdanovds/rocket_factory/order/internal/repository"
+)
+
+// RepositorySuite - контрактный тестовый набор для репозитория заказов.
+// NewRepository вызывается перед каждым тестом и должен возвращать пустое хранилище.
+type RepositorySuite struct {
+	suite.Suite
+	NewRepository func() repository.Repository
+
+	ctx  context.Context
+	repo repository.Repository
+}
+
+// SetupTest выполняется перед каждым тестом
+func (s *RepositorySuite) SetupTest() {
+	s.ctx = context.Background()
+	s.repo = s.NewRepository()
+}
+
+func newOrder() *model.Order {
+	return &model.Order{
+		ID:            uuid.New(),
+		UserID:        uuid.New(),
+		PartIDs:       []uuid.UUID{uuid.New(), uuid.New()},
+		SubtotalPrice: 150.50,
+		PriceAdjustments: []model.PriceAdjustment{
+			{RuleID: "volume", Description: "volume discount", Amount: -10.50},
+		},
+		TotalPrice:           140.00,
+		Status:               model.OrderStatusPending,
+		PreferredWarehouseID: uuid.New(),
+	}
+}
+
+func (s *RepositorySuite) TestCreateAndGet() {
+	order := newOrder()
+
+	err := s.repo.Create(s.ctx, order)
+	s.Require().NoError(err)
+	s.NotEmpty(order.Number)
+
+	saved, err := s.repo.Get(s.ctx, order.ID)
+	s.Require().NoError(err)
+	s.Equal(order, saved)
+}
+
+func (s *RepositorySuite) TestCreate_WithoutAdjustments() {
+	order := newOrder()
+	order.PriceAdjustments = nil
+
+	err := s.repo.Create(s.ctx, order)
+	s.Require().NoError(err)
+
+	saved, err := s.repo.Get(s.ctx, order.ID)
+	s.Require().NoError(err)
+	s.NotNil(saved.PriceAdjustments)
+	s.Empty(saved.PriceAdjustments)
+}
+
+func (s *RepositorySuite) TestCreate_DuplicateID() {
+	order := newOrder()
+
+	err := s.repo.Create(s.ctx, order)
+	s.Require().NoError(err)
+
+	duplicate := newOrder()
+	duplicate.ID = order.ID
+
+	err = s.repo.Create(s.ctx, duplicate)
+	s.ErrorIs(err, model.ErrOrderAlreadyExists)
+
+	// Неудачная вставка не расходует номер заказа
+	next := newOrder()
+	err = s.repo.Create(s.ctx, next)
+	s.Require().NoError(err)
+	s.Equal(sequenceOf(s, order.Number)+1, sequenceOf(s, next.Number))
+}
+
+func (s *RepositorySuite) TestCreate_StoresCopy() {
+	order := newOrder()
+	partID := order.PartIDs[0]
+
+	err := s.repo.Create(s.ctx, order)
+	s.Require().NoError(err)
+
+	order.Status = model.OrderStatusPaid
+	order.PartIDs[0] = uuid.New()
+
+	saved, err := s.repo.Get(s.ctx, order.ID)
+	s.Require().NoError(err)
+	s.Equal(model.OrderStatusPending, saved.Status)
+	s.Equal(partID, saved.PartIDs[0])
+}
+
+func (s *RepositorySuite) TestGet_NotFound() {
+	order, err := s.repo.Get(s.ctx, uuid.New())
+
+	s.ErrorIs(err, model.ErrOrderNotFound)
+	s.Nil(order)
+}
+
+func (s *RepositorySuite) TestGet_ReturnsCopy() {
+	order := newOrder()
+	err := s.repo.Create(s.ctx, order)
+	s.Require().NoError(err)
+
+	first, err := s.repo.Get(s.ctx, order.ID)
+	s.Require().NoError(err)
+	first.Status = model.OrderStatusCancelled
+	first.PartIDs[0] = uuid.New()
+
+	second, err := s.repo.Get(s.ctx, order.ID)
+	s.Require().NoError(err)
+	s.Equal(order, second)
+}
+
+func (s *RepositorySuite) TestGetByNumber() {
+	order := newOrder()
+	err := s.repo.Create(s.ctx, order)
+	s.Require().NoError(err)
+
+	saved, err := s.repo.GetByNumber(s.ctx, order.Number)
+	s.Require().NoError(err)
+	s.Equal(order, saved)
+
+	_, err = s.repo.GetByNumber(s.ctx, "RF-1999-000001")
+	s.ErrorIs(err, model.ErrOrderNotFound)
+}
+
+func (s *RepositorySuite) TestCreate_AssignsSequentialNumbers() {
+	year := time.Now().UTC().Year()
+
+	var first int64
+	for i := 0; i < 3; i++ {
+		order := newOrder()
+
+		err := s.repo.Create(s.ctx, order)
+		s.Require().NoError(err)
+		s.True(model.IsValidOrderNumber(order.Number), order.Number)
+		s.True(strings.HasPrefix(order.Number, fmt.Sprintf("RF-%d-", year)), order.Number)
+
+		if i == 0 {
+			first = sequenceOf(s, order.Number)
+		}
+		s.Equal(model.FormatOrderNumber(year, first+int64(i)), order.Number)
+	}
+}
+
+func (s *RepositorySuite) TestCreate_ConcurrentNumbersAreUnique() {
+	const count = 20
+
+	orders := make([]*model.Order, count)
+	errs := make(chan error, count)
+	var wg sync.WaitGroup
+	for i := range orders {
+		orders[i] = newOrder()
+
+		wg.Add(1)
+		go func(order *model.Order) {
+			defer wg.Done()
+			errs <- s.repo.Create(s.ctx, order)
+		}(orders[i])
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		s.Require().NoError(err)
+	}
+
+	seen := make(map[string]struct{}, count)
+	for _, order := range orders {
+		_, duplicate := seen[order.Number]
+		s.False(duplicate, order.Number)
+		seen[order.Number] = struct{}{}
+	}
+}
+
+func (s *RepositorySuite) TestUpdate() {
+	order := newOrder()
+	err := s.repo.Create(s.ctx, order)
+	s.Require().NoError(err)
+
+	order.Status = model.OrderStatusRejected
+	order.ReviewedBy = uuid.New()
+	order.RejectionReason = "Budget exhausted"
+	order.PaymentMethod = "CARD"
+	order.TransactionID = uuid.New()
+
+	err = s.repo.Update(s.ctx, order, model.OrderStatusPending)
+	s.Require().NoError(err)
+
+	saved, err := s.repo.Get(s.ctx, order.ID)
+	s.Require().NoError(err)
+	s.Equal(order, saved)
+}
+
+func (s *RepositorySuite) TestUpdate_KeepsNumber() {
+	order := newOrder()
+	err := s.repo.Create(s.ctx, order)
+	s.Require().NoError(err)
+	number := order.Number
+
+	order.Number = "RF-1999-000001"
+	err = s.repo.Update(s.ctx, order, model.OrderStatusPending)
+	s.Require().NoError(err)
+
+	saved, err := s.repo.Get(s.ctx, order.ID)
+	s.Require().NoError(err)
+	s.Equal(number, saved.Number)
+}
+
+func (s *RepositorySuite) TestUpdate_NotFound() {
+	err := s.repo.Update(s.ctx, newOrder(), model.OrderStatusPending)
+
+	s.ErrorIs(err, model.ErrOrderNotFound)
+}
+
+func (s *RepositorySuite) TestUpdate_StatusChanged() {
+	order := newOrder()
+	order.Status = model.OrderStatusPaid
+	s.Require().NoError(s.repo.Create(s.ctx, order))
+
+	fulfilled := *order
+	fulfilled.Status = model.OrderStatusFulfilled
+	s.Require().NoError(s.repo.Update(s.ctx, &fulfilled, model.OrderStatusPaid))
+
+	// Второй переход из PAID не проходит: заказ уже отгружен
+	s.ErrorIs(s.repo.Update(s.ctx, &fulfilled, model.OrderStatusPaid), model.ErrOrderStatusChanged)
+
+	saved, err := s.repo.Get(s.ctx, order.ID)
+	s.Require().NoError(err)
+	s.Equal(model.OrderStatusFulfilled, saved.Status)
+}
+
+func (s *RepositorySuite) TestListByUser() {
+	userID := uuid.New()
+
+	var expected []*model.Order
+	for i := 0; i < 3; i++ {
+		order := newOrder()
+		order.UserID = userID
+		s.Require().NoError(s.repo.Create(s.ctx, order))
+		expected = append(expected, order)
+
+		// Заказ другого пользователя не должен попасть в выборку
+		s.Require().NoError(s.repo.Create(s.ctx, newOrder()))
+	}
+
+	var actual []*model.Order
+	for order, err := range s.repo.ListByUser(s.ctx, userID) {
+		s.Require().NoError(err)
+		actual = append(actual, order)
+	}
+
+	s.Equal(expected, actual)
+}
+
+func (s *RepositorySuite) TestListByUser_Break() {
+	userID := uuid.New()
+	for i := 0; i < 3; i++ {
+		order := newOrder()
+		order.UserID = userID
+		s.Require().NoError(s.repo.Create(s.ctx, order))
+	}
+
+	count := 0
+	for _, err := range s.repo.ListByUser(s.ctx, userID) {
+		s.Require().NoError(err)
+		count++
+		break
+	}
+
+	s.Equal(1, count)
+}
+
+func (s *RepositorySuite) TestAnonymizeUser() {
+	userID := uuid.New()
+
+	order := newOrder()
+	order.UserID = userID
+	order.Status = model.OrderStatusPaid
+	order.PaymentMethod = "CARD"
+	order.TransactionID = uuid.New()
+	s.Require().NoError(s.repo.Create(s.ctx, order))
+
+	other := newOrder()
+	s.Require().NoError(s.repo.Create(s.ctx, other))
+
+	count, err := s.repo.AnonymizeUser(s.ctx, userID)
+	s.Require().NoError(err)
+	s.Equal(1, count)
+
+	// Финансовые данные сохраняются, меняется только пользователь
+	saved, err := s.repo.Get(s.ctx, order.ID)
+	s.Require().NoError(err)
+	order.UserID = model.AnonymizedUserID
+	s.Equal(order, saved)
+
+	savedOther, err := s.repo.Get(s.ctx, other.ID)
+	s.Require().NoError(err)
+	s.Equal(other.UserID, savedOther.UserID)
+
+	for range s.repo.ListByUser(s.ctx, userID) {
+		s.Fail("anonymized orders must not be listed for the user")
+	}
+
+	count, err = s.repo.AnonymizeUser(s.ctx, userID)
+	s.Require().NoError(err)
+	s.Equal(0, count)
+}
+
+func (s *RepositorySuite) TestAnonymizeUser_ActiveOrders() {
+	userID := uuid.New()
+
+	paid := newOrder()
+	paid.UserID = userID
+	paid.Status = model.OrderStatusPaid
+	s.Require().NoError(s.repo.Create(s.ctx, paid))
+
+	pending := newOrder()
+	pending.UserID = userID
+	s.Require().NoError(s.repo.Create(s.ctx, pending))
+
+	count, err := s.repo.AnonymizeUser(s.ctx, userID)
+	s.ErrorIs(err, model.ErrUserHasActiveOrders)
+	s.Zero(count)
+
+	// Ни один заказ не обезличен
+	saved, err := s.repo.Get(s.ctx, paid.ID)
+	s.Require().NoError(err)
+	s.Equal(userID, saved.UserID)
+}
+
+func (s *RepositorySuite) TestUpdate_KeepsAnonymizedUser() {
+	order := newOrder()
+	order.Status = model.OrderStatusPaid
+	s.Require().NoError(s.repo.Create(s.ctx, order))
+
+	// Заказ прочитан до обезличивания и сохраняется после него
+	stale, err := s.repo.Get(s.ctx, order.ID)
+	s.Require().NoError(err)
+	_, err = s.repo.AnonymizeUser(s.ctx, order.UserID)
+	s.Require().NoError(err)
+
+	stale.Status = model.OrderStatusFulfilled
+	s.Require().NoError(s.repo.Update(s.ctx, stale, model.OrderStatusPaid))
+
+	saved, err := s.repo.Get(s.ctx, order.ID)
+	s.Require().NoError(err)
+	s.Equal(model.AnonymizedUserID, saved.UserID)
+	s.Equal(model.OrderStatusFulfilled, saved.Status)
+}
+
+// sequenceOf возвращает порядковый номер заказа внутри года
+func sequenceOf(s *RepositorySuite, number string) int64 {
+	seq, err := strconv.ParseInt(number[strings.LastIndex(number, "-")+1:], 10, 64)
+	s.Require().NoError(err)
+	return seq
+}
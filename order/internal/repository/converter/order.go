@@ -12,13 +12,20 @@ func ToServiceModel(order *repoModel.Order) *model.Order {
 	}
 
 	return &model.Order{
-		ID:            order.ID,
-		UserID:        order.UserID,
-		PartIDs:       order.PartIDs,
-		TotalPrice:    order.TotalPrice,
-		Status:        model.OrderStatus(order.Status),
-		PaymentMethod: order.PaymentMethod,
-		TransactionID: order.TransactionID,
+		ID:               order.ID,
+		Number:           order.Number,
+		UserID:           order.UserID,
+		PartIDs:          order.PartIDs,
+		SubtotalPrice:    order.SubtotalPrice,
+		PriceAdjustments: ToServicePriceAdjustments(order.PriceAdjustments),
+		TotalPrice:       order.TotalPrice,
+		Status:           model.OrderStatus(order.Status),
+		PaymentMethod:    order.PaymentMethod,
+		TransactionID:    order.TransactionID,
+		ReviewedBy:       order.ReviewedBy,
+		RejectionReason:  order.RejectionReason,
+
+		PreferredWarehouseID: order.PreferredWarehouseID,
 	}
 }
 
@@ -29,12 +36,47 @@ func ToRepoModel(order *model.Order) *repoModel.Order {
 	}
 
 	return &repoModel.Order{
-		ID:            order.ID,
-		UserID:        order.UserID,
-		PartIDs:       order.PartIDs,
-		TotalPrice:    order.TotalPrice,
-		Status:        repoModel.OrderStatus(order.Status),
-		PaymentMethod: order.PaymentMethod,
-		TransactionID: order.TransactionID,
+		ID:               order.ID,
+		Number:           order.Number,
+		UserID:           order.UserID,
+		PartIDs:          order.PartIDs,
+		SubtotalPrice:    order.SubtotalPrice,
+		PriceAdjustments: ToRepoPriceAdjustments(order.PriceAdjustments),
+		TotalPrice:       order.TotalPrice,
+		Status:           repoModel.OrderStatus(order.Status),
+		PaymentMethod:    order.PaymentMethod,
+		TransactionID:    order.TransactionID,
+		ReviewedBy:       order.ReviewedBy,
+		RejectionReason:  order.RejectionReason,
+
+		PreferredWarehouseID: order.PreferredWarehouseID,
+	}
+}
+
+// ToServicePriceAdjustments конвертирует корректировки цены из модели repository в модель сервисного слоя
+func ToServicePriceAdjustments(adjustments []repoModel.PriceAdjustment) []model.PriceAdjustment {
+	result := make([]model.PriceAdjustment, 0, len(adjustments))
+	for _, a := range adjustments {
+		result = append(result, model.PriceAdjustment{
+			RuleID:      a.RuleID,
+			Description: a.Description,
+			PartID:      a.PartID,
+			Amount:      a.Amount,
+		})
+	}
+	return result
+}
+
+// ToRepoPriceAdjustments конвертирует корректировки цены из модели сервисного слоя в модель repository
+func ToRepoPriceAdjustments(adjustments []model.PriceAdjustment) []repoModel.PriceAdjustment {
+	result := make([]repoModel.PriceAdjustment, 0, len(adjustments))
+	for _, a := range adjustments {
+		result = append(result, repoModel.PriceAdjustment{
+			RuleID:      a.RuleID,
+			Description: a.Description,
+			PartID:      a.PartID,
+			Amount:      a.Amount,
+		})
 	}
+	return result
 }
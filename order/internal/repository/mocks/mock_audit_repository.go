@@ -0,0 +1,32 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/bogdanovds/rocket_factory/order/internal/model"
+)
+
+// MockAuditRepository - мок журнала аудита
+type MockAuditRepository struct {
+	mock.Mock
+}
+
+// NewMockAuditRepository создает новый мок журнала аудита
+func NewMockAuditRepository() *MockAuditRepository {
+	return &MockAuditRepository{}
+}
+
+// Create добавляет запись в журнал аудита
+func (m *MockAuditRepository) Create(ctx context.Context, entry *model.AuditEntry) error {
+	args := m.Called(ctx, entry)
+	return args.Error(0)
+}
+
+// UpdateDetails заменяет параметры записи журнала аудита
+func (m *MockAuditRepository) UpdateDetails(ctx context.Context, id uuid.UUID, details map[string]string) error {
+	args := m.Called(ctx, id, details)
+	return args.Error(0)
+}
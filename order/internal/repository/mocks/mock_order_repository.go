@@ -2,6 +2,7 @@ package mocks
 
 import (
 	"context"
+	"iter"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/mock"
@@ -34,8 +35,29 @@ func (m *MockOrderRepository) Get(ctx context.Context, id uuid.UUID) (*model.Ord
 	return args.Get(0).(*model.Order), args.Error(1)
 }
 
+// GetByNumber возвращает заказ по человекочитаемому номеру
+func (m *MockOrderRepository) GetByNumber(ctx context.Context, number string) (*model.Order, error) {
+	args := m.Called(ctx, number)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Order), args.Error(1)
+}
+
 // Update обновляет заказ
-func (m *MockOrderRepository) Update(ctx context.Context, order *model.Order) error {
-	args := m.Called(ctx, order)
+func (m *MockOrderRepository) Update(ctx context.Context, order *model.Order, expected model.OrderStatus) error {
+	args := m.Called(ctx, order, expected)
 	return args.Error(0)
 }
+
+// ListByUser возвращает заказы пользователя
+func (m *MockOrderRepository) ListByUser(ctx context.Context, userID uuid.UUID) iter.Seq2[*model.Order, error] {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(iter.Seq2[*model.Order, error])
+}
+
+// AnonymizeUser обезличивает заказы пользователя
+func (m *MockOrderRepository) AnonymizeUser(ctx context.Context, userID uuid.UUID) (int, error) {
+	args := m.Called(ctx, userID)
+	return args.Int(0), args.Error(1)
+}
@@ -0,0 +1,33 @@
+package order
+
+import (
+	"context"
+	"slices"
+
+	"github.com/google/uuid"
+
+	"github.com/bogdanovds/rocket_factory/order/internal/model"
+)
+
+// AnonymizeUser обезличивает заказы пользователя, не затрагивая финансовые данные.
+// Незавершённые заказы проверяются под той же блокировкой, что и замена.
+func (r *Repository) AnonymizeUser(_ context.Context, userID uuid.UUID) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, order := range r.orders {
+		if order.UserID == userID && slices.Contains(model.ActiveOrderStatuses, model.OrderStatus(order.Status)) {
+			return 0, model.ErrUserHasActiveOrders
+		}
+	}
+
+	count := 0
+	for _, order := range r.orders {
+		if order.UserID == userID {
+			order.UserID = model.AnonymizedUserID
+			count++
+		}
+	}
+
+	return count, nil
+}
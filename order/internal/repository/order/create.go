@@ -2,14 +2,31 @@ package order
 
 import (
 	"context"
+	"time"
 
 	"github.com/bogdanovds/rocket_factory/order/internal/model"
+	"github.com/bogdanovds/rocket_factory/order/internal/repository/converter"
 )
 
-func (r *Repository) Create(ctx context.Context, order *model.Order) error {
+// Create сохраняет новый заказ и присваивает ему номер
+func (r *Repository) Create(_ context.Context, order *model.Order) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	r.orders[order.ID] = order
+	if _, exists := r.orders[order.ID]; exists {
+		return model.ErrOrderAlreadyExists
+	}
+
+	year := time.Now().UTC().Year()
+	r.sequences[year]++
+	order.Number = model.FormatOrderNumber(year, r.sequences[year])
+
+	stored := converter.ToRepoModel(order)
+	stored.PartIDs = clonePartIDs(order.PartIDs)
+
+	r.orders[order.ID] = stored
+	r.byNumber[order.Number] = order.ID
+	r.created = append(r.created, order.ID)
+
 	return nil
 }
@@ -6,14 +6,38 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/bogdanovds/rocket_factory/order/internal/model"
+	"github.com/bogdanovds/rocket_factory/order/internal/repository/converter"
 )
 
-func (r *Repository) Get(ctx context.Context, id uuid.UUID) (*model.Order, error) {
+// Get возвращает копию заказа по ID
+func (r *Repository) Get(_ context.Context, id uuid.UUID) (*model.Order, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
-	order, exists := r.orders[id]
+
+	return r.get(id)
+}
+
+// GetByNumber возвращает копию заказа по человекочитаемому номеру
+func (r *Repository) GetByNumber(_ context.Context, number string) (*model.Order, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	id, exists := r.byNumber[number]
+	if !exists {
+		return nil, model.ErrOrderNotFound
+	}
+
+	return r.get(id)
+}
+
+func (r *Repository) get(id uuid.UUID) (*model.Order, error) {
+	stored, exists := r.orders[id]
 	if !exists {
 		return nil, model.ErrOrderNotFound
 	}
+
+	order := converter.ToServiceModel(stored)
+	order.PartIDs = clonePartIDs(stored.PartIDs)
+
 	return order, nil
 }
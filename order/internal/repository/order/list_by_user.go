@@ -0,0 +1,32 @@
+package order
+
+import (
+	"context"
+	"iter"
+
+	"github.com/google/uuid"
+
+	"github.com/bogdanovds/rocket_factory/order/internal/model"
+)
+
+// ListByUser возвращает копии заказов пользователя в порядке создания.
+// Выборка фиксируется при начале итерации, блокировка на время обработки заказов не удерживается.
+func (r *Repository) ListByUser(_ context.Context, userID uuid.UUID) iter.Seq2[*model.Order, error] {
+	return func(yield func(*model.Order, error) bool) {
+		r.mu.RLock()
+		var orders []*model.Order
+		for _, id := range r.created {
+			if r.orders[id].UserID == userID {
+				order, _ := r.get(id)
+				orders = append(orders, order)
+			}
+		}
+		r.mu.RUnlock()
+
+		for _, order := range orders {
+			if !yield(order, nil) {
+				return
+			}
+		}
+	}
+}
@@ -5,16 +5,34 @@ import (
 
 	"github.com/google/uuid"
 
-	"github.com/bogdanovds/rocket_factory/order/internal/model"
+	repoModel "github.com/bogdanovds/rocket_factory/order/internal/repository/model"
 )
 
+// Repository - потокобезопасное хранилище заказов в памяти.
+// Семантика совпадает с PostgreSQL-репозиторием: заказы хранятся копиями,
+// поэтому изменения возвращённого или переданного заказа не влияют на сохранённые данные.
 type Repository struct {
-	mu     sync.RWMutex
-	orders map[uuid.UUID]*model.Order
+	mu       sync.RWMutex
+	orders   map[uuid.UUID]*repoModel.Order
+	byNumber map[string]uuid.UUID
+	// created - ID заказов в порядке создания
+	created []uuid.UUID
+	// sequences - последний выданный порядковый номер заказа по годам
+	sequences map[int]int64
 }
 
-func NewRepo() *Repository {
+// NewRepository создаёт репозиторий заказов в памяти
+func NewRepository() *Repository {
 	return &Repository{
-		orders: make(map[uuid.UUID]*model.Order),
+		orders:    make(map[uuid.UUID]*repoModel.Order),
+		byNumber:  make(map[string]uuid.UUID),
+		sequences: make(map[int]int64),
 	}
 }
+
+// clonePartIDs копирует список деталей, чтобы хранимый заказ не разделял память с вызывающим кодом
+func clonePartIDs(partIDs []uuid.UUID) []uuid.UUID {
+	result := make([]uuid.UUID, len(partIDs))
+	copy(result, partIDs)
+	return result
+}
@@ -0,0 +1,19 @@
+package order
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/bogdanovds/rocket_factory/order/internal/repository"
+	"github.com/bogdanovds/rocket_factory/order/internal/repository/contracttest"
+)
+
+// TestRepositoryContract проверяет репозиторий в памяти общим контрактным набором
+func TestRepositoryContract(t *testing.T) {
+	suite.Run(t, &contracttest.RepositorySuite{
+		NewRepository: func() repository.Repository {
+			return NewRepository()
+		},
+	})
+}
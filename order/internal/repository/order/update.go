@@ -4,11 +4,29 @@ import (
 	"context"
 
 	"github.com/bogdanovds/rocket_factory/order/internal/model"
+	"github.com/bogdanovds/rocket_factory/order/internal/repository/converter"
+	repoModel "github.com/bogdanovds/rocket_factory/order/internal/repository/model"
 )
 
-func (r *Repository) Update(ctx context.Context, order *model.Order) error {
+// Update обновляет существующий заказ, если его статус равен expected. Номер и пользователь заказа не меняются.
+func (r *Repository) Update(_ context.Context, order *model.Order, expected model.OrderStatus) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
-	r.orders[order.ID] = order
+
+	existing, exists := r.orders[order.ID]
+	if !exists {
+		return model.ErrOrderNotFound
+	}
+	if existing.Status != repoModel.OrderStatus(expected) {
+		return model.ErrOrderStatusChanged
+	}
+
+	stored := converter.ToRepoModel(order)
+	stored.PartIDs = clonePartIDs(order.PartIDs)
+	stored.Number = existing.Number
+	stored.UserID = existing.UserID
+
+	r.orders[order.ID] = stored
+
 	return nil
 }
@@ -0,0 +1,47 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+
+	"github.com/bogdanovds/rocket_factory/order/internal/model"
+)
+
+// AnonymizeUser обезличивает заказы пользователя, не затрагивая финансовые данные.
+// Проверка незавершённых заказов и замена выполняются одним запросом, поэтому заказ,
+// ставший активным между ними, не будет обезличен.
+func (r *Repository) AnonymizeUser(ctx context.Context, userID uuid.UUID) (int, error) {
+	query := `
+		WITH active AS (
+			SELECT EXISTS (SELECT 1 FROM orders WHERE user_id = $1 AND status = ANY($3)) AS found
+		), anonymized AS (
+			UPDATE orders
+			SET user_id = $2, updated_at = CURRENT_TIMESTAMP
+			WHERE user_id = $1 AND NOT (SELECT found FROM active)
+			RETURNING 1
+		)
+		SELECT (SELECT found FROM active), (SELECT COUNT(*) FROM anonymized)
+	`
+
+	statuses := make([]string, len(model.ActiveOrderStatuses))
+	for i, status := range model.ActiveOrderStatuses {
+		statuses[i] = string(status)
+	}
+
+	var (
+		hasActive bool
+		count     int
+	)
+	err := r.db.QueryRowContext(ctx, query, userID, model.AnonymizedUserID, pq.Array(statuses)).Scan(&hasActive, &count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to anonymize user orders: %w", err)
+	}
+	if hasActive {
+		return 0, model.ErrUserHasActiveOrders
+	}
+
+	return count, nil
+}
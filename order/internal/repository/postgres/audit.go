@@ -0,0 +1,78 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/bogdanovds/rocket_factory/order/internal/model"
+)
+
+// AuditRepository реализует интерфейс repository.AuditRepository для PostgreSQL
+type AuditRepository struct {
+	db *sql.DB
+}
+
+// NewAuditRepository создаёт журнал аудита в PostgreSQL
+func NewAuditRepository(db *sql.DB) *AuditRepository {
+	return &AuditRepository{db: db}
+}
+
+// Create добавляет запись в журнал аудита. Время записи проставляет база данных.
+func (r *AuditRepository) Create(ctx context.Context, entry *model.AuditEntry) error {
+	query := `
+		INSERT INTO audit_log (id, action, actor_id, subject_user_id, details)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING created_at
+	`
+
+	details, err := json.Marshal(entry.Details)
+	if err != nil {
+		return fmt.Errorf("failed to marshal audit details: %w", err)
+	}
+
+	err = r.db.QueryRowContext(ctx, query,
+		entry.ID,
+		string(entry.Action),
+		entry.ActorID,
+		entry.SubjectUserID,
+		details,
+	).Scan(&entry.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create audit entry: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateDetails заменяет параметры записи журнала аудита
+func (r *AuditRepository) UpdateDetails(ctx context.Context, id uuid.UUID, details map[string]string) error {
+	query := `
+		UPDATE audit_log
+		SET details = $2
+		WHERE id = $1
+	`
+
+	data, err := json.Marshal(details)
+	if err != nil {
+		return fmt.Errorf("failed to marshal audit details: %w", err)
+	}
+
+	result, err := r.db.ExecContext(ctx, query, id, data)
+	if err != nil {
+		return fmt.Errorf("failed to update audit entry: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return model.ErrAuditEntryNotFound
+	}
+
+	return nil
+}
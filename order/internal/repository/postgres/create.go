@@ -2,6 +2,7 @@ package postgres
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/lib/pq"
@@ -11,9 +12,21 @@ import (
 
 // Create создаёт новый заказ в базе данных
 func (r *Repository) Create(ctx context.Context, order *model.Order) error {
+	// Номер заказа выдаётся в том же запросе, что и вставка: строка счётчика года блокируется
+	// до конца транзакции, поэтому номера уникальны и идут без пропусков даже при конкурентных вставках
 	query := `
-		INSERT INTO orders (id, user_id, part_ids, total_price, status, payment_method, transaction_id)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		WITH seq AS (
+			INSERT INTO order_number_sequences (year, last_value)
+			VALUES (EXTRACT(YEAR FROM CURRENT_TIMESTAMP AT TIME ZONE 'UTC')::INTEGER, 1)
+			ON CONFLICT (year) DO UPDATE SET last_value = order_number_sequences.last_value + 1
+			RETURNING year, last_value
+		)
+		INSERT INTO orders (id, number, user_id, part_ids, total_price, status, payment_method, transaction_id,
+		                    subtotal_price, price_adjustments, reviewed_by, rejection_reason, preferred_warehouse_id)
+		SELECT $1, 'RF-' || seq.year || '-' || LPAD(seq.last_value::TEXT, GREATEST(6, LENGTH(seq.last_value::TEXT)), '0'),
+		       $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
+		FROM seq
+		RETURNING number
 	`
 
 	// Конвертируем []uuid.UUID в []string для pq.Array
@@ -22,21 +35,30 @@ func (r *Repository) Create(ctx context.Context, order *model.Order) error {
 		partIDs[i] = id.String()
 	}
 
-	var transactionID interface{}
-	if order.TransactionID.String() != "00000000-0000-0000-0000-000000000000" {
-		transactionID = order.TransactionID
+	priceAdjustments, err := marshalPriceAdjustments(order.PriceAdjustments)
+	if err != nil {
+		return err
 	}
 
-	_, err := r.db.ExecContext(ctx, query,
+	err = r.db.QueryRowContext(ctx, query,
 		order.ID,
 		order.UserID,
 		pq.Array(partIDs),
 		order.TotalPrice,
 		string(order.Status),
 		order.PaymentMethod,
-		transactionID,
-	)
+		nullableUUID(order.TransactionID),
+		order.SubtotalPrice,
+		priceAdjustments,
+		nullableUUID(order.ReviewedBy),
+		nullableString(order.RejectionReason),
+		nullableUUID(order.PreferredWarehouseID),
+	).Scan(&order.Number)
 	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == uniqueViolationCode && pqErr.Constraint == "orders_pkey" {
+			return model.ErrOrderAlreadyExists
+		}
 		return fmt.Errorf("failed to create order: %w", err)
 	}
 
@@ -12,27 +12,52 @@ import (
 	"github.com/bogdanovds/rocket_factory/order/internal/model"
 )
 
+const selectOrderQuery = `
+	SELECT id, number, user_id, part_ids, total_price, status, payment_method, transaction_id,
+	       subtotal_price, price_adjustments, reviewed_by, rejection_reason,
+	       preferred_warehouse_id
+	FROM orders
+`
+
 // Get получает заказ по ID из базы данных
 func (r *Repository) Get(ctx context.Context, id uuid.UUID) (*model.Order, error) {
-	query := `
-		SELECT id, user_id, part_ids, total_price, status, payment_method, transaction_id
-		FROM orders
-		WHERE id = $1
-	`
+	return scanOrder(r.db.QueryRowContext(ctx, selectOrderQuery+"WHERE id = $1", id))
+}
+
+// GetByNumber получает заказ по человекочитаемому номеру из базы данных
+func (r *Repository) GetByNumber(ctx context.Context, number string) (*model.Order, error) {
+	return scanOrder(r.db.QueryRowContext(ctx, selectOrderQuery+"WHERE number = $1", number))
+}
+
+// rowScanner - общий интерфейс *sql.Row и *sql.Rows
+type rowScanner interface {
+	Scan(dest ...any) error
+}
 
+func scanOrder(row rowScanner) (*model.Order, error) {
 	var order model.Order
 	var partIDs pq.StringArray
 	var paymentMethod sql.NullString
 	var transactionID sql.NullString
+	var priceAdjustments []byte
+	var reviewedBy sql.NullString
+	var rejectionReason sql.NullString
+	var preferredWarehouseID sql.NullString
 
-	err := r.db.QueryRowContext(ctx, query, id).Scan(
+	err := row.Scan(
 		&order.ID,
+		&order.Number,
 		&order.UserID,
 		&partIDs,
 		&order.TotalPrice,
 		&order.Status,
 		&paymentMethod,
 		&transactionID,
+		&order.SubtotalPrice,
+		&priceAdjustments,
+		&reviewedBy,
+		&rejectionReason,
+		&preferredWarehouseID,
 	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -63,5 +88,30 @@ func (r *Repository) Get(ctx context.Context, id uuid.UUID) (*model.Order, error
 		order.TransactionID = parsedTransactionID
 	}
 
+	if reviewedBy.Valid {
+		parsedReviewedBy, parseErr := uuid.Parse(reviewedBy.String)
+		if parseErr != nil {
+			return nil, fmt.Errorf("failed to parse reviewer ID: %w", parseErr)
+		}
+		order.ReviewedBy = parsedReviewedBy
+	}
+
+	if rejectionReason.Valid {
+		order.RejectionReason = rejectionReason.String
+	}
+
+	if preferredWarehouseID.Valid {
+		parsedWarehouseID, parseErr := uuid.Parse(preferredWarehouseID.String)
+		if parseErr != nil {
+			return nil, fmt.Errorf("failed to parse preferred warehouse ID: %w", parseErr)
+		}
+		order.PreferredWarehouseID = parsedWarehouseID
+	}
+
+	order.PriceAdjustments, err = unmarshalPriceAdjustments(priceAdjustments)
+	if err != nil {
+		return nil, err
+	}
+
 	return &order, nil
 }
@@ -0,0 +1,39 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/google/uuid"
+
+	"github.com/bogdanovds/rocket_factory/order/internal/model"
+)
+
+// ListByUser построчно читает заказы пользователя в порядке создания
+func (r *Repository) ListByUser(ctx context.Context, userID uuid.UUID) iter.Seq2[*model.Order, error] {
+	return func(yield func(*model.Order, error) bool) {
+		rows, err := r.db.QueryContext(ctx, selectOrderQuery+"WHERE user_id = $1 ORDER BY created_at, id", userID)
+		if err != nil {
+			yield(nil, fmt.Errorf("failed to list user orders: %w", err))
+			return
+		}
+		defer func() { _ = rows.Close() }()
+
+		for rows.Next() {
+			order, err := scanOrder(rows)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+
+			if !yield(order, nil) {
+				return
+			}
+		}
+
+		if err := rows.Err(); err != nil {
+			yield(nil, fmt.Errorf("failed to list user orders: %w", err))
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package postgres
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/bogdanovds/rocket_factory/order/internal/model"
+	"github.com/bogdanovds/rocket_factory/order/internal/repository/converter"
+	repoModel "github.com/bogdanovds/rocket_factory/order/internal/repository/model"
+)
+
+// marshalPriceAdjustments сериализует корректировки цены для хранения в колонке JSONB
+func marshalPriceAdjustments(adjustments []model.PriceAdjustment) ([]byte, error) {
+	data, err := json.Marshal(converter.ToRepoPriceAdjustments(adjustments))
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal price adjustments: %w", err)
+	}
+
+	return data, nil
+}
+
+// unmarshalPriceAdjustments десериализует корректировки цены из колонки JSONB
+func unmarshalPriceAdjustments(data []byte) ([]model.PriceAdjustment, error) {
+	var adjustments []repoModel.PriceAdjustment
+	if err := json.Unmarshal(data, &adjustments); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal price adjustments: %w", err)
+	}
+
+	return converter.ToServicePriceAdjustments(adjustments), nil
+}
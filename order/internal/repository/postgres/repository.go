@@ -3,9 +3,13 @@ package postgres
 import (
 	"database/sql"
 
+	"github.com/google/uuid"
 	_ "github.com/lib/pq"
 )
 
+// uniqueViolationCode - код ошибки PostgreSQL при нарушении ограничения уникальности
+const uniqueViolationCode = "23505"
+
 // Repository реализует интерфейс repository.Repository для PostgreSQL
 type Repository struct {
 	db *sql.DB
@@ -15,3 +19,19 @@ type Repository struct {
 func NewRepository(db *sql.DB) *Repository {
 	return &Repository{db: db}
 }
+
+// nullableUUID возвращает nil для нулевого UUID, чтобы в колонку записывался NULL
+func nullableUUID(id uuid.UUID) interface{} {
+	if id == uuid.Nil {
+		return nil
+	}
+	return id
+}
+
+// nullableString возвращает nil для пустой строки, чтобы в колонку записывался NULL
+func nullableString(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+	return s
+}
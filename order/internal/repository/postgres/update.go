@@ -9,13 +9,15 @@ import (
 	"github.com/bogdanovds/rocket_factory/order/internal/model"
 )
 
-// Update обновляет заказ в базе данных
-func (r *Repository) Update(ctx context.Context, order *model.Order) error {
+// Update обновляет заказ в базе данных, если его статус равен expected. user_id не меняется.
+func (r *Repository) Update(ctx context.Context, order *model.Order, expected model.OrderStatus) error {
 	query := `
 		UPDATE orders
-		SET user_id = $2, part_ids = $3, total_price = $4, status = $5, 
-		    payment_method = $6, transaction_id = $7, updated_at = CURRENT_TIMESTAMP
-		WHERE id = $1
+		SET part_ids = $2, total_price = $3, status = $4,
+		    payment_method = $5, transaction_id = $6, subtotal_price = $7, price_adjustments = $8,
+		    reviewed_by = $9, rejection_reason = $10,
+		    preferred_warehouse_id = $11, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1 AND status = $12
 	`
 
 	// Конвертируем []uuid.UUID в []string для pq.Array
@@ -24,19 +26,24 @@ func (r *Repository) Update(ctx context.Context, order *model.Order) error {
 		partIDs[i] = id.String()
 	}
 
-	var transactionID interface{}
-	if order.TransactionID.String() != "00000000-0000-0000-0000-000000000000" {
-		transactionID = order.TransactionID
+	priceAdjustments, err := marshalPriceAdjustments(order.PriceAdjustments)
+	if err != nil {
+		return err
 	}
 
 	result, err := r.db.ExecContext(ctx, query,
 		order.ID,
-		order.UserID,
 		pq.Array(partIDs),
 		order.TotalPrice,
 		string(order.Status),
 		order.PaymentMethod,
-		transactionID,
+		nullableUUID(order.TransactionID),
+		order.SubtotalPrice,
+		priceAdjustments,
+		nullableUUID(order.ReviewedBy),
+		nullableString(order.RejectionReason),
+		nullableUUID(order.PreferredWarehouseID),
+		string(expected),
 	)
 	if err != nil {
 		return fmt.Errorf("failed to update order: %w", err)
@@ -47,9 +54,18 @@ func (r *Repository) Update(ctx context.Context, order *model.Order) error {
 		return fmt.Errorf("failed to get rows affected: %w", err)
 	}
 
-	if rowsAffected == 0 {
+	if rowsAffected > 0 {
+		return nil
+	}
+
+	// Заказ не найден по паре id + status: либо его нет, либо статус успели изменить
+	var exists bool
+	if err := r.db.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM orders WHERE id = $1)`, order.ID).Scan(&exists); err != nil {
+		return fmt.Errorf("failed to check order existence: %w", err)
+	}
+	if !exists {
 		return model.ErrOrderNotFound
 	}
 
-	return nil
+	return model.ErrOrderStatusChanged
 }
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"iter"
 
 	"github.com/google/uuid"
 
@@ -11,5 +12,27 @@ import (
 type Repository interface {
 	Create(ctx context.Context, order *model.Order) error
 	Get(ctx context.Context, id uuid.UUID) (*model.Order, error)
-	Update(ctx context.Context, order *model.Order) error
+	GetByNumber(ctx context.Context, number string) (*model.Order, error)
+	// Update сохраняет заказ, если его статус всё ещё равен expected (статусу на момент чтения).
+	// Пользователь заказа не меняется: его заменяет только AnonymizeUser, и обновление заказа,
+	// прочитанного до обезличивания, не должно вернуть прежний user_id.
+	// Возвращает model.ErrOrderNotFound, если заказа нет, и model.ErrOrderStatusChanged,
+	// если статус успели изменить: так один переход статуса не выполняется дважды.
+	Update(ctx context.Context, order *model.Order, expected model.OrderStatus) error
+	// ListByUser возвращает заказы пользователя в порядке создания, не загружая их в память целиком.
+	// После первой ошибки итерация прекращается.
+	ListByUser(ctx context.Context, userID uuid.UUID) iter.Seq2[*model.Order, error]
+	// AnonymizeUser заменяет user_id во всех заказах пользователя на model.AnonymizedUserID,
+	// сохраняя остальные данные заказов, и возвращает количество изменённых заказов. Проверка
+	// и замена атомарны: если у пользователя есть заказ в статусе из model.ActiveOrderStatuses,
+	// ничего не меняется и возвращается model.ErrUserHasActiveOrders.
+	AnonymizeUser(ctx context.Context, userID uuid.UUID) (int, error)
+}
+
+// AuditRepository - журнал аудита действий с данными пользователей
+type AuditRepository interface {
+	Create(ctx context.Context, entry *model.AuditEntry) error
+	// UpdateDetails заменяет параметры записи журнала, например чтобы завершить запись о начатом действии.
+	// Возвращает model.ErrAuditEntryNotFound, если записи нет.
+	UpdateDetails(ctx context.Context, id uuid.UUID, details map[string]string) error
 }
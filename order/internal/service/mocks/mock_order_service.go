@@ -2,6 +2,7 @@ package mocks
 
 import (
 	"context"
+	"iter"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/mock"
@@ -20,8 +21,8 @@ func NewMockOrderService() *MockOrderService {
 }
 
 // CreateOrder создает новый заказ
-func (m *MockOrderService) CreateOrder(ctx context.Context, userID uuid.UUID, partIDs []uuid.UUID) (*model.Order, error) {
-	args := m.Called(ctx, userID, partIDs)
+func (m *MockOrderService) CreateOrder(ctx context.Context, userID uuid.UUID, partIDs []uuid.UUID, preferredWarehouseID uuid.UUID) (*model.Order, error) {
+	args := m.Called(ctx, userID, partIDs, preferredWarehouseID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -37,6 +38,15 @@ func (m *MockOrderService) GetOrder(ctx context.Context, orderID uuid.UUID) (*mo
 	return args.Get(0).(*model.Order), args.Error(1)
 }
 
+// GetOrderByNumber возвращает заказ по человекочитаемому номеру
+func (m *MockOrderService) GetOrderByNumber(ctx context.Context, number string) (*model.Order, error) {
+	args := m.Called(ctx, number)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Order), args.Error(1)
+}
+
 // PayOrder оплачивает заказ
 func (m *MockOrderService) PayOrder(ctx context.Context, orderID uuid.UUID, paymentMethod string) (*model.Order, error) {
 	args := m.Called(ctx, orderID, paymentMethod)
@@ -46,8 +56,53 @@ func (m *MockOrderService) PayOrder(ctx context.Context, orderID uuid.UUID, paym
 	return args.Get(0).(*model.Order), args.Error(1)
 }
 
+// FulfillOrder повторяет отгрузку оплаченного заказа
+func (m *MockOrderService) FulfillOrder(ctx context.Context, orderID uuid.UUID) (*model.Order, error) {
+	args := m.Called(ctx, orderID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Order), args.Error(1)
+}
+
 // CancelOrder отменяет заказ
 func (m *MockOrderService) CancelOrder(ctx context.Context, orderID uuid.UUID) error {
 	args := m.Called(ctx, orderID)
 	return args.Error(0)
 }
+
+// ApproveOrder согласует заказ
+func (m *MockOrderService) ApproveOrder(ctx context.Context, orderID uuid.UUID, actor model.Actor) (*model.Order, error) {
+	args := m.Called(ctx, orderID, actor)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Order), args.Error(1)
+}
+
+// RejectOrder отклоняет заказ
+func (m *MockOrderService) RejectOrder(ctx context.Context, orderID uuid.UUID, actor model.Actor, reason string) (*model.Order, error) {
+	args := m.Called(ctx, orderID, actor, reason)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Order), args.Error(1)
+}
+
+// ExportUserOrders возвращает заказы пользователя для выгрузки
+func (m *MockOrderService) ExportUserOrders(ctx context.Context, actor model.Actor, userID uuid.UUID, format model.ExportFormat) (iter.Seq2[*model.Order, error], error) {
+	args := m.Called(ctx, actor, userID, format)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(iter.Seq2[*model.Order, error]), args.Error(1)
+}
+
+// EraseUserData обезличивает заказы пользователя
+func (m *MockOrderService) EraseUserData(ctx context.Context, actor model.Actor, userID uuid.UUID) (*model.ErasureResult, error) {
+	args := m.Called(ctx, actor, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.ErasureResult), args.Error(1)
+}
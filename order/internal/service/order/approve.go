@@ -0,0 +1,72 @@
+package order
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/bogdanovds/rocket_factory/order/internal/model"
+)
+
+// ApproveOrder согласует крупный заказ, после чего его можно оплатить
+func (s *Service) ApproveOrder(ctx context.Context, orderID uuid.UUID, actor model.Actor) (*model.Order, error) {
+	order, err := s.getOrderForReview(ctx, orderID, actor)
+	if err != nil {
+		return nil, err
+	}
+
+	order.Status = model.OrderStatusPending
+	order.ReviewedBy = actor.UserID
+
+	if err := s.repo.Update(ctx, order, model.OrderStatusAwaitingApproval); err != nil {
+		return nil, fmt.Errorf("repository error: %w", err)
+	}
+
+	return order, nil
+}
+
+// RejectOrder отклоняет крупный заказ с указанием причины
+func (s *Service) RejectOrder(ctx context.Context, orderID uuid.UUID, actor model.Actor, reason string) (*model.Order, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, model.ErrRejectionReasonRequired
+	}
+
+	order, err := s.getOrderForReview(ctx, orderID, actor)
+	if err != nil {
+		return nil, err
+	}
+
+	order.Status = model.OrderStatusRejected
+	order.ReviewedBy = actor.UserID
+	order.RejectionReason = reason
+
+	if err := s.repo.Update(ctx, order, model.OrderStatusAwaitingApproval); err != nil {
+		return nil, fmt.Errorf("repository error: %w", err)
+	}
+
+	return order, nil
+}
+
+func (s *Service) getOrderForReview(ctx context.Context, orderID uuid.UUID, actor model.Actor) (*model.Order, error) {
+	if actor.Role != model.RoleApprover {
+		return nil, model.ErrApproverRoleRequired
+	}
+
+	order, err := s.repo.Get(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	if order.Status != model.OrderStatusAwaitingApproval {
+		return nil, model.ErrOrderNotAwaitingApproval
+	}
+
+	return order, nil
+}
+
+func (s *Service) requiresApproval(order *model.Order) bool {
+	return s.approvalThreshold > 0 && order.TotalPrice > s.approvalThreshold
+}
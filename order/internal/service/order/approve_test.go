@@ -0,0 +1,119 @@
+package order
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/bogdanovds/rocket_factory/order/internal/model"
+)
+
+func (s *OrderServiceTestSuite) TestApproveOrder_Success() {
+	ctx := context.Background()
+	orderID := uuid.New()
+	approver := model.Actor{UserID: uuid.New(), Role: model.RoleApprover}
+
+	existingOrder := &model.Order{
+		ID:         orderID,
+		TotalPrice: 2500000.0,
+		Status:     model.OrderStatusAwaitingApproval,
+	}
+
+	s.mockRepo.On("Get", ctx, orderID).Return(existingOrder, nil)
+	s.mockRepo.On("Update", ctx, mock.AnythingOfType("*model.Order"), model.OrderStatusAwaitingApproval).Return(nil)
+
+	order, err := s.service.ApproveOrder(ctx, orderID, approver)
+
+	s.NoError(err)
+	s.Equal(model.OrderStatusPending, order.Status)
+	s.Equal(approver.UserID, order.ReviewedBy)
+}
+
+func (s *OrderServiceTestSuite) TestApproveOrder_NotApprover() {
+	ctx := context.Background()
+
+	order, err := s.service.ApproveOrder(ctx, uuid.New(), model.Actor{UserID: uuid.New(), Role: "buyer"})
+
+	s.Nil(order)
+	s.ErrorIs(err, model.ErrApproverRoleRequired)
+}
+
+func (s *OrderServiceTestSuite) TestApproveOrder_NotAwaitingApproval() {
+	ctx := context.Background()
+	orderID := uuid.New()
+
+	existingOrder := &model.Order{
+		ID:     orderID,
+		Status: model.OrderStatusPending,
+	}
+
+	s.mockRepo.On("Get", ctx, orderID).Return(existingOrder, nil)
+
+	order, err := s.service.ApproveOrder(ctx, orderID, model.Actor{UserID: uuid.New(), Role: model.RoleApprover})
+
+	s.Nil(order)
+	s.ErrorIs(err, model.ErrOrderNotAwaitingApproval)
+}
+
+func (s *OrderServiceTestSuite) TestApproveOrder_OrderNotFound() {
+	ctx := context.Background()
+	orderID := uuid.New()
+
+	s.mockRepo.On("Get", ctx, orderID).Return(nil, model.ErrOrderNotFound)
+
+	order, err := s.service.ApproveOrder(ctx, orderID, model.Actor{UserID: uuid.New(), Role: model.RoleApprover})
+
+	s.Nil(order)
+	s.ErrorIs(err, model.ErrOrderNotFound)
+}
+
+func (s *OrderServiceTestSuite) TestRejectOrder_Success() {
+	ctx := context.Background()
+	orderID := uuid.New()
+	approver := model.Actor{UserID: uuid.New(), Role: model.RoleApprover}
+
+	existingOrder := &model.Order{
+		ID:     orderID,
+		Status: model.OrderStatusAwaitingApproval,
+	}
+
+	s.mockRepo.On("Get", ctx, orderID).Return(existingOrder, nil)
+	s.mockRepo.On("Update", ctx, mock.AnythingOfType("*model.Order"), model.OrderStatusAwaitingApproval).Return(nil)
+
+	order, err := s.service.RejectOrder(ctx, orderID, approver, "  Budget exhausted ")
+
+	s.NoError(err)
+	s.Equal(model.OrderStatusRejected, order.Status)
+	s.Equal(approver.UserID, order.ReviewedBy)
+	s.Equal("Budget exhausted", order.RejectionReason)
+}
+
+func (s *OrderServiceTestSuite) TestRejectOrder_ReasonRequired() {
+	ctx := context.Background()
+
+	order, err := s.service.RejectOrder(ctx, uuid.New(), model.Actor{UserID: uuid.New(), Role: model.RoleApprover}, "   ")
+
+	s.Nil(order)
+	s.ErrorIs(err, model.ErrRejectionReasonRequired)
+}
+
+func (s *OrderServiceTestSuite) TestRejectOrder_RepositoryError() {
+	ctx := context.Background()
+	orderID := uuid.New()
+
+	existingOrder := &model.Order{
+		ID:     orderID,
+		Status: model.OrderStatusAwaitingApproval,
+	}
+	repoErr := errors.New("database error")
+
+	s.mockRepo.On("Get", ctx, orderID).Return(existingOrder, nil)
+	s.mockRepo.On("Update", ctx, mock.AnythingOfType("*model.Order"), model.OrderStatusAwaitingApproval).Return(repoErr)
+
+	order, err := s.service.RejectOrder(ctx, orderID, model.Actor{UserID: uuid.New(), Role: model.RoleApprover}, "No budget")
+
+	s.Nil(order)
+	s.ErrorIs(err, repoErr)
+}
@@ -16,6 +16,8 @@ func (s *Service) CancelOrder(ctx context.Context, orderID uuid.UUID) error {
 	}
 
 	switch order.Status {
+	case model.OrderStatusRejected:
+		return model.ErrOrderRejected
 	case model.OrderStatusPaid:
 		return model.ErrOrderAlreadyPaid
 	case model.OrderStatusCancelled:
@@ -24,8 +26,9 @@ func (s *Service) CancelOrder(ctx context.Context, orderID uuid.UUID) error {
 		return model.ErrOrderFulfilled
 	}
 
+	prevStatus := order.Status
 	order.Status = model.OrderStatusCancelled
-	if err := s.repo.Update(ctx, order); err != nil {
+	if err := s.repo.Update(ctx, order, prevStatus); err != nil {
 		return fmt.Errorf("repository error: %w", err)
 	}
 
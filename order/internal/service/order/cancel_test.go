@@ -20,7 +20,7 @@ func (s *OrderServiceTestSuite) TestCancelOrder_Success() {
 	}
 
 	s.mockRepo.On("Get", ctx, orderID).Return(existingOrder, nil)
-	s.mockRepo.On("Update", ctx, mock.AnythingOfType("*model.Order")).Return(nil)
+	s.mockRepo.On("Update", ctx, mock.AnythingOfType("*model.Order"), model.OrderStatusPending).Return(nil)
 
 	err := s.service.CancelOrder(ctx, orderID)
 
@@ -86,6 +86,22 @@ func (s *OrderServiceTestSuite) TestCancelOrder_OrderFulfilled() {
 	s.ErrorIs(err, model.ErrOrderFulfilled)
 }
 
+func (s *OrderServiceTestSuite) TestCancelOrder_Rejected() {
+	ctx := context.Background()
+	orderID := uuid.New()
+
+	existingOrder := &model.Order{
+		ID:     orderID,
+		Status: model.OrderStatusRejected,
+	}
+
+	s.mockRepo.On("Get", ctx, orderID).Return(existingOrder, nil)
+
+	err := s.service.CancelOrder(ctx, orderID)
+
+	s.ErrorIs(err, model.ErrOrderRejected)
+}
+
 func (s *OrderServiceTestSuite) TestCancelOrder_RepositoryError() {
 	ctx := context.Background()
 	orderID := uuid.New()
@@ -96,7 +112,7 @@ func (s *OrderServiceTestSuite) TestCancelOrder_RepositoryError() {
 	}
 
 	s.mockRepo.On("Get", ctx, orderID).Return(existingOrder, nil)
-	s.mockRepo.On("Update", ctx, mock.AnythingOfType("*model.Order")).Return(errors.New("db error"))
+	s.mockRepo.On("Update", ctx, mock.AnythingOfType("*model.Order"), model.OrderStatusPending).Return(errors.New("db error"))
 
 	err := s.service.CancelOrder(ctx, orderID)
 
@@ -9,31 +9,68 @@ import (
 	"github.com/bogdanovds/rocket_factory/order/internal/model"
 )
 
-func (s *Service) CreateOrder(ctx context.Context, userID uuid.UUID, partIDs []uuid.UUID) (*model.Order, error) {
+func (s *Service) CreateOrder(ctx context.Context, userID uuid.UUID, partIDs []uuid.UUID, preferredWarehouseID uuid.UUID) (*model.Order, error) {
 	if len(partIDs) == 0 {
 		return nil, model.ErrPartsNotSpecified
 	}
 
-	parts, err := s.inventoryClient.ListParts(ctx, partIDs)
+	if preferredWarehouseID != uuid.Nil {
+		exists, err := s.inventoryClient.WarehouseExists(ctx, preferredWarehouseID)
+		if err != nil {
+			return nil, fmt.Errorf("inventory client error: %w", err)
+		}
+		if !exists {
+			return nil, model.ErrWarehouseNotFound
+		}
+	}
+
+	batch, err := s.inventoryClient.BatchGetParts(ctx, partIDs)
 	if err != nil {
 		return nil, fmt.Errorf("inventory client error: %w", err)
 	}
 
-	if len(parts) != len(partIDs) {
-		return nil, model.ErrPartsNotFound
+	if len(batch.NotFound) > 0 {
+		return nil, &model.PartsNotFoundError{PartIDs: batch.NotFound}
 	}
 
-	totalPrice := 0.0
-	for _, part := range parts {
-		totalPrice += part.Price
+	// Позиции заказа в порядке запроса: каждая деталь учитывается столько раз, сколько она указана
+	lines := make([]*model.Part, 0, len(partIDs))
+	for _, id := range partIDs {
+		part, ok := batch.Parts[id]
+		if !ok {
+			return nil, &model.PartsNotFoundError{PartIDs: []uuid.UUID{id}}
+		}
+		if !part.IsOrderable() {
+			return nil, &model.PartNotOrderableError{
+				PartID:          id,
+				Name:            part.Name,
+				State:           part.LifecycleState,
+				ReplacementID:   part.ReplacementID,
+				ReplacementName: s.replacementName(ctx, part.ReplacementID),
+			}
+		}
+		lines = append(lines, part)
+	}
+
+	pricing, err := s.pricingEngine.Calculate(ctx, userID, lines)
+	if err != nil {
+		return nil, fmt.Errorf("pricing error: %w", err)
 	}
 
 	order := &model.Order{
-		ID:         uuid.New(),
-		UserID:     userID,
-		PartIDs:    partIDs,
-		TotalPrice: totalPrice,
-		Status:     model.OrderStatusPending,
+		ID:               uuid.New(),
+		UserID:           userID,
+		PartIDs:          partIDs,
+		SubtotalPrice:    pricing.Subtotal,
+		PriceAdjustments: pricing.Adjustments,
+		TotalPrice:       pricing.Total,
+		Status:           model.OrderStatusPending,
+
+		PreferredWarehouseID: preferredWarehouseID,
+	}
+
+	if s.requiresApproval(order) {
+		order.Status = model.OrderStatusAwaitingApproval
 	}
 
 	if err := s.repo.Create(ctx, order); err != nil {
@@ -42,3 +79,22 @@ func (s *Service) CreateOrder(ctx context.Context, userID uuid.UUID, partIDs []u
 
 	return order, nil
 }
+
+// replacementName возвращает название детали-замены, чтобы покупатель узнал её без отдельного
+// запроса. Название лишь дополняет ошибку: если inventory недоступен или детали нет, оно пустое.
+func (s *Service) replacementName(ctx context.Context, replacementID uuid.UUID) string {
+	if replacementID == uuid.Nil {
+		return ""
+	}
+
+	batch, err := s.inventoryClient.BatchGetParts(ctx, []uuid.UUID{replacementID})
+	if err != nil {
+		return ""
+	}
+
+	if part, ok := batch.Parts[replacementID]; ok {
+		return part.Name
+	}
+
+	return ""
+}
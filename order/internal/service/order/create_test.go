@@ -20,24 +20,76 @@ func (s *OrderServiceTestSuite) TestCreateOrder_Success() {
 		{ID: partIDs[1], Name: "Part 2", Price: 200.0},
 	}
 
-	s.mockInventoryClient.On("ListParts", ctx, partIDs).Return(parts, nil)
+	pricing := &model.Pricing{
+		Subtotal: 300.0,
+		Adjustments: []model.PriceAdjustment{
+			{RuleID: "volume", Description: "volume discount", Amount: -30.0},
+		},
+		Total: 270.0,
+	}
+
+	s.mockInventoryClient.On("BatchGetParts", ctx, partIDs).Return(partsBatch(parts...), nil)
+	s.mockPricingEngine.On("Calculate", ctx, userID, parts).Return(pricing, nil)
 	s.mockRepo.On("Create", ctx, mock.AnythingOfType("*model.Order")).Return(nil)
 
-	order, err := s.service.CreateOrder(ctx, userID, partIDs)
+	order, err := s.service.CreateOrder(ctx, userID, partIDs, uuid.Nil)
 
 	s.NoError(err)
 	s.NotNil(order)
 	s.Equal(userID, order.UserID)
 	s.Equal(partIDs, order.PartIDs)
-	s.Equal(300.0, order.TotalPrice)
+	s.Equal(300.0, order.SubtotalPrice)
+	s.Equal(pricing.Adjustments, order.PriceAdjustments)
+	s.Equal(270.0, order.TotalPrice)
 	s.Equal(model.OrderStatusPending, order.Status)
 }
 
+func (s *OrderServiceTestSuite) TestCreateOrder_LinesFollowRequestOrder() {
+	ctx := context.Background()
+	userID := uuid.New()
+	partIDs := []uuid.UUID{uuid.New(), uuid.New()}
+
+	// Inventory возвращает детали в произвольном порядке
+	parts := []*model.Part{
+		{ID: partIDs[1], Name: "Part 2", Price: 200.0},
+		{ID: partIDs[0], Name: "Part 1", Price: 100.0},
+	}
+	lines := []*model.Part{parts[1], parts[0]}
+
+	s.mockInventoryClient.On("BatchGetParts", ctx, partIDs).Return(partsBatch(parts...), nil)
+	s.mockPricingEngine.On("Calculate", ctx, userID, lines).Return(&model.Pricing{Subtotal: 300.0, Total: 300.0}, nil)
+	s.mockRepo.On("Create", ctx, mock.AnythingOfType("*model.Order")).Return(nil)
+
+	order, err := s.service.CreateOrder(ctx, userID, partIDs, uuid.Nil)
+
+	s.NoError(err)
+	s.Equal(300.0, order.TotalPrice)
+}
+
+func (s *OrderServiceTestSuite) TestCreateOrder_PricingError() {
+	ctx := context.Background()
+	userID := uuid.New()
+	partIDs := []uuid.UUID{uuid.New()}
+
+	parts := []*model.Part{
+		{ID: partIDs[0], Name: "Part 1", Price: 100.0},
+	}
+
+	s.mockInventoryClient.On("BatchGetParts", ctx, partIDs).Return(partsBatch(parts...), nil)
+	s.mockPricingEngine.On("Calculate", ctx, userID, parts).Return(nil, errors.New("pricing failed"))
+
+	order, err := s.service.CreateOrder(ctx, userID, partIDs, uuid.Nil)
+
+	s.Nil(order)
+	s.Error(err)
+	s.Contains(err.Error(), "pricing error")
+}
+
 func (s *OrderServiceTestSuite) TestCreateOrder_EmptyParts() {
 	ctx := context.Background()
 	userID := uuid.New()
 
-	order, err := s.service.CreateOrder(ctx, userID, []uuid.UUID{})
+	order, err := s.service.CreateOrder(ctx, userID, []uuid.UUID{}, uuid.Nil)
 
 	s.Nil(order)
 	s.ErrorIs(err, model.ErrPartsNotSpecified)
@@ -48,9 +100,9 @@ func (s *OrderServiceTestSuite) TestCreateOrder_InventoryError() {
 	userID := uuid.New()
 	partIDs := []uuid.UUID{uuid.New()}
 
-	s.mockInventoryClient.On("ListParts", ctx, partIDs).Return(nil, errors.New("inventory error"))
+	s.mockInventoryClient.On("BatchGetParts", ctx, partIDs).Return(nil, errors.New("inventory error"))
 
-	order, err := s.service.CreateOrder(ctx, userID, partIDs)
+	order, err := s.service.CreateOrder(ctx, userID, partIDs, uuid.Nil)
 
 	s.Nil(order)
 	s.Error(err)
@@ -62,17 +114,133 @@ func (s *OrderServiceTestSuite) TestCreateOrder_PartsNotFound() {
 	userID := uuid.New()
 	partIDs := []uuid.UUID{uuid.New(), uuid.New()}
 
-	// Возвращаем только одну деталь вместо двух
-	parts := []*model.Part{
-		{ID: partIDs[0], Name: "Part 1", Price: 100.0},
-	}
+	batch := partsBatch(&model.Part{ID: partIDs[0], Name: "Part 1", Price: 100.0})
+	batch.NotFound = []uuid.UUID{partIDs[1]}
 
-	s.mockInventoryClient.On("ListParts", ctx, partIDs).Return(parts, nil)
+	s.mockInventoryClient.On("BatchGetParts", ctx, partIDs).Return(batch, nil)
 
-	order, err := s.service.CreateOrder(ctx, userID, partIDs)
+	order, err := s.service.CreateOrder(ctx, userID, partIDs, uuid.Nil)
 
 	s.Nil(order)
 	s.ErrorIs(err, model.ErrPartsNotFound)
+	var notFoundErr *model.PartsNotFoundError
+	s.Require().ErrorAs(err, &notFoundErr)
+	s.Equal([]uuid.UUID{partIDs[1]}, notFoundErr.PartIDs)
+	s.Contains(err.Error(), partIDs[1].String())
+}
+
+func (s *OrderServiceTestSuite) TestCreateOrder_PartMissingFromBatch() {
+	ctx := context.Background()
+	partIDs := []uuid.UUID{uuid.New()}
+
+	// Inventory не вернул деталь, но и не указал её среди ненайденных
+	s.mockInventoryClient.On("BatchGetParts", ctx, partIDs).Return(partsBatch(), nil)
+
+	order, err := s.service.CreateOrder(ctx, uuid.New(), partIDs, uuid.Nil)
+
+	s.Nil(order)
+	var notFoundErr *model.PartsNotFoundError
+	s.Require().ErrorAs(err, &notFoundErr)
+	s.Equal(partIDs, notFoundErr.PartIDs)
+}
+
+func (s *OrderServiceTestSuite) TestCreateOrder_DiscontinuedPartNamesReplacement() {
+	ctx := context.Background()
+	engineID, replacementID := uuid.New(), uuid.New()
+	partIDs := []uuid.UUID{engineID}
+
+	engine := &model.Part{
+		ID:             engineID,
+		Name:           "Main Engine",
+		Price:          100.0,
+		LifecycleState: model.PartLifecycleStateDiscontinued,
+		ReplacementID:  replacementID,
+	}
+	replacement := &model.Part{ID: replacementID, Name: "Vacuum Engine", Price: 120.0}
+	s.mockInventoryClient.On("BatchGetParts", ctx, partIDs).Return(partsBatch(engine), nil)
+	s.mockInventoryClient.On("BatchGetParts", ctx, []uuid.UUID{replacementID}).Return(partsBatch(replacement), nil)
+
+	order, err := s.service.CreateOrder(ctx, uuid.New(), partIDs, uuid.Nil)
+
+	s.Nil(order)
+	s.ErrorIs(err, model.ErrPartNotOrderable)
+	var notOrderableErr *model.PartNotOrderableError
+	s.Require().ErrorAs(err, &notOrderableErr)
+	s.Equal(engineID, notOrderableErr.PartID)
+	s.Equal(replacementID, notOrderableErr.ReplacementID)
+	s.Equal("Vacuum Engine", notOrderableErr.ReplacementName)
+	s.Contains(err.Error(), "discontinued")
+	s.Contains(err.Error(), "order replacement part "+replacementID.String()+" (Vacuum Engine)")
+}
+
+func (s *OrderServiceTestSuite) TestCreateOrder_ReplacementLookupFails() {
+	ctx := context.Background()
+	engineID, replacementID := uuid.New(), uuid.New()
+	partIDs := []uuid.UUID{engineID}
+
+	engine := &model.Part{
+		ID:             engineID,
+		Name:           "Main Engine",
+		Price:          100.0,
+		LifecycleState: model.PartLifecycleStateDiscontinued,
+		ReplacementID:  replacementID,
+	}
+	s.mockInventoryClient.On("BatchGetParts", ctx, partIDs).Return(partsBatch(engine), nil)
+	s.mockInventoryClient.On("BatchGetParts", ctx, []uuid.UUID{replacementID}).Return(nil, errors.New("inventory unavailable"))
+
+	order, err := s.service.CreateOrder(ctx, uuid.New(), partIDs, uuid.Nil)
+
+	// Замена без названия всё равно сообщается: отказ в заказе важнее подробностей о ней
+	s.Nil(order)
+	var notOrderableErr *model.PartNotOrderableError
+	s.Require().ErrorAs(err, &notOrderableErr)
+	s.Equal(replacementID, notOrderableErr.ReplacementID)
+	s.Empty(notOrderableErr.ReplacementName)
+	s.Contains(err.Error(), "order replacement part "+replacementID.String()+" instead")
+}
+
+func (s *OrderServiceTestSuite) TestCreateOrder_NotActivePartRejected() {
+	ctx := context.Background()
+
+	for _, state := range []string{model.PartLifecycleStateDraft, model.PartLifecycleStateRecalled} {
+		wingID := uuid.New()
+		partIDs := []uuid.UUID{uuid.New(), wingID}
+		active := &model.Part{ID: partIDs[0], Name: "Main Engine", Price: 100.0, LifecycleState: model.PartLifecycleStateActive}
+		wing := &model.Part{ID: wingID, Name: "Wing", Price: 50.0, LifecycleState: state}
+
+		s.mockInventoryClient.On("BatchGetParts", ctx, partIDs).Return(partsBatch(active, wing), nil)
+
+		order, err := s.service.CreateOrder(ctx, uuid.New(), partIDs, uuid.Nil)
+
+		s.Nil(order, state)
+		var notOrderableErr *model.PartNotOrderableError
+		s.Require().ErrorAs(err, &notOrderableErr, state)
+		s.Equal(wingID, notOrderableErr.PartID, state)
+		s.Equal(state, notOrderableErr.State, state)
+		s.NotContains(err.Error(), "replacement", state)
+	}
+}
+
+func (s *OrderServiceTestSuite) TestCreateOrder_DuplicateParts() {
+	ctx := context.Background()
+	userID := uuid.New()
+	engineID, wingID := uuid.New(), uuid.New()
+	partIDs := []uuid.UUID{engineID, wingID, engineID}
+
+	engine := &model.Part{ID: engineID, Name: "Main Engine", Price: 100.0}
+	wing := &model.Part{ID: wingID, Name: "Wing", Price: 50.0}
+
+	// Повторная деталь возвращается inventory один раз, но в заказе учитывается дважды
+	s.mockInventoryClient.On("BatchGetParts", ctx, partIDs).Return(partsBatch(engine, wing), nil)
+	s.mockPricingEngine.On("Calculate", ctx, userID, []*model.Part{engine, wing, engine}).
+		Return(&model.Pricing{Subtotal: 250.0, Total: 250.0}, nil)
+	s.mockRepo.On("Create", ctx, mock.AnythingOfType("*model.Order")).Return(nil)
+
+	order, err := s.service.CreateOrder(ctx, userID, partIDs, uuid.Nil)
+
+	s.NoError(err)
+	s.Equal(partIDs, order.PartIDs)
+	s.Equal(250.0, order.TotalPrice)
 }
 
 func (s *OrderServiceTestSuite) TestCreateOrder_RepositoryError() {
@@ -84,12 +252,94 @@ func (s *OrderServiceTestSuite) TestCreateOrder_RepositoryError() {
 		{ID: partIDs[0], Name: "Part 1", Price: 100.0},
 	}
 
-	s.mockInventoryClient.On("ListParts", ctx, partIDs).Return(parts, nil)
+	s.mockInventoryClient.On("BatchGetParts", ctx, partIDs).Return(partsBatch(parts...), nil)
+	s.mockPricingEngine.On("Calculate", ctx, userID, parts).Return(&model.Pricing{Subtotal: 100.0, Total: 100.0}, nil)
 	s.mockRepo.On("Create", ctx, mock.AnythingOfType("*model.Order")).Return(errors.New("db error"))
 
-	order, err := s.service.CreateOrder(ctx, userID, partIDs)
+	order, err := s.service.CreateOrder(ctx, userID, partIDs, uuid.Nil)
 
 	s.Nil(order)
 	s.Error(err)
 	s.Contains(err.Error(), "repository error")
 }
+
+func (s *OrderServiceTestSuite) TestCreateOrder_AboveApprovalThreshold() {
+	ctx := context.Background()
+	userID := uuid.New()
+	partIDs := []uuid.UUID{uuid.New()}
+
+	parts := []*model.Part{
+		{ID: partIDs[0], Name: "Main Engine", Price: 2500000.0},
+	}
+
+	s.mockInventoryClient.On("BatchGetParts", ctx, partIDs).Return(partsBatch(parts...), nil)
+	s.mockPricingEngine.On("Calculate", ctx, userID, parts).Return(&model.Pricing{Subtotal: 2500000.0, Total: 2500000.0}, nil)
+	s.mockRepo.On("Create", ctx, mock.AnythingOfType("*model.Order")).Return(nil)
+
+	order, err := s.service.CreateOrder(ctx, userID, partIDs, uuid.Nil)
+
+	s.NoError(err)
+	s.Equal(model.OrderStatusAwaitingApproval, order.Status)
+}
+
+func (s *OrderServiceTestSuite) TestCreateOrder_ApprovalDisabled() {
+	ctx := context.Background()
+	userID := uuid.New()
+	partIDs := []uuid.UUID{uuid.New()}
+
+	parts := []*model.Part{
+		{ID: partIDs[0], Name: "Main Engine", Price: 2500000.0},
+	}
+
+	s.service = NewService(s.mockRepo, s.mockAuditRepo, s.mockInventoryClient, s.mockPaymentClient, s.mockPricingEngine, 0)
+
+	s.mockInventoryClient.On("BatchGetParts", ctx, partIDs).Return(partsBatch(parts...), nil)
+	s.mockPricingEngine.On("Calculate", ctx, userID, parts).Return(&model.Pricing{Subtotal: 2500000.0, Total: 2500000.0}, nil)
+	s.mockRepo.On("Create", ctx, mock.AnythingOfType("*model.Order")).Return(nil)
+
+	order, err := s.service.CreateOrder(ctx, userID, partIDs, uuid.Nil)
+
+	s.NoError(err)
+	s.Equal(model.OrderStatusPending, order.Status)
+}
+
+func (s *OrderServiceTestSuite) TestCreateOrder_PreferredWarehouse() {
+	ctx := context.Background()
+	userID := uuid.New()
+	warehouseID := uuid.New()
+	partIDs := []uuid.UUID{uuid.New()}
+
+	parts := []*model.Part{
+		{ID: partIDs[0], Name: "Part 1", Price: 100.0},
+	}
+
+	s.mockInventoryClient.On("WarehouseExists", ctx, warehouseID).Return(true, nil)
+	s.mockInventoryClient.On("BatchGetParts", ctx, partIDs).Return(partsBatch(parts...), nil)
+	s.mockPricingEngine.On("Calculate", ctx, userID, parts).Return(&model.Pricing{Subtotal: 100.0, Total: 100.0}, nil)
+	s.mockRepo.On("Create", ctx, mock.AnythingOfType("*model.Order")).Return(nil)
+
+	order, err := s.service.CreateOrder(ctx, userID, partIDs, warehouseID)
+
+	s.NoError(err)
+	s.Equal(warehouseID, order.PreferredWarehouseID)
+}
+
+func (s *OrderServiceTestSuite) TestCreateOrder_UnknownWarehouse() {
+	ctx := context.Background()
+	warehouseID := uuid.New()
+
+	s.mockInventoryClient.On("WarehouseExists", ctx, warehouseID).Return(false, nil)
+
+	order, err := s.service.CreateOrder(ctx, uuid.New(), []uuid.UUID{uuid.New()}, warehouseID)
+
+	s.Nil(order)
+	s.ErrorIs(err, model.ErrWarehouseNotFound)
+}
+
+func partsBatch(parts ...*model.Part) *model.PartsBatch {
+	batch := &model.PartsBatch{Parts: make(map[uuid.UUID]*model.Part, len(parts))}
+	for _, part := range parts {
+		batch.Parts[part.ID] = part
+	}
+	return batch
+}
@@ -0,0 +1,18 @@
+package order
+
+import (
+	"context"
+	"strings"
+
+	"github.com/bogdanovds/rocket_factory/order/internal/model"
+)
+
+// GetOrderByNumber возвращает заказ по человекочитаемому номеру (например, RF-2026-000123)
+func (s *Service) GetOrderByNumber(ctx context.Context, number string) (*model.Order, error) {
+	number = strings.ToUpper(strings.TrimSpace(number))
+	if !model.IsValidOrderNumber(number) {
+		return nil, model.ErrInvalidOrderNumber
+	}
+
+	return s.repo.GetByNumber(ctx, number)
+}
@@ -40,3 +40,41 @@ func (s *OrderServiceTestSuite) TestGetOrder_NotFound() {
 	s.Nil(order)
 	s.ErrorIs(err, model.ErrOrderNotFound)
 }
+
+func (s *OrderServiceTestSuite) TestGetOrderByNumber_Success() {
+	ctx := context.Background()
+	expectedOrder := &model.Order{
+		ID:     uuid.New(),
+		Number: "RF-2026-000123",
+		Status: model.OrderStatusPending,
+	}
+
+	s.mockRepo.On("GetByNumber", ctx, "RF-2026-000123").Return(expectedOrder, nil)
+
+	order, err := s.service.GetOrderByNumber(ctx, " rf-2026-000123 ")
+
+	s.NoError(err)
+	s.Equal(expectedOrder.ID, order.ID)
+}
+
+func (s *OrderServiceTestSuite) TestGetOrderByNumber_InvalidFormat() {
+	ctx := context.Background()
+
+	for _, number := range []string{"", "123", "RF-26-000123", "RF-2026-123", "XX-2026-000123"} {
+		order, err := s.service.GetOrderByNumber(ctx, number)
+
+		s.Nil(order, number)
+		s.ErrorIs(err, model.ErrInvalidOrderNumber, number)
+	}
+}
+
+func (s *OrderServiceTestSuite) TestGetOrderByNumber_NotFound() {
+	ctx := context.Background()
+
+	s.mockRepo.On("GetByNumber", ctx, "RF-2026-000999").Return(nil, model.ErrOrderNotFound)
+
+	order, err := s.service.GetOrderByNumber(ctx, "RF-2026-000999")
+
+	s.Nil(order)
+	s.ErrorIs(err, model.ErrOrderNotFound)
+}
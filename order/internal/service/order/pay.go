@@ -5,8 +5,10 @@ import (
 	"fmt"
 
 	"github.com/google/uuid"
+	"go.uber.org/zap"
 
 	"github.com/bogdanovds/rocket_factory/order/internal/model"
+	"github.com/bogdanovds/rocket_factory/platform/pkg/logger"
 )
 
 func (s *Service) PayOrder(ctx context.Context, orderID uuid.UUID, paymentMethod string) (*model.Order, error) {
@@ -16,6 +18,10 @@ func (s *Service) PayOrder(ctx context.Context, orderID uuid.UUID, paymentMethod
 	}
 
 	switch order.Status {
+	case model.OrderStatusAwaitingApproval:
+		return nil, model.ErrOrderAwaitingApproval
+	case model.OrderStatusRejected:
+		return nil, model.ErrOrderRejected
 	case model.OrderStatusPaid:
 		return nil, model.ErrOrderAlreadyPaid
 	case model.OrderStatusCancelled:
@@ -33,13 +39,60 @@ func (s *Service) PayOrder(ctx context.Context, orderID uuid.UUID, paymentMethod
 		return nil, fmt.Errorf("payment failed: %w", err)
 	}
 
+	prevStatus := order.Status
 	order.Status = model.OrderStatusPaid
 	order.PaymentMethod = paymentMethod
 	order.TransactionID = transactionID
 
-	if err := s.repo.Update(ctx, order); err != nil {
+	if err := s.repo.Update(ctx, order, prevStatus); err != nil {
 		return nil, fmt.Errorf("repository error: %w", err)
 	}
 
+	// Оплата уже прошла и не отменяется: заказ остаётся в PAID, а отгрузку повторяет FulfillOrder
+	if err := s.fulfill(ctx, order); err != nil {
+		logger.Error(ctx, "Failed to fulfill paid order", zap.String("order_id", order.ID.String()), zap.Error(err))
+	}
+
 	return order, nil
 }
+
+// FulfillOrder повторяет отгрузку оплаченного заказа, которую не удалось выполнить при оплате
+func (s *Service) FulfillOrder(ctx context.Context, orderID uuid.UUID) (*model.Order, error) {
+	order, err := s.repo.Get(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch order.Status {
+	case model.OrderStatusPaid:
+	case model.OrderStatusFulfilled:
+		return nil, model.ErrOrderFulfilled
+	default:
+		return nil, model.ErrOrderNotPaid
+	}
+
+	if err := s.fulfill(ctx, order); err != nil {
+		return nil, err
+	}
+
+	return order, nil
+}
+
+// fulfill списывает детали оплаченного заказа со складов и переводит заказ в FULFILLED.
+// Списание идемпотентно по заказу, а переход выполняется только из PAID, поэтому повторная
+// или параллельная отгрузка не списывает детали дважды. Ошибка склада оборачивается
+// в model.ErrFulfillmentFailed; при любой ошибке order не меняется.
+func (s *Service) fulfill(ctx context.Context, order *model.Order) error {
+	if err := s.inventoryClient.FulfillOrder(ctx, order.ID, order.PreferredWarehouseID, order.PartIDs); err != nil {
+		return fmt.Errorf("%w: %w", model.ErrFulfillmentFailed, err)
+	}
+
+	fulfilled := *order
+	fulfilled.Status = model.OrderStatusFulfilled
+	if err := s.repo.Update(ctx, &fulfilled, model.OrderStatusPaid); err != nil {
+		return fmt.Errorf("repository error: %w", err)
+	}
+
+	*order = fulfilled
+	return nil
+}
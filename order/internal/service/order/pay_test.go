@@ -27,17 +27,156 @@ func (s *OrderServiceTestSuite) TestPayOrder_Success() {
 
 	s.mockRepo.On("Get", ctx, orderID).Return(existingOrder, nil)
 	s.mockPaymentClient.On("PayOrder", ctx, orderID, userID, paymentMethod).Return(transactionID, nil)
-	s.mockRepo.On("Update", ctx, mock.AnythingOfType("*model.Order")).Return(nil)
+	s.mockRepo.On("Update", ctx, mock.AnythingOfType("*model.Order"), model.OrderStatusPending).Return(nil).Once()
+	s.mockRepo.On("Update", ctx, mock.AnythingOfType("*model.Order"), model.OrderStatusPaid).Return(nil).Once()
+	s.mockInventoryClient.On("FulfillOrder", ctx, orderID, uuid.Nil, existingOrder.PartIDs).Return(nil)
 
 	order, err := s.service.PayOrder(ctx, orderID, paymentMethod)
 
 	s.NoError(err)
 	s.NotNil(order)
-	s.Equal(model.OrderStatusPaid, order.Status)
+	s.Equal(model.OrderStatusFulfilled, order.Status)
 	s.Equal(transactionID, order.TransactionID)
 	s.Equal(paymentMethod, order.PaymentMethod)
 }
 
+func (s *OrderServiceTestSuite) TestPayOrder_FulfillsFromPreferredWarehouse() {
+	ctx := context.Background()
+	orderID := uuid.New()
+	userID := uuid.New()
+	warehouseID := uuid.New()
+	partID := uuid.New()
+
+	existingOrder := &model.Order{
+		ID:                   orderID,
+		UserID:               userID,
+		PartIDs:              []uuid.UUID{partID, partID},
+		TotalPrice:           300.0,
+		Status:               model.OrderStatusPending,
+		PreferredWarehouseID: warehouseID,
+	}
+
+	s.mockRepo.On("Get", ctx, orderID).Return(existingOrder, nil)
+	s.mockPaymentClient.On("PayOrder", ctx, orderID, userID, "CARD").Return(uuid.New(), nil)
+	s.mockRepo.On("Update", ctx, mock.AnythingOfType("*model.Order"), model.OrderStatusPending).Return(nil).Once()
+	s.mockRepo.On("Update", ctx, mock.AnythingOfType("*model.Order"), model.OrderStatusPaid).Return(nil).Once()
+	s.mockInventoryClient.On("FulfillOrder", ctx, orderID, warehouseID, []uuid.UUID{partID, partID}).Return(nil)
+
+	order, err := s.service.PayOrder(ctx, orderID, "CARD")
+
+	s.NoError(err)
+	s.Equal(model.OrderStatusFulfilled, order.Status)
+}
+
+func (s *OrderServiceTestSuite) TestPayOrder_FulfillmentFailedKeepsPaid() {
+	ctx := context.Background()
+	orderID := uuid.New()
+	userID := uuid.New()
+	transactionID := uuid.New()
+
+	existingOrder := &model.Order{
+		ID:         orderID,
+		UserID:     userID,
+		PartIDs:    []uuid.UUID{uuid.New()},
+		TotalPrice: 150.0,
+		Status:     model.OrderStatusPending,
+	}
+
+	s.mockRepo.On("Get", ctx, orderID).Return(existingOrder, nil)
+	s.mockPaymentClient.On("PayOrder", ctx, orderID, userID, "CARD").Return(transactionID, nil)
+	s.mockRepo.On("Update", ctx, mock.MatchedBy(func(o *model.Order) bool {
+		return o.Status == model.OrderStatusPaid
+	}), model.OrderStatusPending).Return(nil).Once()
+	s.mockInventoryClient.On("FulfillOrder", ctx, orderID, uuid.Nil, existingOrder.PartIDs).
+		Return(errors.New("insufficient stock"))
+
+	order, err := s.service.PayOrder(ctx, orderID, "CARD")
+
+	// Оплата прошла - ошибка отгрузки не возвращается клиенту
+	s.NoError(err)
+	s.Equal(model.OrderStatusPaid, order.Status)
+	s.Equal(transactionID, order.TransactionID)
+}
+
+func (s *OrderServiceTestSuite) TestFulfillOrder_RetriesPaidOrder() {
+	ctx := context.Background()
+	orderID := uuid.New()
+	warehouseID := uuid.New()
+
+	existingOrder := &model.Order{
+		ID:                   orderID,
+		PartIDs:              []uuid.UUID{uuid.New()},
+		Status:               model.OrderStatusPaid,
+		PreferredWarehouseID: warehouseID,
+	}
+
+	s.mockRepo.On("Get", ctx, orderID).Return(existingOrder, nil)
+	s.mockInventoryClient.On("FulfillOrder", ctx, orderID, warehouseID, existingOrder.PartIDs).Return(nil)
+	s.mockRepo.On("Update", ctx, mock.MatchedBy(func(o *model.Order) bool {
+		return o.Status == model.OrderStatusFulfilled
+	}), model.OrderStatusPaid).Return(nil).Once()
+
+	order, err := s.service.FulfillOrder(ctx, orderID)
+
+	s.NoError(err)
+	s.Equal(model.OrderStatusFulfilled, order.Status)
+}
+
+func (s *OrderServiceTestSuite) TestFulfillOrder_InventoryFailure() {
+	ctx := context.Background()
+	orderID := uuid.New()
+
+	existingOrder := &model.Order{ID: orderID, PartIDs: []uuid.UUID{uuid.New()}, Status: model.OrderStatusPaid}
+
+	s.mockRepo.On("Get", ctx, orderID).Return(existingOrder, nil)
+	s.mockInventoryClient.On("FulfillOrder", ctx, orderID, uuid.Nil, existingOrder.PartIDs).
+		Return(errors.New("insufficient stock"))
+
+	order, err := s.service.FulfillOrder(ctx, orderID)
+
+	s.Nil(order)
+	s.ErrorIs(err, model.ErrFulfillmentFailed)
+	s.Equal(model.OrderStatusPaid, existingOrder.Status)
+}
+
+func (s *OrderServiceTestSuite) TestFulfillOrder_ConcurrentlyFulfilled() {
+	ctx := context.Background()
+	orderID := uuid.New()
+
+	existingOrder := &model.Order{ID: orderID, PartIDs: []uuid.UUID{uuid.New()}, Status: model.OrderStatusPaid}
+
+	s.mockRepo.On("Get", ctx, orderID).Return(existingOrder, nil)
+	// Склад вернул уже выполненное списание, а статус заказа успел сменить параллельный вызов
+	s.mockInventoryClient.On("FulfillOrder", ctx, orderID, uuid.Nil, existingOrder.PartIDs).Return(nil)
+	s.mockRepo.On("Update", ctx, mock.AnythingOfType("*model.Order"), model.OrderStatusPaid).
+		Return(model.ErrOrderStatusChanged).Once()
+
+	order, err := s.service.FulfillOrder(ctx, orderID)
+
+	s.Nil(order)
+	s.ErrorIs(err, model.ErrOrderStatusChanged)
+	s.Equal(model.OrderStatusPaid, existingOrder.Status)
+}
+
+func (s *OrderServiceTestSuite) TestFulfillOrder_WrongStatus() {
+	ctx := context.Background()
+	expected := map[model.OrderStatus]error{
+		model.OrderStatusPending:   model.ErrOrderNotPaid,
+		model.OrderStatusCancelled: model.ErrOrderNotPaid,
+		model.OrderStatusFulfilled: model.ErrOrderFulfilled,
+	}
+
+	for status, expectedErr := range expected {
+		orderID := uuid.New()
+		s.mockRepo.On("Get", ctx, orderID).Return(&model.Order{ID: orderID, Status: status}, nil)
+
+		order, err := s.service.FulfillOrder(ctx, orderID)
+
+		s.Nil(order, status)
+		s.ErrorIs(err, expectedErr, status)
+	}
+}
+
 func (s *OrderServiceTestSuite) TestPayOrder_OrderNotFound() {
 	ctx := context.Background()
 	orderID := uuid.New()
@@ -138,3 +277,37 @@ func (s *OrderServiceTestSuite) TestPayOrder_PaymentFailed() {
 	s.Error(err)
 	s.Contains(err.Error(), "payment failed")
 }
+
+func (s *OrderServiceTestSuite) TestPayOrder_AwaitingApproval() {
+	ctx := context.Background()
+	orderID := uuid.New()
+
+	existingOrder := &model.Order{
+		ID:     orderID,
+		Status: model.OrderStatusAwaitingApproval,
+	}
+
+	s.mockRepo.On("Get", ctx, orderID).Return(existingOrder, nil)
+
+	order, err := s.service.PayOrder(ctx, orderID, "CARD")
+
+	s.Nil(order)
+	s.ErrorIs(err, model.ErrOrderAwaitingApproval)
+}
+
+func (s *OrderServiceTestSuite) TestPayOrder_Rejected() {
+	ctx := context.Background()
+	orderID := uuid.New()
+
+	existingOrder := &model.Order{
+		ID:     orderID,
+		Status: model.OrderStatusRejected,
+	}
+
+	s.mockRepo.On("Get", ctx, orderID).Return(existingOrder, nil)
+
+	order, err := s.service.PayOrder(ctx, orderID, "CARD")
+
+	s.Nil(order)
+	s.ErrorIs(err, model.ErrOrderRejected)
+}
@@ -2,19 +2,35 @@ package order
 
 import (
 	"github.com/bogdanovds/rocket_factory/order/internal/client"
+	"github.com/bogdanovds/rocket_factory/order/internal/pricing"
 	"github.com/bogdanovds/rocket_factory/order/internal/repository"
 )
 
 type Service struct {
 	repo            repository.Repository
+	auditRepo       repository.AuditRepository
 	inventoryClient client.InventoryClient
 	paymentClient   client.PaymentClient
+	pricingEngine   pricing.Engine
+	// approvalThreshold - сумма заказа, выше которой заказ требует согласования. 0 отключает согласование.
+	approvalThreshold float64
 }
 
-func NewService(repo repository.Repository, invClient client.InventoryClient, payClient client.PaymentClient) *Service {
+func NewService(
+	repo repository.Repository,
+	auditRepo repository.AuditRepository,
+	invClient client.InventoryClient,
+	payClient client.PaymentClient,
+	pricingEngine pricing.Engine,
+	approvalThreshold float64,
+) *Service {
 	return &Service{
 		repo:            repo,
+		auditRepo:       auditRepo,
 		inventoryClient: invClient,
 		paymentClient:   payClient,
+		pricingEngine:   pricingEngine,
+
+		approvalThreshold: approvalThreshold,
 	}
 }
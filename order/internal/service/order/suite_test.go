@@ -6,31 +6,41 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	clientMocks "github.com/bogdanovds/rocket_factory/order/internal/client/grpc/mocks"
+	pricingMocks "github.com/bogdanovds/rocket_factory/order/internal/pricing/mocks"
 	repoMocks "github.com/bogdanovds/rocket_factory/order/internal/repository/mocks"
 )
 
+// testApprovalThreshold - порог согласования, используемый в тестах
+const testApprovalThreshold = 1000000.0
+
 // OrderServiceTestSuite - тестовый набор для сервиса заказов
 type OrderServiceTestSuite struct {
 	suite.Suite
 	mockRepo            *repoMocks.MockOrderRepository
+	mockAuditRepo       *repoMocks.MockAuditRepository
 	mockInventoryClient *clientMocks.MockInventoryClient
 	mockPaymentClient   *clientMocks.MockPaymentClient
+	mockPricingEngine   *pricingMocks.MockPricingEngine
 	service             *Service
 }
 
 // SetupTest выполняется перед каждым тестом
 func (s *OrderServiceTestSuite) SetupTest() {
 	s.mockRepo = repoMocks.NewMockOrderRepository()
+	s.mockAuditRepo = repoMocks.NewMockAuditRepository()
 	s.mockInventoryClient = clientMocks.NewMockInventoryClient()
 	s.mockPaymentClient = clientMocks.NewMockPaymentClient()
-	s.service = NewService(s.mockRepo, s.mockInventoryClient, s.mockPaymentClient)
+	s.mockPricingEngine = pricingMocks.NewMockPricingEngine()
+	s.service = NewService(s.mockRepo, s.mockAuditRepo, s.mockInventoryClient, s.mockPaymentClient, s.mockPricingEngine, testApprovalThreshold)
 }
 
 // TearDownTest выполняется после каждого теста
 func (s *OrderServiceTestSuite) TearDownTest() {
 	s.mockRepo.AssertExpectations(s.T())
+	s.mockAuditRepo.AssertExpectations(s.T())
 	s.mockInventoryClient.AssertExpectations(s.T())
 	s.mockPaymentClient.AssertExpectations(s.T())
+	s.mockPricingEngine.AssertExpectations(s.T())
 }
 
 // TestOrderServiceTestSuite запускает тестовый набор
@@ -0,0 +1,90 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"strconv"
+
+	"github.com/google/uuid"
+
+	"github.com/bogdanovds/rocket_factory/order/internal/model"
+)
+
+// ExportUserOrders возвращает все заказы пользователя для выгрузки по его запросу.
+// Права и формат проверяются, а запись в журнал аудита создаётся до начала выгрузки,
+// сами заказы читаются из хранилища по мере обхода результата.
+func (s *Service) ExportUserOrders(ctx context.Context, actor model.Actor, userID uuid.UUID, format model.ExportFormat) (iter.Seq2[*model.Order, error], error) {
+	if actor.Role != model.RoleAdmin {
+		return nil, model.ErrAdminRoleRequired
+	}
+
+	switch format {
+	case model.ExportFormatJSONLines, model.ExportFormatCSV:
+	default:
+		return nil, model.ErrInvalidExportFormat
+	}
+
+	entry := &model.AuditEntry{
+		ID:            uuid.New(),
+		Action:        model.AuditActionUserDataExport,
+		ActorID:       actor.UserID,
+		SubjectUserID: userID,
+		Details:       map[string]string{"format": string(format)},
+	}
+	if err := s.auditRepo.Create(ctx, entry); err != nil {
+		return nil, fmt.Errorf("audit error: %w", err)
+	}
+
+	return s.repo.ListByUser(ctx, userID), nil
+}
+
+// EraseUserData обезличивает заказы пользователя по его запросу на удаление данных.
+// Финансовые данные заказов (состав, суммы, оплата) сохраняются. Запись в журнале аудита
+// создаётся до обезличивания и завершается после него: без записи заказы не обезличиваются.
+// Пока у пользователя есть незавершённые заказы, обезличивание отклоняется; проверку
+// выполняет репозиторий атомарно с заменой (см. repository.Repository.AnonymizeUser).
+func (s *Service) EraseUserData(ctx context.Context, actor model.Actor, userID uuid.UUID) (*model.ErasureResult, error) {
+	if actor.Role != model.RoleAdmin {
+		return nil, model.ErrAdminRoleRequired
+	}
+
+	entry := &model.AuditEntry{
+		ID:            uuid.New(),
+		Action:        model.AuditActionUserDataErasure,
+		ActorID:       actor.UserID,
+		SubjectUserID: userID,
+		Details:       map[string]string{model.AuditDetailStatus: model.AuditStatusStarted},
+	}
+	if err := s.auditRepo.Create(ctx, entry); err != nil {
+		return nil, fmt.Errorf("audit error: %w", err)
+	}
+
+	anonymized, err := s.repo.AnonymizeUser(ctx, userID)
+	if err != nil {
+		failed := map[string]string{model.AuditDetailStatus: model.AuditStatusFailed}
+		if aerr := s.auditRepo.UpdateDetails(ctx, entry.ID, failed); aerr != nil {
+			err = errors.Join(err, fmt.Errorf("audit error: %w", aerr))
+		}
+		if errors.Is(err, model.ErrUserHasActiveOrders) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("repository error: %w", err)
+	}
+
+	// Повторный запрос безопасен: обезличенных заказов у пользователя уже нет
+	completed := map[string]string{
+		model.AuditDetailStatus: model.AuditStatusCompleted,
+		"anonymized_orders":     strconv.Itoa(anonymized),
+	}
+	if err := s.auditRepo.UpdateDetails(ctx, entry.ID, completed); err != nil {
+		return nil, fmt.Errorf("audit error: %w", err)
+	}
+
+	return &model.ErasureResult{
+		UserID:           userID,
+		AnonymizedOrders: anonymized,
+		AuditEntryID:     entry.ID,
+	}, nil
+}
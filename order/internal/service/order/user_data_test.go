@@ -0,0 +1,161 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"iter"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/bogdanovds/rocket_factory/order/internal/model"
+)
+
+func ordersSeq(orders ...*model.Order) iter.Seq2[*model.Order, error] {
+	return func(yield func(*model.Order, error) bool) {
+		for _, order := range orders {
+			if !yield(order, nil) {
+				return
+			}
+		}
+	}
+}
+
+func (s *OrderServiceTestSuite) TestExportUserOrders_Success() {
+	ctx := context.Background()
+	admin := model.Actor{UserID: uuid.New(), Role: model.RoleAdmin}
+	userID := uuid.New()
+	stored := []*model.Order{
+		{ID: uuid.New(), UserID: userID, Status: model.OrderStatusPaid},
+		{ID: uuid.New(), UserID: userID, Status: model.OrderStatusCancelled},
+	}
+
+	s.mockAuditRepo.On("Create", ctx, mock.MatchedBy(func(entry *model.AuditEntry) bool {
+		return entry.Action == model.AuditActionUserDataExport &&
+			entry.ActorID == admin.UserID &&
+			entry.SubjectUserID == userID &&
+			entry.Details["format"] == "csv"
+	})).Return(nil)
+	s.mockRepo.On("ListByUser", ctx, userID).Return(ordersSeq(stored...))
+
+	orders, err := s.service.ExportUserOrders(ctx, admin, userID, model.ExportFormatCSV)
+	s.Require().NoError(err)
+
+	var exported []*model.Order
+	for order, err := range orders {
+		s.Require().NoError(err)
+		exported = append(exported, order)
+	}
+	s.Equal(stored, exported)
+}
+
+func (s *OrderServiceTestSuite) TestExportUserOrders_AdminRoleRequired() {
+	ctx := context.Background()
+
+	orders, err := s.service.ExportUserOrders(ctx, model.Actor{UserID: uuid.New(), Role: model.RoleApprover}, uuid.New(), model.ExportFormatJSONLines)
+
+	s.Nil(orders)
+	s.ErrorIs(err, model.ErrAdminRoleRequired)
+}
+
+func (s *OrderServiceTestSuite) TestExportUserOrders_InvalidFormat() {
+	ctx := context.Background()
+
+	orders, err := s.service.ExportUserOrders(ctx, model.Actor{UserID: uuid.New(), Role: model.RoleAdmin}, uuid.New(), "xml")
+
+	s.Nil(orders)
+	s.ErrorIs(err, model.ErrInvalidExportFormat)
+}
+
+func (s *OrderServiceTestSuite) TestExportUserOrders_AuditError() {
+	ctx := context.Background()
+	auditErr := errors.New("database error")
+
+	s.mockAuditRepo.On("Create", ctx, mock.AnythingOfType("*model.AuditEntry")).Return(auditErr)
+
+	orders, err := s.service.ExportUserOrders(ctx, model.Actor{UserID: uuid.New(), Role: model.RoleAdmin}, uuid.New(), model.ExportFormatJSONLines)
+
+	s.Nil(orders)
+	s.ErrorIs(err, auditErr)
+}
+
+func (s *OrderServiceTestSuite) TestEraseUserData_Success() {
+	ctx := context.Background()
+	admin := model.Actor{UserID: uuid.New(), Role: model.RoleAdmin}
+	userID := uuid.New()
+
+	var entryID uuid.UUID
+	s.mockAuditRepo.On("Create", ctx, mock.MatchedBy(func(entry *model.AuditEntry) bool {
+		return entry.Action == model.AuditActionUserDataErasure &&
+			entry.SubjectUserID == userID &&
+			entry.Details[model.AuditDetailStatus] == model.AuditStatusStarted
+	})).Run(func(args mock.Arguments) { entryID = args.Get(1).(*model.AuditEntry).ID }).Return(nil).Once()
+	s.mockRepo.On("AnonymizeUser", ctx, userID).Return(2, nil)
+	s.mockAuditRepo.On("UpdateDetails", ctx, mock.AnythingOfType("uuid.UUID"), map[string]string{
+		model.AuditDetailStatus: model.AuditStatusCompleted,
+		"anonymized_orders":     "2",
+	}).Return(nil).Once()
+
+	result, err := s.service.EraseUserData(ctx, admin, userID)
+
+	s.NoError(err)
+	s.Equal(userID, result.UserID)
+	s.Equal(2, result.AnonymizedOrders)
+	s.Equal(entryID, result.AuditEntryID)
+	s.mockAuditRepo.AssertCalled(s.T(), "UpdateDetails", ctx, entryID, mock.Anything)
+}
+
+func (s *OrderServiceTestSuite) TestEraseUserData_AuditFailureKeepsOrders() {
+	ctx := context.Background()
+	userID := uuid.New()
+
+	s.mockAuditRepo.On("Create", ctx, mock.AnythingOfType("*model.AuditEntry")).Return(errors.New("connection refused"))
+
+	result, err := s.service.EraseUserData(ctx, model.Actor{UserID: uuid.New(), Role: model.RoleAdmin}, userID)
+
+	s.Nil(result)
+	s.Error(err)
+	s.mockRepo.AssertNotCalled(s.T(), "AnonymizeUser", mock.Anything, mock.Anything)
+}
+
+func (s *OrderServiceTestSuite) TestEraseUserData_AnonymizeFailureMarksAuditEntry() {
+	ctx := context.Background()
+	userID := uuid.New()
+	anonymizeErr := errors.New("connection refused")
+
+	s.mockAuditRepo.On("Create", ctx, mock.AnythingOfType("*model.AuditEntry")).Return(nil)
+	s.mockRepo.On("AnonymizeUser", ctx, userID).Return(0, anonymizeErr)
+	s.mockAuditRepo.On("UpdateDetails", ctx, mock.AnythingOfType("uuid.UUID"), map[string]string{
+		model.AuditDetailStatus: model.AuditStatusFailed,
+	}).Return(nil)
+
+	result, err := s.service.EraseUserData(ctx, model.Actor{UserID: uuid.New(), Role: model.RoleAdmin}, userID)
+
+	s.Nil(result)
+	s.ErrorIs(err, anonymizeErr)
+}
+
+func (s *OrderServiceTestSuite) TestEraseUserData_ActiveOrders() {
+	ctx := context.Background()
+	userID := uuid.New()
+
+	s.mockAuditRepo.On("Create", ctx, mock.AnythingOfType("*model.AuditEntry")).Return(nil)
+	s.mockRepo.On("AnonymizeUser", ctx, userID).Return(0, model.ErrUserHasActiveOrders)
+	s.mockAuditRepo.On("UpdateDetails", ctx, mock.AnythingOfType("uuid.UUID"), map[string]string{
+		model.AuditDetailStatus: model.AuditStatusFailed,
+	}).Return(nil).Once()
+
+	result, err := s.service.EraseUserData(ctx, model.Actor{UserID: uuid.New(), Role: model.RoleAdmin}, userID)
+
+	s.Nil(result)
+	s.Equal(model.ErrUserHasActiveOrders, err)
+}
+
+func (s *OrderServiceTestSuite) TestEraseUserData_AdminRoleRequired() {
+	ctx := context.Background()
+
+	result, err := s.service.EraseUserData(ctx, model.Actor{UserID: uuid.New()}, uuid.New())
+
+	s.Nil(result)
+	s.ErrorIs(err, model.ErrAdminRoleRequired)
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"iter"
 
 	"github.com/google/uuid"
 
@@ -9,8 +10,14 @@ import (
 )
 
 type Service interface {
-	CreateOrder(ctx context.Context, userID uuid.UUID, partIDs []uuid.UUID) (*model.Order, error)
+	CreateOrder(ctx context.Context, userID uuid.UUID, partIDs []uuid.UUID, preferredWarehouseID uuid.UUID) (*model.Order, error)
 	GetOrder(ctx context.Context, orderID uuid.UUID) (*model.Order, error)
+	GetOrderByNumber(ctx context.Context, number string) (*model.Order, error)
 	PayOrder(ctx context.Context, orderID uuid.UUID, paymentMethod string) (*model.Order, error)
+	FulfillOrder(ctx context.Context, orderID uuid.UUID) (*model.Order, error)
 	CancelOrder(ctx context.Context, orderID uuid.UUID) error
+	ApproveOrder(ctx context.Context, orderID uuid.UUID, actor model.Actor) (*model.Order, error)
+	RejectOrder(ctx context.Context, orderID uuid.UUID, actor model.Actor, reason string) (*model.Order, error)
+	ExportUserOrders(ctx context.Context, actor model.Actor, userID uuid.UUID, format model.ExportFormat) (iter.Seq2[*model.Order, error], error)
+	EraseUserData(ctx context.Context, actor model.Actor, userID uuid.UUID) (*model.ErasureResult, error)
 }
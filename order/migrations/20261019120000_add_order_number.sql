@@ -0,0 +1,40 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS order_number_sequences (
+    year INTEGER PRIMARY KEY,
+    last_value BIGINT NOT NULL
+);
+
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS number VARCHAR(32);
+
+-- Нумеруем существующие заказы по дате создания внутри каждого года
+WITH numbered AS (
+    SELECT id,
+           EXTRACT(YEAR FROM created_at AT TIME ZONE 'UTC')::INTEGER AS year,
+           ROW_NUMBER() OVER (
+               PARTITION BY EXTRACT(YEAR FROM created_at AT TIME ZONE 'UTC')
+               ORDER BY created_at, id
+           ) AS seq
+    FROM orders
+)
+UPDATE orders
+SET number = 'RF-' || numbered.year || '-' || LPAD(numbered.seq::TEXT, GREATEST(6, LENGTH(numbered.seq::TEXT)), '0')
+FROM numbered
+WHERE orders.id = numbered.id;
+
+INSERT INTO order_number_sequences (year, last_value)
+SELECT EXTRACT(YEAR FROM created_at AT TIME ZONE 'UTC')::INTEGER, COUNT(*)
+FROM orders
+GROUP BY 1
+ON CONFLICT (year) DO UPDATE SET last_value = EXCLUDED.last_value;
+
+ALTER TABLE orders ALTER COLUMN number SET NOT NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_orders_number ON orders(number);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_orders_number;
+ALTER TABLE orders DROP COLUMN IF EXISTS number;
+DROP TABLE IF EXISTS order_number_sequences;
+-- +goose StatementEnd
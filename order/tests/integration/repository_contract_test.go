@@ -0,0 +1,44 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/bogdanovds/rocket_factory/order/internal/migrator"
+	"github.com/bogdanovds/rocket_factory/order/internal/repository"
+	"github.com/bogdanovds/rocket_factory/order/internal/repository/contracttest"
+	"github.com/bogdanovds/rocket_factory/order/internal/repository/postgres"
+	tcpostgres "github.com/bogdanovds/rocket_factory/platform/pkg/testcontainers/postgres"
+)
+
+// TestRepositoryContract проверяет PostgreSQL-репозиторий общим контрактным набором
+func TestRepositoryContract(t *testing.T) {
+	ctx := context.Background()
+
+	container, err := tcpostgres.NewContainer(ctx,
+		tcpostgres.WithDatabase("order_contract_test"),
+		tcpostgres.WithAuth("test", "test"),
+	)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, container.Terminate(ctx))
+	}()
+
+	err = migrator.New(container.DB()).UpEmbed()
+	require.NoError(t, err)
+
+	suite.Run(t, &contracttest.RepositorySuite{
+		NewRepository: func() repository.Repository {
+			// Каждый тест начинает с пустой таблицы заказов
+			_, err := container.DB().ExecContext(ctx, "TRUNCATE orders")
+			require.NoError(t, err)
+
+			return postgres.NewRepository(container.DB())
+		},
+	})
+}
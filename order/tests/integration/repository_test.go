@@ -99,6 +99,31 @@ func (s *RepositoryIntegrationTestSuite) TestCreate_WithTransactionID() {
 	s.Equal(transactionID, savedOrder.TransactionID)
 }
 
+func (s *RepositoryIntegrationTestSuite) TestCreate_WithPriceAdjustments() {
+	partID := uuid.New()
+	order := &model.Order{
+		ID:            uuid.New(),
+		UserID:        uuid.New(),
+		PartIDs:       []uuid.UUID{partID, partID},
+		SubtotalPrice: 200.00,
+		PriceAdjustments: []model.PriceAdjustment{
+			{RuleID: "vip-price-list", Description: "VIP price list", PartID: partID, Amount: -20.00},
+			{RuleID: "volume", Description: "volume discount", Amount: -9.00},
+		},
+		TotalPrice: 171.00,
+		Status:     model.OrderStatusPending,
+	}
+
+	err := s.repo.Create(s.ctx, order)
+	s.Require().NoError(err)
+
+	savedOrder, err := s.repo.Get(s.ctx, order.ID)
+	s.Require().NoError(err)
+	s.Equal(order.SubtotalPrice, savedOrder.SubtotalPrice)
+	s.Equal(order.PriceAdjustments, savedOrder.PriceAdjustments)
+	s.Equal(order.TotalPrice, savedOrder.TotalPrice)
+}
+
 func (s *RepositoryIntegrationTestSuite) TestGet_NotFound() {
 	nonExistentID := uuid.New()
 
@@ -153,7 +178,7 @@ func (s *RepositoryIntegrationTestSuite) TestUpdate_Success() {
 	order.PaymentMethod = "CARD"
 	order.TransactionID = uuid.New()
 
-	err = s.repo.Update(s.ctx, order)
+	err = s.repo.Update(s.ctx, order, model.OrderStatusPending)
 	s.Require().NoError(err)
 
 	// Проверяем обновление
@@ -174,7 +199,7 @@ func (s *RepositoryIntegrationTestSuite) TestUpdate_NotFound() {
 		PaymentMethod: "",
 	}
 
-	err := s.repo.Update(s.ctx, order)
+	err := s.repo.Update(s.ctx, order, model.OrderStatusPending)
 	s.ErrorIs(err, model.ErrOrderNotFound)
 }
 
@@ -193,7 +218,7 @@ func (s *RepositoryIntegrationTestSuite) TestUpdate_StatusChange() {
 
 	// Меняем статус на Cancelled
 	order.Status = model.OrderStatusCancelled
-	err = s.repo.Update(s.ctx, order)
+	err = s.repo.Update(s.ctx, order, model.OrderStatusPending)
 	s.Require().NoError(err)
 
 	updatedOrder, err := s.repo.Get(s.ctx, order.ID)
@@ -201,6 +226,31 @@ func (s *RepositoryIntegrationTestSuite) TestUpdate_StatusChange() {
 	s.Equal(model.OrderStatusCancelled, updatedOrder.Status)
 }
 
+func (s *RepositoryIntegrationTestSuite) TestUpdate_Rejection() {
+	order := &model.Order{
+		ID:         uuid.New(),
+		UserID:     uuid.New(),
+		PartIDs:    []uuid.UUID{uuid.New()},
+		TotalPrice: 7500000.00,
+		Status:     model.OrderStatusAwaitingApproval,
+	}
+
+	err := s.repo.Create(s.ctx, order)
+	s.Require().NoError(err)
+
+	order.Status = model.OrderStatusRejected
+	order.ReviewedBy = uuid.New()
+	order.RejectionReason = "Budget exhausted"
+	err = s.repo.Update(s.ctx, order, model.OrderStatusAwaitingApproval)
+	s.Require().NoError(err)
+
+	updatedOrder, err := s.repo.Get(s.ctx, order.ID)
+	s.Require().NoError(err)
+	s.Equal(model.OrderStatusRejected, updatedOrder.Status)
+	s.Equal(order.ReviewedBy, updatedOrder.ReviewedBy)
+	s.Equal("Budget exhausted", updatedOrder.RejectionReason)
+}
+
 func (s *RepositoryIntegrationTestSuite) TestCreateMultipleOrders() {
 	userID := uuid.New()
 
@@ -242,7 +292,30 @@ func (s *RepositoryIntegrationTestSuite) TestCreateMultipleOrders() {
 	}
 }
 
+func (s *RepositoryIntegrationTestSuite) TestAuditRepository_Create() {
+	auditRepo := postgres.NewAuditRepository(s.container.DB())
+	entry := &model.AuditEntry{
+		ID:            uuid.New(),
+		Action:        model.AuditActionUserDataErasure,
+		ActorID:       uuid.New(),
+		SubjectUserID: uuid.New(),
+		Details:       map[string]string{"anonymized_orders": "2"},
+	}
+
+	err := auditRepo.Create(s.ctx, entry)
+	s.Require().NoError(err)
+	s.False(entry.CreatedAt.IsZero())
+
+	var action string
+	var details []byte
+	err = s.container.DB().QueryRowContext(s.ctx,
+		"SELECT action, details FROM audit_log WHERE id = $1", entry.ID,
+	).Scan(&action, &details)
+	s.Require().NoError(err)
+	s.Equal(string(model.AuditActionUserDataErasure), action)
+	s.JSONEq(`{"anonymized_orders": "2"}`, string(details))
+}
+
 func TestRepositoryIntegrationTestSuite(t *testing.T) {
 	suite.Run(t, new(RepositoryIntegrationTestSuite))
 }
-
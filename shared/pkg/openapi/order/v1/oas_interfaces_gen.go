@@ -1,6 +1,10 @@
 // Code generated by ogen, DO NOT EDIT.
 package order_v1
 
+type ApproveOrderRes interface {
+	approveOrderRes()
+}
+
 type CancelOrderRes interface {
 	cancelOrderRes()
 }
@@ -9,6 +13,22 @@ type CreateOrderRes interface {
 	createOrderRes()
 }
 
+type EraseUserDataRes interface {
+	eraseUserDataRes()
+}
+
+type ExportUserOrdersRes interface {
+	exportUserOrdersRes()
+}
+
+type FulfillOrderRes interface {
+	fulfillOrderRes()
+}
+
+type GetOrderByNumberRes interface {
+	getOrderByNumberRes()
+}
+
 type GetOrderRes interface {
 	getOrderRes()
 }
@@ -16,3 +36,7 @@ type GetOrderRes interface {
 type PayOrderRes interface {
 	payOrderRes()
 }
+
+type RejectOrderRes interface {
+	rejectOrderRes()
+}
@@ -6,8 +6,14 @@ package order_v1
 type OperationName = string
 
 const (
-	CancelOrderOperation OperationName = "CancelOrder"
-	CreateOrderOperation OperationName = "CreateOrder"
-	GetOrderOperation    OperationName = "GetOrder"
-	PayOrderOperation    OperationName = "PayOrder"
+	ApproveOrderOperation     OperationName = "ApproveOrder"
+	CancelOrderOperation      OperationName = "CancelOrder"
+	CreateOrderOperation      OperationName = "CreateOrder"
+	EraseUserDataOperation    OperationName = "EraseUserData"
+	ExportUserOrdersOperation OperationName = "ExportUserOrders"
+	FulfillOrderOperation     OperationName = "FulfillOrder"
+	GetOrderOperation         OperationName = "GetOrder"
+	GetOrderByNumberOperation OperationName = "GetOrderByNumber"
+	PayOrderOperation         OperationName = "PayOrder"
+	RejectOrderOperation      OperationName = "RejectOrder"
 )
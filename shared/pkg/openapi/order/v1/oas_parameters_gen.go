@@ -16,13 +16,644 @@ import (
 	"github.com/ogen-go/ogen/validate"
 )
 
+// ApproveOrderParams is parameters of ApproveOrder operation.
+type ApproveOrderParams struct {
+	// UUID заказа.
+	OrderUUID uuid.UUID
+	// UUID пользователя, выполняющего действие. Как и X-User-Role,
+	// выставляется API-шлюзом
+	// и принимается только вместе с X-Gateway-Secret, равным
+	// HTTP_GATEWAY_SECRET.
+	XUserUUID uuid.UUID
+	// Роль пользователя, выполняющего действие (например,
+	// approver или admin).
+	// Сервис не аутентифицирует пользователей и доверяет
+	// этому заголовку: его должен выставлять
+	// API-шлюз по результатам аутентификации, перезаписывая
+	// значение клиента. Заголовок принимается
+	// только вместе с X-Gateway-Secret, равным HTTP_GATEWAY_SECRET, иначе 401;
+	// если секрет не задан,
+	// запрос с заголовком всегда отклоняется.
+	XUserRole string
+}
+
+func unpackApproveOrderParams(packed middleware.Parameters) (params ApproveOrderParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "order_uuid",
+			In:   "path",
+		}
+		params.OrderUUID = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "X-User-UUID",
+			In:   "header",
+		}
+		params.XUserUUID = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "X-User-Role",
+			In:   "header",
+		}
+		params.XUserRole = packed[key].(string)
+	}
+	return params
+}
+
+func decodeApproveOrderParams(args [1]string, argsEscaped bool, r *http.Request) (params ApproveOrderParams, _ error) {
+	h := uri.NewHeaderDecoder(r.Header)
+	// Decode path: order_uuid.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "order_uuid",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.OrderUUID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "order_uuid",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode header: X-User-UUID.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "X-User-UUID",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.XUserUUID = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "X-User-UUID",
+			In:   "header",
+			Err:  err,
+		}
+	}
+	// Decode header: X-User-Role.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "X-User-Role",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.XUserRole = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "X-User-Role",
+			In:   "header",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
 // CancelOrderParams is parameters of CancelOrder operation.
 type CancelOrderParams struct {
 	// UUID заказа.
 	OrderUUID uuid.UUID
 }
 
-func unpackCancelOrderParams(packed middleware.Parameters) (params CancelOrderParams) {
+func unpackCancelOrderParams(packed middleware.Parameters) (params CancelOrderParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "order_uuid",
+			In:   "path",
+		}
+		params.OrderUUID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeCancelOrderParams(args [1]string, argsEscaped bool, r *http.Request) (params CancelOrderParams, _ error) {
+	// Decode path: order_uuid.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "order_uuid",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.OrderUUID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "order_uuid",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// EraseUserDataParams is parameters of EraseUserData operation.
+type EraseUserDataParams struct {
+	// UUID пользователя, чьи данные выгружаются или удаляются.
+	UserUUID uuid.UUID
+	// UUID пользователя, выполняющего действие. Как и X-User-Role,
+	// выставляется API-шлюзом
+	// и принимается только вместе с X-Gateway-Secret, равным
+	// HTTP_GATEWAY_SECRET.
+	XUserUUID uuid.UUID
+	// Роль пользователя, выполняющего действие (например,
+	// approver или admin).
+	// Сервис не аутентифицирует пользователей и доверяет
+	// этому заголовку: его должен выставлять
+	// API-шлюз по результатам аутентификации, перезаписывая
+	// значение клиента. Заголовок принимается
+	// только вместе с X-Gateway-Secret, равным HTTP_GATEWAY_SECRET, иначе 401;
+	// если секрет не задан,
+	// запрос с заголовком всегда отклоняется.
+	XUserRole string
+}
+
+func unpackEraseUserDataParams(packed middleware.Parameters) (params EraseUserDataParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "user_uuid",
+			In:   "path",
+		}
+		params.UserUUID = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "X-User-UUID",
+			In:   "header",
+		}
+		params.XUserUUID = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "X-User-Role",
+			In:   "header",
+		}
+		params.XUserRole = packed[key].(string)
+	}
+	return params
+}
+
+func decodeEraseUserDataParams(args [1]string, argsEscaped bool, r *http.Request) (params EraseUserDataParams, _ error) {
+	h := uri.NewHeaderDecoder(r.Header)
+	// Decode path: user_uuid.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "user_uuid",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.UserUUID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "user_uuid",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode header: X-User-UUID.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "X-User-UUID",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.XUserUUID = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "X-User-UUID",
+			In:   "header",
+			Err:  err,
+		}
+	}
+	// Decode header: X-User-Role.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "X-User-Role",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.XUserRole = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "X-User-Role",
+			In:   "header",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ExportUserOrdersParams is parameters of ExportUserOrders operation.
+type ExportUserOrdersParams struct {
+	// UUID пользователя, чьи данные выгружаются или удаляются.
+	UserUUID uuid.UUID
+	// Формат выгрузки.
+	Format OptExportFormat
+	// UUID пользователя, выполняющего действие. Как и X-User-Role,
+	// выставляется API-шлюзом
+	// и принимается только вместе с X-Gateway-Secret, равным
+	// HTTP_GATEWAY_SECRET.
+	XUserUUID uuid.UUID
+	// Роль пользователя, выполняющего действие (например,
+	// approver или admin).
+	// Сервис не аутентифицирует пользователей и доверяет
+	// этому заголовку: его должен выставлять
+	// API-шлюз по результатам аутентификации, перезаписывая
+	// значение клиента. Заголовок принимается
+	// только вместе с X-Gateway-Secret, равным HTTP_GATEWAY_SECRET, иначе 401;
+	// если секрет не задан,
+	// запрос с заголовком всегда отклоняется.
+	XUserRole string
+}
+
+func unpackExportUserOrdersParams(packed middleware.Parameters) (params ExportUserOrdersParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "user_uuid",
+			In:   "path",
+		}
+		params.UserUUID = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "format",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Format = v.(OptExportFormat)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "X-User-UUID",
+			In:   "header",
+		}
+		params.XUserUUID = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "X-User-Role",
+			In:   "header",
+		}
+		params.XUserRole = packed[key].(string)
+	}
+	return params
+}
+
+func decodeExportUserOrdersParams(args [1]string, argsEscaped bool, r *http.Request) (params ExportUserOrdersParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	h := uri.NewHeaderDecoder(r.Header)
+	// Decode path: user_uuid.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "user_uuid",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.UserUUID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "user_uuid",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Set default value for query: format.
+	{
+		val := ExportFormat("jsonl")
+		params.Format.SetTo(val)
+	}
+	// Decode query: format.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "format",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotFormatVal ExportFormat
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotFormatVal = ExportFormat(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Format.SetTo(paramsDotFormatVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Format.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "format",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode header: X-User-UUID.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "X-User-UUID",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.XUserUUID = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "X-User-UUID",
+			In:   "header",
+			Err:  err,
+		}
+	}
+	// Decode header: X-User-Role.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "X-User-Role",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.XUserRole = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "X-User-Role",
+			In:   "header",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// FulfillOrderParams is parameters of FulfillOrder operation.
+type FulfillOrderParams struct {
+	// UUID заказа.
+	OrderUUID uuid.UUID
+}
+
+func unpackFulfillOrderParams(packed middleware.Parameters) (params FulfillOrderParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "order_uuid",
@@ -33,7 +664,7 @@ func unpackCancelOrderParams(packed middleware.Parameters) (params CancelOrderPa
 	return params
 }
 
-func decodeCancelOrderParams(args [1]string, argsEscaped bool, r *http.Request) (params CancelOrderParams, _ error) {
+func decodeFulfillOrderParams(args [1]string, argsEscaped bool, r *http.Request) (params FulfillOrderParams, _ error) {
 	// Decode path: order_uuid.
 	if err := func() error {
 		param := args[0]
@@ -148,6 +779,72 @@ func decodeGetOrderParams(args [1]string, argsEscaped bool, r *http.Request) (pa
 	return params, nil
 }
 
+// GetOrderByNumberParams is parameters of GetOrderByNumber operation.
+type GetOrderByNumberParams struct {
+	// Человекочитаемый номер заказа.
+	OrderNumber string
+}
+
+func unpackGetOrderByNumberParams(packed middleware.Parameters) (params GetOrderByNumberParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "order_number",
+			In:   "path",
+		}
+		params.OrderNumber = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetOrderByNumberParams(args [1]string, argsEscaped bool, r *http.Request) (params GetOrderByNumberParams, _ error) {
+	// Decode path: order_number.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "order_number",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.OrderNumber = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "order_number",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
 // PayOrderParams is parameters of PayOrder operation.
 type PayOrderParams struct {
 	// UUID заказа.
@@ -213,3 +910,167 @@ func decodePayOrderParams(args [1]string, argsEscaped bool, r *http.Request) (pa
 	}
 	return params, nil
 }
+
+// RejectOrderParams is parameters of RejectOrder operation.
+type RejectOrderParams struct {
+	// UUID заказа.
+	OrderUUID uuid.UUID
+	// UUID пользователя, выполняющего действие. Как и X-User-Role,
+	// выставляется API-шлюзом
+	// и принимается только вместе с X-Gateway-Secret, равным
+	// HTTP_GATEWAY_SECRET.
+	XUserUUID uuid.UUID
+	// Роль пользователя, выполняющего действие (например,
+	// approver или admin).
+	// Сервис не аутентифицирует пользователей и доверяет
+	// этому заголовку: его должен выставлять
+	// API-шлюз по результатам аутентификации, перезаписывая
+	// значение клиента. Заголовок принимается
+	// только вместе с X-Gateway-Secret, равным HTTP_GATEWAY_SECRET, иначе 401;
+	// если секрет не задан,
+	// запрос с заголовком всегда отклоняется.
+	XUserRole string
+}
+
+func unpackRejectOrderParams(packed middleware.Parameters) (params RejectOrderParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "order_uuid",
+			In:   "path",
+		}
+		params.OrderUUID = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "X-User-UUID",
+			In:   "header",
+		}
+		params.XUserUUID = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "X-User-Role",
+			In:   "header",
+		}
+		params.XUserRole = packed[key].(string)
+	}
+	return params
+}
+
+func decodeRejectOrderParams(args [1]string, argsEscaped bool, r *http.Request) (params RejectOrderParams, _ error) {
+	h := uri.NewHeaderDecoder(r.Header)
+	// Decode path: order_uuid.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "order_uuid",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.OrderUUID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "order_uuid",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode header: X-User-UUID.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "X-User-UUID",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.XUserUUID = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "X-User-UUID",
+			In:   "header",
+			Err:  err,
+		}
+	}
+	// Decode header: X-User-Role.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "X-User-Role",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.XUserRole = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "X-User-Role",
+			In:   "header",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
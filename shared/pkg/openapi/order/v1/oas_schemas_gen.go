@@ -3,10 +3,42 @@
 package order_v1
 
 import (
+	"io"
+
 	"github.com/go-faster/errors"
 	"github.com/google/uuid"
 )
 
+// Ref: #/components/schemas/bad_gateway_error
+type BadGatewayError struct {
+	// HTTP-код ошибки.
+	Code int `json:"code"`
+	// Описание ошибки.
+	Message string `json:"message"`
+}
+
+// GetCode returns the value of Code.
+func (s *BadGatewayError) GetCode() int {
+	return s.Code
+}
+
+// GetMessage returns the value of Message.
+func (s *BadGatewayError) GetMessage() string {
+	return s.Message
+}
+
+// SetCode sets the value of Code.
+func (s *BadGatewayError) SetCode(val int) {
+	s.Code = val
+}
+
+// SetMessage sets the value of Message.
+func (s *BadGatewayError) SetMessage(val string) {
+	s.Message = val
+}
+
+func (*BadGatewayError) fulfillOrderRes() {}
+
 // Ref: #/components/schemas/bad_request_error
 type BadRequestError struct {
 	// HTTP-код ошибки.
@@ -35,10 +67,16 @@ func (s *BadRequestError) SetMessage(val string) {
 	s.Message = val
 }
 
-func (*BadRequestError) cancelOrderRes() {}
-func (*BadRequestError) createOrderRes() {}
-func (*BadRequestError) getOrderRes()    {}
-func (*BadRequestError) payOrderRes()    {}
+func (*BadRequestError) approveOrderRes()     {}
+func (*BadRequestError) cancelOrderRes()      {}
+func (*BadRequestError) createOrderRes()      {}
+func (*BadRequestError) eraseUserDataRes()    {}
+func (*BadRequestError) exportUserOrdersRes() {}
+func (*BadRequestError) fulfillOrderRes()     {}
+func (*BadRequestError) getOrderByNumberRes() {}
+func (*BadRequestError) getOrderRes()         {}
+func (*BadRequestError) payOrderRes()         {}
+func (*BadRequestError) rejectOrderRes()      {}
 
 // CancelOrderNoContent is response for CancelOrder operation.
 type CancelOrderNoContent struct{}
@@ -73,8 +111,12 @@ func (s *ConflictError) SetMessage(val string) {
 	s.Message = val
 }
 
-func (*ConflictError) cancelOrderRes() {}
-func (*ConflictError) payOrderRes()    {}
+func (*ConflictError) approveOrderRes()  {}
+func (*ConflictError) cancelOrderRes()   {}
+func (*ConflictError) eraseUserDataRes() {}
+func (*ConflictError) fulfillOrderRes()  {}
+func (*ConflictError) payOrderRes()      {}
+func (*ConflictError) rejectOrderRes()   {}
 
 // Ref: #/components/schemas/create_order_request
 type CreateOrderRequest struct {
@@ -82,6 +124,9 @@ type CreateOrderRequest struct {
 	UserUUID uuid.UUID `json:"user_uuid"`
 	// Список UUID деталей.
 	PartUuids []uuid.UUID `json:"part_uuids"`
+	// UUID склада, с которого детали списываются в первую
+	// очередь после оплаты.
+	PreferredWarehouseUUID OptUUID `json:"preferred_warehouse_uuid"`
 }
 
 // GetUserUUID returns the value of UserUUID.
@@ -94,6 +139,11 @@ func (s *CreateOrderRequest) GetPartUuids() []uuid.UUID {
 	return s.PartUuids
 }
 
+// GetPreferredWarehouseUUID returns the value of PreferredWarehouseUUID.
+func (s *CreateOrderRequest) GetPreferredWarehouseUUID() OptUUID {
+	return s.PreferredWarehouseUUID
+}
+
 // SetUserUUID sets the value of UserUUID.
 func (s *CreateOrderRequest) SetUserUUID(val uuid.UUID) {
 	s.UserUUID = val
@@ -104,10 +154,22 @@ func (s *CreateOrderRequest) SetPartUuids(val []uuid.UUID) {
 	s.PartUuids = val
 }
 
+// SetPreferredWarehouseUUID sets the value of PreferredWarehouseUUID.
+func (s *CreateOrderRequest) SetPreferredWarehouseUUID(val OptUUID) {
+	s.PreferredWarehouseUUID = val
+}
+
 // Ref: #/components/schemas/create_order_response
 type CreateOrderResponse struct {
 	// UUID созданного заказа.
 	OrderUUID uuid.UUID `json:"order_uuid"`
+	// Человекочитаемый номер созданного заказа.
+	OrderNumber string `json:"order_number"`
+	// Стоимость заказа до применения правил
+	// ценообразования.
+	SubtotalPrice float64 `json:"subtotal_price"`
+	// Корректировки цены в порядке применения правил.
+	PriceAdjustments []PriceAdjustment `json:"price_adjustments"`
 	// Общая стоимость заказа.
 	TotalPrice float32 `json:"total_price"`
 }
@@ -117,6 +179,21 @@ func (s *CreateOrderResponse) GetOrderUUID() uuid.UUID {
 	return s.OrderUUID
 }
 
+// GetOrderNumber returns the value of OrderNumber.
+func (s *CreateOrderResponse) GetOrderNumber() string {
+	return s.OrderNumber
+}
+
+// GetSubtotalPrice returns the value of SubtotalPrice.
+func (s *CreateOrderResponse) GetSubtotalPrice() float64 {
+	return s.SubtotalPrice
+}
+
+// GetPriceAdjustments returns the value of PriceAdjustments.
+func (s *CreateOrderResponse) GetPriceAdjustments() []PriceAdjustment {
+	return s.PriceAdjustments
+}
+
 // GetTotalPrice returns the value of TotalPrice.
 func (s *CreateOrderResponse) GetTotalPrice() float32 {
 	return s.TotalPrice
@@ -127,6 +204,21 @@ func (s *CreateOrderResponse) SetOrderUUID(val uuid.UUID) {
 	s.OrderUUID = val
 }
 
+// SetOrderNumber sets the value of OrderNumber.
+func (s *CreateOrderResponse) SetOrderNumber(val string) {
+	s.OrderNumber = val
+}
+
+// SetSubtotalPrice sets the value of SubtotalPrice.
+func (s *CreateOrderResponse) SetSubtotalPrice(val float64) {
+	s.SubtotalPrice = val
+}
+
+// SetPriceAdjustments sets the value of PriceAdjustments.
+func (s *CreateOrderResponse) SetPriceAdjustments(val []PriceAdjustment) {
+	s.PriceAdjustments = val
+}
+
 // SetTotalPrice sets the value of TotalPrice.
 func (s *CreateOrderResponse) SetTotalPrice(val float32) {
 	s.TotalPrice = val
@@ -134,6 +226,157 @@ func (s *CreateOrderResponse) SetTotalPrice(val float32) {
 
 func (*CreateOrderResponse) createOrderRes() {}
 
+// Ref: #/components/schemas/erase_user_data_response
+type EraseUserDataResponse struct {
+	// UUID пользователя, чьи данные удалены.
+	UserUUID uuid.UUID `json:"user_uuid"`
+	// Количество обезличенных заказов.
+	AnonymizedOrders int `json:"anonymized_orders"`
+	// UUID записи в журнале аудита.
+	AuditEntryUUID uuid.UUID `json:"audit_entry_uuid"`
+}
+
+// GetUserUUID returns the value of UserUUID.
+func (s *EraseUserDataResponse) GetUserUUID() uuid.UUID {
+	return s.UserUUID
+}
+
+// GetAnonymizedOrders returns the value of AnonymizedOrders.
+func (s *EraseUserDataResponse) GetAnonymizedOrders() int {
+	return s.AnonymizedOrders
+}
+
+// GetAuditEntryUUID returns the value of AuditEntryUUID.
+func (s *EraseUserDataResponse) GetAuditEntryUUID() uuid.UUID {
+	return s.AuditEntryUUID
+}
+
+// SetUserUUID sets the value of UserUUID.
+func (s *EraseUserDataResponse) SetUserUUID(val uuid.UUID) {
+	s.UserUUID = val
+}
+
+// SetAnonymizedOrders sets the value of AnonymizedOrders.
+func (s *EraseUserDataResponse) SetAnonymizedOrders(val int) {
+	s.AnonymizedOrders = val
+}
+
+// SetAuditEntryUUID sets the value of AuditEntryUUID.
+func (s *EraseUserDataResponse) SetAuditEntryUUID(val uuid.UUID) {
+	s.AuditEntryUUID = val
+}
+
+func (*EraseUserDataResponse) eraseUserDataRes() {}
+
+// Формат выгрузки заказов (jsonl - JSON Lines, по одному заказу
+// на строку; csv - CSV с заголовком).
+// Ref: #/components/schemas/export_format
+type ExportFormat string
+
+const (
+	ExportFormatJsonl ExportFormat = "jsonl"
+	ExportFormatCsv   ExportFormat = "csv"
+)
+
+// AllValues returns all ExportFormat values.
+func (ExportFormat) AllValues() []ExportFormat {
+	return []ExportFormat{
+		ExportFormatJsonl,
+		ExportFormatCsv,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ExportFormat) MarshalText() ([]byte, error) {
+	switch s {
+	case ExportFormatJsonl:
+		return []byte(s), nil
+	case ExportFormatCsv:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ExportFormat) UnmarshalText(data []byte) error {
+	switch ExportFormat(data) {
+	case ExportFormatJsonl:
+		*s = ExportFormatJsonl
+		return nil
+	case ExportFormatCsv:
+		*s = ExportFormatCsv
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type ExportUserOrdersOKApplicationXNdjson struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s ExportUserOrdersOKApplicationXNdjson) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*ExportUserOrdersOKApplicationXNdjson) exportUserOrdersRes() {}
+
+type ExportUserOrdersOKTextCsv struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s ExportUserOrdersOKTextCsv) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*ExportUserOrdersOKTextCsv) exportUserOrdersRes() {}
+
+// Ref: #/components/schemas/forbidden_error
+type ForbiddenError struct {
+	// HTTP-код ошибки.
+	Code int `json:"code"`
+	// Описание ошибки.
+	Message string `json:"message"`
+}
+
+// GetCode returns the value of Code.
+func (s *ForbiddenError) GetCode() int {
+	return s.Code
+}
+
+// GetMessage returns the value of Message.
+func (s *ForbiddenError) GetMessage() string {
+	return s.Message
+}
+
+// SetCode sets the value of Code.
+func (s *ForbiddenError) SetCode(val int) {
+	s.Code = val
+}
+
+// SetMessage sets the value of Message.
+func (s *ForbiddenError) SetMessage(val string) {
+	s.Message = val
+}
+
+func (*ForbiddenError) approveOrderRes()     {}
+func (*ForbiddenError) eraseUserDataRes()    {}
+func (*ForbiddenError) exportUserOrdersRes() {}
+func (*ForbiddenError) rejectOrderRes()      {}
+
 // Ref: #/components/schemas/internal_server_error
 type InternalServerError struct {
 	// HTTP-код ошибки.
@@ -162,10 +405,16 @@ func (s *InternalServerError) SetMessage(val string) {
 	s.Message = val
 }
 
-func (*InternalServerError) cancelOrderRes() {}
-func (*InternalServerError) createOrderRes() {}
-func (*InternalServerError) getOrderRes()    {}
-func (*InternalServerError) payOrderRes()    {}
+func (*InternalServerError) approveOrderRes()     {}
+func (*InternalServerError) cancelOrderRes()      {}
+func (*InternalServerError) createOrderRes()      {}
+func (*InternalServerError) eraseUserDataRes()    {}
+func (*InternalServerError) exportUserOrdersRes() {}
+func (*InternalServerError) fulfillOrderRes()     {}
+func (*InternalServerError) getOrderByNumberRes() {}
+func (*InternalServerError) getOrderRes()         {}
+func (*InternalServerError) payOrderRes()         {}
+func (*InternalServerError) rejectOrderRes()      {}
 
 // Ref: #/components/schemas/not_found_error
 type NotFoundError struct {
@@ -195,10 +444,59 @@ func (s *NotFoundError) SetMessage(val string) {
 	s.Message = val
 }
 
-func (*NotFoundError) cancelOrderRes() {}
-func (*NotFoundError) createOrderRes() {}
-func (*NotFoundError) getOrderRes()    {}
-func (*NotFoundError) payOrderRes()    {}
+func (*NotFoundError) approveOrderRes()     {}
+func (*NotFoundError) cancelOrderRes()      {}
+func (*NotFoundError) fulfillOrderRes()     {}
+func (*NotFoundError) getOrderByNumberRes() {}
+func (*NotFoundError) getOrderRes()         {}
+func (*NotFoundError) payOrderRes()         {}
+func (*NotFoundError) rejectOrderRes()      {}
+
+// NewOptExportFormat returns new OptExportFormat with value set to v.
+func NewOptExportFormat(v ExportFormat) OptExportFormat {
+	return OptExportFormat{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptExportFormat is optional ExportFormat.
+type OptExportFormat struct {
+	Value ExportFormat
+	Set   bool
+}
+
+// IsSet returns true if OptExportFormat was set.
+func (o OptExportFormat) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptExportFormat) Reset() {
+	var v ExportFormat
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptExportFormat) SetTo(v ExportFormat) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptExportFormat) Get() (v ExportFormat, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptExportFormat) Or(d ExportFormat) ExportFormat {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
 
 // NewOptNilUUID returns new OptNilUUID with value set to v.
 func NewOptNilUUID(v uuid.UUID) OptNilUUID {
@@ -309,14 +607,113 @@ func (o OptPaymentMethod) Or(d PaymentMethod) PaymentMethod {
 	return d
 }
 
+// NewOptString returns new OptString with value set to v.
+func NewOptString(v string) OptString {
+	return OptString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptString is optional string.
+type OptString struct {
+	Value string
+	Set   bool
+}
+
+// IsSet returns true if OptString was set.
+func (o OptString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptString) SetTo(v string) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptString) Get() (v string, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptUUID returns new OptUUID with value set to v.
+func NewOptUUID(v uuid.UUID) OptUUID {
+	return OptUUID{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptUUID is optional uuid.UUID.
+type OptUUID struct {
+	Value uuid.UUID
+	Set   bool
+}
+
+// IsSet returns true if OptUUID was set.
+func (o OptUUID) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptUUID) Reset() {
+	var v uuid.UUID
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptUUID) SetTo(v uuid.UUID) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptUUID) Get() (v uuid.UUID, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptUUID) Or(d uuid.UUID) uuid.UUID {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
 // Ref: #/components/schemas/order_dto
 type OrderDto struct {
 	// UUID заказа.
 	OrderUUID uuid.UUID `json:"order_uuid"`
+	// Человекочитаемый номер заказа, уникальный в пределах
+	// года.
+	OrderNumber string `json:"order_number"`
 	// UUID пользователя.
 	UserUUID uuid.UUID `json:"user_uuid"`
 	// Список UUID деталей.
 	PartUuids []uuid.UUID `json:"part_uuids"`
+	// Стоимость до применения правил ценообразования.
+	SubtotalPrice float64 `json:"subtotal_price"`
+	// Корректировки цены в порядке применения правил.
+	PriceAdjustments []PriceAdjustment `json:"price_adjustments"`
 	// Общая стоимость.
 	TotalPrice float32 `json:"total_price"`
 	// UUID транзакции (если есть).
@@ -324,6 +721,14 @@ type OrderDto struct {
 	// Способ оплаты (если есть).
 	PaymentMethod OptPaymentMethod `json:"payment_method"`
 	Status        OrderStatus      `json:"status"`
+	// UUID согласующего, принявшего решение по заказу (если
+	// есть).
+	ReviewedBy OptUUID `json:"reviewed_by"`
+	// Причина отклонения заказа (если заказ отклонён).
+	RejectionReason OptString `json:"rejection_reason"`
+	// UUID предпочтительного склада для отгрузки (если
+	// указан).
+	PreferredWarehouseUUID OptUUID `json:"preferred_warehouse_uuid"`
 }
 
 // GetOrderUUID returns the value of OrderUUID.
@@ -331,6 +736,11 @@ func (s *OrderDto) GetOrderUUID() uuid.UUID {
 	return s.OrderUUID
 }
 
+// GetOrderNumber returns the value of OrderNumber.
+func (s *OrderDto) GetOrderNumber() string {
+	return s.OrderNumber
+}
+
 // GetUserUUID returns the value of UserUUID.
 func (s *OrderDto) GetUserUUID() uuid.UUID {
 	return s.UserUUID
@@ -341,6 +751,16 @@ func (s *OrderDto) GetPartUuids() []uuid.UUID {
 	return s.PartUuids
 }
 
+// GetSubtotalPrice returns the value of SubtotalPrice.
+func (s *OrderDto) GetSubtotalPrice() float64 {
+	return s.SubtotalPrice
+}
+
+// GetPriceAdjustments returns the value of PriceAdjustments.
+func (s *OrderDto) GetPriceAdjustments() []PriceAdjustment {
+	return s.PriceAdjustments
+}
+
 // GetTotalPrice returns the value of TotalPrice.
 func (s *OrderDto) GetTotalPrice() float32 {
 	return s.TotalPrice
@@ -361,11 +781,31 @@ func (s *OrderDto) GetStatus() OrderStatus {
 	return s.Status
 }
 
+// GetReviewedBy returns the value of ReviewedBy.
+func (s *OrderDto) GetReviewedBy() OptUUID {
+	return s.ReviewedBy
+}
+
+// GetRejectionReason returns the value of RejectionReason.
+func (s *OrderDto) GetRejectionReason() OptString {
+	return s.RejectionReason
+}
+
+// GetPreferredWarehouseUUID returns the value of PreferredWarehouseUUID.
+func (s *OrderDto) GetPreferredWarehouseUUID() OptUUID {
+	return s.PreferredWarehouseUUID
+}
+
 // SetOrderUUID sets the value of OrderUUID.
 func (s *OrderDto) SetOrderUUID(val uuid.UUID) {
 	s.OrderUUID = val
 }
 
+// SetOrderNumber sets the value of OrderNumber.
+func (s *OrderDto) SetOrderNumber(val string) {
+	s.OrderNumber = val
+}
+
 // SetUserUUID sets the value of UserUUID.
 func (s *OrderDto) SetUserUUID(val uuid.UUID) {
 	s.UserUUID = val
@@ -376,6 +816,16 @@ func (s *OrderDto) SetPartUuids(val []uuid.UUID) {
 	s.PartUuids = val
 }
 
+// SetSubtotalPrice sets the value of SubtotalPrice.
+func (s *OrderDto) SetSubtotalPrice(val float64) {
+	s.SubtotalPrice = val
+}
+
+// SetPriceAdjustments sets the value of PriceAdjustments.
+func (s *OrderDto) SetPriceAdjustments(val []PriceAdjustment) {
+	s.PriceAdjustments = val
+}
+
 // SetTotalPrice sets the value of TotalPrice.
 func (s *OrderDto) SetTotalPrice(val float32) {
 	s.TotalPrice = val
@@ -396,25 +846,48 @@ func (s *OrderDto) SetStatus(val OrderStatus) {
 	s.Status = val
 }
 
-func (*OrderDto) getOrderRes() {}
+// SetReviewedBy sets the value of ReviewedBy.
+func (s *OrderDto) SetReviewedBy(val OptUUID) {
+	s.ReviewedBy = val
+}
+
+// SetRejectionReason sets the value of RejectionReason.
+func (s *OrderDto) SetRejectionReason(val OptString) {
+	s.RejectionReason = val
+}
+
+// SetPreferredWarehouseUUID sets the value of PreferredWarehouseUUID.
+func (s *OrderDto) SetPreferredWarehouseUUID(val OptUUID) {
+	s.PreferredWarehouseUUID = val
+}
+
+func (*OrderDto) approveOrderRes()     {}
+func (*OrderDto) fulfillOrderRes()     {}
+func (*OrderDto) getOrderByNumberRes() {}
+func (*OrderDto) getOrderRes()         {}
+func (*OrderDto) rejectOrderRes()      {}
 
 // Статус заказа.
 // Ref: #/components/schemas/order_status
 type OrderStatus string
 
 const (
-	OrderStatusUNKNOWN        OrderStatus = "UNKNOWN"
-	OrderStatusPENDINGPAYMENT OrderStatus = "PENDING_PAYMENT"
-	OrderStatusPAID           OrderStatus = "PAID"
-	OrderStatusCANCELLED      OrderStatus = "CANCELLED"
-	OrderStatusFULFILLED      OrderStatus = "FULFILLED"
+	OrderStatusUNKNOWN          OrderStatus = "UNKNOWN"
+	OrderStatusAWAITINGAPPROVAL OrderStatus = "AWAITING_APPROVAL"
+	OrderStatusPENDINGPAYMENT   OrderStatus = "PENDING_PAYMENT"
+	OrderStatusREJECTED         OrderStatus = "REJECTED"
+	OrderStatusPAID             OrderStatus = "PAID"
+	OrderStatusCANCELLED        OrderStatus = "CANCELLED"
+	OrderStatusFULFILLED        OrderStatus = "FULFILLED"
 )
 
 // AllValues returns all OrderStatus values.
 func (OrderStatus) AllValues() []OrderStatus {
 	return []OrderStatus{
 		OrderStatusUNKNOWN,
+		OrderStatusAWAITINGAPPROVAL,
 		OrderStatusPENDINGPAYMENT,
+		OrderStatusREJECTED,
 		OrderStatusPAID,
 		OrderStatusCANCELLED,
 		OrderStatusFULFILLED,
@@ -426,8 +899,12 @@ func (s OrderStatus) MarshalText() ([]byte, error) {
 	switch s {
 	case OrderStatusUNKNOWN:
 		return []byte(s), nil
+	case OrderStatusAWAITINGAPPROVAL:
+		return []byte(s), nil
 	case OrderStatusPENDINGPAYMENT:
 		return []byte(s), nil
+	case OrderStatusREJECTED:
+		return []byte(s), nil
 	case OrderStatusPAID:
 		return []byte(s), nil
 	case OrderStatusCANCELLED:
@@ -445,9 +922,15 @@ func (s *OrderStatus) UnmarshalText(data []byte) error {
 	case OrderStatusUNKNOWN:
 		*s = OrderStatusUNKNOWN
 		return nil
+	case OrderStatusAWAITINGAPPROVAL:
+		*s = OrderStatusAWAITINGAPPROVAL
+		return nil
 	case OrderStatusPENDINGPAYMENT:
 		*s = OrderStatusPENDINGPAYMENT
 		return nil
+	case OrderStatusREJECTED:
+		*s = OrderStatusREJECTED
+		return nil
 	case OrderStatusPAID:
 		*s = OrderStatusPAID
 		return nil
@@ -462,6 +945,177 @@ func (s *OrderStatus) UnmarshalText(data []byte) error {
 	}
 }
 
+// Ref: #/components/schemas/part_not_orderable_error
+type PartNotOrderableError struct {
+	// HTTP-код ошибки.
+	Code int `json:"code"`
+	// Описание ошибки.
+	Message string `json:"message"`
+	// Деталь, которую нельзя заказать.
+	PartUUID uuid.UUID `json:"part_uuid"`
+	// Состояние детали в жизненном цикле.
+	LifecycleState PartNotOrderableErrorLifecycleState `json:"lifecycle_state"`
+	// Рекомендуемая замена детали, если inventory её указал.
+	ReplacementPartUUID OptUUID `json:"replacement_part_uuid"`
+	// Название рекомендуемой замены, если inventory нашёл эту
+	// деталь.
+	ReplacementPartName OptString `json:"replacement_part_name"`
+}
+
+// GetCode returns the value of Code.
+func (s *PartNotOrderableError) GetCode() int {
+	return s.Code
+}
+
+// GetMessage returns the value of Message.
+func (s *PartNotOrderableError) GetMessage() string {
+	return s.Message
+}
+
+// GetPartUUID returns the value of PartUUID.
+func (s *PartNotOrderableError) GetPartUUID() uuid.UUID {
+	return s.PartUUID
+}
+
+// GetLifecycleState returns the value of LifecycleState.
+func (s *PartNotOrderableError) GetLifecycleState() PartNotOrderableErrorLifecycleState {
+	return s.LifecycleState
+}
+
+// GetReplacementPartUUID returns the value of ReplacementPartUUID.
+func (s *PartNotOrderableError) GetReplacementPartUUID() OptUUID {
+	return s.ReplacementPartUUID
+}
+
+// GetReplacementPartName returns the value of ReplacementPartName.
+func (s *PartNotOrderableError) GetReplacementPartName() OptString {
+	return s.ReplacementPartName
+}
+
+// SetCode sets the value of Code.
+func (s *PartNotOrderableError) SetCode(val int) {
+	s.Code = val
+}
+
+// SetMessage sets the value of Message.
+func (s *PartNotOrderableError) SetMessage(val string) {
+	s.Message = val
+}
+
+// SetPartUUID sets the value of PartUUID.
+func (s *PartNotOrderableError) SetPartUUID(val uuid.UUID) {
+	s.PartUUID = val
+}
+
+// SetLifecycleState sets the value of LifecycleState.
+func (s *PartNotOrderableError) SetLifecycleState(val PartNotOrderableErrorLifecycleState) {
+	s.LifecycleState = val
+}
+
+// SetReplacementPartUUID sets the value of ReplacementPartUUID.
+func (s *PartNotOrderableError) SetReplacementPartUUID(val OptUUID) {
+	s.ReplacementPartUUID = val
+}
+
+// SetReplacementPartName sets the value of ReplacementPartName.
+func (s *PartNotOrderableError) SetReplacementPartName(val OptString) {
+	s.ReplacementPartName = val
+}
+
+func (*PartNotOrderableError) createOrderRes() {}
+
+// Состояние детали в жизненном цикле.
+type PartNotOrderableErrorLifecycleState string
+
+const (
+	PartNotOrderableErrorLifecycleStateDRAFT        PartNotOrderableErrorLifecycleState = "DRAFT"
+	PartNotOrderableErrorLifecycleStateDISCONTINUED PartNotOrderableErrorLifecycleState = "DISCONTINUED"
+	PartNotOrderableErrorLifecycleStateRECALLED     PartNotOrderableErrorLifecycleState = "RECALLED"
+)
+
+// AllValues returns all PartNotOrderableErrorLifecycleState values.
+func (PartNotOrderableErrorLifecycleState) AllValues() []PartNotOrderableErrorLifecycleState {
+	return []PartNotOrderableErrorLifecycleState{
+		PartNotOrderableErrorLifecycleStateDRAFT,
+		PartNotOrderableErrorLifecycleStateDISCONTINUED,
+		PartNotOrderableErrorLifecycleStateRECALLED,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s PartNotOrderableErrorLifecycleState) MarshalText() ([]byte, error) {
+	switch s {
+	case PartNotOrderableErrorLifecycleStateDRAFT:
+		return []byte(s), nil
+	case PartNotOrderableErrorLifecycleStateDISCONTINUED:
+		return []byte(s), nil
+	case PartNotOrderableErrorLifecycleStateRECALLED:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *PartNotOrderableErrorLifecycleState) UnmarshalText(data []byte) error {
+	switch PartNotOrderableErrorLifecycleState(data) {
+	case PartNotOrderableErrorLifecycleStateDRAFT:
+		*s = PartNotOrderableErrorLifecycleStateDRAFT
+		return nil
+	case PartNotOrderableErrorLifecycleStateDISCONTINUED:
+		*s = PartNotOrderableErrorLifecycleStateDISCONTINUED
+		return nil
+	case PartNotOrderableErrorLifecycleStateRECALLED:
+		*s = PartNotOrderableErrorLifecycleStateRECALLED
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/parts_not_found_error
+type PartsNotFoundError struct {
+	// HTTP-код ошибки.
+	Code int `json:"code"`
+	// Описание ошибки.
+	Message string `json:"message"`
+	// Детали заказа, которых нет в inventory. Не заполняется,
+	// если не найден склад.
+	MissingPartUuids []uuid.UUID `json:"missing_part_uuids"`
+}
+
+// GetCode returns the value of Code.
+func (s *PartsNotFoundError) GetCode() int {
+	return s.Code
+}
+
+// GetMessage returns the value of Message.
+func (s *PartsNotFoundError) GetMessage() string {
+	return s.Message
+}
+
+// GetMissingPartUuids returns the value of MissingPartUuids.
+func (s *PartsNotFoundError) GetMissingPartUuids() []uuid.UUID {
+	return s.MissingPartUuids
+}
+
+// SetCode sets the value of Code.
+func (s *PartsNotFoundError) SetCode(val int) {
+	s.Code = val
+}
+
+// SetMessage sets the value of Message.
+func (s *PartsNotFoundError) SetMessage(val string) {
+	s.Message = val
+}
+
+// SetMissingPartUuids sets the value of MissingPartUuids.
+func (s *PartsNotFoundError) SetMissingPartUuids(val []uuid.UUID) {
+	s.MissingPartUuids = val
+}
+
+func (*PartsNotFoundError) createOrderRes() {}
+
 // Ref: #/components/schemas/pay_order_request
 type PayOrderRequest struct {
 	// Выбранный способ оплаты.
@@ -481,7 +1135,8 @@ func (s *PayOrderRequest) SetPaymentMethod(val PaymentMethod) {
 // Ref: #/components/schemas/pay_order_response
 type PayOrderResponse struct {
 	// UUID созданной транзакции.
-	TransactionUUID uuid.UUID `json:"transaction_uuid"`
+	TransactionUUID uuid.UUID   `json:"transaction_uuid"`
+	Status          OrderStatus `json:"status"`
 }
 
 // GetTransactionUUID returns the value of TransactionUUID.
@@ -489,11 +1144,21 @@ func (s *PayOrderResponse) GetTransactionUUID() uuid.UUID {
 	return s.TransactionUUID
 }
 
+// GetStatus returns the value of Status.
+func (s *PayOrderResponse) GetStatus() OrderStatus {
+	return s.Status
+}
+
 // SetTransactionUUID sets the value of TransactionUUID.
 func (s *PayOrderResponse) SetTransactionUUID(val uuid.UUID) {
 	s.TransactionUUID = val
 }
 
+// SetStatus sets the value of Status.
+func (s *PayOrderResponse) SetStatus(val OrderStatus) {
+	s.Status = val
+}
+
 func (*PayOrderResponse) payOrderRes() {}
 
 // Способ оплаты.
@@ -559,3 +1224,107 @@ func (s *PaymentMethod) UnmarshalText(data []byte) error {
 		return errors.Errorf("invalid value: %q", data)
 	}
 }
+
+// Ref: #/components/schemas/price_adjustment
+type PriceAdjustment struct {
+	// Идентификатор применённого правила ценообразования.
+	RuleID string `json:"rule_id"`
+	// Объяснение корректировки.
+	Description string `json:"description"`
+	// UUID детали, к которой относится корректировка.
+	// Отсутствует, если корректировка относится ко всему
+	// заказу.
+	PartUUID OptUUID `json:"part_uuid"`
+	// Сумма корректировки. Отрицательное значение
+	// означает скидку.
+	Amount float64 `json:"amount"`
+}
+
+// GetRuleID returns the value of RuleID.
+func (s *PriceAdjustment) GetRuleID() string {
+	return s.RuleID
+}
+
+// GetDescription returns the value of Description.
+func (s *PriceAdjustment) GetDescription() string {
+	return s.Description
+}
+
+// GetPartUUID returns the value of PartUUID.
+func (s *PriceAdjustment) GetPartUUID() OptUUID {
+	return s.PartUUID
+}
+
+// GetAmount returns the value of Amount.
+func (s *PriceAdjustment) GetAmount() float64 {
+	return s.Amount
+}
+
+// SetRuleID sets the value of RuleID.
+func (s *PriceAdjustment) SetRuleID(val string) {
+	s.RuleID = val
+}
+
+// SetDescription sets the value of Description.
+func (s *PriceAdjustment) SetDescription(val string) {
+	s.Description = val
+}
+
+// SetPartUUID sets the value of PartUUID.
+func (s *PriceAdjustment) SetPartUUID(val OptUUID) {
+	s.PartUUID = val
+}
+
+// SetAmount sets the value of Amount.
+func (s *PriceAdjustment) SetAmount(val float64) {
+	s.Amount = val
+}
+
+// Ref: #/components/schemas/reject_order_request
+type RejectOrderRequest struct {
+	// Причина отклонения заказа.
+	Reason string `json:"reason"`
+}
+
+// GetReason returns the value of Reason.
+func (s *RejectOrderRequest) GetReason() string {
+	return s.Reason
+}
+
+// SetReason sets the value of Reason.
+func (s *RejectOrderRequest) SetReason(val string) {
+	s.Reason = val
+}
+
+// Ref: #/components/schemas/unauthorized_error
+type UnauthorizedError struct {
+	// HTTP-код ошибки.
+	Code int `json:"code"`
+	// Описание ошибки.
+	Message string `json:"message"`
+}
+
+// GetCode returns the value of Code.
+func (s *UnauthorizedError) GetCode() int {
+	return s.Code
+}
+
+// GetMessage returns the value of Message.
+func (s *UnauthorizedError) GetMessage() string {
+	return s.Message
+}
+
+// SetCode sets the value of Code.
+func (s *UnauthorizedError) SetCode(val int) {
+	s.Code = val
+}
+
+// SetMessage sets the value of Message.
+func (s *UnauthorizedError) SetMessage(val string) {
+	s.Message = val
+}
+
+func (*UnauthorizedError) approveOrderRes()     {}
+func (*UnauthorizedError) eraseUserDataRes()    {}
+func (*UnauthorizedError) exportUserOrdersRes() {}
+func (*UnauthorizedError) rejectOrderRes()      {}
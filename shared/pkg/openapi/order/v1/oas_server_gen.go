@@ -8,6 +8,13 @@ import (
 
 // Handler handles operations described by OpenAPI v3 specification.
 type Handler interface {
+	// ApproveOrder implements ApproveOrder operation.
+	//
+	// Согласует заказ, сумма которого превышает порог
+	// согласования. Доступно только роли approver.
+	//
+	// POST /orders/{order_uuid}/approve
+	ApproveOrder(ctx context.Context, params ApproveOrderParams) (ApproveOrderRes, error)
 	// CancelOrder implements CancelOrder operation.
 	//
 	// Отменяет заказ, если он ещё не оплачен.
@@ -21,18 +28,67 @@ type Handler interface {
 	//
 	// POST /orders
 	CreateOrder(ctx context.Context, req *CreateOrderRequest) (CreateOrderRes, error)
+	// EraseUserData implements EraseUserData operation.
+	//
+	// Обезличивает все заказы пользователя: user_uuid
+	// заменяется нулевым UUID, финансовые данные заказов
+	// сохраняются.
+	// Недоступно, пока у пользователя есть незавершённые
+	// заказы. Доступно только роли admin,
+	// каждое удаление фиксируется в журнале аудита: запись
+	// создаётся до обезличивания и получает
+	// статус COMPLETED или FAILED. Повторный запрос безопасен.
+	//
+	// POST /admin/users/{user_uuid}/erase
+	EraseUserData(ctx context.Context, params EraseUserDataParams) (EraseUserDataRes, error)
+	// ExportUserOrders implements ExportUserOrders operation.
+	//
+	// Потоково выгружает все заказы пользователя в формате
+	// JSON Lines или CSV.
+	// Доступно только роли admin, каждая выгрузка фиксируется
+	// в журнале аудита.
+	//
+	// GET /admin/users/{user_uuid}/orders/export
+	ExportUserOrders(ctx context.Context, params ExportUserOrdersParams) (ExportUserOrdersRes, error)
+	// FulfillOrder implements FulfillOrder operation.
+	//
+	// Списывает детали оплаченного заказа со складов и
+	// переводит его в FULFILLED.
+	// Нужна, если при оплате списать детали не удалось и
+	// заказ остался в статусе PAID.
+	//
+	// POST /orders/{order_uuid}/fulfill
+	FulfillOrder(ctx context.Context, params FulfillOrderParams) (FulfillOrderRes, error)
 	// GetOrder implements GetOrder operation.
 	//
 	// Возвращает информацию о заказе по его UUID.
 	//
 	// GET /orders/{order_uuid}
 	GetOrder(ctx context.Context, params GetOrderParams) (GetOrderRes, error)
+	// GetOrderByNumber implements GetOrderByNumber operation.
+	//
+	// Возвращает информацию о заказе по его
+	// человекочитаемому номеру (например, RF-2026-000123).
+	//
+	// GET /orders/by-number/{order_number}
+	GetOrderByNumber(ctx context.Context, params GetOrderByNumberParams) (GetOrderByNumberRes, error)
 	// PayOrder implements PayOrder operation.
 	//
-	// Проводит оплату ранее созданного заказа.
+	// Проводит оплату ранее созданного заказа и списывает
+	// его детали со складов.
+	// Если списать детали не удалось, оплата не отменяется:
+	// ответ содержит статус PAID,
+	// и отгрузку нужно повторить через POST /orders/{order_uuid}/fulfill.
 	//
 	// POST /orders/{order_uuid}/pay
 	PayOrder(ctx context.Context, req *PayOrderRequest, params PayOrderParams) (PayOrderRes, error)
+	// RejectOrder implements RejectOrder operation.
+	//
+	// Отклоняет заказ, ожидающий согласования, с указанием
+	// причины. Доступно только роли approver.
+	//
+	// POST /orders/{order_uuid}/reject
+	RejectOrder(ctx context.Context, req *RejectOrderRequest, params RejectOrderParams) (RejectOrderRes, error)
 }
 
 // Server implements http server based on OpenAPI v3 specification and
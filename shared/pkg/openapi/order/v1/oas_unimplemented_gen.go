@@ -13,6 +13,16 @@ type UnimplementedHandler struct{}
 
 var _ Handler = UnimplementedHandler{}
 
+// ApproveOrder implements ApproveOrder operation.
+//
+// Согласует заказ, сумма которого превышает порог
+// согласования. Доступно только роли approver.
+//
+// POST /orders/{order_uuid}/approve
+func (UnimplementedHandler) ApproveOrder(ctx context.Context, params ApproveOrderParams) (r ApproveOrderRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // CancelOrder implements CancelOrder operation.
 //
 // Отменяет заказ, если он ещё не оплачен.
@@ -32,6 +42,46 @@ func (UnimplementedHandler) CreateOrder(ctx context.Context, req *CreateOrderReq
 	return r, ht.ErrNotImplemented
 }
 
+// EraseUserData implements EraseUserData operation.
+//
+// Обезличивает все заказы пользователя: user_uuid
+// заменяется нулевым UUID, финансовые данные заказов
+// сохраняются.
+// Недоступно, пока у пользователя есть незавершённые
+// заказы. Доступно только роли admin,
+// каждое удаление фиксируется в журнале аудита: запись
+// создаётся до обезличивания и получает
+// статус COMPLETED или FAILED. Повторный запрос безопасен.
+//
+// POST /admin/users/{user_uuid}/erase
+func (UnimplementedHandler) EraseUserData(ctx context.Context, params EraseUserDataParams) (r EraseUserDataRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ExportUserOrders implements ExportUserOrders operation.
+//
+// Потоково выгружает все заказы пользователя в формате
+// JSON Lines или CSV.
+// Доступно только роли admin, каждая выгрузка фиксируется
+// в журнале аудита.
+//
+// GET /admin/users/{user_uuid}/orders/export
+func (UnimplementedHandler) ExportUserOrders(ctx context.Context, params ExportUserOrdersParams) (r ExportUserOrdersRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// FulfillOrder implements FulfillOrder operation.
+//
+// Списывает детали оплаченного заказа со складов и
+// переводит его в FULFILLED.
+// Нужна, если при оплате списать детали не удалось и
+// заказ остался в статусе PAID.
+//
+// POST /orders/{order_uuid}/fulfill
+func (UnimplementedHandler) FulfillOrder(ctx context.Context, params FulfillOrderParams) (r FulfillOrderRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetOrder implements GetOrder operation.
 //
 // Возвращает информацию о заказе по его UUID.
@@ -41,11 +91,35 @@ func (UnimplementedHandler) GetOrder(ctx context.Context, params GetOrderParams)
 	return r, ht.ErrNotImplemented
 }
 
+// GetOrderByNumber implements GetOrderByNumber operation.
+//
+// Возвращает информацию о заказе по его
+// человекочитаемому номеру (например, RF-2026-000123).
+//
+// GET /orders/by-number/{order_number}
+func (UnimplementedHandler) GetOrderByNumber(ctx context.Context, params GetOrderByNumberParams) (r GetOrderByNumberRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // PayOrder implements PayOrder operation.
 //
-// Проводит оплату ранее созданного заказа.
+// Проводит оплату ранее созданного заказа и списывает
+// его детали со складов.
+// Если списать детали не удалось, оплата не отменяется:
+// ответ содержит статус PAID,
+// и отгрузку нужно повторить через POST /orders/{order_uuid}/fulfill.
 //
 // POST /orders/{order_uuid}/pay
 func (UnimplementedHandler) PayOrder(ctx context.Context, req *PayOrderRequest, params PayOrderParams) (r PayOrderRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
+
+// RejectOrder implements RejectOrder operation.
+//
+// Отклоняет заказ, ожидающий согласования, с указанием
+// причины. Доступно только роли approver.
+//
+// POST /orders/{order_uuid}/reject
+func (UnimplementedHandler) RejectOrder(ctx context.Context, req *RejectOrderRequest, params RejectOrderParams) (r RejectOrderRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
@@ -9,6 +9,7 @@ package inventory_v1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -22,6 +23,393 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Поля, по которым можно сортировать список деталей
+type PartsOrderField int32
+
+const (
+	// Сортировка только по UUID (по умолчанию)
+	PartsOrderField_PARTS_ORDER_FIELD_UNSPECIFIED PartsOrderField = 0
+	// Цена за единицу
+	PartsOrderField_PARTS_ORDER_FIELD_PRICE PartsOrderField = 1
+	// Название детали
+	PartsOrderField_PARTS_ORDER_FIELD_NAME PartsOrderField = 2
+	// Дата создания записи
+	PartsOrderField_PARTS_ORDER_FIELD_CREATED_AT PartsOrderField = 3
+	// Количество на складе
+	PartsOrderField_PARTS_ORDER_FIELD_STOCK_QUANTITY PartsOrderField = 4
+	// Релевантность полнотекстовому запросу, самые релевантные первыми. Требует filter.query.
+	PartsOrderField_PARTS_ORDER_FIELD_RELEVANCE PartsOrderField = 5
+)
+
+// Enum value maps for PartsOrderField.
+var (
+	PartsOrderField_name = map[int32]string{
+		0: "PARTS_ORDER_FIELD_UNSPECIFIED",
+		1: "PARTS_ORDER_FIELD_PRICE",
+		2: "PARTS_ORDER_FIELD_NAME",
+		3: "PARTS_ORDER_FIELD_CREATED_AT",
+		4: "PARTS_ORDER_FIELD_STOCK_QUANTITY",
+		5: "PARTS_ORDER_FIELD_RELEVANCE",
+	}
+	PartsOrderField_value = map[string]int32{
+		"PARTS_ORDER_FIELD_UNSPECIFIED":    0,
+		"PARTS_ORDER_FIELD_PRICE":          1,
+		"PARTS_ORDER_FIELD_NAME":           2,
+		"PARTS_ORDER_FIELD_CREATED_AT":     3,
+		"PARTS_ORDER_FIELD_STOCK_QUANTITY": 4,
+		"PARTS_ORDER_FIELD_RELEVANCE":      5,
+	}
+)
+
+func (x PartsOrderField) Enum() *PartsOrderField {
+	p := new(PartsOrderField)
+	*p = x
+	return p
+}
+
+func (x PartsOrderField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PartsOrderField) Descriptor() protoreflect.EnumDescriptor {
+	return file_inventory_v1_inventory_proto_enumTypes[0].Descriptor()
+}
+
+func (PartsOrderField) Type() protoreflect.EnumType {
+	return &file_inventory_v1_inventory_proto_enumTypes[0]
+}
+
+func (x PartsOrderField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PartsOrderField.Descriptor instead.
+func (PartsOrderField) EnumDescriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{0}
+}
+
+// Состояния жизненного цикла детали
+type PartLifecycleState int32
+
+const (
+	// Не указано. При создании детали означает ACTIVE.
+	PartLifecycleState_PART_LIFECYCLE_STATE_UNSPECIFIED PartLifecycleState = 0
+	// Черновик: деталь заводится в каталог, но ещё не продаётся
+	PartLifecycleState_PART_LIFECYCLE_STATE_DRAFT PartLifecycleState = 1
+	// Действующая деталь, доступна для заказа
+	PartLifecycleState_PART_LIFECYCLE_STATE_ACTIVE PartLifecycleState = 2
+	// Снята с производства, заказать нельзя
+	PartLifecycleState_PART_LIFECYCLE_STATE_DISCONTINUED PartLifecycleState = 3
+	// Отозвана из-за дефекта, заказать нельзя
+	PartLifecycleState_PART_LIFECYCLE_STATE_RECALLED PartLifecycleState = 4
+)
+
+// Enum value maps for PartLifecycleState.
+var (
+	PartLifecycleState_name = map[int32]string{
+		0: "PART_LIFECYCLE_STATE_UNSPECIFIED",
+		1: "PART_LIFECYCLE_STATE_DRAFT",
+		2: "PART_LIFECYCLE_STATE_ACTIVE",
+		3: "PART_LIFECYCLE_STATE_DISCONTINUED",
+		4: "PART_LIFECYCLE_STATE_RECALLED",
+	}
+	PartLifecycleState_value = map[string]int32{
+		"PART_LIFECYCLE_STATE_UNSPECIFIED":  0,
+		"PART_LIFECYCLE_STATE_DRAFT":        1,
+		"PART_LIFECYCLE_STATE_ACTIVE":       2,
+		"PART_LIFECYCLE_STATE_DISCONTINUED": 3,
+		"PART_LIFECYCLE_STATE_RECALLED":     4,
+	}
+)
+
+func (x PartLifecycleState) Enum() *PartLifecycleState {
+	p := new(PartLifecycleState)
+	*p = x
+	return p
+}
+
+func (x PartLifecycleState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PartLifecycleState) Descriptor() protoreflect.EnumDescriptor {
+	return file_inventory_v1_inventory_proto_enumTypes[1].Descriptor()
+}
+
+func (PartLifecycleState) Type() protoreflect.EnumType {
+	return &file_inventory_v1_inventory_proto_enumTypes[1]
+}
+
+func (x PartLifecycleState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PartLifecycleState.Descriptor instead.
+func (PartLifecycleState) EnumDescriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{1}
+}
+
+// Причины движения остатка
+type StockMovementReason int32
+
+const (
+	// Не указана (недопустима)
+	StockMovementReason_STOCK_MOVEMENT_REASON_UNSPECIFIED StockMovementReason = 0
+	// Поступление на склад
+	StockMovementReason_STOCK_MOVEMENT_REASON_RECEIPT StockMovementReason = 1
+	// Продажа
+	StockMovementReason_STOCK_MOVEMENT_REASON_SALE StockMovementReason = 2
+	// Резервирование (отрицательное delta) или снятие резерва (положительное)
+	StockMovementReason_STOCK_MOVEMENT_REASON_RESERVATION StockMovementReason = 3
+	// Списание из-за повреждения
+	StockMovementReason_STOCK_MOVEMENT_REASON_DAMAGE StockMovementReason = 4
+	// Корректировка по результатам инвентаризации
+	StockMovementReason_STOCK_MOVEMENT_REASON_CORRECTION StockMovementReason = 5
+	// Перемещение между складами: списание со склада-отправителя (отрицательное delta)
+	// или поступление на склад-получатель (положительное). Записывается только TransferStock.
+	StockMovementReason_STOCK_MOVEMENT_REASON_TRANSFER StockMovementReason = 6
+)
+
+// Enum value maps for StockMovementReason.
+var (
+	StockMovementReason_name = map[int32]string{
+		0: "STOCK_MOVEMENT_REASON_UNSPECIFIED",
+		1: "STOCK_MOVEMENT_REASON_RECEIPT",
+		2: "STOCK_MOVEMENT_REASON_SALE",
+		3: "STOCK_MOVEMENT_REASON_RESERVATION",
+		4: "STOCK_MOVEMENT_REASON_DAMAGE",
+		5: "STOCK_MOVEMENT_REASON_CORRECTION",
+		6: "STOCK_MOVEMENT_REASON_TRANSFER",
+	}
+	StockMovementReason_value = map[string]int32{
+		"STOCK_MOVEMENT_REASON_UNSPECIFIED": 0,
+		"STOCK_MOVEMENT_REASON_RECEIPT":     1,
+		"STOCK_MOVEMENT_REASON_SALE":        2,
+		"STOCK_MOVEMENT_REASON_RESERVATION": 3,
+		"STOCK_MOVEMENT_REASON_DAMAGE":      4,
+		"STOCK_MOVEMENT_REASON_CORRECTION":  5,
+		"STOCK_MOVEMENT_REASON_TRANSFER":    6,
+	}
+)
+
+func (x StockMovementReason) Enum() *StockMovementReason {
+	p := new(StockMovementReason)
+	*p = x
+	return p
+}
+
+func (x StockMovementReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StockMovementReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_inventory_v1_inventory_proto_enumTypes[2].Descriptor()
+}
+
+func (StockMovementReason) Type() protoreflect.EnumType {
+	return &file_inventory_v1_inventory_proto_enumTypes[2]
+}
+
+func (x StockMovementReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StockMovementReason.Descriptor instead.
+func (StockMovementReason) EnumDescriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{2}
+}
+
+// Типы изменений каталога
+type PartEventType int32
+
+const (
+	// Не указан
+	PartEventType_PART_EVENT_TYPE_UNSPECIFIED PartEventType = 0
+	// Деталь создана
+	PartEventType_PART_EVENT_TYPE_CREATED PartEventType = 1
+	// Деталь изменена, включая изменение остатка
+	PartEventType_PART_EVENT_TYPE_UPDATED PartEventType = 2
+	// Деталь удалена
+	PartEventType_PART_EVENT_TYPE_DELETED PartEventType = 3
+)
+
+// Enum value maps for PartEventType.
+var (
+	PartEventType_name = map[int32]string{
+		0: "PART_EVENT_TYPE_UNSPECIFIED",
+		1: "PART_EVENT_TYPE_CREATED",
+		2: "PART_EVENT_TYPE_UPDATED",
+		3: "PART_EVENT_TYPE_DELETED",
+	}
+	PartEventType_value = map[string]int32{
+		"PART_EVENT_TYPE_UNSPECIFIED": 0,
+		"PART_EVENT_TYPE_CREATED":     1,
+		"PART_EVENT_TYPE_UPDATED":     2,
+		"PART_EVENT_TYPE_DELETED":     3,
+	}
+)
+
+func (x PartEventType) Enum() *PartEventType {
+	p := new(PartEventType)
+	*p = x
+	return p
+}
+
+func (x PartEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PartEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_inventory_v1_inventory_proto_enumTypes[3].Descriptor()
+}
+
+func (PartEventType) Type() protoreflect.EnumType {
+	return &file_inventory_v1_inventory_proto_enumTypes[3]
+}
+
+func (x PartEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PartEventType.Descriptor instead.
+func (PartEventType) EnumDescriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{3}
+}
+
+// Тип значения поля метаданных
+type MetadataType int32
+
+const (
+	// Тип не указан (недопустим в схеме)
+	MetadataType_METADATA_TYPE_UNSPECIFIED MetadataType = 0
+	// Строка, Value.string_value
+	MetadataType_METADATA_TYPE_STRING MetadataType = 1
+	// Целое число, Value.int64_value
+	MetadataType_METADATA_TYPE_INT64 MetadataType = 2
+	// Число с плавающей точкой, Value.double_value. Целые значения тоже допускаются.
+	MetadataType_METADATA_TYPE_DOUBLE MetadataType = 3
+	// Логическое значение, Value.bool_value
+	MetadataType_METADATA_TYPE_BOOL MetadataType = 4
+)
+
+// Enum value maps for MetadataType.
+var (
+	MetadataType_name = map[int32]string{
+		0: "METADATA_TYPE_UNSPECIFIED",
+		1: "METADATA_TYPE_STRING",
+		2: "METADATA_TYPE_INT64",
+		3: "METADATA_TYPE_DOUBLE",
+		4: "METADATA_TYPE_BOOL",
+	}
+	MetadataType_value = map[string]int32{
+		"METADATA_TYPE_UNSPECIFIED": 0,
+		"METADATA_TYPE_STRING":      1,
+		"METADATA_TYPE_INT64":       2,
+		"METADATA_TYPE_DOUBLE":      3,
+		"METADATA_TYPE_BOOL":        4,
+	}
+)
+
+func (x MetadataType) Enum() *MetadataType {
+	p := new(MetadataType)
+	*p = x
+	return p
+}
+
+func (x MetadataType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MetadataType) Descriptor() protoreflect.EnumDescriptor {
+	return file_inventory_v1_inventory_proto_enumTypes[4].Descriptor()
+}
+
+func (MetadataType) Type() protoreflect.EnumType {
+	return &file_inventory_v1_inventory_proto_enumTypes[4]
+}
+
+func (x MetadataType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MetadataType.Descriptor instead.
+func (MetadataType) EnumDescriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{4}
+}
+
+// Операторы сравнения для условий на метаданные
+type MetadataOperator int32
+
+const (
+	// Не указан (недопустим)
+	MetadataOperator_METADATA_OPERATOR_UNSPECIFIED MetadataOperator = 0
+	// Значение равно заданному
+	MetadataOperator_METADATA_OPERATOR_EQ MetadataOperator = 1
+	// Значение не равно заданному. Детали без ключа также подходят.
+	MetadataOperator_METADATA_OPERATOR_NE MetadataOperator = 2
+	// Значение больше заданного
+	MetadataOperator_METADATA_OPERATOR_GT MetadataOperator = 3
+	// Значение больше или равно заданному
+	MetadataOperator_METADATA_OPERATOR_GTE MetadataOperator = 4
+	// Значение меньше заданного
+	MetadataOperator_METADATA_OPERATOR_LT MetadataOperator = 5
+	// Значение меньше или равно заданному
+	MetadataOperator_METADATA_OPERATOR_LTE MetadataOperator = 6
+	// Ключ присутствует в метаданных
+	MetadataOperator_METADATA_OPERATOR_EXISTS MetadataOperator = 7
+)
+
+// Enum value maps for MetadataOperator.
+var (
+	MetadataOperator_name = map[int32]string{
+		0: "METADATA_OPERATOR_UNSPECIFIED",
+		1: "METADATA_OPERATOR_EQ",
+		2: "METADATA_OPERATOR_NE",
+		3: "METADATA_OPERATOR_GT",
+		4: "METADATA_OPERATOR_GTE",
+		5: "METADATA_OPERATOR_LT",
+		6: "METADATA_OPERATOR_LTE",
+		7: "METADATA_OPERATOR_EXISTS",
+	}
+	MetadataOperator_value = map[string]int32{
+		"METADATA_OPERATOR_UNSPECIFIED": 0,
+		"METADATA_OPERATOR_EQ":          1,
+		"METADATA_OPERATOR_NE":          2,
+		"METADATA_OPERATOR_GT":          3,
+		"METADATA_OPERATOR_GTE":         4,
+		"METADATA_OPERATOR_LT":          5,
+		"METADATA_OPERATOR_LTE":         6,
+		"METADATA_OPERATOR_EXISTS":      7,
+	}
+)
+
+func (x MetadataOperator) Enum() *MetadataOperator {
+	p := new(MetadataOperator)
+	*p = x
+	return p
+}
+
+func (x MetadataOperator) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MetadataOperator) Descriptor() protoreflect.EnumDescriptor {
+	return file_inventory_v1_inventory_proto_enumTypes[5].Descriptor()
+}
+
+func (MetadataOperator) Type() protoreflect.EnumType {
+	return &file_inventory_v1_inventory_proto_enumTypes[5]
+}
+
+func (x MetadataOperator) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MetadataOperator.Descriptor instead.
+func (MetadataOperator) EnumDescriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{5}
+}
+
 // Категории деталей космического корабля
 type Category int32
 
@@ -67,11 +455,11 @@ func (x Category) String() string {
 }
 
 func (Category) Descriptor() protoreflect.EnumDescriptor {
-	return file_inventory_v1_inventory_proto_enumTypes[0].Descriptor()
+	return file_inventory_v1_inventory_proto_enumTypes[6].Descriptor()
 }
 
 func (Category) Type() protoreflect.EnumType {
-	return &file_inventory_v1_inventory_proto_enumTypes[0]
+	return &file_inventory_v1_inventory_proto_enumTypes[6]
 }
 
 func (x Category) Number() protoreflect.EnumNumber {
@@ -80,14 +468,16 @@ func (x Category) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Category.Descriptor instead.
 func (Category) EnumDescriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{0}
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{6}
 }
 
 // Запрос для получения информации о конкретной детали
 type GetPartRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// UUID детали, которую нужно найти
-	Uuid          string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// Момент, на который возвращается цена детали. Если не указан - текущий.
+	AsOf          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -129,6 +519,13 @@ func (x *GetPartRequest) GetUuid() string {
 	return ""
 }
 
+func (x *GetPartRequest) GetAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
 // Ответ с информацией о запрошенной детали
 type GetPartResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -175,29 +572,31 @@ func (x *GetPartResponse) GetPart() *Part {
 	return nil
 }
 
-// Запрос для получения списка деталей с фильтрацией
-type ListPartsRequest struct {
+// Запрос нескольких деталей по UUID
+type BatchGetPartsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Фильтр для отбора деталей. Если не указан, возвращаются все детали.
-	Filter        *PartsFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// UUID деталей, не больше 1000. Повторы допускаются и учитываются один раз.
+	Uuids []string `protobuf:"bytes,1,rep,name=uuids,proto3" json:"uuids,omitempty"`
+	// Момент, на который возвращаются цены деталей. Если не указан - текущий.
+	AsOf          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListPartsRequest) Reset() {
-	*x = ListPartsRequest{}
+func (x *BatchGetPartsRequest) Reset() {
+	*x = BatchGetPartsRequest{}
 	mi := &file_inventory_v1_inventory_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPartsRequest) String() string {
+func (x *BatchGetPartsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPartsRequest) ProtoMessage() {}
+func (*BatchGetPartsRequest) ProtoMessage() {}
 
-func (x *ListPartsRequest) ProtoReflect() protoreflect.Message {
+func (x *BatchGetPartsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_inventory_v1_inventory_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -209,41 +608,52 @@ func (x *ListPartsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPartsRequest.ProtoReflect.Descriptor instead.
-func (*ListPartsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatchGetPartsRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetPartsRequest) Descriptor() ([]byte, []int) {
 	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *ListPartsRequest) GetFilter() *PartsFilter {
+func (x *BatchGetPartsRequest) GetUuids() []string {
 	if x != nil {
-		return x.Filter
+		return x.Uuids
 	}
 	return nil
 }
 
-// Ответ со списком найденных деталей
-type ListPartsResponse struct {
+func (x *BatchGetPartsRequest) GetAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
+// Детали, найденные по списку UUID
+type BatchGetPartsResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Список деталей, удовлетворяющих условиям фильтра
-	Parts         []*Part `protobuf:"bytes,1,rep,name=parts,proto3" json:"parts,omitempty"`
+	// Найденные детали по UUID
+	Parts map[string]*Part `protobuf:"bytes,1,rep,name=parts,proto3" json:"parts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Корректные UUID, деталей с которыми нет, в порядке первого упоминания в запросе
+	NotFoundUuids []string `protobuf:"bytes,2,rep,name=not_found_uuids,json=notFoundUuids,proto3" json:"not_found_uuids,omitempty"`
+	// Строки запроса, не являющиеся UUID, в порядке первого упоминания в запросе
+	InvalidUuids  []string `protobuf:"bytes,3,rep,name=invalid_uuids,json=invalidUuids,proto3" json:"invalid_uuids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListPartsResponse) Reset() {
-	*x = ListPartsResponse{}
+func (x *BatchGetPartsResponse) Reset() {
+	*x = BatchGetPartsResponse{}
 	mi := &file_inventory_v1_inventory_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPartsResponse) String() string {
+func (x *BatchGetPartsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPartsResponse) ProtoMessage() {}
+func (*BatchGetPartsResponse) ProtoMessage() {}
 
-func (x *ListPartsResponse) ProtoReflect() protoreflect.Message {
+func (x *BatchGetPartsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_inventory_v1_inventory_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -255,54 +665,65 @@ func (x *ListPartsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPartsResponse.ProtoReflect.Descriptor instead.
-func (*ListPartsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatchGetPartsResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetPartsResponse) Descriptor() ([]byte, []int) {
 	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *ListPartsResponse) GetParts() []*Part {
+func (x *BatchGetPartsResponse) GetParts() map[string]*Part {
 	if x != nil {
 		return x.Parts
 	}
 	return nil
 }
 
-// Фильтр для отбора деталей
-type PartsFilter struct {
+func (x *BatchGetPartsResponse) GetNotFoundUuids() []string {
+	if x != nil {
+		return x.NotFoundUuids
+	}
+	return nil
+}
+
+func (x *BatchGetPartsResponse) GetInvalidUuids() []string {
+	if x != nil {
+		return x.InvalidUuids
+	}
+	return nil
+}
+
+// Запрос для получения списка деталей с фильтрацией
+type ListPartsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Список UUID'ов для фильтрации. Пустой список означает отсутствие фильтрации по UUID.
-	// Фильтрация по UUID работает как логическое ИЛИ (деталь должна иметь один из указанных UUID).
-	Uuids []string `protobuf:"bytes,1,rep,name=uuids,proto3" json:"uuids,omitempty"`
-	// Список имён для фильтрации. Пустой список означает отсутствие фильтрации по имени.
-	// Фильтрация по имени работает как логическое ИЛИ (деталь должна иметь одно из указанных имён).
-	Names []string `protobuf:"bytes,2,rep,name=names,proto3" json:"names,omitempty"`
-	// Список категорий для фильтрации. Пустой список означает отсутствие фильтрации по категории.
-	// Фильтрация по категории работает как логическое ИЛИ (деталь должна быть в одной из указанных категорий).
-	Categories []Category `protobuf:"varint,3,rep,packed,name=categories,proto3,enum=inventory.v1.Category" json:"categories,omitempty"`
-	// Список стран производителей для фильтрации. Пустой список означает отсутствие фильтрации по стране.
-	// Фильтрация по стране работает как логическое ИЛИ (деталь должна быть произведена в одной из указанных стран).
-	ManufacturerCountries []string `protobuf:"bytes,4,rep,name=manufacturer_countries,json=manufacturerCountries,proto3" json:"manufacturer_countries,omitempty"`
-	// Список тегов для фильтрации. Пустой список означает отсутствие фильтрации по тегам.
-	// Фильтрация по тегам работает как логическое ИЛИ (деталь должна иметь хотя бы один из указанных тегов).
-	Tags          []string `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Фильтр для отбора деталей. Если не указан, возвращаются все детали.
+	Filter *PartsFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Максимальное количество деталей на странице. 0 - размер по умолчанию (50), значения больше 1000 уменьшаются до 1000.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Токен страницы из next_page_token предыдущего ответа. Пустой - первая страница.
+	// Токен действителен только с теми же filter и order_by, с которыми был получен.
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Порядок сортировки. Если не указан, детали упорядочены по UUID.
+	OrderBy *PartsOrderBy `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// Момент, на который возвращаются цены деталей. Если не указан - текущий.
+	// Фильтр и сортировка по цене всегда используют текущую цену, поэтому с as_of не допускаются.
+	AsOf          *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PartsFilter) Reset() {
-	*x = PartsFilter{}
+func (x *ListPartsRequest) Reset() {
+	*x = ListPartsRequest{}
 	mi := &file_inventory_v1_inventory_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PartsFilter) String() string {
+func (x *ListPartsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PartsFilter) ProtoMessage() {}
+func (*ListPartsRequest) ProtoMessage() {}
 
-func (x *PartsFilter) ProtoReflect() protoreflect.Message {
+func (x *ListPartsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_inventory_v1_inventory_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -314,42 +735,4125 @@ func (x *PartsFilter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PartsFilter.ProtoReflect.Descriptor instead.
-func (*PartsFilter) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListPartsRequest.ProtoReflect.Descriptor instead.
+func (*ListPartsRequest) Descriptor() ([]byte, []int) {
 	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *PartsFilter) GetUuids() []string {
+func (x *ListPartsRequest) GetFilter() *PartsFilter {
 	if x != nil {
-		return x.Uuids
+		return x.Filter
 	}
 	return nil
 }
 
-func (x *PartsFilter) GetNames() []string {
+func (x *ListPartsRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.Names
+		return x.PageSize
 	}
-	return nil
+	return 0
 }
 
-func (x *PartsFilter) GetCategories() []Category {
+func (x *ListPartsRequest) GetPageToken() string {
 	if x != nil {
-		return x.Categories
+		return x.PageToken
 	}
-	return nil
+	return ""
 }
 
-func (x *PartsFilter) GetManufacturerCountries() []string {
+func (x *ListPartsRequest) GetOrderBy() *PartsOrderBy {
 	if x != nil {
-		return x.ManufacturerCountries
+		return x.OrderBy
+	}
+	return nil
+}
+
+func (x *ListPartsRequest) GetAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
+// Ответ со списком найденных деталей
+type ListPartsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Детали текущей страницы, удовлетворяющие условиям фильтра
+	Parts []*Part `protobuf:"bytes,1,rep,name=parts,proto3" json:"parts,omitempty"`
+	// Токен следующей страницы. Пустой, если страница последняя.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Общее количество деталей, удовлетворяющих фильтру
+	TotalSize     int32 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPartsResponse) Reset() {
+	*x = ListPartsResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPartsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPartsResponse) ProtoMessage() {}
+
+func (x *ListPartsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPartsResponse.ProtoReflect.Descriptor instead.
+func (*ListPartsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListPartsResponse) GetParts() []*Part {
+	if x != nil {
+		return x.Parts
+	}
+	return nil
+}
+
+func (x *ListPartsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListPartsResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+// Порядок сортировки списка деталей
+type PartsOrderBy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Поле сортировки. При равенстве значений детали упорядочены по UUID в том же направлении.
+	Field PartsOrderField `protobuf:"varint,1,opt,name=field,proto3,enum=inventory.v1.PartsOrderField" json:"field,omitempty"`
+	// Сортировать по убыванию
+	Descending    bool `protobuf:"varint,2,opt,name=descending,proto3" json:"descending,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartsOrderBy) Reset() {
+	*x = PartsOrderBy{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartsOrderBy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartsOrderBy) ProtoMessage() {}
+
+func (x *PartsOrderBy) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartsOrderBy.ProtoReflect.Descriptor instead.
+func (*PartsOrderBy) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PartsOrderBy) GetField() PartsOrderField {
+	if x != nil {
+		return x.Field
+	}
+	return PartsOrderField_PARTS_ORDER_FIELD_UNSPECIFIED
+}
+
+func (x *PartsOrderBy) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+// Запрос на создание детали
+type CreatePartRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Создаваемая деталь. Если uuid не указан, он будет сгенерирован.
+	// Поля created_at и updated_at заполняются сервисом и игнорируются.
+	// Требования: непустое название, положительная цена, неотрицательный остаток, известная категория.
+	Part          *Part `protobuf:"bytes,1,opt,name=part,proto3" json:"part,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePartRequest) Reset() {
+	*x = CreatePartRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePartRequest) ProtoMessage() {}
+
+func (x *CreatePartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePartRequest.ProtoReflect.Descriptor instead.
+func (*CreatePartRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreatePartRequest) GetPart() *Part {
+	if x != nil {
+		return x.Part
+	}
+	return nil
+}
+
+// Ответ с созданной деталью
+type CreatePartResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Созданная деталь
+	Part          *Part `protobuf:"bytes,1,opt,name=part,proto3" json:"part,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePartResponse) Reset() {
+	*x = CreatePartResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePartResponse) ProtoMessage() {}
+
+func (x *CreatePartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePartResponse.ProtoReflect.Descriptor instead.
+func (*CreatePartResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CreatePartResponse) GetPart() *Part {
+	if x != nil {
+		return x.Part
+	}
+	return nil
+}
+
+// Запрос на обновление детали
+type UpdatePartRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Новые значения полей детали. Деталь определяется по part.uuid.
+	Part *Part `protobuf:"bytes,1,opt,name=part,proto3" json:"part,omitempty"`
+	// Обновляемые поля (например, "price", "dimensions.weight").
+	// Если не указано, обновляются все изменяемые поля. Поля uuid, created_at и updated_at изменить нельзя,
+	// stock_quantity изменяется только через AdjustStock.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePartRequest) Reset() {
+	*x = UpdatePartRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePartRequest) ProtoMessage() {}
+
+func (x *UpdatePartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePartRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePartRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdatePartRequest) GetPart() *Part {
+	if x != nil {
+		return x.Part
+	}
+	return nil
+}
+
+func (x *UpdatePartRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// Ответ с обновлённой деталью
+type UpdatePartResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Деталь после обновления
+	Part          *Part `protobuf:"bytes,1,opt,name=part,proto3" json:"part,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePartResponse) Reset() {
+	*x = UpdatePartResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePartResponse) ProtoMessage() {}
+
+func (x *UpdatePartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePartResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePartResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdatePartResponse) GetPart() *Part {
+	if x != nil {
+		return x.Part
+	}
+	return nil
+}
+
+// Запрос на удаление детали
+type DeletePartRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID удаляемой детали
+	Uuid          string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePartRequest) Reset() {
+	*x = DeletePartRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePartRequest) ProtoMessage() {}
+
+func (x *DeletePartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePartRequest.ProtoReflect.Descriptor instead.
+func (*DeletePartRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeletePartRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+// Ответ на удаление детали
+type DeletePartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePartResponse) Reset() {
+	*x = DeletePartResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePartResponse) ProtoMessage() {}
+
+func (x *DeletePartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePartResponse.ProtoReflect.Descriptor instead.
+func (*DeletePartResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{12}
+}
+
+// Запрос на смену состояния жизненного цикла детали
+type ChangePartLifecycleStateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID детали
+	PartUuid string `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	// Новое состояние. Допустимые переходы: DRAFT -> ACTIVE; ACTIVE -> DISCONTINUED, RECALLED;
+	// DISCONTINUED -> ACTIVE, RECALLED; RECALLED -> ACTIVE, DISCONTINUED. Повторный перевод в DISCONTINUED
+	// или RECALLED меняет замену.
+	State PartLifecycleState `protobuf:"varint,2,opt,name=state,proto3,enum=inventory.v1.PartLifecycleState" json:"state,omitempty"`
+	// UUID рекомендуемой замены, только для DISCONTINUED и RECALLED. Замена должна быть действующей (ACTIVE)
+	// деталью. Пустой - без замены; при переводе в ACTIVE замена сбрасывается.
+	ReplacementPartUuid string `protobuf:"bytes,3,opt,name=replacement_part_uuid,json=replacementPartUuid,proto3" json:"replacement_part_uuid,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ChangePartLifecycleStateRequest) Reset() {
+	*x = ChangePartLifecycleStateRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePartLifecycleStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePartLifecycleStateRequest) ProtoMessage() {}
+
+func (x *ChangePartLifecycleStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePartLifecycleStateRequest.ProtoReflect.Descriptor instead.
+func (*ChangePartLifecycleStateRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ChangePartLifecycleStateRequest) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *ChangePartLifecycleStateRequest) GetState() PartLifecycleState {
+	if x != nil {
+		return x.State
+	}
+	return PartLifecycleState_PART_LIFECYCLE_STATE_UNSPECIFIED
+}
+
+func (x *ChangePartLifecycleStateRequest) GetReplacementPartUuid() string {
+	if x != nil {
+		return x.ReplacementPartUuid
+	}
+	return ""
+}
+
+// Ответ на смену состояния жизненного цикла детали
+type ChangePartLifecycleStateResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Деталь в новом состоянии
+	Part          *Part `protobuf:"bytes,1,opt,name=part,proto3" json:"part,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangePartLifecycleStateResponse) Reset() {
+	*x = ChangePartLifecycleStateResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePartLifecycleStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePartLifecycleStateResponse) ProtoMessage() {}
+
+func (x *ChangePartLifecycleStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePartLifecycleStateResponse.ProtoReflect.Descriptor instead.
+func (*ChangePartLifecycleStateResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ChangePartLifecycleStateResponse) GetPart() *Part {
+	if x != nil {
+		return x.Part
+	}
+	return nil
+}
+
+// Запрос на изменение остатка детали
+type AdjustStockRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID детали
+	PartUuid string `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	// Изменение остатка, не равно нулю. Остаток не может стать отрицательным.
+	Delta int64 `protobuf:"varint,2,opt,name=delta,proto3" json:"delta,omitempty"`
+	// Причина движения. RECEIPT допускает только положительное delta, SALE и DAMAGE - только отрицательное.
+	Reason StockMovementReason `protobuf:"varint,3,opt,name=reason,proto3,enum=inventory.v1.StockMovementReason" json:"reason,omitempty"`
+	// Идентификатор связанного документа (заказ, накладная, акт), необязательный
+	ReferenceId string `protobuf:"bytes,4,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
+	// UUID склада, на котором изменяется остаток. Пустой - основной склад.
+	WarehouseUuid string `protobuf:"bytes,5,opt,name=warehouse_uuid,json=warehouseUuid,proto3" json:"warehouse_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdjustStockRequest) Reset() {
+	*x = AdjustStockRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdjustStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjustStockRequest) ProtoMessage() {}
+
+func (x *AdjustStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjustStockRequest.ProtoReflect.Descriptor instead.
+func (*AdjustStockRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AdjustStockRequest) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *AdjustStockRequest) GetDelta() int64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *AdjustStockRequest) GetReason() StockMovementReason {
+	if x != nil {
+		return x.Reason
+	}
+	return StockMovementReason_STOCK_MOVEMENT_REASON_UNSPECIFIED
+}
+
+func (x *AdjustStockRequest) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+func (x *AdjustStockRequest) GetWarehouseUuid() string {
+	if x != nil {
+		return x.WarehouseUuid
+	}
+	return ""
+}
+
+// Ответ на изменение остатка
+type AdjustStockResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Записанное движение, включая остаток после него
+	Movement      *StockMovement `protobuf:"bytes,1,opt,name=movement,proto3" json:"movement,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdjustStockResponse) Reset() {
+	*x = AdjustStockResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdjustStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjustStockResponse) ProtoMessage() {}
+
+func (x *AdjustStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjustStockResponse.ProtoReflect.Descriptor instead.
+func (*AdjustStockResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *AdjustStockResponse) GetMovement() *StockMovement {
+	if x != nil {
+		return x.Movement
+	}
+	return nil
+}
+
+// Запрос журнала движений остатка
+type ListStockMovementsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID детали
+	PartUuid string `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	// Максимальное количество движений на странице. 0 - размер по умолчанию (50), значения больше 1000 уменьшаются до 1000.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Токен страницы из next_page_token предыдущего ответа. Пустой - первая страница.
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStockMovementsRequest) Reset() {
+	*x = ListStockMovementsRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStockMovementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStockMovementsRequest) ProtoMessage() {}
+
+func (x *ListStockMovementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStockMovementsRequest.ProtoReflect.Descriptor instead.
+func (*ListStockMovementsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListStockMovementsRequest) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *ListStockMovementsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListStockMovementsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// Журнал движений остатка
+type ListStockMovementsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Движения, начиная с последних
+	Movements []*StockMovement `protobuf:"bytes,1,rep,name=movements,proto3" json:"movements,omitempty"`
+	// Токен следующей страницы. Пустой, если страница последняя.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStockMovementsResponse) Reset() {
+	*x = ListStockMovementsResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStockMovementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStockMovementsResponse) ProtoMessage() {}
+
+func (x *ListStockMovementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStockMovementsResponse.ProtoReflect.Descriptor instead.
+func (*ListStockMovementsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListStockMovementsResponse) GetMovements() []*StockMovement {
+	if x != nil {
+		return x.Movements
+	}
+	return nil
+}
+
+func (x *ListStockMovementsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Движение остатка детали
+type StockMovement struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Уникальный идентификатор движения (UUIDv7, упорядочен по времени)
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// UUID детали
+	PartUuid string `protobuf:"bytes,2,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	// Изменение остатка
+	Delta int64 `protobuf:"varint,3,opt,name=delta,proto3" json:"delta,omitempty"`
+	// Общий остаток детали по всем складам после движения
+	QuantityAfter int64 `protobuf:"varint,4,opt,name=quantity_after,json=quantityAfter,proto3" json:"quantity_after,omitempty"`
+	// Причина движения
+	Reason StockMovementReason `protobuf:"varint,5,opt,name=reason,proto3,enum=inventory.v1.StockMovementReason" json:"reason,omitempty"`
+	// Идентификатор связанного документа
+	ReferenceId string `protobuf:"bytes,6,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
+	// Время движения
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// UUID склада, на котором изменился остаток
+	WarehouseUuid string `protobuf:"bytes,8,opt,name=warehouse_uuid,json=warehouseUuid,proto3" json:"warehouse_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockMovement) Reset() {
+	*x = StockMovement{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockMovement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockMovement) ProtoMessage() {}
+
+func (x *StockMovement) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockMovement.ProtoReflect.Descriptor instead.
+func (*StockMovement) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *StockMovement) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *StockMovement) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *StockMovement) GetDelta() int64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *StockMovement) GetQuantityAfter() int64 {
+	if x != nil {
+		return x.QuantityAfter
+	}
+	return 0
+}
+
+func (x *StockMovement) GetReason() StockMovementReason {
+	if x != nil {
+		return x.Reason
+	}
+	return StockMovementReason_STOCK_MOVEMENT_REASON_UNSPECIFIED
+}
+
+func (x *StockMovement) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+func (x *StockMovement) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *StockMovement) GetWarehouseUuid() string {
+	if x != nil {
+		return x.WarehouseUuid
+	}
+	return ""
+}
+
+// Запрос на подписку на изменения каталога
+type WatchPartsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Фильтр деталей, об изменениях которых нужно сообщать. Пустой - все детали.
+	// Изменение отправляется, если деталь удовлетворяет фильтру до или после него,
+	// поэтому подписчик узнаёт и о том, что деталь перестала попадать в выборку.
+	Filter *PartsFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Токен из resume_token последнего полученного события. Поток продолжится со следующего события.
+	// Пустой - только события, произошедшие после подписки.
+	ResumeToken   string `protobuf:"bytes,2,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchPartsRequest) Reset() {
+	*x = WatchPartsRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchPartsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchPartsRequest) ProtoMessage() {}
+
+func (x *WatchPartsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchPartsRequest.ProtoReflect.Descriptor instead.
+func (*WatchPartsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *WatchPartsRequest) GetFilter() *PartsFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *WatchPartsRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+// Событие изменения каталога
+type WatchPartsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Тип изменения
+	Type PartEventType `protobuf:"varint,1,opt,name=type,proto3,enum=inventory.v1.PartEventType" json:"type,omitempty"`
+	// UUID изменённой детали
+	PartUuid string `protobuf:"bytes,2,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	// Состояние детали после изменения. Для удаления - последнее известное состояние, если оно доступно.
+	Part *Part `protobuf:"bytes,3,opt,name=part,proto3" json:"part,omitempty"`
+	// Токен для возобновления потока после этого события
+	ResumeToken   string `protobuf:"bytes,4,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchPartsResponse) Reset() {
+	*x = WatchPartsResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchPartsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchPartsResponse) ProtoMessage() {}
+
+func (x *WatchPartsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchPartsResponse.ProtoReflect.Descriptor instead.
+func (*WatchPartsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *WatchPartsResponse) GetType() PartEventType {
+	if x != nil {
+		return x.Type
+	}
+	return PartEventType_PART_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *WatchPartsResponse) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *WatchPartsResponse) GetPart() *Part {
+	if x != nil {
+		return x.Part
+	}
+	return nil
+}
+
+func (x *WatchPartsResponse) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+// Запрос на разузлование спецификации сборки
+type ExpandBomRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID детали или сборки
+	PartUuid string `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	// Количество собираемых единиц. 0 - одна единица.
+	Quantity      int64 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpandBomRequest) Reset() {
+	*x = ExpandBomRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpandBomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpandBomRequest) ProtoMessage() {}
+
+func (x *ExpandBomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpandBomRequest.ProtoReflect.Descriptor instead.
+func (*ExpandBomRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ExpandBomRequest) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *ExpandBomRequest) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// Разузлованная спецификация сборки
+type ExpandBomResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Дерево спецификации. Компонент, входящий в несколько сборок, повторяется в каждой из них.
+	Root *BomNode `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	// Стоимость компонентов на запрошенное количество (root.rolled_up_price * quantity)
+	TotalPrice float64 `protobuf:"fixed64,2,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
+	// Вес компонентов на запрошенное количество (root.rolled_up_weight * quantity)
+	TotalWeight float64 `protobuf:"fixed64,3,opt,name=total_weight,json=totalWeight,proto3" json:"total_weight,omitempty"`
+	// Сколько единиц можно собрать из текущих остатков. Учитываются остатки готовых подсборок
+	// и то, что один компонент может требоваться в нескольких ветках дерева.
+	BuildableQuantity int64 `protobuf:"varint,4,opt,name=buildable_quantity,json=buildableQuantity,proto3" json:"buildable_quantity,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ExpandBomResponse) Reset() {
+	*x = ExpandBomResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpandBomResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpandBomResponse) ProtoMessage() {}
+
+func (x *ExpandBomResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpandBomResponse.ProtoReflect.Descriptor instead.
+func (*ExpandBomResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ExpandBomResponse) GetRoot() *BomNode {
+	if x != nil {
+		return x.Root
+	}
+	return nil
+}
+
+func (x *ExpandBomResponse) GetTotalPrice() float64 {
+	if x != nil {
+		return x.TotalPrice
+	}
+	return 0
+}
+
+func (x *ExpandBomResponse) GetTotalWeight() float64 {
+	if x != nil {
+		return x.TotalWeight
+	}
+	return 0
+}
+
+func (x *ExpandBomResponse) GetBuildableQuantity() int64 {
+	if x != nil {
+		return x.BuildableQuantity
+	}
+	return 0
+}
+
+// Узел спецификации
+type BomNode struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Деталь или сборка
+	Part *Part `protobuf:"bytes,1,opt,name=part,proto3" json:"part,omitempty"`
+	// Количество на единицу родительской сборки (для корня - запрошенное количество)
+	Quantity int64 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// Количество на всё запрошенное количество корневой сборки
+	TotalQuantity int64 `protobuf:"varint,3,opt,name=total_quantity,json=totalQuantity,proto3" json:"total_quantity,omitempty"`
+	// Стоимость единицы: для сборки - сумма по компонентам с учётом количества, для детали - её цена
+	RolledUpPrice float64 `protobuf:"fixed64,4,opt,name=rolled_up_price,json=rolledUpPrice,proto3" json:"rolled_up_price,omitempty"`
+	// Вес единицы: для сборки - сумма по компонентам с учётом количества, для детали - её вес
+	RolledUpWeight float64 `protobuf:"fixed64,5,opt,name=rolled_up_weight,json=rolledUpWeight,proto3" json:"rolled_up_weight,omitempty"`
+	// Компоненты сборки. Пусто для детали.
+	Components    []*BomNode `protobuf:"bytes,6,rep,name=components,proto3" json:"components,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BomNode) Reset() {
+	*x = BomNode{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BomNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BomNode) ProtoMessage() {}
+
+func (x *BomNode) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BomNode.ProtoReflect.Descriptor instead.
+func (*BomNode) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *BomNode) GetPart() *Part {
+	if x != nil {
+		return x.Part
+	}
+	return nil
+}
+
+func (x *BomNode) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *BomNode) GetTotalQuantity() int64 {
+	if x != nil {
+		return x.TotalQuantity
+	}
+	return 0
+}
+
+func (x *BomNode) GetRolledUpPrice() float64 {
+	if x != nil {
+		return x.RolledUpPrice
+	}
+	return 0
+}
+
+func (x *BomNode) GetRolledUpWeight() float64 {
+	if x != nil {
+		return x.RolledUpWeight
+	}
+	return 0
+}
+
+func (x *BomNode) GetComponents() []*BomNode {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+// Запрос на добавление производителя
+type CreateManufacturerRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Новый производитель. Если uuid не указан, он будет сгенерирован. Название обязательно и уникально.
+	Manufacturer  *Manufacturer `protobuf:"bytes,1,opt,name=manufacturer,proto3" json:"manufacturer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateManufacturerRequest) Reset() {
+	*x = CreateManufacturerRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateManufacturerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateManufacturerRequest) ProtoMessage() {}
+
+func (x *CreateManufacturerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateManufacturerRequest.ProtoReflect.Descriptor instead.
+func (*CreateManufacturerRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CreateManufacturerRequest) GetManufacturer() *Manufacturer {
+	if x != nil {
+		return x.Manufacturer
+	}
+	return nil
+}
+
+// Ответ с добавленным производителем
+type CreateManufacturerResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Добавленный производитель
+	Manufacturer  *Manufacturer `protobuf:"bytes,1,opt,name=manufacturer,proto3" json:"manufacturer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateManufacturerResponse) Reset() {
+	*x = CreateManufacturerResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateManufacturerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateManufacturerResponse) ProtoMessage() {}
+
+func (x *CreateManufacturerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateManufacturerResponse.ProtoReflect.Descriptor instead.
+func (*CreateManufacturerResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CreateManufacturerResponse) GetManufacturer() *Manufacturer {
+	if x != nil {
+		return x.Manufacturer
+	}
+	return nil
+}
+
+// Запрос производителя по UUID
+type GetManufacturerRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID производителя
+	Uuid          string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetManufacturerRequest) Reset() {
+	*x = GetManufacturerRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetManufacturerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetManufacturerRequest) ProtoMessage() {}
+
+func (x *GetManufacturerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetManufacturerRequest.ProtoReflect.Descriptor instead.
+func (*GetManufacturerRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetManufacturerRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+// Ответ с найденным производителем
+type GetManufacturerResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Найденный производитель
+	Manufacturer  *Manufacturer `protobuf:"bytes,1,opt,name=manufacturer,proto3" json:"manufacturer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetManufacturerResponse) Reset() {
+	*x = GetManufacturerResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetManufacturerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetManufacturerResponse) ProtoMessage() {}
+
+func (x *GetManufacturerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetManufacturerResponse.ProtoReflect.Descriptor instead.
+func (*GetManufacturerResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetManufacturerResponse) GetManufacturer() *Manufacturer {
+	if x != nil {
+		return x.Manufacturer
+	}
+	return nil
+}
+
+// Запрос списка производителей
+type ListManufacturersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Страны производителей для фильтрации (логическое ИЛИ). Пустой список - все страны.
+	Countries     []string `protobuf:"bytes,1,rep,name=countries,proto3" json:"countries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListManufacturersRequest) Reset() {
+	*x = ListManufacturersRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListManufacturersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListManufacturersRequest) ProtoMessage() {}
+
+func (x *ListManufacturersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListManufacturersRequest.ProtoReflect.Descriptor instead.
+func (*ListManufacturersRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListManufacturersRequest) GetCountries() []string {
+	if x != nil {
+		return x.Countries
+	}
+	return nil
+}
+
+// Ответ со списком производителей
+type ListManufacturersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Производители, упорядоченные по названию
+	Manufacturers []*Manufacturer `protobuf:"bytes,1,rep,name=manufacturers,proto3" json:"manufacturers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListManufacturersResponse) Reset() {
+	*x = ListManufacturersResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListManufacturersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListManufacturersResponse) ProtoMessage() {}
+
+func (x *ListManufacturersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListManufacturersResponse.ProtoReflect.Descriptor instead.
+func (*ListManufacturersResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListManufacturersResponse) GetManufacturers() []*Manufacturer {
+	if x != nil {
+		return x.Manufacturers
+	}
+	return nil
+}
+
+// Запрос на изменение производителя
+type UpdateManufacturerRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Новые значения полей производителя, определяется по manufacturer.uuid. Поля заменяются целиком.
+	Manufacturer  *Manufacturer `protobuf:"bytes,1,opt,name=manufacturer,proto3" json:"manufacturer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateManufacturerRequest) Reset() {
+	*x = UpdateManufacturerRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateManufacturerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateManufacturerRequest) ProtoMessage() {}
+
+func (x *UpdateManufacturerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateManufacturerRequest.ProtoReflect.Descriptor instead.
+func (*UpdateManufacturerRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *UpdateManufacturerRequest) GetManufacturer() *Manufacturer {
+	if x != nil {
+		return x.Manufacturer
+	}
+	return nil
+}
+
+// Ответ с изменённым производителем
+type UpdateManufacturerResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Производитель после изменения
+	Manufacturer *Manufacturer `protobuf:"bytes,1,opt,name=manufacturer,proto3" json:"manufacturer,omitempty"`
+	// Количество деталей, в которых обновлена копия производителя
+	UpdatedParts  int32 `protobuf:"varint,2,opt,name=updated_parts,json=updatedParts,proto3" json:"updated_parts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateManufacturerResponse) Reset() {
+	*x = UpdateManufacturerResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateManufacturerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateManufacturerResponse) ProtoMessage() {}
+
+func (x *UpdateManufacturerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateManufacturerResponse.ProtoReflect.Descriptor instead.
+func (*UpdateManufacturerResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *UpdateManufacturerResponse) GetManufacturer() *Manufacturer {
+	if x != nil {
+		return x.Manufacturer
+	}
+	return nil
+}
+
+func (x *UpdateManufacturerResponse) GetUpdatedParts() int32 {
+	if x != nil {
+		return x.UpdatedParts
+	}
+	return 0
+}
+
+// Запрос на удаление производителя
+type DeleteManufacturerRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID удаляемого производителя
+	Uuid          string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteManufacturerRequest) Reset() {
+	*x = DeleteManufacturerRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteManufacturerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteManufacturerRequest) ProtoMessage() {}
+
+func (x *DeleteManufacturerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteManufacturerRequest.ProtoReflect.Descriptor instead.
+func (*DeleteManufacturerRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DeleteManufacturerRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+// Ответ на удаление производителя
+type DeleteManufacturerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteManufacturerResponse) Reset() {
+	*x = DeleteManufacturerResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteManufacturerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteManufacturerResponse) ProtoMessage() {}
+
+func (x *DeleteManufacturerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteManufacturerResponse.ProtoReflect.Descriptor instead.
+func (*DeleteManufacturerResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{34}
+}
+
+// Запрос на добавление категории
+type CreateCategoryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Новая категория. Если uuid не указан, он будет сгенерирован. legacy_category и metadata_schema игнорируются.
+	Category      *CategoryNode `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCategoryRequest) Reset() {
+	*x = CreateCategoryRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryRequest) ProtoMessage() {}
+
+func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*CreateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CreateCategoryRequest) GetCategory() *CategoryNode {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+// Ответ с добавленной категорией
+type CreateCategoryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Добавленная категория
+	Category      *CategoryNode `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCategoryResponse) Reset() {
+	*x = CreateCategoryResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryResponse) ProtoMessage() {}
+
+func (x *CreateCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryResponse.ProtoReflect.Descriptor instead.
+func (*CreateCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CreateCategoryResponse) GetCategory() *CategoryNode {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+// Запрос категории по UUID
+type GetCategoryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID категории
+	Uuid          string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryRequest) Reset() {
+	*x = GetCategoryRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryRequest) ProtoMessage() {}
+
+func (x *GetCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryRequest.ProtoReflect.Descriptor instead.
+func (*GetCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetCategoryRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+// Ответ с найденной категорией
+type GetCategoryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Найденная категория
+	Category      *CategoryNode `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryResponse) Reset() {
+	*x = GetCategoryResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryResponse) ProtoMessage() {}
+
+func (x *GetCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryResponse.ProtoReflect.Descriptor instead.
+func (*GetCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetCategoryResponse) GetCategory() *CategoryNode {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+// Запрос дерева категорий
+type ListCategoriesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID категории, поддерево которой нужно вернуть (вместе с ней). Пусто - всё дерево.
+	RootUuid      string `protobuf:"bytes,1,opt,name=root_uuid,json=rootUuid,proto3" json:"root_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoriesRequest) Reset() {
+	*x = ListCategoriesRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesRequest) ProtoMessage() {}
+
+func (x *ListCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*ListCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListCategoriesRequest) GetRootUuid() string {
+	if x != nil {
+		return x.RootUuid
+	}
+	return ""
+}
+
+// Ответ с категориями
+type ListCategoriesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Категории в порядке обхода в ширину: родитель всегда раньше потомков
+	Categories    []*CategoryNode `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoriesResponse) Reset() {
+	*x = ListCategoriesResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesResponse) ProtoMessage() {}
+
+func (x *ListCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesResponse.ProtoReflect.Descriptor instead.
+func (*ListCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListCategoriesResponse) GetCategories() []*CategoryNode {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+// Запрос на изменение категории
+type UpdateCategoryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Новые значения полей категории, определяется по category.uuid. Поля заменяются целиком,
+	// кроме metadata_schema: она не меняется.
+	// Встроенные категории нельзя перенести, а перенос остальных не должен менять их legacy_category.
+	Category      *CategoryNode `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCategoryRequest) Reset() {
+	*x = UpdateCategoryRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCategoryRequest) ProtoMessage() {}
+
+func (x *UpdateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *UpdateCategoryRequest) GetCategory() *CategoryNode {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+// Ответ с изменённой категорией
+type UpdateCategoryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Категория после изменения
+	Category      *CategoryNode `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCategoryResponse) Reset() {
+	*x = UpdateCategoryResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCategoryResponse) ProtoMessage() {}
+
+func (x *UpdateCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCategoryResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *UpdateCategoryResponse) GetCategory() *CategoryNode {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+// Запрос на удаление категории
+type DeleteCategoryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID удаляемой категории
+	Uuid          string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryRequest) Reset() {
+	*x = DeleteCategoryRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryRequest) ProtoMessage() {}
+
+func (x *DeleteCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *DeleteCategoryRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+// Ответ на удаление категории
+type DeleteCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryResponse) Reset() {
+	*x = DeleteCategoryResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryResponse) ProtoMessage() {}
+
+func (x *DeleteCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{44}
+}
+
+// Запрос на замену схемы метаданных категории
+type SetCategoryMetadataSchemaRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID категории
+	CategoryUuid string `protobuf:"bytes,1,opt,name=category_uuid,json=categoryUuid,proto3" json:"category_uuid,omitempty"`
+	// Поля схемы. Пустой список удаляет схему. Ключи уникальны и не совпадают с ключами в схемах
+	// предков и потомков категории. Существующие детали не перепроверяются: новая схема применяется
+	// при следующем изменении их метаданных или категории.
+	Fields        []*MetadataField `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCategoryMetadataSchemaRequest) Reset() {
+	*x = SetCategoryMetadataSchemaRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCategoryMetadataSchemaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCategoryMetadataSchemaRequest) ProtoMessage() {}
+
+func (x *SetCategoryMetadataSchemaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCategoryMetadataSchemaRequest.ProtoReflect.Descriptor instead.
+func (*SetCategoryMetadataSchemaRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SetCategoryMetadataSchemaRequest) GetCategoryUuid() string {
+	if x != nil {
+		return x.CategoryUuid
+	}
+	return ""
+}
+
+func (x *SetCategoryMetadataSchemaRequest) GetFields() []*MetadataField {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+// Ответ с категорией после замены схемы
+type SetCategoryMetadataSchemaResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Категория с новой схемой
+	Category      *CategoryNode `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCategoryMetadataSchemaResponse) Reset() {
+	*x = SetCategoryMetadataSchemaResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCategoryMetadataSchemaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCategoryMetadataSchemaResponse) ProtoMessage() {}
+
+func (x *SetCategoryMetadataSchemaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCategoryMetadataSchemaResponse.ProtoReflect.Descriptor instead.
+func (*SetCategoryMetadataSchemaResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *SetCategoryMetadataSchemaResponse) GetCategory() *CategoryNode {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+// Запрос действующей схемы метаданных категории
+type GetCategoryMetadataSchemaRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID категории
+	CategoryUuid  string `protobuf:"bytes,1,opt,name=category_uuid,json=categoryUuid,proto3" json:"category_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryMetadataSchemaRequest) Reset() {
+	*x = GetCategoryMetadataSchemaRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryMetadataSchemaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryMetadataSchemaRequest) ProtoMessage() {}
+
+func (x *GetCategoryMetadataSchemaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryMetadataSchemaRequest.ProtoReflect.Descriptor instead.
+func (*GetCategoryMetadataSchemaRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetCategoryMetadataSchemaRequest) GetCategoryUuid() string {
+	if x != nil {
+		return x.CategoryUuid
+	}
+	return ""
+}
+
+// Действующая схема метаданных категории
+type GetCategoryMetadataSchemaResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Поля схем категории и её предков, начиная с корня дерева. У каждого поля заполнен category_uuid.
+	Fields        []*MetadataField `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryMetadataSchemaResponse) Reset() {
+	*x = GetCategoryMetadataSchemaResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryMetadataSchemaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryMetadataSchemaResponse) ProtoMessage() {}
+
+func (x *GetCategoryMetadataSchemaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryMetadataSchemaResponse.ProtoReflect.Descriptor instead.
+func (*GetCategoryMetadataSchemaResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetCategoryMetadataSchemaResponse) GetFields() []*MetadataField {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+// Описание поля метаданных деталей категории.
+// Метаданные детали проверяются по действующей схеме её категории: обязательные поля должны быть заданы,
+// значения - иметь указанный тип и попадать в диапазон. Ключи вне схемы не проверяются.
+type MetadataField struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Ключ в metadata детали: непустой, без точек и без "$" в начале
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// Тип значения
+	Type MetadataType `protobuf:"varint,2,opt,name=type,proto3,enum=inventory.v1.MetadataType" json:"type,omitempty"`
+	// Единица измерения для отображения (например, "кН"). Не проверяется.
+	Unit string `protobuf:"bytes,3,opt,name=unit,proto3" json:"unit,omitempty"`
+	// Описание поля для отображения
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// Поле обязательно для деталей категории
+	Required bool `protobuf:"varint,5,opt,name=required,proto3" json:"required,omitempty"`
+	// Допустимый диапазон значения, границы включаются. Только для типов INT64 и DOUBLE.
+	Range *DoubleRange `protobuf:"bytes,6,opt,name=range,proto3" json:"range,omitempty"`
+	// Категория, в схеме которой задано поле (только для чтения)
+	CategoryUuid  string `protobuf:"bytes,7,opt,name=category_uuid,json=categoryUuid,proto3" json:"category_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MetadataField) Reset() {
+	*x = MetadataField{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MetadataField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetadataField) ProtoMessage() {}
+
+func (x *MetadataField) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetadataField.ProtoReflect.Descriptor instead.
+func (*MetadataField) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *MetadataField) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *MetadataField) GetType() MetadataType {
+	if x != nil {
+		return x.Type
+	}
+	return MetadataType_METADATA_TYPE_UNSPECIFIED
+}
+
+func (x *MetadataField) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *MetadataField) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *MetadataField) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *MetadataField) GetRange() *DoubleRange {
+	if x != nil {
+		return x.Range
+	}
+	return nil
+}
+
+func (x *MetadataField) GetCategoryUuid() string {
+	if x != nil {
+		return x.CategoryUuid
+	}
+	return ""
+}
+
+// Запрос на запланированное изменение цены
+type SchedulePriceChangeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID детали
+	PartUuid string `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	// Новая цена, больше нуля
+	Price float64 `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
+	// Момент, с которого действует новая цена. Должен быть в будущем: немедленно цена меняется через UpdatePart.
+	EffectiveFrom *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchedulePriceChangeRequest) Reset() {
+	*x = SchedulePriceChangeRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchedulePriceChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchedulePriceChangeRequest) ProtoMessage() {}
+
+func (x *SchedulePriceChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchedulePriceChangeRequest.ProtoReflect.Descriptor instead.
+func (*SchedulePriceChangeRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *SchedulePriceChangeRequest) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *SchedulePriceChangeRequest) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *SchedulePriceChangeRequest) GetEffectiveFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return nil
+}
+
+// Ответ на запланированное изменение цены
+type SchedulePriceChangeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Добавленная версия цены
+	PriceVersion  *PriceVersion `protobuf:"bytes,1,opt,name=price_version,json=priceVersion,proto3" json:"price_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchedulePriceChangeResponse) Reset() {
+	*x = SchedulePriceChangeResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchedulePriceChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchedulePriceChangeResponse) ProtoMessage() {}
+
+func (x *SchedulePriceChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchedulePriceChangeResponse.ProtoReflect.Descriptor instead.
+func (*SchedulePriceChangeResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *SchedulePriceChangeResponse) GetPriceVersion() *PriceVersion {
+	if x != nil {
+		return x.PriceVersion
+	}
+	return nil
+}
+
+// Запрос истории цен детали
+type GetPriceHistoryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID детали
+	PartUuid      string `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPriceHistoryRequest) Reset() {
+	*x = GetPriceHistoryRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPriceHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPriceHistoryRequest) ProtoMessage() {}
+
+func (x *GetPriceHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPriceHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetPriceHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetPriceHistoryRequest) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+// История цен детали
+type GetPriceHistoryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Версии цены в порядке начала действия
+	Versions      []*PriceVersion `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPriceHistoryResponse) Reset() {
+	*x = GetPriceHistoryResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPriceHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPriceHistoryResponse) ProtoMessage() {}
+
+func (x *GetPriceHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPriceHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetPriceHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetPriceHistoryResponse) GetVersions() []*PriceVersion {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+// Версия цены детали. Действует с effective_from до начала следующей версии.
+type PriceVersion struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID версии (UUIDv7). Из версий с одинаковым effective_from действует созданная последней.
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// Цена
+	Price float64 `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
+	// Момент, с которого действует цена
+	EffectiveFrom *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`
+	// Время создания версии
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceVersion) Reset() {
+	*x = PriceVersion{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceVersion) ProtoMessage() {}
+
+func (x *PriceVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceVersion.ProtoReflect.Descriptor instead.
+func (*PriceVersion) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *PriceVersion) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *PriceVersion) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *PriceVersion) GetEffectiveFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return nil
+}
+
+func (x *PriceVersion) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Запрос списка деталей с низким остатком
+type ListLowStockPartsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Максимальное количество деталей на странице. 0 - размер по умолчанию (50), значения больше 1000 уменьшаются до 1000.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Токен страницы из next_page_token предыдущего ответа. Пустой - первая страница.
+	PageToken     string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLowStockPartsRequest) Reset() {
+	*x = ListLowStockPartsRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLowStockPartsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLowStockPartsRequest) ProtoMessage() {}
+
+func (x *ListLowStockPartsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLowStockPartsRequest.ProtoReflect.Descriptor instead.
+func (*ListLowStockPartsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ListLowStockPartsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListLowStockPartsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// Список деталей с низким остатком
+type ListLowStockPartsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Детали текущей страницы в порядке возрастания остатка
+	Parts []*Part `protobuf:"bytes,1,rep,name=parts,proto3" json:"parts,omitempty"`
+	// Токен следующей страницы. Пустой, если страница последняя.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Общее количество деталей с низким остатком
+	TotalSize     int32 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLowStockPartsResponse) Reset() {
+	*x = ListLowStockPartsResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLowStockPartsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLowStockPartsResponse) ProtoMessage() {}
+
+func (x *ListLowStockPartsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLowStockPartsResponse.ProtoReflect.Descriptor instead.
+func (*ListLowStockPartsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListLowStockPartsResponse) GetParts() []*Part {
+	if x != nil {
+		return x.Parts
+	}
+	return nil
+}
+
+func (x *ListLowStockPartsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListLowStockPartsResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+// Запрос на перемещение остатка между складами
+type TransferStockRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID детали
+	PartUuid string `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	// UUID склада-отправителя
+	FromWarehouseUuid string `protobuf:"bytes,2,opt,name=from_warehouse_uuid,json=fromWarehouseUuid,proto3" json:"from_warehouse_uuid,omitempty"`
+	// UUID склада-получателя, отличается от отправителя
+	ToWarehouseUuid string `protobuf:"bytes,3,opt,name=to_warehouse_uuid,json=toWarehouseUuid,proto3" json:"to_warehouse_uuid,omitempty"`
+	// Перемещаемое количество, больше нуля и не больше остатка на складе-отправителе
+	Quantity int64 `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// Идентификатор связанного документа (накладная на перемещение), необязательный
+	ReferenceId   string `protobuf:"bytes,5,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferStockRequest) Reset() {
+	*x = TransferStockRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferStockRequest) ProtoMessage() {}
+
+func (x *TransferStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferStockRequest.ProtoReflect.Descriptor instead.
+func (*TransferStockRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *TransferStockRequest) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *TransferStockRequest) GetFromWarehouseUuid() string {
+	if x != nil {
+		return x.FromWarehouseUuid
+	}
+	return ""
+}
+
+func (x *TransferStockRequest) GetToWarehouseUuid() string {
+	if x != nil {
+		return x.ToWarehouseUuid
+	}
+	return ""
+}
+
+func (x *TransferStockRequest) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *TransferStockRequest) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+// Ответ с записанными движениями перемещения
+type TransferStockResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Списание со склада-отправителя
+	Outgoing *StockMovement `protobuf:"bytes,1,opt,name=outgoing,proto3" json:"outgoing,omitempty"`
+	// Поступление на склад-получатель
+	Incoming      *StockMovement `protobuf:"bytes,2,opt,name=incoming,proto3" json:"incoming,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferStockResponse) Reset() {
+	*x = TransferStockResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferStockResponse) ProtoMessage() {}
+
+func (x *TransferStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferStockResponse.ProtoReflect.Descriptor instead.
+func (*TransferStockResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *TransferStockResponse) GetOutgoing() *StockMovement {
+	if x != nil {
+		return x.Outgoing
+	}
+	return nil
+}
+
+func (x *TransferStockResponse) GetIncoming() *StockMovement {
+	if x != nil {
+		return x.Incoming
+	}
+	return nil
+}
+
+// Запрос на списание остатков под отгрузку
+type FulfillStockRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Идентификатор документа отгрузки (например, UUID заказа), записывается в движения
+	ReferenceId string `protobuf:"bytes,1,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
+	// UUID склада, с которого отгрузка выполняется в первую очередь. Недостающее количество
+	// списывается с остальных складов, начиная с наибольшего остатка. Пустой - без предпочтения.
+	PreferredWarehouseUuid string `protobuf:"bytes,2,opt,name=preferred_warehouse_uuid,json=preferredWarehouseUuid,proto3" json:"preferred_warehouse_uuid,omitempty"`
+	// Отгружаемые детали, непустой список
+	Items         []*FulfillmentItem `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FulfillStockRequest) Reset() {
+	*x = FulfillStockRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FulfillStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FulfillStockRequest) ProtoMessage() {}
+
+func (x *FulfillStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FulfillStockRequest.ProtoReflect.Descriptor instead.
+func (*FulfillStockRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *FulfillStockRequest) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+func (x *FulfillStockRequest) GetPreferredWarehouseUuid() string {
+	if x != nil {
+		return x.PreferredWarehouseUuid
+	}
+	return ""
+}
+
+func (x *FulfillStockRequest) GetItems() []*FulfillmentItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// Позиция отгрузки
+type FulfillmentItem struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID детали
+	PartUuid string `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	// Количество, больше нуля
+	Quantity      int64 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FulfillmentItem) Reset() {
+	*x = FulfillmentItem{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FulfillmentItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FulfillmentItem) ProtoMessage() {}
+
+func (x *FulfillmentItem) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FulfillmentItem.ProtoReflect.Descriptor instead.
+func (*FulfillmentItem) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *FulfillmentItem) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *FulfillmentItem) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// Ответ с движениями отгрузки
+type FulfillStockResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Движения SALE по складам, с которых списаны детали. Если остатка недостаточно хотя бы
+	// для одной позиции, ничего не списывается.
+	Movements     []*StockMovement `protobuf:"bytes,1,rep,name=movements,proto3" json:"movements,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FulfillStockResponse) Reset() {
+	*x = FulfillStockResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FulfillStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FulfillStockResponse) ProtoMessage() {}
+
+func (x *FulfillStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FulfillStockResponse.ProtoReflect.Descriptor instead.
+func (*FulfillStockResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *FulfillStockResponse) GetMovements() []*StockMovement {
+	if x != nil {
+		return x.Movements
+	}
+	return nil
+}
+
+// Запрос на добавление склада
+type CreateWarehouseRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Новый склад. Если uuid не указан, он будет сгенерирован. Код и название обязательны, код уникален.
+	Warehouse     *Warehouse `protobuf:"bytes,1,opt,name=warehouse,proto3" json:"warehouse,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWarehouseRequest) Reset() {
+	*x = CreateWarehouseRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWarehouseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWarehouseRequest) ProtoMessage() {}
+
+func (x *CreateWarehouseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWarehouseRequest.ProtoReflect.Descriptor instead.
+func (*CreateWarehouseRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *CreateWarehouseRequest) GetWarehouse() *Warehouse {
+	if x != nil {
+		return x.Warehouse
+	}
+	return nil
+}
+
+// Ответ с добавленным складом
+type CreateWarehouseResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Добавленный склад
+	Warehouse     *Warehouse `protobuf:"bytes,1,opt,name=warehouse,proto3" json:"warehouse,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWarehouseResponse) Reset() {
+	*x = CreateWarehouseResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWarehouseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWarehouseResponse) ProtoMessage() {}
+
+func (x *CreateWarehouseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWarehouseResponse.ProtoReflect.Descriptor instead.
+func (*CreateWarehouseResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *CreateWarehouseResponse) GetWarehouse() *Warehouse {
+	if x != nil {
+		return x.Warehouse
+	}
+	return nil
+}
+
+// Запрос склада по UUID
+type GetWarehouseRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID склада
+	Uuid          string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWarehouseRequest) Reset() {
+	*x = GetWarehouseRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWarehouseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWarehouseRequest) ProtoMessage() {}
+
+func (x *GetWarehouseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWarehouseRequest.ProtoReflect.Descriptor instead.
+func (*GetWarehouseRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetWarehouseRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+// Ответ с найденным складом
+type GetWarehouseResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Найденный склад
+	Warehouse     *Warehouse `protobuf:"bytes,1,opt,name=warehouse,proto3" json:"warehouse,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWarehouseResponse) Reset() {
+	*x = GetWarehouseResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWarehouseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWarehouseResponse) ProtoMessage() {}
+
+func (x *GetWarehouseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWarehouseResponse.ProtoReflect.Descriptor instead.
+func (*GetWarehouseResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetWarehouseResponse) GetWarehouse() *Warehouse {
+	if x != nil {
+		return x.Warehouse
+	}
+	return nil
+}
+
+// Запрос списка складов
+type ListWarehousesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWarehousesRequest) Reset() {
+	*x = ListWarehousesRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWarehousesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWarehousesRequest) ProtoMessage() {}
+
+func (x *ListWarehousesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWarehousesRequest.ProtoReflect.Descriptor instead.
+func (*ListWarehousesRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{66}
+}
+
+// Ответ со списком складов
+type ListWarehousesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Склады, упорядоченные по коду
+	Warehouses    []*Warehouse `protobuf:"bytes,1,rep,name=warehouses,proto3" json:"warehouses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWarehousesResponse) Reset() {
+	*x = ListWarehousesResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWarehousesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWarehousesResponse) ProtoMessage() {}
+
+func (x *ListWarehousesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWarehousesResponse.ProtoReflect.Descriptor instead.
+func (*ListWarehousesResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ListWarehousesResponse) GetWarehouses() []*Warehouse {
+	if x != nil {
+		return x.Warehouses
+	}
+	return nil
+}
+
+// Запрос количества деталей по значениям измерений
+type GetPartFacetsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Фильтр, как в ListParts. Условия на категории (categories, category_uuids), страны производителей
+	// и теги не применяются к количеству по своему измерению, но применяются к остальным.
+	Filter        *PartsFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPartFacetsRequest) Reset() {
+	*x = GetPartFacetsRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPartFacetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPartFacetsRequest) ProtoMessage() {}
+
+func (x *GetPartFacetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPartFacetsRequest.ProtoReflect.Descriptor instead.
+func (*GetPartFacetsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GetPartFacetsRequest) GetFilter() *PartsFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+// Количество деталей по значениям измерений. Значения упорядочены по убыванию количества,
+// при равенстве - по значению. Значения без единой детали не возвращаются.
+type GetPartFacetsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Количество по категориям деталей
+	Categories []*CategoryFacetCount `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	// Количество по странам производителей. Детали без страны производителя не учитываются.
+	ManufacturerCountries []*FacetCount `protobuf:"bytes,2,rep,name=manufacturer_countries,json=manufacturerCountries,proto3" json:"manufacturer_countries,omitempty"`
+	// Количество по тегам. Деталь с несколькими тегами учитывается в каждом из них.
+	Tags          []*FacetCount `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPartFacetsResponse) Reset() {
+	*x = GetPartFacetsResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPartFacetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPartFacetsResponse) ProtoMessage() {}
+
+func (x *GetPartFacetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPartFacetsResponse.ProtoReflect.Descriptor instead.
+func (*GetPartFacetsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GetPartFacetsResponse) GetCategories() []*CategoryFacetCount {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *GetPartFacetsResponse) GetManufacturerCountries() []*FacetCount {
+	if x != nil {
+		return x.ManufacturerCountries
+	}
+	return nil
+}
+
+func (x *GetPartFacetsResponse) GetTags() []*FacetCount {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// Количество деталей в категории
+type CategoryFacetCount struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID категории в дереве категорий. Пустой у деталей, созданных до появления дерева.
+	CategoryUuid string `protobuf:"bytes,1,opt,name=category_uuid,json=categoryUuid,proto3" json:"category_uuid,omitempty"`
+	// Соответствующее категории значение enum
+	Category Category `protobuf:"varint,2,opt,name=category,proto3,enum=inventory.v1.Category" json:"category,omitempty"`
+	// Количество деталей
+	Count         int32 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryFacetCount) Reset() {
+	*x = CategoryFacetCount{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryFacetCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryFacetCount) ProtoMessage() {}
+
+func (x *CategoryFacetCount) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryFacetCount.ProtoReflect.Descriptor instead.
+func (*CategoryFacetCount) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *CategoryFacetCount) GetCategoryUuid() string {
+	if x != nil {
+		return x.CategoryUuid
+	}
+	return ""
+}
+
+func (x *CategoryFacetCount) GetCategory() Category {
+	if x != nil {
+		return x.Category
+	}
+	return Category_CATEGORY_UNSPECIFIED
+}
+
+func (x *CategoryFacetCount) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// Количество деталей со значением измерения
+type FacetCount struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Значение измерения
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	// Количество деталей
+	Count         int32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FacetCount) Reset() {
+	*x = FacetCount{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FacetCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FacetCount) ProtoMessage() {}
+
+func (x *FacetCount) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FacetCount.ProtoReflect.Descriptor instead.
+func (*FacetCount) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *FacetCount) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *FacetCount) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// Фильтр для отбора деталей.
+// Все заданные условия объединяются по логическому И: деталь попадает в выборку, только если удовлетворяет каждому.
+// Значения внутри одного списочного поля (uuids, names, categories, manufacturer_countries, tags)
+// объединяются по логическому ИЛИ. Незаданное (пустое) условие не ограничивает выборку.
+type PartsFilter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Список UUID'ов для фильтрации. Пустой список означает отсутствие фильтрации по UUID.
+	// Фильтрация по UUID работает как логическое ИЛИ (деталь должна иметь один из указанных UUID).
+	Uuids []string `protobuf:"bytes,1,rep,name=uuids,proto3" json:"uuids,omitempty"`
+	// Список имён для фильтрации. Пустой список означает отсутствие фильтрации по имени.
+	// Фильтрация по имени работает как логическое ИЛИ (деталь должна иметь одно из указанных имён).
+	Names []string `protobuf:"bytes,2,rep,name=names,proto3" json:"names,omitempty"`
+	// Список категорий для фильтрации. Пустой список означает отсутствие фильтрации по категории.
+	// Фильтрация по категории работает как логическое ИЛИ (деталь должна быть в одной из указанных категорий).
+	Categories []Category `protobuf:"varint,3,rep,packed,name=categories,proto3,enum=inventory.v1.Category" json:"categories,omitempty"`
+	// Список стран производителей для фильтрации. Пустой список означает отсутствие фильтрации по стране.
+	// Фильтрация по стране работает как логическое ИЛИ (деталь должна быть произведена в одной из указанных стран).
+	ManufacturerCountries []string `protobuf:"bytes,4,rep,name=manufacturer_countries,json=manufacturerCountries,proto3" json:"manufacturer_countries,omitempty"`
+	// Список тегов для фильтрации. Пустой список означает отсутствие фильтрации по тегам.
+	// Фильтрация по тегам работает как логическое ИЛИ (деталь должна иметь хотя бы один из указанных тегов).
+	Tags []string `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Полнотекстовый запрос по названию, описанию и тегам (веса полей 10, 1 и 5 соответственно).
+	// Слова запроса и детали приводятся к основе с учётом английской и русской морфологии
+	// ("двигатели" находит "двигатель"), стоп-слова отбрасываются. Слово запроса совпадает со словом
+	// детали целиком или как префикс его основы ("hydro" находит "hydrogen"). Деталь подходит,
+	// если совпало хотя бы одно слово запроса; запрос из одних стоп-слов не находит ничего.
+	// Релевантность - сумма по всем парам (слово детали, слово запроса): вес поля за совпадение
+	// основ целиком и половина веса за совпадение по префиксу. Так "hydrogen" в названии даёт 10
+	// на запрос "hydrogen" и 5 на запрос "hydro". Оценка одинакова во всех хранилищах каталога.
+	// Релевантность возвращается в Part.search_score. Пустая строка - без полнотекстового поиска.
+	Query string `protobuf:"bytes,6,opt,name=query,proto3" json:"query,omitempty"`
+	// Диапазон цены за единицу (границы включительно)
+	Price *DoubleRange `protobuf:"bytes,7,opt,name=price,proto3" json:"price,omitempty"`
+	// Только детали в наличии (stock_quantity > 0)
+	InStockOnly bool `protobuf:"varint,8,opt,name=in_stock_only,json=inStockOnly,proto3" json:"in_stock_only,omitempty"`
+	// Диапазоны физических размеров и веса. Детали без размеров не проходят заданные диапазоны.
+	Dimensions *DimensionsFilter `protobuf:"bytes,9,opt,name=dimensions,proto3" json:"dimensions,omitempty"`
+	// Диапазон даты создания
+	CreatedAt *TimestampRange `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Диапазон даты последнего обновления
+	UpdatedAt *TimestampRange `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Условия на метаданные, объединяются по логическому И
+	Metadata []*MetadataPredicate `protobuf:"bytes,12,rep,name=metadata,proto3" json:"metadata,omitempty"`
+	// UUID компонентов: отбираются сборки, в состав которых напрямую входит любой из них
+	ComponentUuids []string `protobuf:"bytes,13,rep,name=component_uuids,json=componentUuids,proto3" json:"component_uuids,omitempty"`
+	// UUID производителей из справочника (логическое ИЛИ). Детали без ссылки на справочник не проходят фильтр.
+	ManufacturerUuids []string `protobuf:"bytes,14,rep,name=manufacturer_uuids,json=manufacturerUuids,proto3" json:"manufacturer_uuids,omitempty"`
+	// UUID категорий дерева (логическое ИЛИ). Деталь подходит, если её категория - одна из указанных
+	// или их потомок.
+	CategoryUuids []string `protobuf:"bytes,15,rep,name=category_uuids,json=categoryUuids,proto3" json:"category_uuids,omitempty"`
+	// Только детали с заданным порогом дозаказа, остаток которых не превышает его
+	LowStock bool `protobuf:"varint,16,opt,name=low_stock,json=lowStock,proto3" json:"low_stock,omitempty"`
+	// Состояния жизненного цикла (логическое ИЛИ). Пустой список в ListParts, ListLowStockParts
+	// и GetPartFacets означает только действующие детали (ACTIVE).
+	LifecycleStates []PartLifecycleState `protobuf:"varint,17,rep,packed,name=lifecycle_states,json=lifecycleStates,proto3,enum=inventory.v1.PartLifecycleState" json:"lifecycle_states,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PartsFilter) Reset() {
+	*x = PartsFilter{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartsFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartsFilter) ProtoMessage() {}
+
+func (x *PartsFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartsFilter.ProtoReflect.Descriptor instead.
+func (*PartsFilter) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *PartsFilter) GetUuids() []string {
+	if x != nil {
+		return x.Uuids
+	}
+	return nil
+}
+
+func (x *PartsFilter) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *PartsFilter) GetCategories() []Category {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *PartsFilter) GetManufacturerCountries() []string {
+	if x != nil {
+		return x.ManufacturerCountries
+	}
+	return nil
+}
+
+func (x *PartsFilter) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *PartsFilter) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *PartsFilter) GetPrice() *DoubleRange {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *PartsFilter) GetInStockOnly() bool {
+	if x != nil {
+		return x.InStockOnly
+	}
+	return false
+}
+
+func (x *PartsFilter) GetDimensions() *DimensionsFilter {
+	if x != nil {
+		return x.Dimensions
+	}
+	return nil
+}
+
+func (x *PartsFilter) GetCreatedAt() *TimestampRange {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PartsFilter) GetUpdatedAt() *TimestampRange {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *PartsFilter) GetMetadata() []*MetadataPredicate {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *PartsFilter) GetComponentUuids() []string {
+	if x != nil {
+		return x.ComponentUuids
+	}
+	return nil
+}
+
+func (x *PartsFilter) GetManufacturerUuids() []string {
+	if x != nil {
+		return x.ManufacturerUuids
+	}
+	return nil
+}
+
+func (x *PartsFilter) GetCategoryUuids() []string {
+	if x != nil {
+		return x.CategoryUuids
+	}
+	return nil
+}
+
+func (x *PartsFilter) GetLowStock() bool {
+	if x != nil {
+		return x.LowStock
+	}
+	return false
+}
+
+func (x *PartsFilter) GetLifecycleStates() []PartLifecycleState {
+	if x != nil {
+		return x.LifecycleStates
+	}
+	return nil
+}
+
+// Диапазон чисел с плавающей точкой. Границы включительно, незаданная граница не ограничивает.
+type DoubleRange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Нижняя граница
+	Min *float64 `protobuf:"fixed64,1,opt,name=min,proto3,oneof" json:"min,omitempty"`
+	// Верхняя граница
+	Max           *float64 `protobuf:"fixed64,2,opt,name=max,proto3,oneof" json:"max,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DoubleRange) Reset() {
+	*x = DoubleRange{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DoubleRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoubleRange) ProtoMessage() {}
+
+func (x *DoubleRange) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoubleRange.ProtoReflect.Descriptor instead.
+func (*DoubleRange) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *DoubleRange) GetMin() float64 {
+	if x != nil && x.Min != nil {
+		return *x.Min
+	}
+	return 0
+}
+
+func (x *DoubleRange) GetMax() float64 {
+	if x != nil && x.Max != nil {
+		return *x.Max
+	}
+	return 0
+}
+
+// Диапазон времени [from, to). Незаданная граница не ограничивает.
+type TimestampRange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Начало диапазона (включительно)
+	From *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// Конец диапазона (не включительно)
+	To            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimestampRange) Reset() {
+	*x = TimestampRange{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimestampRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimestampRange) ProtoMessage() {}
+
+func (x *TimestampRange) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimestampRange.ProtoReflect.Descriptor instead.
+func (*TimestampRange) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *TimestampRange) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *TimestampRange) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+// Диапазоны физических размеров детали. Заданные диапазоны объединяются по логическому И.
+type DimensionsFilter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Диапазон длины в сантиметрах
+	Length *DoubleRange `protobuf:"bytes,1,opt,name=length,proto3" json:"length,omitempty"`
+	// Диапазон ширины в сантиметрах
+	Width *DoubleRange `protobuf:"bytes,2,opt,name=width,proto3" json:"width,omitempty"`
+	// Диапазон высоты в сантиметрах
+	Height *DoubleRange `protobuf:"bytes,3,opt,name=height,proto3" json:"height,omitempty"`
+	// Диапазон веса в килограммах
+	Weight        *DoubleRange `protobuf:"bytes,4,opt,name=weight,proto3" json:"weight,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DimensionsFilter) Reset() {
+	*x = DimensionsFilter{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DimensionsFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DimensionsFilter) ProtoMessage() {}
+
+func (x *DimensionsFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DimensionsFilter.ProtoReflect.Descriptor instead.
+func (*DimensionsFilter) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *DimensionsFilter) GetLength() *DoubleRange {
+	if x != nil {
+		return x.Length
+	}
+	return nil
+}
+
+func (x *DimensionsFilter) GetWidth() *DoubleRange {
+	if x != nil {
+		return x.Width
+	}
+	return nil
+}
+
+func (x *DimensionsFilter) GetHeight() *DoubleRange {
+	if x != nil {
+		return x.Height
+	}
+	return nil
+}
+
+func (x *DimensionsFilter) GetWeight() *DoubleRange {
+	if x != nil {
+		return x.Weight
+	}
+	return nil
+}
+
+// Условие на значение метаданных детали
+type MetadataPredicate struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Ключ метаданных. Не может быть пустым, содержать точку или начинаться с "$".
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// Оператор сравнения
+	Operator MetadataOperator `protobuf:"varint,2,opt,name=operator,proto3,enum=inventory.v1.MetadataOperator" json:"operator,omitempty"`
+	// Значение для сравнения. Не используется оператором EXISTS.
+	// EQ и NE применимы к любому типу; GT, GTE, LT и LTE - только к int64_value и double_value.
+	// Числа сравниваются по значению независимо от типа (int64 5 равно double 5.0),
+	// значения разных типов (строка и число) никогда не равны.
+	Value         *Value `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MetadataPredicate) Reset() {
+	*x = MetadataPredicate{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MetadataPredicate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetadataPredicate) ProtoMessage() {}
+
+func (x *MetadataPredicate) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetadataPredicate.ProtoReflect.Descriptor instead.
+func (*MetadataPredicate) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *MetadataPredicate) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *MetadataPredicate) GetOperator() MetadataOperator {
+	if x != nil {
+		return x.Operator
 	}
-	return nil
+	return MetadataOperator_METADATA_OPERATOR_UNSPECIFIED
 }
 
-func (x *PartsFilter) GetTags() []string {
+func (x *MetadataPredicate) GetValue() *Value {
 	if x != nil {
-		return x.Tags
+		return x.Value
 	}
 	return nil
 }
@@ -365,9 +4869,10 @@ type Part struct {
 	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
 	// Цена за единицу в условных единицах
 	Price float64 `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
-	// Количество единиц на складе
+	// Количество единиц на всех складах (сумма stock_levels)
 	StockQuantity int64 `protobuf:"varint,5,opt,name=stock_quantity,json=stockQuantity,proto3" json:"stock_quantity,omitempty"`
-	// Категория детали
+	// Категория детали из фиксированного списка. Для категорий дерева - значение ближайшей встроенной
+	// категории среди предков, CATEGORY_UNSPECIFIED, если такой нет.
 	Category Category `protobuf:"varint,6,opt,name=category,proto3,enum=inventory.v1.Category" json:"category,omitempty"`
 	// Физические размеры детали
 	Dimensions *Dimensions `protobuf:"bytes,7,opt,name=dimensions,proto3" json:"dimensions,omitempty"`
@@ -380,14 +4885,35 @@ type Part struct {
 	// Дата создания записи о детали
 	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	// Дата последнего обновления информации о детали
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Релевантность полнотекстовому запросу filter.query в ListParts. 0 вне поиска.
+	// Значения сравнимы только в рамках одного запроса.
+	SearchScore float64 `protobuf:"fixed64,13,opt,name=search_score,json=searchScore,proto3" json:"search_score,omitempty"`
+	// Состав сборки. Пусто для детали, которая не собирается из других деталей.
+	// Компоненты должны существовать и не могут образовывать цикл.
+	Components []*BomComponent `protobuf:"bytes,14,rep,name=components,proto3" json:"components,omitempty"`
+	// UUID категории в дереве категорий. При сохранении достаточно указать category или category_uuid:
+	// по значению enum выбирается соответствующая встроенная категория. Если указаны оба, они должны совпадать.
+	// В update_mask пути category и category_uuid обновляют оба поля.
+	CategoryUuid string `protobuf:"bytes,15,opt,name=category_uuid,json=categoryUuid,proto3" json:"category_uuid,omitempty"`
+	// Порог дозаказа: когда остаток опускается до него, отправляется оповещение о низком остатке.
+	// 0 - порог не задан.
+	ReorderThreshold int64 `protobuf:"varint,16,opt,name=reorder_threshold,json=reorderThreshold,proto3" json:"reorder_threshold,omitempty"`
+	// Остатки по складам. При создании детали можно указать их или только stock_quantity - тогда
+	// весь остаток относится к основному складу. Как и stock_quantity, изменяются только движениями остатка.
+	StockLevels []*StockLevel `protobuf:"bytes,17,rep,name=stock_levels,json=stockLevels,proto3" json:"stock_levels,omitempty"`
+	// Состояние жизненного цикла. При создании можно указать DRAFT или ACTIVE (по умолчанию),
+	// затем изменяется только через ChangePartLifecycleState.
+	LifecycleState PartLifecycleState `protobuf:"varint,18,opt,name=lifecycle_state,json=lifecycleState,proto3,enum=inventory.v1.PartLifecycleState" json:"lifecycle_state,omitempty"`
+	// UUID рекомендуемой замены снятой с производства или отозванной детали. Только для чтения.
+	ReplacementPartUuid string `protobuf:"bytes,19,opt,name=replacement_part_uuid,json=replacementPartUuid,proto3" json:"replacement_part_uuid,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *Part) Reset() {
 	*x = Part{}
-	mi := &file_inventory_v1_inventory_proto_msgTypes[5]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -399,7 +4925,7 @@ func (x *Part) String() string {
 func (*Part) ProtoMessage() {}
 
 func (x *Part) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_inventory_proto_msgTypes[5]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -412,7 +4938,7 @@ func (x *Part) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Part.ProtoReflect.Descriptor instead.
 func (*Part) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{5}
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{77}
 }
 
 func (x *Part) GetUuid() string {
@@ -499,6 +5025,238 @@ func (x *Part) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Part) GetSearchScore() float64 {
+	if x != nil {
+		return x.SearchScore
+	}
+	return 0
+}
+
+func (x *Part) GetComponents() []*BomComponent {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+func (x *Part) GetCategoryUuid() string {
+	if x != nil {
+		return x.CategoryUuid
+	}
+	return ""
+}
+
+func (x *Part) GetReorderThreshold() int64 {
+	if x != nil {
+		return x.ReorderThreshold
+	}
+	return 0
+}
+
+func (x *Part) GetStockLevels() []*StockLevel {
+	if x != nil {
+		return x.StockLevels
+	}
+	return nil
+}
+
+func (x *Part) GetLifecycleState() PartLifecycleState {
+	if x != nil {
+		return x.LifecycleState
+	}
+	return PartLifecycleState_PART_LIFECYCLE_STATE_UNSPECIFIED
+}
+
+func (x *Part) GetReplacementPartUuid() string {
+	if x != nil {
+		return x.ReplacementPartUuid
+	}
+	return ""
+}
+
+// Остаток детали на складе
+type StockLevel struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID склада
+	WarehouseUuid string `protobuf:"bytes,1,opt,name=warehouse_uuid,json=warehouseUuid,proto3" json:"warehouse_uuid,omitempty"`
+	// Количество единиц на складе, неотрицательное
+	Quantity      int64 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockLevel) Reset() {
+	*x = StockLevel{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockLevel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockLevel) ProtoMessage() {}
+
+func (x *StockLevel) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockLevel.ProtoReflect.Descriptor instead.
+func (*StockLevel) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *StockLevel) GetWarehouseUuid() string {
+	if x != nil {
+		return x.WarehouseUuid
+	}
+	return ""
+}
+
+func (x *StockLevel) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// Склад, на котором хранятся детали
+type Warehouse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Уникальный идентификатор склада
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// Уникальный короткий код: строчные латинские буквы и цифры через дефис
+	Code string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	// Название склада
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Расположение склада (например, стартовая площадка), необязательное
+	Location      string `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Warehouse) Reset() {
+	*x = Warehouse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Warehouse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Warehouse) ProtoMessage() {}
+
+func (x *Warehouse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Warehouse.ProtoReflect.Descriptor instead.
+func (*Warehouse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *Warehouse) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *Warehouse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Warehouse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Warehouse) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+// Компонент сборки
+type BomComponent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID детали или подсборки
+	PartUuid string `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	// Количество на одну сборку, больше нуля
+	Quantity      int64 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BomComponent) Reset() {
+	*x = BomComponent{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BomComponent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BomComponent) ProtoMessage() {}
+
+func (x *BomComponent) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BomComponent.ProtoReflect.Descriptor instead.
+func (*BomComponent) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *BomComponent) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *BomComponent) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
 // Физические размеры детали
 type Dimensions struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -516,7 +5274,7 @@ type Dimensions struct {
 
 func (x *Dimensions) Reset() {
 	*x = Dimensions{}
-	mi := &file_inventory_v1_inventory_proto_msgTypes[6]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -528,7 +5286,7 @@ func (x *Dimensions) String() string {
 func (*Dimensions) ProtoMessage() {}
 
 func (x *Dimensions) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_inventory_proto_msgTypes[6]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -541,7 +5299,7 @@ func (x *Dimensions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Dimensions.ProtoReflect.Descriptor instead.
 func (*Dimensions) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{6}
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{81}
 }
 
 func (x *Dimensions) GetLength() float64 {
@@ -572,7 +5330,103 @@ func (x *Dimensions) GetWeight() float64 {
 	return 0
 }
 
-// Информация о производителе детали
+// Узел дерева категорий
+type CategoryNode struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Уникальный идентификатор категории
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// Уникальное машинное имя: строчные латинские буквы и цифры через дефис
+	Slug string `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	// Отображаемое название
+	DisplayName string `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// UUID родительской категории. Пусто для корневой.
+	ParentUuid string `protobuf:"bytes,4,opt,name=parent_uuid,json=parentUuid,proto3" json:"parent_uuid,omitempty"`
+	// Значение enum Category ближайшей встроенной категории среди предков (только для чтения).
+	// Встроенные категории - корни, соответствующие значениям enum.
+	LegacyCategory Category `protobuf:"varint,5,opt,name=legacy_category,json=legacyCategory,proto3,enum=inventory.v1.Category" json:"legacy_category,omitempty"`
+	// Собственная схема метаданных деталей категории (только для чтения, задаётся SetCategoryMetadataSchema).
+	// Действующая схема включает также схемы предков, см. GetCategoryMetadataSchema.
+	MetadataSchema []*MetadataField `protobuf:"bytes,6,rep,name=metadata_schema,json=metadataSchema,proto3" json:"metadata_schema,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CategoryNode) Reset() {
+	*x = CategoryNode{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryNode) ProtoMessage() {}
+
+func (x *CategoryNode) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryNode.ProtoReflect.Descriptor instead.
+func (*CategoryNode) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *CategoryNode) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *CategoryNode) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *CategoryNode) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *CategoryNode) GetParentUuid() string {
+	if x != nil {
+		return x.ParentUuid
+	}
+	return ""
+}
+
+func (x *CategoryNode) GetLegacyCategory() Category {
+	if x != nil {
+		return x.LegacyCategory
+	}
+	return Category_CATEGORY_UNSPECIFIED
+}
+
+func (x *CategoryNode) GetMetadataSchema() []*MetadataField {
+	if x != nil {
+		return x.MetadataSchema
+	}
+	return nil
+}
+
+// Информация о производителе детали.
+// В детали это копия записи справочника производителей: если указан uuid, при сохранении детали
+// название, страна и сайт берутся из справочника, а при изменении производителя копия обновляется.
+// Производитель без uuid сохраняется в детали как есть и не связан со справочником.
 type Manufacturer struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Название компании-производителя
@@ -580,14 +5434,16 @@ type Manufacturer struct {
 	// Страна производства
 	Country string `protobuf:"bytes,2,opt,name=country,proto3" json:"country,omitempty"`
 	// Веб-сайт производителя
-	Website       string `protobuf:"bytes,3,opt,name=website,proto3" json:"website,omitempty"`
+	Website string `protobuf:"bytes,3,opt,name=website,proto3" json:"website,omitempty"`
+	// UUID производителя в справочнике
+	Uuid          string `protobuf:"bytes,4,opt,name=uuid,proto3" json:"uuid,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Manufacturer) Reset() {
 	*x = Manufacturer{}
-	mi := &file_inventory_v1_inventory_proto_msgTypes[7]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -599,7 +5455,7 @@ func (x *Manufacturer) String() string {
 func (*Manufacturer) ProtoMessage() {}
 
 func (x *Manufacturer) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_inventory_proto_msgTypes[7]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -612,7 +5468,7 @@ func (x *Manufacturer) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Manufacturer.ProtoReflect.Descriptor instead.
 func (*Manufacturer) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{7}
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{83}
 }
 
 func (x *Manufacturer) GetName() string {
@@ -636,6 +5492,13 @@ func (x *Manufacturer) GetWebsite() string {
 	return ""
 }
 
+func (x *Manufacturer) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
 // Универсальное значение для метаданных
 type Value struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -654,7 +5517,7 @@ type Value struct {
 
 func (x *Value) Reset() {
 	*x = Value{}
-	mi := &file_inventory_v1_inventory_proto_msgTypes[8]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -666,7 +5529,7 @@ func (x *Value) String() string {
 func (*Value) ProtoMessage() {}
 
 func (x *Value) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_inventory_proto_msgTypes[8]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -679,7 +5542,7 @@ func (x *Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Value.ProtoReflect.Descriptor instead.
 func (*Value) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{8}
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{84}
 }
 
 func (x *Value) GetValue() isValue_Value {
@@ -761,15 +5624,241 @@ var File_inventory_v1_inventory_proto protoreflect.FileDescriptor
 
 const file_inventory_v1_inventory_proto_rawDesc = "" +
 	"\n" +
-	"\x1cinventory/v1/inventory.proto\x12\finventory.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"$\n" +
+	"\x1cinventory/v1/inventory.proto\x12\finventory.v1\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"U\n" +
 	"\x0eGetPartRequest\x12\x12\n" +
-	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"9\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12/\n" +
+	"\x05as_of\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04asOf\"9\n" +
 	"\x0fGetPartResponse\x12&\n" +
-	"\x04part\x18\x01 \x01(\v2\x12.inventory.v1.PartR\x04part\"E\n" +
+	"\x04part\x18\x01 \x01(\v2\x12.inventory.v1.PartR\x04part\"]\n" +
+	"\x14BatchGetPartsRequest\x12\x14\n" +
+	"\x05uuids\x18\x01 \x03(\tR\x05uuids\x12/\n" +
+	"\x05as_of\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04asOf\"\xf8\x01\n" +
+	"\x15BatchGetPartsResponse\x12D\n" +
+	"\x05parts\x18\x01 \x03(\v2..inventory.v1.BatchGetPartsResponse.PartsEntryR\x05parts\x12&\n" +
+	"\x0fnot_found_uuids\x18\x02 \x03(\tR\rnotFoundUuids\x12#\n" +
+	"\rinvalid_uuids\x18\x03 \x03(\tR\finvalidUuids\x1aL\n" +
+	"\n" +
+	"PartsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12(\n" +
+	"\x05value\x18\x02 \x01(\v2\x12.inventory.v1.PartR\x05value:\x028\x01\"\xe9\x01\n" +
 	"\x10ListPartsRequest\x121\n" +
-	"\x06filter\x18\x01 \x01(\v2\x19.inventory.v1.PartsFilterR\x06filter\"=\n" +
+	"\x06filter\x18\x01 \x01(\v2\x19.inventory.v1.PartsFilterR\x06filter\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x125\n" +
+	"\border_by\x18\x04 \x01(\v2\x1a.inventory.v1.PartsOrderByR\aorderBy\x12/\n" +
+	"\x05as_of\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x04asOf\"\x84\x01\n" +
 	"\x11ListPartsResponse\x12(\n" +
-	"\x05parts\x18\x01 \x03(\v2\x12.inventory.v1.PartR\x05parts\"\xbc\x01\n" +
+	"\x05parts\x18\x01 \x03(\v2\x12.inventory.v1.PartR\x05parts\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x03 \x01(\x05R\ttotalSize\"c\n" +
+	"\fPartsOrderBy\x123\n" +
+	"\x05field\x18\x01 \x01(\x0e2\x1d.inventory.v1.PartsOrderFieldR\x05field\x12\x1e\n" +
+	"\n" +
+	"descending\x18\x02 \x01(\bR\n" +
+	"descending\";\n" +
+	"\x11CreatePartRequest\x12&\n" +
+	"\x04part\x18\x01 \x01(\v2\x12.inventory.v1.PartR\x04part\"<\n" +
+	"\x12CreatePartResponse\x12&\n" +
+	"\x04part\x18\x01 \x01(\v2\x12.inventory.v1.PartR\x04part\"x\n" +
+	"\x11UpdatePartRequest\x12&\n" +
+	"\x04part\x18\x01 \x01(\v2\x12.inventory.v1.PartR\x04part\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"<\n" +
+	"\x12UpdatePartResponse\x12&\n" +
+	"\x04part\x18\x01 \x01(\v2\x12.inventory.v1.PartR\x04part\"'\n" +
+	"\x11DeletePartRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"\x14\n" +
+	"\x12DeletePartResponse\"\xaa\x01\n" +
+	"\x1fChangePartLifecycleStateRequest\x12\x1b\n" +
+	"\tpart_uuid\x18\x01 \x01(\tR\bpartUuid\x126\n" +
+	"\x05state\x18\x02 \x01(\x0e2 .inventory.v1.PartLifecycleStateR\x05state\x122\n" +
+	"\x15replacement_part_uuid\x18\x03 \x01(\tR\x13replacementPartUuid\"J\n" +
+	" ChangePartLifecycleStateResponse\x12&\n" +
+	"\x04part\x18\x01 \x01(\v2\x12.inventory.v1.PartR\x04part\"\xcc\x01\n" +
+	"\x12AdjustStockRequest\x12\x1b\n" +
+	"\tpart_uuid\x18\x01 \x01(\tR\bpartUuid\x12\x14\n" +
+	"\x05delta\x18\x02 \x01(\x03R\x05delta\x129\n" +
+	"\x06reason\x18\x03 \x01(\x0e2!.inventory.v1.StockMovementReasonR\x06reason\x12!\n" +
+	"\freference_id\x18\x04 \x01(\tR\vreferenceId\x12%\n" +
+	"\x0ewarehouse_uuid\x18\x05 \x01(\tR\rwarehouseUuid\"N\n" +
+	"\x13AdjustStockResponse\x127\n" +
+	"\bmovement\x18\x01 \x01(\v2\x1b.inventory.v1.StockMovementR\bmovement\"t\n" +
+	"\x19ListStockMovementsRequest\x12\x1b\n" +
+	"\tpart_uuid\x18\x01 \x01(\tR\bpartUuid\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x7f\n" +
+	"\x1aListStockMovementsResponse\x129\n" +
+	"\tmovements\x18\x01 \x03(\v2\x1b.inventory.v1.StockMovementR\tmovements\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xbd\x02\n" +
+	"\rStockMovement\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x1b\n" +
+	"\tpart_uuid\x18\x02 \x01(\tR\bpartUuid\x12\x14\n" +
+	"\x05delta\x18\x03 \x01(\x03R\x05delta\x12%\n" +
+	"\x0equantity_after\x18\x04 \x01(\x03R\rquantityAfter\x129\n" +
+	"\x06reason\x18\x05 \x01(\x0e2!.inventory.v1.StockMovementReasonR\x06reason\x12!\n" +
+	"\freference_id\x18\x06 \x01(\tR\vreferenceId\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12%\n" +
+	"\x0ewarehouse_uuid\x18\b \x01(\tR\rwarehouseUuid\"i\n" +
+	"\x11WatchPartsRequest\x121\n" +
+	"\x06filter\x18\x01 \x01(\v2\x19.inventory.v1.PartsFilterR\x06filter\x12!\n" +
+	"\fresume_token\x18\x02 \x01(\tR\vresumeToken\"\xad\x01\n" +
+	"\x12WatchPartsResponse\x12/\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1b.inventory.v1.PartEventTypeR\x04type\x12\x1b\n" +
+	"\tpart_uuid\x18\x02 \x01(\tR\bpartUuid\x12&\n" +
+	"\x04part\x18\x03 \x01(\v2\x12.inventory.v1.PartR\x04part\x12!\n" +
+	"\fresume_token\x18\x04 \x01(\tR\vresumeToken\"K\n" +
+	"\x10ExpandBomRequest\x12\x1b\n" +
+	"\tpart_uuid\x18\x01 \x01(\tR\bpartUuid\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x03R\bquantity\"\xb1\x01\n" +
+	"\x11ExpandBomResponse\x12)\n" +
+	"\x04root\x18\x01 \x01(\v2\x15.inventory.v1.BomNodeR\x04root\x12\x1f\n" +
+	"\vtotal_price\x18\x02 \x01(\x01R\n" +
+	"totalPrice\x12!\n" +
+	"\ftotal_weight\x18\x03 \x01(\x01R\vtotalWeight\x12-\n" +
+	"\x12buildable_quantity\x18\x04 \x01(\x03R\x11buildableQuantity\"\xfd\x01\n" +
+	"\aBomNode\x12&\n" +
+	"\x04part\x18\x01 \x01(\v2\x12.inventory.v1.PartR\x04part\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x03R\bquantity\x12%\n" +
+	"\x0etotal_quantity\x18\x03 \x01(\x03R\rtotalQuantity\x12&\n" +
+	"\x0frolled_up_price\x18\x04 \x01(\x01R\rrolledUpPrice\x12(\n" +
+	"\x10rolled_up_weight\x18\x05 \x01(\x01R\x0erolledUpWeight\x125\n" +
+	"\n" +
+	"components\x18\x06 \x03(\v2\x15.inventory.v1.BomNodeR\n" +
+	"components\"[\n" +
+	"\x19CreateManufacturerRequest\x12>\n" +
+	"\fmanufacturer\x18\x01 \x01(\v2\x1a.inventory.v1.ManufacturerR\fmanufacturer\"\\\n" +
+	"\x1aCreateManufacturerResponse\x12>\n" +
+	"\fmanufacturer\x18\x01 \x01(\v2\x1a.inventory.v1.ManufacturerR\fmanufacturer\",\n" +
+	"\x16GetManufacturerRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"Y\n" +
+	"\x17GetManufacturerResponse\x12>\n" +
+	"\fmanufacturer\x18\x01 \x01(\v2\x1a.inventory.v1.ManufacturerR\fmanufacturer\"8\n" +
+	"\x18ListManufacturersRequest\x12\x1c\n" +
+	"\tcountries\x18\x01 \x03(\tR\tcountries\"]\n" +
+	"\x19ListManufacturersResponse\x12@\n" +
+	"\rmanufacturers\x18\x01 \x03(\v2\x1a.inventory.v1.ManufacturerR\rmanufacturers\"[\n" +
+	"\x19UpdateManufacturerRequest\x12>\n" +
+	"\fmanufacturer\x18\x01 \x01(\v2\x1a.inventory.v1.ManufacturerR\fmanufacturer\"\x81\x01\n" +
+	"\x1aUpdateManufacturerResponse\x12>\n" +
+	"\fmanufacturer\x18\x01 \x01(\v2\x1a.inventory.v1.ManufacturerR\fmanufacturer\x12#\n" +
+	"\rupdated_parts\x18\x02 \x01(\x05R\fupdatedParts\"/\n" +
+	"\x19DeleteManufacturerRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"\x1c\n" +
+	"\x1aDeleteManufacturerResponse\"O\n" +
+	"\x15CreateCategoryRequest\x126\n" +
+	"\bcategory\x18\x01 \x01(\v2\x1a.inventory.v1.CategoryNodeR\bcategory\"P\n" +
+	"\x16CreateCategoryResponse\x126\n" +
+	"\bcategory\x18\x01 \x01(\v2\x1a.inventory.v1.CategoryNodeR\bcategory\"(\n" +
+	"\x12GetCategoryRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"M\n" +
+	"\x13GetCategoryResponse\x126\n" +
+	"\bcategory\x18\x01 \x01(\v2\x1a.inventory.v1.CategoryNodeR\bcategory\"4\n" +
+	"\x15ListCategoriesRequest\x12\x1b\n" +
+	"\troot_uuid\x18\x01 \x01(\tR\brootUuid\"T\n" +
+	"\x16ListCategoriesResponse\x12:\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2\x1a.inventory.v1.CategoryNodeR\n" +
+	"categories\"O\n" +
+	"\x15UpdateCategoryRequest\x126\n" +
+	"\bcategory\x18\x01 \x01(\v2\x1a.inventory.v1.CategoryNodeR\bcategory\"P\n" +
+	"\x16UpdateCategoryResponse\x126\n" +
+	"\bcategory\x18\x01 \x01(\v2\x1a.inventory.v1.CategoryNodeR\bcategory\"+\n" +
+	"\x15DeleteCategoryRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"\x18\n" +
+	"\x16DeleteCategoryResponse\"|\n" +
+	" SetCategoryMetadataSchemaRequest\x12#\n" +
+	"\rcategory_uuid\x18\x01 \x01(\tR\fcategoryUuid\x123\n" +
+	"\x06fields\x18\x02 \x03(\v2\x1b.inventory.v1.MetadataFieldR\x06fields\"[\n" +
+	"!SetCategoryMetadataSchemaResponse\x126\n" +
+	"\bcategory\x18\x01 \x01(\v2\x1a.inventory.v1.CategoryNodeR\bcategory\"G\n" +
+	" GetCategoryMetadataSchemaRequest\x12#\n" +
+	"\rcategory_uuid\x18\x01 \x01(\tR\fcategoryUuid\"X\n" +
+	"!GetCategoryMetadataSchemaResponse\x123\n" +
+	"\x06fields\x18\x01 \x03(\v2\x1b.inventory.v1.MetadataFieldR\x06fields\"\xf9\x01\n" +
+	"\rMetadataField\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12.\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x1a.inventory.v1.MetadataTypeR\x04type\x12\x12\n" +
+	"\x04unit\x18\x03 \x01(\tR\x04unit\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1a\n" +
+	"\brequired\x18\x05 \x01(\bR\brequired\x12/\n" +
+	"\x05range\x18\x06 \x01(\v2\x19.inventory.v1.DoubleRangeR\x05range\x12#\n" +
+	"\rcategory_uuid\x18\a \x01(\tR\fcategoryUuid\"\x92\x01\n" +
+	"\x1aSchedulePriceChangeRequest\x12\x1b\n" +
+	"\tpart_uuid\x18\x01 \x01(\tR\bpartUuid\x12\x14\n" +
+	"\x05price\x18\x02 \x01(\x01R\x05price\x12A\n" +
+	"\x0eeffective_from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\reffectiveFrom\"^\n" +
+	"\x1bSchedulePriceChangeResponse\x12?\n" +
+	"\rprice_version\x18\x01 \x01(\v2\x1a.inventory.v1.PriceVersionR\fpriceVersion\"5\n" +
+	"\x16GetPriceHistoryRequest\x12\x1b\n" +
+	"\tpart_uuid\x18\x01 \x01(\tR\bpartUuid\"Q\n" +
+	"\x17GetPriceHistoryResponse\x126\n" +
+	"\bversions\x18\x01 \x03(\v2\x1a.inventory.v1.PriceVersionR\bversions\"\xb6\x01\n" +
+	"\fPriceVersion\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x14\n" +
+	"\x05price\x18\x02 \x01(\x01R\x05price\x12A\n" +
+	"\x0eeffective_from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\reffectiveFrom\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"V\n" +
+	"\x18ListLowStockPartsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\"\x8c\x01\n" +
+	"\x19ListLowStockPartsResponse\x12(\n" +
+	"\x05parts\x18\x01 \x03(\v2\x12.inventory.v1.PartR\x05parts\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x03 \x01(\x05R\ttotalSize\"\xce\x01\n" +
+	"\x14TransferStockRequest\x12\x1b\n" +
+	"\tpart_uuid\x18\x01 \x01(\tR\bpartUuid\x12.\n" +
+	"\x13from_warehouse_uuid\x18\x02 \x01(\tR\x11fromWarehouseUuid\x12*\n" +
+	"\x11to_warehouse_uuid\x18\x03 \x01(\tR\x0ftoWarehouseUuid\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x03R\bquantity\x12!\n" +
+	"\freference_id\x18\x05 \x01(\tR\vreferenceId\"\x89\x01\n" +
+	"\x15TransferStockResponse\x127\n" +
+	"\boutgoing\x18\x01 \x01(\v2\x1b.inventory.v1.StockMovementR\boutgoing\x127\n" +
+	"\bincoming\x18\x02 \x01(\v2\x1b.inventory.v1.StockMovementR\bincoming\"\xa7\x01\n" +
+	"\x13FulfillStockRequest\x12!\n" +
+	"\freference_id\x18\x01 \x01(\tR\vreferenceId\x128\n" +
+	"\x18preferred_warehouse_uuid\x18\x02 \x01(\tR\x16preferredWarehouseUuid\x123\n" +
+	"\x05items\x18\x03 \x03(\v2\x1d.inventory.v1.FulfillmentItemR\x05items\"J\n" +
+	"\x0fFulfillmentItem\x12\x1b\n" +
+	"\tpart_uuid\x18\x01 \x01(\tR\bpartUuid\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x03R\bquantity\"Q\n" +
+	"\x14FulfillStockResponse\x129\n" +
+	"\tmovements\x18\x01 \x03(\v2\x1b.inventory.v1.StockMovementR\tmovements\"O\n" +
+	"\x16CreateWarehouseRequest\x125\n" +
+	"\twarehouse\x18\x01 \x01(\v2\x17.inventory.v1.WarehouseR\twarehouse\"P\n" +
+	"\x17CreateWarehouseResponse\x125\n" +
+	"\twarehouse\x18\x01 \x01(\v2\x17.inventory.v1.WarehouseR\twarehouse\")\n" +
+	"\x13GetWarehouseRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"M\n" +
+	"\x14GetWarehouseResponse\x125\n" +
+	"\twarehouse\x18\x01 \x01(\v2\x17.inventory.v1.WarehouseR\twarehouse\"\x17\n" +
+	"\x15ListWarehousesRequest\"Q\n" +
+	"\x16ListWarehousesResponse\x127\n" +
+	"\n" +
+	"warehouses\x18\x01 \x03(\v2\x17.inventory.v1.WarehouseR\n" +
+	"warehouses\"I\n" +
+	"\x14GetPartFacetsRequest\x121\n" +
+	"\x06filter\x18\x01 \x01(\v2\x19.inventory.v1.PartsFilterR\x06filter\"\xd8\x01\n" +
+	"\x15GetPartFacetsResponse\x12@\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2 .inventory.v1.CategoryFacetCountR\n" +
+	"categories\x12O\n" +
+	"\x16manufacturer_countries\x18\x02 \x03(\v2\x18.inventory.v1.FacetCountR\x15manufacturerCountries\x12,\n" +
+	"\x04tags\x18\x03 \x03(\v2\x18.inventory.v1.FacetCountR\x04tags\"\x83\x01\n" +
+	"\x12CategoryFacetCount\x12#\n" +
+	"\rcategory_uuid\x18\x01 \x01(\tR\fcategoryUuid\x122\n" +
+	"\bcategory\x18\x02 \x01(\x0e2\x16.inventory.v1.CategoryR\bcategory\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x05R\x05count\"8\n" +
+	"\n" +
+	"FacetCount\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"\x87\x06\n" +
 	"\vPartsFilter\x12\x14\n" +
 	"\x05uuids\x18\x01 \x03(\tR\x05uuids\x12\x14\n" +
 	"\x05names\x18\x02 \x03(\tR\x05names\x126\n" +
@@ -777,7 +5866,41 @@ const file_inventory_v1_inventory_proto_rawDesc = "" +
 	"categories\x18\x03 \x03(\x0e2\x16.inventory.v1.CategoryR\n" +
 	"categories\x125\n" +
 	"\x16manufacturer_countries\x18\x04 \x03(\tR\x15manufacturerCountries\x12\x12\n" +
-	"\x04tags\x18\x05 \x03(\tR\x04tags\"\xd5\x04\n" +
+	"\x04tags\x18\x05 \x03(\tR\x04tags\x12\x14\n" +
+	"\x05query\x18\x06 \x01(\tR\x05query\x12/\n" +
+	"\x05price\x18\a \x01(\v2\x19.inventory.v1.DoubleRangeR\x05price\x12\"\n" +
+	"\rin_stock_only\x18\b \x01(\bR\vinStockOnly\x12>\n" +
+	"\n" +
+	"dimensions\x18\t \x01(\v2\x1e.inventory.v1.DimensionsFilterR\n" +
+	"dimensions\x12;\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1c.inventory.v1.TimestampRangeR\tcreatedAt\x12;\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1c.inventory.v1.TimestampRangeR\tupdatedAt\x12;\n" +
+	"\bmetadata\x18\f \x03(\v2\x1f.inventory.v1.MetadataPredicateR\bmetadata\x12'\n" +
+	"\x0fcomponent_uuids\x18\r \x03(\tR\x0ecomponentUuids\x12-\n" +
+	"\x12manufacturer_uuids\x18\x0e \x03(\tR\x11manufacturerUuids\x12%\n" +
+	"\x0ecategory_uuids\x18\x0f \x03(\tR\rcategoryUuids\x12\x1b\n" +
+	"\tlow_stock\x18\x10 \x01(\bR\blowStock\x12K\n" +
+	"\x10lifecycle_states\x18\x11 \x03(\x0e2 .inventory.v1.PartLifecycleStateR\x0flifecycleStates\"K\n" +
+	"\vDoubleRange\x12\x15\n" +
+	"\x03min\x18\x01 \x01(\x01H\x00R\x03min\x88\x01\x01\x12\x15\n" +
+	"\x03max\x18\x02 \x01(\x01H\x01R\x03max\x88\x01\x01B\x06\n" +
+	"\x04_minB\x06\n" +
+	"\x04_max\"l\n" +
+	"\x0eTimestampRange\x12.\n" +
+	"\x04from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\"\xdc\x01\n" +
+	"\x10DimensionsFilter\x121\n" +
+	"\x06length\x18\x01 \x01(\v2\x19.inventory.v1.DoubleRangeR\x06length\x12/\n" +
+	"\x05width\x18\x02 \x01(\v2\x19.inventory.v1.DoubleRangeR\x05width\x121\n" +
+	"\x06height\x18\x03 \x01(\v2\x19.inventory.v1.DoubleRangeR\x06height\x121\n" +
+	"\x06weight\x18\x04 \x01(\v2\x19.inventory.v1.DoubleRangeR\x06weight\"\x8c\x01\n" +
+	"\x11MetadataPredicate\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12:\n" +
+	"\boperator\x18\x02 \x01(\x0e2\x1e.inventory.v1.MetadataOperatorR\boperator\x12)\n" +
+	"\x05value\x18\x03 \x01(\v2\x13.inventory.v1.ValueR\x05value\"\xc2\a\n" +
 	"\x04Part\x12\x12\n" +
 	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -795,20 +5918,50 @@ const file_inventory_v1_inventory_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x1aP\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12!\n" +
+	"\fsearch_score\x18\r \x01(\x01R\vsearchScore\x12:\n" +
+	"\n" +
+	"components\x18\x0e \x03(\v2\x1a.inventory.v1.BomComponentR\n" +
+	"components\x12#\n" +
+	"\rcategory_uuid\x18\x0f \x01(\tR\fcategoryUuid\x12+\n" +
+	"\x11reorder_threshold\x18\x10 \x01(\x03R\x10reorderThreshold\x12;\n" +
+	"\fstock_levels\x18\x11 \x03(\v2\x18.inventory.v1.StockLevelR\vstockLevels\x12I\n" +
+	"\x0flifecycle_state\x18\x12 \x01(\x0e2 .inventory.v1.PartLifecycleStateR\x0elifecycleState\x122\n" +
+	"\x15replacement_part_uuid\x18\x13 \x01(\tR\x13replacementPartUuid\x1aP\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12)\n" +
-	"\x05value\x18\x02 \x01(\v2\x13.inventory.v1.ValueR\x05value:\x028\x01\"j\n" +
+	"\x05value\x18\x02 \x01(\v2\x13.inventory.v1.ValueR\x05value:\x028\x01\"O\n" +
+	"\n" +
+	"StockLevel\x12%\n" +
+	"\x0ewarehouse_uuid\x18\x01 \x01(\tR\rwarehouseUuid\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x03R\bquantity\"c\n" +
+	"\tWarehouse\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1a\n" +
+	"\blocation\x18\x04 \x01(\tR\blocation\"G\n" +
+	"\fBomComponent\x12\x1b\n" +
+	"\tpart_uuid\x18\x01 \x01(\tR\bpartUuid\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x03R\bquantity\"j\n" +
 	"\n" +
 	"Dimensions\x12\x16\n" +
 	"\x06length\x18\x01 \x01(\x01R\x06length\x12\x14\n" +
 	"\x05width\x18\x02 \x01(\x01R\x05width\x12\x16\n" +
 	"\x06height\x18\x03 \x01(\x01R\x06height\x12\x16\n" +
-	"\x06weight\x18\x04 \x01(\x01R\x06weight\"V\n" +
+	"\x06weight\x18\x04 \x01(\x01R\x06weight\"\x81\x02\n" +
+	"\fCategoryNode\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x12\n" +
+	"\x04slug\x18\x02 \x01(\tR\x04slug\x12!\n" +
+	"\fdisplay_name\x18\x03 \x01(\tR\vdisplayName\x12\x1f\n" +
+	"\vparent_uuid\x18\x04 \x01(\tR\n" +
+	"parentUuid\x12?\n" +
+	"\x0flegacy_category\x18\x05 \x01(\x0e2\x16.inventory.v1.CategoryR\x0elegacyCategory\x12D\n" +
+	"\x0fmetadata_schema\x18\x06 \x03(\v2\x1b.inventory.v1.MetadataFieldR\x0emetadataSchema\"j\n" +
 	"\fManufacturer\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
 	"\acountry\x18\x02 \x01(\tR\acountry\x12\x18\n" +
-	"\awebsite\x18\x03 \x01(\tR\awebsite\"\x9e\x01\n" +
+	"\awebsite\x18\x03 \x01(\tR\awebsite\x12\x12\n" +
+	"\x04uuid\x18\x04 \x01(\tR\x04uuid\"\x9e\x01\n" +
 	"\x05Value\x12#\n" +
 	"\fstring_value\x18\x01 \x01(\tH\x00R\vstringValue\x12!\n" +
 	"\vint64_value\x18\x02 \x01(\x03H\x00R\n" +
@@ -816,16 +5969,91 @@ const file_inventory_v1_inventory_proto_rawDesc = "" +
 	"\fdouble_value\x18\x03 \x01(\x01H\x00R\vdoubleValue\x12\x1f\n" +
 	"\n" +
 	"bool_value\x18\x04 \x01(\bH\x00R\tboolValueB\a\n" +
-	"\x05value*v\n" +
+	"\x05value*\xd6\x01\n" +
+	"\x0fPartsOrderField\x12!\n" +
+	"\x1dPARTS_ORDER_FIELD_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17PARTS_ORDER_FIELD_PRICE\x10\x01\x12\x1a\n" +
+	"\x16PARTS_ORDER_FIELD_NAME\x10\x02\x12 \n" +
+	"\x1cPARTS_ORDER_FIELD_CREATED_AT\x10\x03\x12$\n" +
+	" PARTS_ORDER_FIELD_STOCK_QUANTITY\x10\x04\x12\x1f\n" +
+	"\x1bPARTS_ORDER_FIELD_RELEVANCE\x10\x05*\xc5\x01\n" +
+	"\x12PartLifecycleState\x12$\n" +
+	" PART_LIFECYCLE_STATE_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aPART_LIFECYCLE_STATE_DRAFT\x10\x01\x12\x1f\n" +
+	"\x1bPART_LIFECYCLE_STATE_ACTIVE\x10\x02\x12%\n" +
+	"!PART_LIFECYCLE_STATE_DISCONTINUED\x10\x03\x12!\n" +
+	"\x1dPART_LIFECYCLE_STATE_RECALLED\x10\x04*\x92\x02\n" +
+	"\x13StockMovementReason\x12%\n" +
+	"!STOCK_MOVEMENT_REASON_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dSTOCK_MOVEMENT_REASON_RECEIPT\x10\x01\x12\x1e\n" +
+	"\x1aSTOCK_MOVEMENT_REASON_SALE\x10\x02\x12%\n" +
+	"!STOCK_MOVEMENT_REASON_RESERVATION\x10\x03\x12 \n" +
+	"\x1cSTOCK_MOVEMENT_REASON_DAMAGE\x10\x04\x12$\n" +
+	" STOCK_MOVEMENT_REASON_CORRECTION\x10\x05\x12\"\n" +
+	"\x1eSTOCK_MOVEMENT_REASON_TRANSFER\x10\x06*\x87\x01\n" +
+	"\rPartEventType\x12\x1f\n" +
+	"\x1bPART_EVENT_TYPE_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17PART_EVENT_TYPE_CREATED\x10\x01\x12\x1b\n" +
+	"\x17PART_EVENT_TYPE_UPDATED\x10\x02\x12\x1b\n" +
+	"\x17PART_EVENT_TYPE_DELETED\x10\x03*\x92\x01\n" +
+	"\fMetadataType\x12\x1d\n" +
+	"\x19METADATA_TYPE_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14METADATA_TYPE_STRING\x10\x01\x12\x17\n" +
+	"\x13METADATA_TYPE_INT64\x10\x02\x12\x18\n" +
+	"\x14METADATA_TYPE_DOUBLE\x10\x03\x12\x16\n" +
+	"\x12METADATA_TYPE_BOOL\x10\x04*\xf1\x01\n" +
+	"\x10MetadataOperator\x12!\n" +
+	"\x1dMETADATA_OPERATOR_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14METADATA_OPERATOR_EQ\x10\x01\x12\x18\n" +
+	"\x14METADATA_OPERATOR_NE\x10\x02\x12\x18\n" +
+	"\x14METADATA_OPERATOR_GT\x10\x03\x12\x19\n" +
+	"\x15METADATA_OPERATOR_GTE\x10\x04\x12\x18\n" +
+	"\x14METADATA_OPERATOR_LT\x10\x05\x12\x19\n" +
+	"\x15METADATA_OPERATOR_LTE\x10\x06\x12\x1c\n" +
+	"\x18METADATA_OPERATOR_EXISTS\x10\a*v\n" +
 	"\bCategory\x12\x18\n" +
 	"\x14CATEGORY_UNSPECIFIED\x10\x00\x12\x13\n" +
 	"\x0fCATEGORY_ENGINE\x10\x01\x12\x11\n" +
 	"\rCATEGORY_FUEL\x10\x02\x12\x15\n" +
 	"\x11CATEGORY_PORTHOLE\x10\x03\x12\x11\n" +
-	"\rCATEGORY_WING\x10\x042\xa8\x01\n" +
+	"\rCATEGORY_WING\x10\x042\xe4\x17\n" +
 	"\x10InventoryService\x12F\n" +
-	"\aGetPart\x12\x1c.inventory.v1.GetPartRequest\x1a\x1d.inventory.v1.GetPartResponse\x12L\n" +
-	"\tListParts\x12\x1e.inventory.v1.ListPartsRequest\x1a\x1f.inventory.v1.ListPartsResponseBoZm/Users/dmitrijbogdanov/go/src/github.com/bogdanovds/rocket_factory/shared/pkg/proto/inventory/v1;inventory_v1b\x06proto3"
+	"\aGetPart\x12\x1c.inventory.v1.GetPartRequest\x1a\x1d.inventory.v1.GetPartResponse\x12X\n" +
+	"\rBatchGetParts\x12\".inventory.v1.BatchGetPartsRequest\x1a#.inventory.v1.BatchGetPartsResponse\x12L\n" +
+	"\tListParts\x12\x1e.inventory.v1.ListPartsRequest\x1a\x1f.inventory.v1.ListPartsResponse\x12X\n" +
+	"\rGetPartFacets\x12\".inventory.v1.GetPartFacetsRequest\x1a#.inventory.v1.GetPartFacetsResponse\x12O\n" +
+	"\n" +
+	"CreatePart\x12\x1f.inventory.v1.CreatePartRequest\x1a .inventory.v1.CreatePartResponse\x12O\n" +
+	"\n" +
+	"UpdatePart\x12\x1f.inventory.v1.UpdatePartRequest\x1a .inventory.v1.UpdatePartResponse\x12O\n" +
+	"\n" +
+	"DeletePart\x12\x1f.inventory.v1.DeletePartRequest\x1a .inventory.v1.DeletePartResponse\x12y\n" +
+	"\x18ChangePartLifecycleState\x12-.inventory.v1.ChangePartLifecycleStateRequest\x1a..inventory.v1.ChangePartLifecycleStateResponse\x12R\n" +
+	"\vAdjustStock\x12 .inventory.v1.AdjustStockRequest\x1a!.inventory.v1.AdjustStockResponse\x12g\n" +
+	"\x12ListStockMovements\x12'.inventory.v1.ListStockMovementsRequest\x1a(.inventory.v1.ListStockMovementsResponse\x12X\n" +
+	"\rTransferStock\x12\".inventory.v1.TransferStockRequest\x1a#.inventory.v1.TransferStockResponse\x12U\n" +
+	"\fFulfillStock\x12!.inventory.v1.FulfillStockRequest\x1a\".inventory.v1.FulfillStockResponse\x12d\n" +
+	"\x11ListLowStockParts\x12&.inventory.v1.ListLowStockPartsRequest\x1a'.inventory.v1.ListLowStockPartsResponse\x12j\n" +
+	"\x13SchedulePriceChange\x12(.inventory.v1.SchedulePriceChangeRequest\x1a).inventory.v1.SchedulePriceChangeResponse\x12^\n" +
+	"\x0fGetPriceHistory\x12$.inventory.v1.GetPriceHistoryRequest\x1a%.inventory.v1.GetPriceHistoryResponse\x12Q\n" +
+	"\n" +
+	"WatchParts\x12\x1f.inventory.v1.WatchPartsRequest\x1a .inventory.v1.WatchPartsResponse0\x01\x12L\n" +
+	"\tExpandBom\x12\x1e.inventory.v1.ExpandBomRequest\x1a\x1f.inventory.v1.ExpandBomResponse\x12g\n" +
+	"\x12CreateManufacturer\x12'.inventory.v1.CreateManufacturerRequest\x1a(.inventory.v1.CreateManufacturerResponse\x12^\n" +
+	"\x0fGetManufacturer\x12$.inventory.v1.GetManufacturerRequest\x1a%.inventory.v1.GetManufacturerResponse\x12d\n" +
+	"\x11ListManufacturers\x12&.inventory.v1.ListManufacturersRequest\x1a'.inventory.v1.ListManufacturersResponse\x12g\n" +
+	"\x12UpdateManufacturer\x12'.inventory.v1.UpdateManufacturerRequest\x1a(.inventory.v1.UpdateManufacturerResponse\x12g\n" +
+	"\x12DeleteManufacturer\x12'.inventory.v1.DeleteManufacturerRequest\x1a(.inventory.v1.DeleteManufacturerResponse\x12[\n" +
+	"\x0eCreateCategory\x12#.inventory.v1.CreateCategoryRequest\x1a$.inventory.v1.CreateCategoryResponse\x12R\n" +
+	"\vGetCategory\x12 .inventory.v1.GetCategoryRequest\x1a!.inventory.v1.GetCategoryResponse\x12[\n" +
+	"\x0eListCategories\x12#.inventory.v1.ListCategoriesRequest\x1a$.inventory.v1.ListCategoriesResponse\x12[\n" +
+	"\x0eUpdateCategory\x12#.inventory.v1.UpdateCategoryRequest\x1a$.inventory.v1.UpdateCategoryResponse\x12[\n" +
+	"\x0eDeleteCategory\x12#.inventory.v1.DeleteCategoryRequest\x1a$.inventory.v1.DeleteCategoryResponse\x12|\n" +
+	"\x19SetCategoryMetadataSchema\x12..inventory.v1.SetCategoryMetadataSchemaRequest\x1a/.inventory.v1.SetCategoryMetadataSchemaResponse\x12|\n" +
+	"\x19GetCategoryMetadataSchema\x12..inventory.v1.GetCategoryMetadataSchemaRequest\x1a/.inventory.v1.GetCategoryMetadataSchemaResponse\x12^\n" +
+	"\x0fCreateWarehouse\x12$.inventory.v1.CreateWarehouseRequest\x1a%.inventory.v1.CreateWarehouseResponse\x12U\n" +
+	"\fGetWarehouse\x12!.inventory.v1.GetWarehouseRequest\x1a\".inventory.v1.GetWarehouseResponse\x12[\n" +
+	"\x0eListWarehouses\x12#.inventory.v1.ListWarehousesRequest\x1a$.inventory.v1.ListWarehousesResponseBoZm/Users/dmitrijbogdanov/go/src/github.com/bogdanovds/rocket_factory/shared/pkg/proto/inventory/v1;inventory_v1b\x06proto3"
 
 var (
 	file_inventory_v1_inventory_proto_rawDescOnce sync.Once
@@ -839,43 +6067,267 @@ func file_inventory_v1_inventory_proto_rawDescGZIP() []byte {
 	return file_inventory_v1_inventory_proto_rawDescData
 }
 
-var file_inventory_v1_inventory_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_inventory_v1_inventory_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_inventory_v1_inventory_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_inventory_v1_inventory_proto_msgTypes = make([]protoimpl.MessageInfo, 87)
 var file_inventory_v1_inventory_proto_goTypes = []any{
-	(Category)(0),                 // 0: inventory.v1.Category
-	(*GetPartRequest)(nil),        // 1: inventory.v1.GetPartRequest
-	(*GetPartResponse)(nil),       // 2: inventory.v1.GetPartResponse
-	(*ListPartsRequest)(nil),      // 3: inventory.v1.ListPartsRequest
-	(*ListPartsResponse)(nil),     // 4: inventory.v1.ListPartsResponse
-	(*PartsFilter)(nil),           // 5: inventory.v1.PartsFilter
-	(*Part)(nil),                  // 6: inventory.v1.Part
-	(*Dimensions)(nil),            // 7: inventory.v1.Dimensions
-	(*Manufacturer)(nil),          // 8: inventory.v1.Manufacturer
-	(*Value)(nil),                 // 9: inventory.v1.Value
-	nil,                           // 10: inventory.v1.Part.MetadataEntry
-	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
+	(PartsOrderField)(0),                      // 0: inventory.v1.PartsOrderField
+	(PartLifecycleState)(0),                   // 1: inventory.v1.PartLifecycleState
+	(StockMovementReason)(0),                  // 2: inventory.v1.StockMovementReason
+	(PartEventType)(0),                        // 3: inventory.v1.PartEventType
+	(MetadataType)(0),                         // 4: inventory.v1.MetadataType
+	(MetadataOperator)(0),                     // 5: inventory.v1.MetadataOperator
+	(Category)(0),                             // 6: inventory.v1.Category
+	(*GetPartRequest)(nil),                    // 7: inventory.v1.GetPartRequest
+	(*GetPartResponse)(nil),                   // 8: inventory.v1.GetPartResponse
+	(*BatchGetPartsRequest)(nil),              // 9: inventory.v1.BatchGetPartsRequest
+	(*BatchGetPartsResponse)(nil),             // 10: inventory.v1.BatchGetPartsResponse
+	(*ListPartsRequest)(nil),                  // 11: inventory.v1.ListPartsRequest
+	(*ListPartsResponse)(nil),                 // 12: inventory.v1.ListPartsResponse
+	(*PartsOrderBy)(nil),                      // 13: inventory.v1.PartsOrderBy
+	(*CreatePartRequest)(nil),                 // 14: inventory.v1.CreatePartRequest
+	(*CreatePartResponse)(nil),                // 15: inventory.v1.CreatePartResponse
+	(*UpdatePartRequest)(nil),                 // 16: inventory.v1.UpdatePartRequest
+	(*UpdatePartResponse)(nil),                // 17: inventory.v1.UpdatePartResponse
+	(*DeletePartRequest)(nil),                 // 18: inventory.v1.DeletePartRequest
+	(*DeletePartResponse)(nil),                // 19: inventory.v1.DeletePartResponse
+	(*ChangePartLifecycleStateRequest)(nil),   // 20: inventory.v1.ChangePartLifecycleStateRequest
+	(*ChangePartLifecycleStateResponse)(nil),  // 21: inventory.v1.ChangePartLifecycleStateResponse
+	(*AdjustStockRequest)(nil),                // 22: inventory.v1.AdjustStockRequest
+	(*AdjustStockResponse)(nil),               // 23: inventory.v1.AdjustStockResponse
+	(*ListStockMovementsRequest)(nil),         // 24: inventory.v1.ListStockMovementsRequest
+	(*ListStockMovementsResponse)(nil),        // 25: inventory.v1.ListStockMovementsResponse
+	(*StockMovement)(nil),                     // 26: inventory.v1.StockMovement
+	(*WatchPartsRequest)(nil),                 // 27: inventory.v1.WatchPartsRequest
+	(*WatchPartsResponse)(nil),                // 28: inventory.v1.WatchPartsResponse
+	(*ExpandBomRequest)(nil),                  // 29: inventory.v1.ExpandBomRequest
+	(*ExpandBomResponse)(nil),                 // 30: inventory.v1.ExpandBomResponse
+	(*BomNode)(nil),                           // 31: inventory.v1.BomNode
+	(*CreateManufacturerRequest)(nil),         // 32: inventory.v1.CreateManufacturerRequest
+	(*CreateManufacturerResponse)(nil),        // 33: inventory.v1.CreateManufacturerResponse
+	(*GetManufacturerRequest)(nil),            // 34: inventory.v1.GetManufacturerRequest
+	(*GetManufacturerResponse)(nil),           // 35: inventory.v1.GetManufacturerResponse
+	(*ListManufacturersRequest)(nil),          // 36: inventory.v1.ListManufacturersRequest
+	(*ListManufacturersResponse)(nil),         // 37: inventory.v1.ListManufacturersResponse
+	(*UpdateManufacturerRequest)(nil),         // 38: inventory.v1.UpdateManufacturerRequest
+	(*UpdateManufacturerResponse)(nil),        // 39: inventory.v1.UpdateManufacturerResponse
+	(*DeleteManufacturerRequest)(nil),         // 40: inventory.v1.DeleteManufacturerRequest
+	(*DeleteManufacturerResponse)(nil),        // 41: inventory.v1.DeleteManufacturerResponse
+	(*CreateCategoryRequest)(nil),             // 42: inventory.v1.CreateCategoryRequest
+	(*CreateCategoryResponse)(nil),            // 43: inventory.v1.CreateCategoryResponse
+	(*GetCategoryRequest)(nil),                // 44: inventory.v1.GetCategoryRequest
+	(*GetCategoryResponse)(nil),               // 45: inventory.v1.GetCategoryResponse
+	(*ListCategoriesRequest)(nil),             // 46: inventory.v1.ListCategoriesRequest
+	(*ListCategoriesResponse)(nil),            // 47: inventory.v1.ListCategoriesResponse
+	(*UpdateCategoryRequest)(nil),             // 48: inventory.v1.UpdateCategoryRequest
+	(*UpdateCategoryResponse)(nil),            // 49: inventory.v1.UpdateCategoryResponse
+	(*DeleteCategoryRequest)(nil),             // 50: inventory.v1.DeleteCategoryRequest
+	(*DeleteCategoryResponse)(nil),            // 51: inventory.v1.DeleteCategoryResponse
+	(*SetCategoryMetadataSchemaRequest)(nil),  // 52: inventory.v1.SetCategoryMetadataSchemaRequest
+	(*SetCategoryMetadataSchemaResponse)(nil), // 53: inventory.v1.SetCategoryMetadataSchemaResponse
+	(*GetCategoryMetadataSchemaRequest)(nil),  // 54: inventory.v1.GetCategoryMetadataSchemaRequest
+	(*GetCategoryMetadataSchemaResponse)(nil), // 55: inventory.v1.GetCategoryMetadataSchemaResponse
+	(*MetadataField)(nil),                     // 56: inventory.v1.MetadataField
+	(*SchedulePriceChangeRequest)(nil),        // 57: inventory.v1.SchedulePriceChangeRequest
+	(*SchedulePriceChangeResponse)(nil),       // 58: inventory.v1.SchedulePriceChangeResponse
+	(*GetPriceHistoryRequest)(nil),            // 59: inventory.v1.GetPriceHistoryRequest
+	(*GetPriceHistoryResponse)(nil),           // 60: inventory.v1.GetPriceHistoryResponse
+	(*PriceVersion)(nil),                      // 61: inventory.v1.PriceVersion
+	(*ListLowStockPartsRequest)(nil),          // 62: inventory.v1.ListLowStockPartsRequest
+	(*ListLowStockPartsResponse)(nil),         // 63: inventory.v1.ListLowStockPartsResponse
+	(*TransferStockRequest)(nil),              // 64: inventory.v1.TransferStockRequest
+	(*TransferStockResponse)(nil),             // 65: inventory.v1.TransferStockResponse
+	(*FulfillStockRequest)(nil),               // 66: inventory.v1.FulfillStockRequest
+	(*FulfillmentItem)(nil),                   // 67: inventory.v1.FulfillmentItem
+	(*FulfillStockResponse)(nil),              // 68: inventory.v1.FulfillStockResponse
+	(*CreateWarehouseRequest)(nil),            // 69: inventory.v1.CreateWarehouseRequest
+	(*CreateWarehouseResponse)(nil),           // 70: inventory.v1.CreateWarehouseResponse
+	(*GetWarehouseRequest)(nil),               // 71: inventory.v1.GetWarehouseRequest
+	(*GetWarehouseResponse)(nil),              // 72: inventory.v1.GetWarehouseResponse
+	(*ListWarehousesRequest)(nil),             // 73: inventory.v1.ListWarehousesRequest
+	(*ListWarehousesResponse)(nil),            // 74: inventory.v1.ListWarehousesResponse
+	(*GetPartFacetsRequest)(nil),              // 75: inventory.v1.GetPartFacetsRequest
+	(*GetPartFacetsResponse)(nil),             // 76: inventory.v1.GetPartFacetsResponse
+	(*CategoryFacetCount)(nil),                // 77: inventory.v1.CategoryFacetCount
+	(*FacetCount)(nil),                        // 78: inventory.v1.FacetCount
+	(*PartsFilter)(nil),                       // 79: inventory.v1.PartsFilter
+	(*DoubleRange)(nil),                       // 80: inventory.v1.DoubleRange
+	(*TimestampRange)(nil),                    // 81: inventory.v1.TimestampRange
+	(*DimensionsFilter)(nil),                  // 82: inventory.v1.DimensionsFilter
+	(*MetadataPredicate)(nil),                 // 83: inventory.v1.MetadataPredicate
+	(*Part)(nil),                              // 84: inventory.v1.Part
+	(*StockLevel)(nil),                        // 85: inventory.v1.StockLevel
+	(*Warehouse)(nil),                         // 86: inventory.v1.Warehouse
+	(*BomComponent)(nil),                      // 87: inventory.v1.BomComponent
+	(*Dimensions)(nil),                        // 88: inventory.v1.Dimensions
+	(*CategoryNode)(nil),                      // 89: inventory.v1.CategoryNode
+	(*Manufacturer)(nil),                      // 90: inventory.v1.Manufacturer
+	(*Value)(nil),                             // 91: inventory.v1.Value
+	nil,                                       // 92: inventory.v1.BatchGetPartsResponse.PartsEntry
+	nil,                                       // 93: inventory.v1.Part.MetadataEntry
+	(*timestamppb.Timestamp)(nil),             // 94: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),             // 95: google.protobuf.FieldMask
 }
 var file_inventory_v1_inventory_proto_depIdxs = []int32{
-	6,  // 0: inventory.v1.GetPartResponse.part:type_name -> inventory.v1.Part
-	5,  // 1: inventory.v1.ListPartsRequest.filter:type_name -> inventory.v1.PartsFilter
-	6,  // 2: inventory.v1.ListPartsResponse.parts:type_name -> inventory.v1.Part
-	0,  // 3: inventory.v1.PartsFilter.categories:type_name -> inventory.v1.Category
-	0,  // 4: inventory.v1.Part.category:type_name -> inventory.v1.Category
-	7,  // 5: inventory.v1.Part.dimensions:type_name -> inventory.v1.Dimensions
-	8,  // 6: inventory.v1.Part.manufacturer:type_name -> inventory.v1.Manufacturer
-	10, // 7: inventory.v1.Part.metadata:type_name -> inventory.v1.Part.MetadataEntry
-	11, // 8: inventory.v1.Part.created_at:type_name -> google.protobuf.Timestamp
-	11, // 9: inventory.v1.Part.updated_at:type_name -> google.protobuf.Timestamp
-	9,  // 10: inventory.v1.Part.MetadataEntry.value:type_name -> inventory.v1.Value
-	1,  // 11: inventory.v1.InventoryService.GetPart:input_type -> inventory.v1.GetPartRequest
-	3,  // 12: inventory.v1.InventoryService.ListParts:input_type -> inventory.v1.ListPartsRequest
-	2,  // 13: inventory.v1.InventoryService.GetPart:output_type -> inventory.v1.GetPartResponse
-	4,  // 14: inventory.v1.InventoryService.ListParts:output_type -> inventory.v1.ListPartsResponse
-	13, // [13:15] is the sub-list for method output_type
-	11, // [11:13] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	94,  // 0: inventory.v1.GetPartRequest.as_of:type_name -> google.protobuf.Timestamp
+	84,  // 1: inventory.v1.GetPartResponse.part:type_name -> inventory.v1.Part
+	94,  // 2: inventory.v1.BatchGetPartsRequest.as_of:type_name -> google.protobuf.Timestamp
+	92,  // 3: inventory.v1.BatchGetPartsResponse.parts:type_name -> inventory.v1.BatchGetPartsResponse.PartsEntry
+	79,  // 4: inventory.v1.ListPartsRequest.filter:type_name -> inventory.v1.PartsFilter
+	13,  // 5: inventory.v1.ListPartsRequest.order_by:type_name -> inventory.v1.PartsOrderBy
+	94,  // 6: inventory.v1.ListPartsRequest.as_of:type_name -> google.protobuf.Timestamp
+	84,  // 7: inventory.v1.ListPartsResponse.parts:type_name -> inventory.v1.Part
+	0,   // 8: inventory.v1.PartsOrderBy.field:type_name -> inventory.v1.PartsOrderField
+	84,  // 9: inventory.v1.CreatePartRequest.part:type_name -> inventory.v1.Part
+	84,  // 10: inventory.v1.CreatePartResponse.part:type_name -> inventory.v1.Part
+	84,  // 11: inventory.v1.UpdatePartRequest.part:type_name -> inventory.v1.Part
+	95,  // 12: inventory.v1.UpdatePartRequest.update_mask:type_name -> google.protobuf.FieldMask
+	84,  // 13: inventory.v1.UpdatePartResponse.part:type_name -> inventory.v1.Part
+	1,   // 14: inventory.v1.ChangePartLifecycleStateRequest.state:type_name -> inventory.v1.PartLifecycleState
+	84,  // 15: inventory.v1.ChangePartLifecycleStateResponse.part:type_name -> inventory.v1.Part
+	2,   // 16: inventory.v1.AdjustStockRequest.reason:type_name -> inventory.v1.StockMovementReason
+	26,  // 17: inventory.v1.AdjustStockResponse.movement:type_name -> inventory.v1.StockMovement
+	26,  // 18: inventory.v1.ListStockMovementsResponse.movements:type_name -> inventory.v1.StockMovement
+	2,   // 19: inventory.v1.StockMovement.reason:type_name -> inventory.v1.StockMovementReason
+	94,  // 20: inventory.v1.StockMovement.created_at:type_name -> google.protobuf.Timestamp
+	79,  // 21: inventory.v1.WatchPartsRequest.filter:type_name -> inventory.v1.PartsFilter
+	3,   // 22: inventory.v1.WatchPartsResponse.type:type_name -> inventory.v1.PartEventType
+	84,  // 23: inventory.v1.WatchPartsResponse.part:type_name -> inventory.v1.Part
+	31,  // 24: inventory.v1.ExpandBomResponse.root:type_name -> inventory.v1.BomNode
+	84,  // 25: inventory.v1.BomNode.part:type_name -> inventory.v1.Part
+	31,  // 26: inventory.v1.BomNode.components:type_name -> inventory.v1.BomNode
+	90,  // 27: inventory.v1.CreateManufacturerRequest.manufacturer:type_name -> inventory.v1.Manufacturer
+	90,  // 28: inventory.v1.CreateManufacturerResponse.manufacturer:type_name -> inventory.v1.Manufacturer
+	90,  // 29: inventory.v1.GetManufacturerResponse.manufacturer:type_name -> inventory.v1.Manufacturer
+	90,  // 30: inventory.v1.ListManufacturersResponse.manufacturers:type_name -> inventory.v1.Manufacturer
+	90,  // 31: inventory.v1.UpdateManufacturerRequest.manufacturer:type_name -> inventory.v1.Manufacturer
+	90,  // 32: inventory.v1.UpdateManufacturerResponse.manufacturer:type_name -> inventory.v1.Manufacturer
+	89,  // 33: inventory.v1.CreateCategoryRequest.category:type_name -> inventory.v1.CategoryNode
+	89,  // 34: inventory.v1.CreateCategoryResponse.category:type_name -> inventory.v1.CategoryNode
+	89,  // 35: inventory.v1.GetCategoryResponse.category:type_name -> inventory.v1.CategoryNode
+	89,  // 36: inventory.v1.ListCategoriesResponse.categories:type_name -> inventory.v1.CategoryNode
+	89,  // 37: inventory.v1.UpdateCategoryRequest.category:type_name -> inventory.v1.CategoryNode
+	89,  // 38: inventory.v1.UpdateCategoryResponse.category:type_name -> inventory.v1.CategoryNode
+	56,  // 39: inventory.v1.SetCategoryMetadataSchemaRequest.fields:type_name -> inventory.v1.MetadataField
+	89,  // 40: inventory.v1.SetCategoryMetadataSchemaResponse.category:type_name -> inventory.v1.CategoryNode
+	56,  // 41: inventory.v1.GetCategoryMetadataSchemaResponse.fields:type_name -> inventory.v1.MetadataField
+	4,   // 42: inventory.v1.MetadataField.type:type_name -> inventory.v1.MetadataType
+	80,  // 43: inventory.v1.MetadataField.range:type_name -> inventory.v1.DoubleRange
+	94,  // 44: inventory.v1.SchedulePriceChangeRequest.effective_from:type_name -> google.protobuf.Timestamp
+	61,  // 45: inventory.v1.SchedulePriceChangeResponse.price_version:type_name -> inventory.v1.PriceVersion
+	61,  // 46: inventory.v1.GetPriceHistoryResponse.versions:type_name -> inventory.v1.PriceVersion
+	94,  // 47: inventory.v1.PriceVersion.effective_from:type_name -> google.protobuf.Timestamp
+	94,  // 48: inventory.v1.PriceVersion.created_at:type_name -> google.protobuf.Timestamp
+	84,  // 49: inventory.v1.ListLowStockPartsResponse.parts:type_name -> inventory.v1.Part
+	26,  // 50: inventory.v1.TransferStockResponse.outgoing:type_name -> inventory.v1.StockMovement
+	26,  // 51: inventory.v1.TransferStockResponse.incoming:type_name -> inventory.v1.StockMovement
+	67,  // 52: inventory.v1.FulfillStockRequest.items:type_name -> inventory.v1.FulfillmentItem
+	26,  // 53: inventory.v1.FulfillStockResponse.movements:type_name -> inventory.v1.StockMovement
+	86,  // 54: inventory.v1.CreateWarehouseRequest.warehouse:type_name -> inventory.v1.Warehouse
+	86,  // 55: inventory.v1.CreateWarehouseResponse.warehouse:type_name -> inventory.v1.Warehouse
+	86,  // 56: inventory.v1.GetWarehouseResponse.warehouse:type_name -> inventory.v1.Warehouse
+	86,  // 57: inventory.v1.ListWarehousesResponse.warehouses:type_name -> inventory.v1.Warehouse
+	79,  // 58: inventory.v1.GetPartFacetsRequest.filter:type_name -> inventory.v1.PartsFilter
+	77,  // 59: inventory.v1.GetPartFacetsResponse.categories:type_name -> inventory.v1.CategoryFacetCount
+	78,  // 60: inventory.v1.GetPartFacetsResponse.manufacturer_countries:type_name -> inventory.v1.FacetCount
+	78,  // 61: inventory.v1.GetPartFacetsResponse.tags:type_name -> inventory.v1.FacetCount
+	6,   // 62: inventory.v1.CategoryFacetCount.category:type_name -> inventory.v1.Category
+	6,   // 63: inventory.v1.PartsFilter.categories:type_name -> inventory.v1.Category
+	80,  // 64: inventory.v1.PartsFilter.price:type_name -> inventory.v1.DoubleRange
+	82,  // 65: inventory.v1.PartsFilter.dimensions:type_name -> inventory.v1.DimensionsFilter
+	81,  // 66: inventory.v1.PartsFilter.created_at:type_name -> inventory.v1.TimestampRange
+	81,  // 67: inventory.v1.PartsFilter.updated_at:type_name -> inventory.v1.TimestampRange
+	83,  // 68: inventory.v1.PartsFilter.metadata:type_name -> inventory.v1.MetadataPredicate
+	1,   // 69: inventory.v1.PartsFilter.lifecycle_states:type_name -> inventory.v1.PartLifecycleState
+	94,  // 70: inventory.v1.TimestampRange.from:type_name -> google.protobuf.Timestamp
+	94,  // 71: inventory.v1.TimestampRange.to:type_name -> google.protobuf.Timestamp
+	80,  // 72: inventory.v1.DimensionsFilter.length:type_name -> inventory.v1.DoubleRange
+	80,  // 73: inventory.v1.DimensionsFilter.width:type_name -> inventory.v1.DoubleRange
+	80,  // 74: inventory.v1.DimensionsFilter.height:type_name -> inventory.v1.DoubleRange
+	80,  // 75: inventory.v1.DimensionsFilter.weight:type_name -> inventory.v1.DoubleRange
+	5,   // 76: inventory.v1.MetadataPredicate.operator:type_name -> inventory.v1.MetadataOperator
+	91,  // 77: inventory.v1.MetadataPredicate.value:type_name -> inventory.v1.Value
+	6,   // 78: inventory.v1.Part.category:type_name -> inventory.v1.Category
+	88,  // 79: inventory.v1.Part.dimensions:type_name -> inventory.v1.Dimensions
+	90,  // 80: inventory.v1.Part.manufacturer:type_name -> inventory.v1.Manufacturer
+	93,  // 81: inventory.v1.Part.metadata:type_name -> inventory.v1.Part.MetadataEntry
+	94,  // 82: inventory.v1.Part.created_at:type_name -> google.protobuf.Timestamp
+	94,  // 83: inventory.v1.Part.updated_at:type_name -> google.protobuf.Timestamp
+	87,  // 84: inventory.v1.Part.components:type_name -> inventory.v1.BomComponent
+	85,  // 85: inventory.v1.Part.stock_levels:type_name -> inventory.v1.StockLevel
+	1,   // 86: inventory.v1.Part.lifecycle_state:type_name -> inventory.v1.PartLifecycleState
+	6,   // 87: inventory.v1.CategoryNode.legacy_category:type_name -> inventory.v1.Category
+	56,  // 88: inventory.v1.CategoryNode.metadata_schema:type_name -> inventory.v1.MetadataField
+	84,  // 89: inventory.v1.BatchGetPartsResponse.PartsEntry.value:type_name -> inventory.v1.Part
+	91,  // 90: inventory.v1.Part.MetadataEntry.value:type_name -> inventory.v1.Value
+	7,   // 91: inventory.v1.InventoryService.GetPart:input_type -> inventory.v1.GetPartRequest
+	9,   // 92: inventory.v1.InventoryService.BatchGetParts:input_type -> inventory.v1.BatchGetPartsRequest
+	11,  // 93: inventory.v1.InventoryService.ListParts:input_type -> inventory.v1.ListPartsRequest
+	75,  // 94: inventory.v1.InventoryService.GetPartFacets:input_type -> inventory.v1.GetPartFacetsRequest
+	14,  // 95: inventory.v1.InventoryService.CreatePart:input_type -> inventory.v1.CreatePartRequest
+	16,  // 96: inventory.v1.InventoryService.UpdatePart:input_type -> inventory.v1.UpdatePartRequest
+	18,  // 97: inventory.v1.InventoryService.DeletePart:input_type -> inventory.v1.DeletePartRequest
+	20,  // 98: inventory.v1.InventoryService.ChangePartLifecycleState:input_type -> inventory.v1.ChangePartLifecycleStateRequest
+	22,  // 99: inventory.v1.InventoryService.AdjustStock:input_type -> inventory.v1.AdjustStockRequest
+	24,  // 100: inventory.v1.InventoryService.ListStockMovements:input_type -> inventory.v1.ListStockMovementsRequest
+	64,  // 101: inventory.v1.InventoryService.TransferStock:input_type -> inventory.v1.TransferStockRequest
+	66,  // 102: inventory.v1.InventoryService.FulfillStock:input_type -> inventory.v1.FulfillStockRequest
+	62,  // 103: inventory.v1.InventoryService.ListLowStockParts:input_type -> inventory.v1.ListLowStockPartsRequest
+	57,  // 104: inventory.v1.InventoryService.SchedulePriceChange:input_type -> inventory.v1.SchedulePriceChangeRequest
+	59,  // 105: inventory.v1.InventoryService.GetPriceHistory:input_type -> inventory.v1.GetPriceHistoryRequest
+	27,  // 106: inventory.v1.InventoryService.WatchParts:input_type -> inventory.v1.WatchPartsRequest
+	29,  // 107: inventory.v1.InventoryService.ExpandBom:input_type -> inventory.v1.ExpandBomRequest
+	32,  // 108: inventory.v1.InventoryService.CreateManufacturer:input_type -> inventory.v1.CreateManufacturerRequest
+	34,  // 109: inventory.v1.InventoryService.GetManufacturer:input_type -> inventory.v1.GetManufacturerRequest
+	36,  // 110: inventory.v1.InventoryService.ListManufacturers:input_type -> inventory.v1.ListManufacturersRequest
+	38,  // 111: inventory.v1.InventoryService.UpdateManufacturer:input_type -> inventory.v1.UpdateManufacturerRequest
+	40,  // 112: inventory.v1.InventoryService.DeleteManufacturer:input_type -> inventory.v1.DeleteManufacturerRequest
+	42,  // 113: inventory.v1.InventoryService.CreateCategory:input_type -> inventory.v1.CreateCategoryRequest
+	44,  // 114: inventory.v1.InventoryService.GetCategory:input_type -> inventory.v1.GetCategoryRequest
+	46,  // 115: inventory.v1.InventoryService.ListCategories:input_type -> inventory.v1.ListCategoriesRequest
+	48,  // 116: inventory.v1.InventoryService.UpdateCategory:input_type -> inventory.v1.UpdateCategoryRequest
+	50,  // 117: inventory.v1.InventoryService.DeleteCategory:input_type -> inventory.v1.DeleteCategoryRequest
+	52,  // 118: inventory.v1.InventoryService.SetCategoryMetadataSchema:input_type -> inventory.v1.SetCategoryMetadataSchemaRequest
+	54,  // 119: inventory.v1.InventoryService.GetCategoryMetadataSchema:input_type -> inventory.v1.GetCategoryMetadataSchemaRequest
+	69,  // 120: inventory.v1.InventoryService.CreateWarehouse:input_type -> inventory.v1.CreateWarehouseRequest
+	71,  // 121: inventory.v1.InventoryService.GetWarehouse:input_type -> inventory.v1.GetWarehouseRequest
+	73,  // 122: inventory.v1.InventoryService.ListWarehouses:input_type -> inventory.v1.ListWarehousesRequest
+	8,   // 123: inventory.v1.InventoryService.GetPart:output_type -> inventory.v1.GetPartResponse
+	10,  // 124: inventory.v1.InventoryService.BatchGetParts:output_type -> inventory.v1.BatchGetPartsResponse
+	12,  // 125: inventory.v1.InventoryService.ListParts:output_type -> inventory.v1.ListPartsResponse
+	76,  // 126: inventory.v1.InventoryService.GetPartFacets:output_type -> inventory.v1.GetPartFacetsResponse
+	15,  // 127: inventory.v1.InventoryService.CreatePart:output_type -> inventory.v1.CreatePartResponse
+	17,  // 128: inventory.v1.InventoryService.UpdatePart:output_type -> inventory.v1.UpdatePartResponse
+	19,  // 129: inventory.v1.InventoryService.DeletePart:output_type -> inventory.v1.DeletePartResponse
+	21,  // 130: inventory.v1.InventoryService.ChangePartLifecycleState:output_type -> inventory.v1.ChangePartLifecycleStateResponse
+	23,  // 131: inventory.v1.InventoryService.AdjustStock:output_type -> inventory.v1.AdjustStockResponse
+	25,  // 132: inventory.v1.InventoryService.ListStockMovements:output_type -> inventory.v1.ListStockMovementsResponse
+	65,  // 133: inventory.v1.InventoryService.TransferStock:output_type -> inventory.v1.TransferStockResponse
+	68,  // 134: inventory.v1.InventoryService.FulfillStock:output_type -> inventory.v1.FulfillStockResponse
+	63,  // 135: inventory.v1.InventoryService.ListLowStockParts:output_type -> inventory.v1.ListLowStockPartsResponse
+	58,  // 136: inventory.v1.InventoryService.SchedulePriceChange:output_type -> inventory.v1.SchedulePriceChangeResponse
+	60,  // 137: inventory.v1.InventoryService.GetPriceHistory:output_type -> inventory.v1.GetPriceHistoryResponse
+	28,  // 138: inventory.v1.InventoryService.WatchParts:output_type -> inventory.v1.WatchPartsResponse
+	30,  // 139: inventory.v1.InventoryService.ExpandBom:output_type -> inventory.v1.ExpandBomResponse
+	33,  // 140: inventory.v1.InventoryService.CreateManufacturer:output_type -> inventory.v1.CreateManufacturerResponse
+	35,  // 141: inventory.v1.InventoryService.GetManufacturer:output_type -> inventory.v1.GetManufacturerResponse
+	37,  // 142: inventory.v1.InventoryService.ListManufacturers:output_type -> inventory.v1.ListManufacturersResponse
+	39,  // 143: inventory.v1.InventoryService.UpdateManufacturer:output_type -> inventory.v1.UpdateManufacturerResponse
+	41,  // 144: inventory.v1.InventoryService.DeleteManufacturer:output_type -> inventory.v1.DeleteManufacturerResponse
+	43,  // 145: inventory.v1.InventoryService.CreateCategory:output_type -> inventory.v1.CreateCategoryResponse
+	45,  // 146: inventory.v1.InventoryService.GetCategory:output_type -> inventory.v1.GetCategoryResponse
+	47,  // 147: inventory.v1.InventoryService.ListCategories:output_type -> inventory.v1.ListCategoriesResponse
+	49,  // 148: inventory.v1.InventoryService.UpdateCategory:output_type -> inventory.v1.UpdateCategoryResponse
+	51,  // 149: inventory.v1.InventoryService.DeleteCategory:output_type -> inventory.v1.DeleteCategoryResponse
+	53,  // 150: inventory.v1.InventoryService.SetCategoryMetadataSchema:output_type -> inventory.v1.SetCategoryMetadataSchemaResponse
+	55,  // 151: inventory.v1.InventoryService.GetCategoryMetadataSchema:output_type -> inventory.v1.GetCategoryMetadataSchemaResponse
+	70,  // 152: inventory.v1.InventoryService.CreateWarehouse:output_type -> inventory.v1.CreateWarehouseResponse
+	72,  // 153: inventory.v1.InventoryService.GetWarehouse:output_type -> inventory.v1.GetWarehouseResponse
+	74,  // 154: inventory.v1.InventoryService.ListWarehouses:output_type -> inventory.v1.ListWarehousesResponse
+	123, // [123:155] is the sub-list for method output_type
+	91,  // [91:123] is the sub-list for method input_type
+	91,  // [91:91] is the sub-list for extension type_name
+	91,  // [91:91] is the sub-list for extension extendee
+	0,   // [0:91] is the sub-list for field type_name
 }
 
 func init() { file_inventory_v1_inventory_proto_init() }
@@ -883,7 +6335,8 @@ func file_inventory_v1_inventory_proto_init() {
 	if File_inventory_v1_inventory_proto != nil {
 		return
 	}
-	file_inventory_v1_inventory_proto_msgTypes[8].OneofWrappers = []any{
+	file_inventory_v1_inventory_proto_msgTypes[73].OneofWrappers = []any{}
+	file_inventory_v1_inventory_proto_msgTypes[84].OneofWrappers = []any{
 		(*Value_StringValue)(nil),
 		(*Value_Int64Value)(nil),
 		(*Value_DoubleValue)(nil),
@@ -894,8 +6347,8 @@ func file_inventory_v1_inventory_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_inventory_v1_inventory_proto_rawDesc), len(file_inventory_v1_inventory_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   10,
+			NumEnums:      7,
+			NumMessages:   87,
 			NumExtensions: 0,
 			NumServices:   1,
 		},